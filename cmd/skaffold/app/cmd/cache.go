@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/GoogleContainerTools/skaffold/cmd/skaffold/app/cmd/cache"
+)
+
+func NewCmdCache() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Inspect and clean up the artifact cache",
+	}
+
+	cmd.AddCommand(NewCmdCacheList())
+	cmd.AddCommand(NewCmdCacheInspect())
+	cmd.AddCommand(NewCmdCachePrune())
+	cmd.AddCommand(NewCmdCacheClear())
+	return cmd
+}
+
+func NewCmdCacheList() *cobra.Command {
+	return NewCmd("list").
+		WithDescription("List the entries of the artifact cache").
+		WithFlagAdder(cache.AddCommonFlags).
+		NoArgs(cache.List)
+}
+
+func NewCmdCacheInspect() *cobra.Command {
+	return NewCmd("inspect <image>").
+		WithDescription("Print the cache entries of an image, with the inputs their hashes were calculated from").
+		WithExample("Print the cache entries of an artifact", "cache inspect leeroy-web").
+		WithFlagAdder(cache.AddCommonFlags).
+		ExactArgs(1, cache.Inspect)
+}
+
+func NewCmdCachePrune() *cobra.Command {
+	return NewCmd("prune").
+		WithDescription("Remove the cache entries that weren't used recently").
+		WithExample("Remove the cache entries that weren't used in the last week", "cache prune --older-than 168h").
+		WithFlagAdder(func(f *pflag.FlagSet) {
+			cache.AddCommonFlags(f)
+			cache.AddPruneFlags(f)
+		}).
+		NoArgs(cache.Prune)
+}
+
+func NewCmdCacheClear() *cobra.Command {
+	return NewCmd("clear").
+		WithDescription("Remove all the entries of the artifact cache").
+		WithFlagAdder(cache.AddCommonFlags).
+		NoArgs(cache.Clear)
+}
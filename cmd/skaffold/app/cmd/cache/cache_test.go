@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cache"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+var testCache = cache.ArtifactCache{
+	"0123456789abcdef": {
+		ID:       "sha256:image1",
+		Image:    "image1",
+		LastUsed: time.Date(2021, time.May, 1, 12, 0, 0, 0, time.UTC),
+		Inputs: &cache.InputDigests{
+			Config:    map[string]string{"docker.dockerfile": "digest1"},
+			Files:     map[string]string{"Dockerfile": "digest2", "main.go": "digest3"},
+			BuildArgs: map[string]string{"VERSION": "digest4"},
+		},
+	},
+	"fedcba9876543210": {
+		Digest:   "sha256:image2",
+		Image:    "image2",
+		LastUsed: time.Date(2021, time.May, 2, 12, 0, 0, 0, time.UTC),
+	},
+	"legacy": {ID: "sha256:legacy"},
+}
+
+func TestList(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&cacheFile, t.NewTempDir().Path("cache"))
+		t.CheckNoError(cache.WriteCacheFile(cacheFile, testCache))
+
+		var out bytes.Buffer
+		err := List(context.Background(), &out)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(`HASH          IMAGE   ID/DIGEST      LAST USED
+fedcba987654  image2  sha256:image2  2021-05-02T12:00:00Z
+0123456789ab  image1  sha256:image1  2021-05-01T12:00:00Z
+legacy        <none>  sha256:legacy  <unknown>
+`, out.String())
+	})
+}
+
+func TestInspect(t *testing.T) {
+	tests := []struct {
+		description    string
+		arg            string
+		shouldErr      bool
+		expectedOutput string
+	}{
+		{
+			description: "by image",
+			arg:         "image1",
+			expectedOutput: `0123456789abcdef:
+  id: sha256:image1
+  image: image1
+  lastUsed: 2021-05-01T12:00:00Z
+  inputs:
+    config:
+      docker.dockerfile: digest1
+    files:
+      Dockerfile: digest2
+      main.go: digest3
+    buildArgs:
+      VERSION: digest4
+`,
+		},
+		{
+			description: "by hash prefix",
+			arg:         "fedcba",
+			expectedOutput: `fedcba9876543210:
+  digest: sha256:image2
+  image: image2
+  lastUsed: 2021-05-02T12:00:00Z
+`,
+		},
+		{
+			description: "not found",
+			arg:         "unknown",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&cacheFile, t.NewTempDir().Path("cache"))
+			t.CheckNoError(cache.WriteCacheFile(cacheFile, testCache))
+
+			var out bytes.Buffer
+			err := Inspect(context.Background(), &out, []string{test.arg})
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedOutput, out.String())
+		})
+	}
+}
+
+func TestPrune(t *testing.T) {
+	tests := []struct {
+		description    string
+		olderThan      time.Duration
+		shouldErr      bool
+		expectedOutput string
+		expectedKept   int
+	}{
+		{
+			description:    "prune everything older than the entries",
+			olderThan:      time.Hour,
+			expectedOutput: "Pruned 3 cache entries, 0 left\n",
+		},
+		{
+			description:    "only prune entries without a last use time",
+			olderThan:      100 * 365 * 24 * time.Hour,
+			expectedOutput: "Pruned 1 cache entries, 2 left\n",
+			expectedKept:   2,
+		},
+		{
+			description: "invalid duration",
+			shouldErr:   true,
+			// The cache is untouched
+			expectedKept: 3,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&cacheFile, t.NewTempDir().Path("cache"))
+			t.Override(&olderThan, test.olderThan)
+			t.CheckNoError(cache.WriteCacheFile(cacheFile, testCache))
+
+			var out bytes.Buffer
+			err := Prune(context.Background(), &out)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedOutput, out.String())
+			_, artifactCache, err := cache.ReadCacheFile(cacheFile)
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedKept, len(artifactCache))
+		})
+	}
+}
+
+func TestClear(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&cacheFile, t.NewTempDir().Path("cache"))
+		t.CheckNoError(cache.WriteCacheFile(cacheFile, testCache))
+
+		var out bytes.Buffer
+		err := Clear(context.Background(), &out)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("Removed 3 cache entries\n", out.String())
+		_, artifactCache, err := cache.ReadCacheFile(cacheFile)
+		t.CheckNoError(err)
+		t.CheckEmpty(artifactCache)
+	})
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cache"
+)
+
+// Clear removes all the entries of the artifact cache.
+func Clear(_ context.Context, out io.Writer) error {
+	path, artifactCache, err := cache.ReadCacheFile(cacheFile)
+	if err != nil {
+		return err
+	}
+
+	if err := cache.WriteCacheFile(path, cache.ArtifactCache{}); err != nil {
+		return fmt.Errorf("writing cache file %q: %w", path, err)
+	}
+
+	fmt.Fprintf(out, "Removed %d cache entries\n", len(artifactCache))
+	return nil
+}
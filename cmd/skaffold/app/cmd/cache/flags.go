@@ -0,0 +1,36 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+var (
+	cacheFile string
+	olderThan time.Duration
+)
+
+func AddCommonFlags(f *pflag.FlagSet) {
+	f.StringVar(&cacheFile, "cache-file", "", "Specify the location of the cache file (default $HOME/.skaffold/cache)")
+}
+
+func AddPruneFlags(f *pflag.FlagSet) {
+	f.DurationVar(&olderThan, "older-than", 30*24*time.Hour, "Remove the entries that weren't used for this long")
+}
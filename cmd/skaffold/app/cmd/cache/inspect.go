@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cache"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// Inspect prints the cache entries of an image, or the entries whose hash starts with the given prefix.
+func Inspect(_ context.Context, out io.Writer, args []string) error {
+	_, artifactCache, err := cache.ReadCacheFile(cacheFile)
+	if err != nil {
+		return err
+	}
+
+	image := args[0]
+	matching := cache.ArtifactCache{}
+	for hash, entry := range artifactCache {
+		if entry.Image == image {
+			matching[hash] = entry
+		}
+	}
+	if len(matching) == 0 {
+		// Fallback to hashes, as printed by `skaffold cache list`
+		for hash, entry := range artifactCache {
+			if strings.HasPrefix(hash, image) {
+				matching[hash] = entry
+			}
+		}
+	}
+	if len(matching) == 0 {
+		return fmt.Errorf("no cache entry found for %q", image)
+	}
+
+	buf, err := yaml.Marshal(matching)
+	if err != nil {
+		return fmt.Errorf("marshaling cache entries: %w", err)
+	}
+	_, err = out.Write(buf)
+	return err
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cache"
+)
+
+// List prints the entries of the artifact cache, most recently used first.
+func List(_ context.Context, out io.Writer) error {
+	_, artifactCache, err := cache.ReadCacheFile(cacheFile)
+	if err != nil {
+		return err
+	}
+
+	hashes := make([]string, 0, len(artifactCache))
+	for hash := range artifactCache {
+		hashes = append(hashes, hash)
+	}
+	sort.Slice(hashes, func(i, j int) bool {
+		ei, ej := artifactCache[hashes[i]], artifactCache[hashes[j]]
+		if !ei.LastUsed.Equal(ej.LastUsed) {
+			return ei.LastUsed.After(ej.LastUsed)
+		}
+		return hashes[i] < hashes[j]
+	})
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "HASH\tIMAGE\tID/DIGEST\tLAST USED")
+	for _, hash := range hashes {
+		entry := artifactCache[hash]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", shortHash(hash), orNone(entry.Image), orNone(imageRef(entry)), lastUsed(entry))
+	}
+	return w.Flush()
+}
+
+func shortHash(hash string) string {
+	if len(hash) > 12 {
+		return hash[:12]
+	}
+	return hash
+}
+
+func imageRef(entry cache.ImageDetails) string {
+	if entry.ID != "" {
+		return entry.ID
+	}
+	return entry.Digest
+}
+
+func lastUsed(entry cache.ImageDetails) string {
+	if entry.LastUsed.IsZero() {
+		return "<unknown>"
+	}
+	return entry.LastUsed.Format(time.RFC3339)
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
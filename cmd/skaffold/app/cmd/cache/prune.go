@@ -0,0 +1,46 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cache"
+)
+
+// Prune removes the cache entries that weren't used for longer than `--older-than`.
+func Prune(_ context.Context, out io.Writer) error {
+	if olderThan <= 0 {
+		return fmt.Errorf("invalid value for --older-than: %s. Must be positive", olderThan)
+	}
+
+	path, artifactCache, err := cache.ReadCacheFile(cacheFile)
+	if err != nil {
+		return err
+	}
+
+	pruned := artifactCache.Prune(time.Now().Add(-olderThan))
+	if err := cache.WriteCacheFile(path, artifactCache); err != nil {
+		return fmt.Errorf("writing cache file %q: %w", path, err)
+	}
+
+	fmt.Fprintf(out, "Pruned %d cache entries, %d left\n", len(pruned), len(artifactCache))
+	return nil
+}
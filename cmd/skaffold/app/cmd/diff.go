@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/diff"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+var (
+	diffShowBuild bool
+	diffOutput    string
+
+	// for tests
+	computeDiff = diff.Compute
+)
+
+// NewCmdDiff describes the CLI command to compare the rendered manifests with the live cluster.
+func NewCmdDiff() *cobra.Command {
+	return NewCmd("diff").
+		WithDescription("[alpha] Show the differences between the rendered Kubernetes manifests and the live cluster").
+		WithLongDescription("Render the Kubernetes manifests and compare each object with its live version in the cluster. Fields that are set by the cluster, like defaults and status, are ignored. Exits with a non-zero code if at least one object differs.").
+		WithExample("Build the artifacts and show what a deployment would change", "diff").
+		WithExample("Show what a deployment of previously built artifacts would change", "diff --build-artifacts=tags.json").
+		WithExample("Print the differences in json", "diff --output=json").
+		WithCommonFlags().
+		WithFlags([]*Flag{
+			{Value: &diffShowBuild, Name: "loud", DefValue: false, Usage: "Show the build logs and output", IsEnum: true},
+			{Value: &diffOutput, Name: "output", Shorthand: "o", DefValue: "text", Usage: "Output format. One of: text, json"},
+		}).
+		WithHouseKeepingMessages().
+		NoArgs(doDiff)
+}
+
+func doDiff(ctx context.Context, out io.Writer) error {
+	if diffOutput != "text" && diffOutput != "json" {
+		return fmt.Errorf("invalid output format %q, must be one of: text, json", diffOutput)
+	}
+
+	buildOut := ioutil.Discard
+	if diffShowBuild {
+		buildOut = out
+	}
+
+	return withRunner(ctx, out, func(r runner.Runner, configs []*latest_v1.SkaffoldConfig) error {
+		var bRes []graph.Artifact
+		var err error
+		if fromBuildOutputFile.String() != "" {
+			bRes, err = getBuildArtifactsAndSetTags(targetArtifacts(opts, configs), r.ApplyDefaultRepo)
+		} else {
+			bRes, err = r.Build(ctx, buildOut, targetArtifacts(opts, configs))
+		}
+		if err != nil {
+			return fmt.Errorf("executing build: %w", err)
+		}
+
+		var rendered bytes.Buffer
+		if err := r.Render(ctx, &rendered, bRes, false, ""); err != nil {
+			return fmt.Errorf("rendering manifests: %w", err)
+		}
+		manifests, err := manifest.Load(&rendered)
+		if err != nil {
+			return fmt.Errorf("reading rendered manifests: %w", err)
+		}
+
+		diffs, err := computeDiff(ctx, manifests, opts.Namespace)
+		if err != nil {
+			return fmt.Errorf("comparing with the live cluster: %w", err)
+		}
+
+		if err := printDiffs(out, diffs); err != nil {
+			return err
+		}
+		if diff.HasDrift(diffs) {
+			return fmt.Errorf("%d of %d objects differ from the live cluster", countDrift(diffs), len(diffs))
+		}
+		return nil
+	})
+}
+
+func printDiffs(out io.Writer, diffs []diff.ObjectDiff) error {
+	if diffOutput == "json" {
+		if diffs == nil {
+			diffs = []diff.ObjectDiff{}
+		}
+		return json.NewEncoder(out).Encode(struct {
+			Drift   bool              `json:"drift"`
+			Objects []diff.ObjectDiff `json:"objects"`
+		}{
+			Drift:   diff.HasDrift(diffs),
+			Objects: diffs,
+		})
+	}
+
+	for _, d := range diffs {
+		switch d.Status {
+		case diff.StatusAdded:
+			color.Green.Fprintf(out, "%s will be created\n", d)
+		case diff.StatusChanged:
+			color.Yellow.Fprintf(out, "%s will be changed\n", d)
+		default:
+			continue
+		}
+
+		for _, line := range strings.Split(strings.TrimSuffix(d.Diff, "\n"), "\n") {
+			switch {
+			case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+				color.Default.Fprintln(out, line)
+			case strings.HasPrefix(line, "+"):
+				color.Green.Fprintln(out, line)
+			case strings.HasPrefix(line, "-"):
+				color.Red.Fprintln(out, line)
+			default:
+				color.Default.Fprintln(out, line)
+			}
+		}
+	}
+	if !diff.HasDrift(diffs) {
+		color.Default.Fprintln(out, "No differences with the live cluster")
+	}
+	return nil
+}
+
+func countDrift(diffs []diff.ObjectDiff) int {
+	count := 0
+	for _, d := range diffs {
+		if d.Status != diff.StatusUnchanged {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/diff"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type mockDiffRunner struct {
+	mockRunner
+	rendered []graph.Artifact
+}
+
+func (r *mockDiffRunner) Render(_ context.Context, out io.Writer, builds []graph.Artifact, _ bool, _ string) error {
+	r.rendered = builds
+	_, err := out.Write([]byte("apiVersion: v1\nkind: Pod\nmetadata:\n  name: web\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n"))
+	return err
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		description    string
+		diffs          []diff.ObjectDiff
+		output         string
+		shouldErr      bool
+		expectedOutput string
+	}{
+		{
+			description: "no drift",
+			diffs: []diff.ObjectDiff{
+				{APIVersion: "v1", Kind: "Pod", Namespace: "ns", Name: "web", Status: diff.StatusUnchanged},
+				{APIVersion: "v1", Kind: "ConfigMap", Namespace: "ns", Name: "config", Status: diff.StatusUnchanged},
+			},
+			output:         "text",
+			expectedOutput: "No differences with the live cluster\n",
+		},
+		{
+			description: "drift",
+			diffs: []diff.ObjectDiff{
+				{APIVersion: "v1", Kind: "Pod", Namespace: "ns", Name: "web", Status: diff.StatusChanged, Diff: "--- live\n+++ rendered\n@@ -1 +1 @@\n-image: web:v1\n+image: web:v2\n"},
+				{APIVersion: "v1", Kind: "ConfigMap", Namespace: "ns", Name: "config", Status: diff.StatusAdded, Diff: "+kind: ConfigMap\n"},
+			},
+			output:         "text",
+			shouldErr:      true,
+			expectedOutput: "pod/web in namespace ns will be changed\n--- live\n+++ rendered\n@@ -1 +1 @@\n-image: web:v1\n+image: web:v2\nconfigmap/config in namespace ns will be created\n+kind: ConfigMap\n",
+		},
+		{
+			description: "json",
+			diffs: []diff.ObjectDiff{
+				{APIVersion: "v1", Kind: "Pod", Namespace: "ns", Name: "web", Status: diff.StatusChanged, Diff: "-a\n+b\n"},
+				{APIVersion: "v1", Kind: "ConfigMap", Namespace: "ns", Name: "config", Status: diff.StatusUnchanged},
+			},
+			output:         "json",
+			shouldErr:      true,
+			expectedOutput: `{"drift":true,"objects":[{"apiVersion":"v1","kind":"Pod","namespace":"ns","name":"web","status":"changed","diff":"-a\n+b\n"},{"apiVersion":"v1","kind":"ConfigMap","namespace":"ns","name":"config","status":"unchanged"}]}` + "\n",
+		},
+		{
+			description: "invalid output",
+			output:      "yaml",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			mock := &mockDiffRunner{}
+			t.Override(&createRunner, func(io.Writer, config.SkaffoldOptions) (runner.Runner, []*latest_v1.SkaffoldConfig, *runcontext.RunContext, error) {
+				return mock, []*latest_v1.SkaffoldConfig{{}}, nil, nil
+			})
+			t.Override(&opts.Namespace, "ns")
+			t.Override(&diffOutput, test.output)
+			var computed manifest.ManifestList
+			t.Override(&computeDiff, func(_ context.Context, manifests manifest.ManifestList, namespace string) ([]diff.ObjectDiff, error) {
+				computed = manifests
+				t.CheckDeepEqual("ns", namespace)
+				return test.diffs, nil
+			})
+
+			var out bytes.Buffer
+			err := doDiff(context.Background(), &out)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+			if test.diffs != nil {
+				t.CheckDeepEqual([]graph.Artifact{{ImageName: "gcr.io/skaffold/example", Tag: "test"}}, mock.rendered)
+				t.CheckDeepEqual(2, len(computed))
+			}
+		})
+	}
+}
@@ -101,7 +101,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.Profiles,
 		DefValue:      []string{},
 		FlagAddMethod: "StringSliceVar",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose", "apply"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose", "apply", "verify", "diff"},
 	},
 	{
 		Name:          "namespace",
@@ -110,7 +110,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.Namespace,
 		DefValue:      "",
 		FlagAddMethod: "StringVar",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "apply"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "apply", "verify", "diff"},
 	},
 	{
 		Name:          "default-repo",
@@ -119,7 +119,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.DefaultRepo,
 		DefValue:      nil,
 		FlagAddMethod: "Var",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "verify", "diff"},
 	},
 	{
 		Name:          "cache-artifacts",
@@ -127,7 +127,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.CacheArtifacts,
 		DefValue:      true,
 		FlagAddMethod: "BoolVar",
-		DefinedOn:     []string{"dev", "build", "run", "debug", "render"},
+		DefinedOn:     []string{"dev", "build", "run", "debug", "render", "diff"},
 		IsEnum:        true,
 	},
 	{
@@ -138,6 +138,31 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "StringVar",
 		DefinedOn:     []string{"dev", "build", "run", "debug"},
 	},
+	{
+		Name:          "explain-cache",
+		Usage:         "Print which inputs of an artifact changed since it was last found in the cache, when it needs to be rebuilt",
+		Value:         &opts.ExplainCache,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug"},
+	},
+	{
+		Name:          "detect-drift",
+		Usage:         "Periodically check whether deployed resources were changed in the cluster and no longer match the applied manifests",
+		Value:         &opts.DetectDrift,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "shared-cache",
+		Usage:         "Location of an artifact cache shared with other machines: a directory, for instance on a network volume, or an image repository prefixed with oci://",
+		Value:         &opts.SharedCache,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug"},
+	},
 	{
 		Name:          "remote-cache-dir",
 		Usage:         "Specify the location of the git repositories cache (default $HOME/.skaffold/repos)",
@@ -198,7 +223,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.CustomLabels,
 		DefValue:      []string{},
 		FlagAddMethod: "StringSliceVar",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "diff"},
 	},
 	{
 		Name:          "toot",
@@ -222,6 +247,15 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "run", "debug", "deploy", "apply"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "persist-logs",
+		Usage:         "Persist the streamed container logs to .skaffold/logs, replacing the logs of the previous run, so that `skaffold logs` can print them",
+		Value:         &opts.PersistLogs,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
+		IsEnum:        true,
+	},
 	{
 		Name:          "force",
 		Usage:         "Recreate Kubernetes resources if necessary for deployment, warning: might cause downtime!",
@@ -240,6 +274,15 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "run", "debug", "build"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "verify",
+		Usage:         "Run the verify tests after deploying",
+		Value:         &opts.Verify,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "debug"},
+		IsEnum:        true,
+	},
 	{
 		Name:          "cleanup",
 		Usage:         "Delete deployments after dev or debug mode is interrupted",
@@ -325,7 +368,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.KubeContext,
 		DefValue:      "",
 		FlagAddMethod: "StringVar",
-		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "filter", "apply"},
+		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "filter", "apply", "verify"},
 	},
 	{
 		Name:          "kubeconfig",
@@ -333,7 +376,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.KubeConfig,
 		DefValue:      "",
 		FlagAddMethod: "StringVar",
-		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "filter", "apply"},
+		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "filter", "apply", "verify"},
 	},
 	{
 		Name:          "tag",
@@ -362,7 +405,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.ProfileAutoActivation,
 		DefValue:      true,
 		FlagAddMethod: "BoolVar",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose", "verify", "diff"},
 		IsEnum:        true,
 	},
 	{
@@ -400,6 +443,14 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "debug"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "native-sync",
+		Usage:         "Sync files over the Kubernetes API instead of `kubectl exec`, using a helper container for images without `tar`",
+		Value:         &opts.NativeSync,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug"},
+	},
 	{
 		Name:     "auto-deploy",
 		Usage:    "When set to false, deploys wait for API request instead of running automatically",
@@ -499,7 +550,7 @@ var flagRegistry = []Flag{
 		Value:         &fromBuildOutputFile,
 		DefValue:      "",
 		FlagAddMethod: "Var",
-		DefinedOn:     []string{"test", "deploy"},
+		DefinedOn:     []string{"test", "deploy", "verify", "diff"},
 	},
 	{
 		Name:          "auto-create-config",
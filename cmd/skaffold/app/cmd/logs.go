@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/logfile"
+)
+
+var (
+	logsIteration int
+	logsContainer string
+	logsSince     time.Duration
+	logsFollow    bool
+
+	// for tests
+	logsDir          = logfile.StoreDir
+	logsPollInterval = time.Second
+)
+
+// NewCmdLogs describes the CLI command to replay the persisted container logs.
+func NewCmdLogs() *cobra.Command {
+	return NewCmd("logs").
+		WithDescription("[alpha] Print the container logs persisted by Skaffold").
+		WithLongDescription("Print the container logs persisted by the last `skaffold dev`, `skaffold debug` or `skaffold run --tail` run with `--persist-logs` in the current directory, even after Skaffold has exited.").
+		WithExample("Print all the persisted logs", "logs").
+		WithExample("Print the logs of the 'web' containers during dev iteration 2", "logs --iteration=2 --container=web").
+		WithExample("Print the logs of the last 5 minutes and wait for new logs", "logs --since=5m --follow").
+		WithFlags([]*Flag{
+			{Value: &logsIteration, Name: "iteration", DefValue: -1, FlagAddMethod: "IntVar", Usage: "Only print the logs of this dev iteration. Prints the logs of all the iterations by default"},
+			{Value: &logsContainer, Name: "container", DefValue: "", Usage: "Only print the logs of the containers with this name"},
+			{Value: &logsSince, Name: "since", DefValue: time.Duration(0), FlagAddMethod: "DurationVar", Usage: "Only print the logs more recent than a relative duration like 5s, 2m, or 3h"},
+			{Value: &logsFollow, Name: "follow", Shorthand: "f", DefValue: false, Usage: "Wait for new logs to be persisted"},
+		}).
+		NoArgs(doLogs)
+}
+
+func doLogs(ctx context.Context, out io.Writer) error {
+	q := logfile.Query{
+		Iteration: logsIteration,
+		Container: logsContainer,
+	}
+	if logsSince > 0 {
+		q.Since = time.Now().Add(-logsSince)
+	}
+
+	p := &logsPrinter{out: out, iteration: -1}
+	if logsFollow {
+		return logfile.Follow(ctx, logsDir, q, logsPollInterval, p.print)
+	}
+
+	entries, err := logfile.Read(logsDir, q)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf("no logs found in %s. Logs are persisted by `skaffold dev`, `skaffold debug` and `skaffold run --tail` when `--persist-logs` is set", logsDir)
+	}
+	for _, e := range entries {
+		p.print(e)
+	}
+	return nil
+}
+
+// logsPrinter prints the persisted log lines like they were printed by the log tailer,
+// with a header for each dev iteration.
+type logsPrinter struct {
+	out       io.Writer
+	iteration int
+}
+
+func (p *logsPrinter) print(e logfile.Entry) {
+	if e.Iteration != p.iteration {
+		color.Yellow.Fprintf(p.out, "Iteration %d\n", e.Iteration)
+		p.iteration = e.Iteration
+	}
+
+	color.Default.Fprintf(p.out, "[%s %s] ", e.Pod, e.Container)
+	fmt.Fprint(p.out, e.Text)
+}
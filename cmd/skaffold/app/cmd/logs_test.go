@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/logfile"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestDoLogs(t *testing.T) {
+	tests := []struct {
+		description string
+		iteration   int
+		container   string
+		since       time.Duration
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "all logs",
+			iteration:   -1,
+			expected:    "Iteration 0\n[web-1 web] old\n[db-1 db] starting\nIteration 1\n[web-2 web] recent\n",
+		},
+		{
+			description: "single iteration",
+			iteration:   1,
+			expected:    "Iteration 1\n[web-2 web] recent\n",
+		},
+		{
+			description: "single container",
+			iteration:   -1,
+			container:   "db",
+			expected:    "Iteration 0\n[db-1 db] starting\n",
+		},
+		{
+			description: "since",
+			iteration:   -1,
+			since:       time.Minute,
+			expected:    "Iteration 1\n[web-2 web] recent\n",
+		},
+		{
+			description: "no logs",
+			iteration:   3,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			dir := t.NewTempDir()
+			store, err := logfile.NewStore(dir.Root())
+			t.CheckNoError(err)
+			t.CheckNoError(store.Write("web-1", "web", time.Now().Add(-time.Hour), "old"))
+			t.CheckNoError(store.Write("db-1", "db", time.Now().Add(-time.Hour+time.Second), "starting"))
+			store.SetIteration(1)
+			t.CheckNoError(store.Write("web-2", "web", time.Now(), "recent"))
+			store.Close()
+
+			t.Override(&logsDir, dir.Root())
+			t.Override(&logsIteration, test.iteration)
+			t.Override(&logsContainer, test.container)
+			t.Override(&logsSince, test.since)
+			t.Override(&logsFollow, false)
+
+			var out bytes.Buffer
+			err = doLogs(context.Background(), &out)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, out.String())
+		})
+	}
+}
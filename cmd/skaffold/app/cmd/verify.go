@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleContainerTools/skaffold/cmd/skaffold/app/tips"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// NewCmdVerify describes the CLI command to verify a deployed application.
+func NewCmdVerify() *cobra.Command {
+	return NewCmd("verify").
+		WithDescription("Run verify tests against your deployed application").
+		WithExample("Build the artifacts and collect the tags into a file", "build --file-output=tags.json").
+		WithExample("Run verify tests that reference images previously built by Skaffold into a 'tags.json' file", "verify --build-artifacts=tags.json").
+		WithCommonFlags().
+		WithHouseKeepingMessages().
+		NoArgs(doVerify)
+}
+
+func doVerify(ctx context.Context, out io.Writer) error {
+	return withRunner(ctx, out, func(r runner.Runner, configs []*latest_v1.SkaffoldConfig) error {
+		var artifacts []*latest_v1.Artifact
+		for _, c := range configs {
+			artifacts = append(artifacts, c.Build.Artifacts...)
+		}
+		buildArtifacts, err := getBuildArtifactsAndSetTags(artifacts, r.ApplyDefaultRepo)
+		if err != nil {
+			tips.PrintForVerify(out)
+			return err
+		}
+
+		return r.Verify(ctx, out, buildArtifacts)
+	})
+}
@@ -43,6 +43,12 @@ func PrintForTest(out io.Writer) {
 	printTip(out, "run [skaffold test] with [--build-artifacts <file-output>] for running tests on artifacts from a given file.")
 }
 
+// PrintForVerify prints tips on when to use skaffold verify.
+func PrintForVerify(out io.Writer) {
+	printTip(out, "You need to:")
+	printTip(out, "run [skaffold verify] with [--build-artifacts <file-output>] for running verify tests on artifacts from a given file.")
+}
+
 // PrintUseRunVsDeploy prints tips on when to use skaffold run vs deploy.
 func PrintUseRunVsDeploy(out io.Writer) {
 	printTip(out, "You either need to:")
@@ -70,8 +70,9 @@ var (
 
 // PodValidator implements the Validator interface for Pods
 type PodValidator struct {
-	k     kubernetes.Interface
-	recos []Recommender
+	k              kubernetes.Interface
+	recos          []Recommender
+	standaloneOnly bool
 }
 
 // NewPodValidator initializes a PodValidator
@@ -80,6 +81,13 @@ func NewPodValidator(k kubernetes.Interface) *PodValidator {
 	return &PodValidator{k: k, recos: rs}
 }
 
+// NewStandalonePodValidator initializes a PodValidator that ignores pods owned by a controller
+func NewStandalonePodValidator(k kubernetes.Interface) *PodValidator {
+	p := NewPodValidator(k)
+	p.standaloneOnly = true
+	return p
+}
+
 // Validate implements the Validate method for Validator interface
 func (p *PodValidator) Validate(ctx context.Context, ns string, opts metav1.ListOptions) ([]Resource, error) {
 	pods, err := p.k.CoreV1().Pods(ns).List(ctx, opts)
@@ -89,6 +97,9 @@ func (p *PodValidator) Validate(ctx context.Context, ns string, opts metav1.List
 	eventsClient := p.k.CoreV1().Events(ns)
 	var rs []Resource
 	for _, po := range pods.Items {
+		if p.standaloneOnly && metav1.GetControllerOf(&po) != nil {
+			continue
+		}
 		ps := p.getPodStatus(&po)
 		// Update Pod status from Pod events if required
 		processPodEvents(eventsClient, po, ps)
@@ -25,6 +25,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tag"
 )
@@ -99,7 +100,22 @@ func (b *BuilderMux) Build(ctx context.Context, out io.Writer, tags tag.ImageTag
 	builder := func(ctx context.Context, out io.Writer, artifact *latest_v1.Artifact, tag string) (string, error) {
 		p := b.byImageName[artifact.ImageName]
 		artifactBuilder := p.Build(ctx, out, artifact)
-		return artifactBuilder(ctx, out, artifact, tag)
+		hooksOpts, err := hooks.NewBuildEnvOpts(artifact, tag)
+		if err != nil {
+			return "", err
+		}
+		r := hooks.NewBuildRunner(artifact.LifecycleHooks, hooksOpts)
+		if err := r.RunPreHooks(ctx, out); err != nil {
+			return "", err
+		}
+		res, err := artifactBuilder(ctx, out, artifact, tag)
+		if err != nil {
+			return "", err
+		}
+		if err := r.RunPostHooks(ctx, out); err != nil {
+			return "", err
+		}
+		return res, nil
 	}
 	ar, err := InOrder(ctx, out, tags, artifacts, builder, b.concurrency, b.store)
 	if err != nil {
@@ -21,7 +21,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/mitchellh/go-homedir"
 	"github.com/sirupsen/logrus"
@@ -36,10 +38,19 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
 )
 
-// ImageDetails holds the Digest and ID of an image
+// ImageDetails holds the Digest and ID of an image, along with metadata about the cache entry
 type ImageDetails struct {
 	Digest string `yaml:"digest,omitempty"`
 	ID     string `yaml:"id,omitempty"`
+
+	// Image is the name of the artifact the entry was built for.
+	Image string `yaml:"image,omitempty"`
+
+	// LastUsed is the last time the entry was built or found in the cache.
+	LastUsed time.Time `yaml:"lastUsed,omitempty"`
+
+	// Inputs is the breakdown of the inputs the entry's hash was calculated from.
+	Inputs *InputDigests `yaml:"inputs,omitempty"`
 }
 
 // ArtifactCache is a map of [artifact dependencies hash : ImageDetails]
@@ -54,6 +65,8 @@ type cache struct {
 	client             docker.LocalDaemon
 	cfg                Config
 	cacheFile          string
+	sharedStore        Store
+	inputsByHash       map[string]*HashInputs
 	isLocalImage       func(imageName string) (bool, error)
 	importMissingImage func(imageName string) (bool, error)
 	lister             DependencyLister
@@ -70,6 +83,8 @@ type Config interface {
 	GetCluster() config.Cluster
 	CacheArtifacts() bool
 	CacheFile() string
+	SharedCache() string
+	ExplainCache() bool
 	Mode() config.RunMode
 }
 
@@ -91,6 +106,13 @@ func NewCache(cfg Config, isLocalImage func(imageName string) (bool, error), dep
 		return &noCache{}, nil
 	}
 
+	var sharedStore Store
+	if location := cfg.SharedCache(); location != "" {
+		if sharedStore, err = NewStore(location, cfg); err != nil {
+			logrus.Warnf("Error initializing shared cache, only using the local cache: %v", err)
+		}
+	}
+
 	client, err := docker.NewAPIClient(cfg)
 	if err != nil {
 		// error only if any pipeline is local.
@@ -122,6 +144,8 @@ func NewCache(cfg Config, isLocalImage func(imageName string) (bool, error), dep
 		client:             client,
 		cfg:                cfg,
 		cacheFile:          cacheFile,
+		sharedStore:        sharedStore,
+		inputsByHash:       map[string]*HashInputs{},
 		isLocalImage:       isLocalImage,
 		importMissingImage: importMissingImage,
 		lister:             dependencies,
@@ -141,6 +165,39 @@ func resolveCacheFile(cacheFile string) (string, error) {
 	return defaultFile, util.VerifyOrCreateFile(defaultFile)
 }
 
+// ReadCacheFile reads the artifact cache from the given file, or from the default cache file if empty.
+// It returns the path of the cache file along with its contents.
+func ReadCacheFile(cacheFile string) (string, ArtifactCache, error) {
+	cacheFile, err := resolveCacheFile(cacheFile)
+	if err != nil {
+		return "", nil, fmt.Errorf("resolving cache file: %w", err)
+	}
+	artifactCache, err := retrieveArtifactCache(cacheFile)
+	if err != nil {
+		return "", nil, fmt.Errorf("reading cache file %q: %w", cacheFile, err)
+	}
+	return cacheFile, artifactCache, nil
+}
+
+// WriteCacheFile writes the artifact cache to the given file.
+func WriteCacheFile(cacheFile string, contents ArtifactCache) error {
+	return saveArtifactCache(cacheFile, contents)
+}
+
+// Prune removes the entries that weren't used since the given time, and returns their hashes.
+// Entries without a last use time, written by older versions of skaffold, are the oldest.
+func (c ArtifactCache) Prune(since time.Time) []string {
+	var pruned []string
+	for hash, entry := range c {
+		if entry.LastUsed.Before(since) {
+			pruned = append(pruned, hash)
+			delete(c, hash)
+		}
+	}
+	sort.Strings(pruned)
+	return pruned
+}
+
 func retrieveArtifactCache(cacheFile string) (ArtifactCache, error) {
 	cache := ArtifactCache{}
 	contents, err := ioutil.ReadFile(cacheFile)
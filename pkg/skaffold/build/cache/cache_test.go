@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestReadWriteCacheFile(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		cacheFile := t.NewTempDir().Path("cache")
+		lastUsed := time.Date(2021, time.May, 1, 12, 0, 0, 0, time.UTC)
+		artifactCache := ArtifactCache{
+			"hash": {
+				ID:       "sha256:abc",
+				Image:    "image",
+				LastUsed: lastUsed,
+				Inputs: &InputDigests{
+					Config:    map[string]string{"docker.target": "digest1"},
+					Files:     map[string]string{"Dockerfile": "digest2"},
+					BuildArgs: map[string]string{"key": "digest3"},
+					Platforms: []string{"linux/amd64"},
+				},
+			},
+		}
+
+		t.CheckNoError(WriteCacheFile(cacheFile, artifactCache))
+		path, actual, err := ReadCacheFile(cacheFile)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(cacheFile, path)
+		t.CheckDeepEqual(artifactCache, actual)
+	})
+}
+
+func TestPrune(t *testing.T) {
+	now := time.Date(2021, time.May, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description    string
+		since          time.Time
+		expectedPruned []string
+		expectedKept   []string
+	}{
+		{
+			description:    "prune old entries and entries without a last use time",
+			since:          now.Add(-24 * time.Hour),
+			expectedPruned: []string{"never", "old"},
+			expectedKept:   []string{"recent"},
+		},
+		{
+			description:    "prune everything",
+			since:          now,
+			expectedPruned: []string{"never", "old", "recent"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			artifactCache := ArtifactCache{
+				"never":  {ID: "sha256:never"},
+				"old":    {ID: "sha256:old", LastUsed: now.Add(-48 * time.Hour)},
+				"recent": {ID: "sha256:recent", LastUsed: now.Add(-1 * time.Hour)},
+			}
+
+			pruned := artifactCache.Prune(test.since)
+
+			t.CheckDeepEqual(test.expectedPruned, pruned)
+			for _, hash := range test.expectedKept {
+				_, found := artifactCache[hash]
+				t.CheckTrue(found)
+			}
+			t.CheckDeepEqual(len(test.expectedKept), len(artifactCache))
+		})
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	protoV2 "github.com/GoogleContainerTools/skaffold/proto/v2"
+)
+
+// Kinds of inputs of an artifact's hash.
+const (
+	fileInput     = "File"
+	configInput   = "Config"
+	buildArgInput = "BuildArg"
+	platformInput = "Platform"
+	artifactInput = "Artifact"
+)
+
+// Kinds of changes to an input of an artifact's hash.
+const (
+	inputAdded    = "Added"
+	inputRemoved  = "Removed"
+	inputModified = "Modified"
+)
+
+// cacheMiss explains why an artifact needs to be built.
+type cacheMiss struct {
+	previousHash string
+	reason       string
+	diffs        []*protoV2.CacheInputDiff
+}
+
+// explainMiss explains why the artifact with the given hash wasn't found in the cache,
+// by comparing its inputs with the ones of the most recently used cache entry for the same image.
+func (c *cache) explainMiss(imageName, hash string) cacheMiss {
+	c.cacheMutex.RLock()
+	defer c.cacheMutex.RUnlock()
+
+	if _, found := c.artifactCache[hash]; found {
+		return cacheMiss{previousHash: hash, reason: "the cached image doesn't exist anymore"}
+	}
+
+	var previousHash string
+	var previous ImageDetails
+	for h, entry := range c.artifactCache {
+		if entry.Image != imageName || (entry.Inputs == nil && c.inputsByHash[h] == nil) {
+			continue
+		}
+		if previousHash == "" || entry.LastUsed.After(previous.LastUsed) || (entry.LastUsed.Equal(previous.LastUsed) && h < previousHash) {
+			previousHash, previous = h, entry
+		}
+	}
+	if previousHash == "" {
+		return cacheMiss{reason: "no previous cache entry for this artifact"}
+	}
+
+	current := c.inputsByHash[hash]
+	if current == nil {
+		return cacheMiss{previousHash: previousHash, reason: "the artifact's inputs are unknown"}
+	}
+
+	previousInputs := previous.Inputs
+	if inputs := c.inputsByHash[previousHash]; inputs != nil {
+		previousInputs = digestInputs(inputs)
+	}
+	diffs := diffDigests(previousInputs, digestInputs(current))
+	if len(diffs) == 0 {
+		return cacheMiss{previousHash: previousHash, reason: "the artifact's inputs are unchanged"}
+	}
+	return cacheMiss{previousHash: previousHash, reason: "inputs changed since the last build", diffs: diffs}
+}
+
+// explainCacheMiss sends an event explaining why an artifact needs to be built,
+// and prints the explanation to out if requested.
+func (c *cache) explainCacheMiss(out io.Writer, imageName, hash string) {
+	miss := c.explainMiss(imageName, hash)
+	eventV2.CacheMiss(imageName, hash, miss.previousHash, miss.reason, miss.diffs)
+
+	if !c.cfg.ExplainCache() {
+		return
+	}
+	color.Default.Fprintf(out, "   - %s\n", miss.reason)
+	for _, d := range miss.diffs {
+		color.Default.Fprintf(out, "     - %s %s: %s\n", strings.ToLower(d.Change), describeKind(d.Kind), d.Name)
+	}
+}
+
+func describeKind(kind string) string {
+	switch kind {
+	case configInput:
+		return "config field"
+	case buildArgInput:
+		return "build arg"
+	case platformInput:
+		return "platform"
+	case artifactInput:
+		return "required artifact"
+	default:
+		return "file"
+	}
+}
+
+// diffDigests lists the inputs that differ between the breakdowns of two cache entries.
+func diffDigests(previous, current *InputDigests) []*protoV2.CacheInputDiff {
+	var diffs []*protoV2.CacheInputDiff
+	diffs = append(diffs, diffMaps(configInput, previous.Config, current.Config)...)
+	diffs = append(diffs, diffMaps(fileInput, previous.Files, current.Files)...)
+	diffs = append(diffs, diffMaps(buildArgInput, previous.BuildArgs, current.BuildArgs)...)
+	diffs = append(diffs, diffMaps(platformInput, setMap(previous.Platforms), setMap(current.Platforms))...)
+	diffs = append(diffs, diffMaps(artifactInput, previous.Artifacts, current.Artifacts)...)
+	return diffs
+}
+
+func diffMaps(kind string, previous, current map[string]string) []*protoV2.CacheInputDiff {
+	var diffs []*protoV2.CacheInputDiff
+	for name, value := range current {
+		previousValue, found := previous[name]
+		switch {
+		case !found:
+			diffs = append(diffs, &protoV2.CacheInputDiff{Kind: kind, Name: name, Change: inputAdded})
+		case previousValue != value:
+			diffs = append(diffs, &protoV2.CacheInputDiff{Kind: kind, Name: name, Change: inputModified})
+		}
+	}
+	for name := range previous {
+		if _, found := current[name]; !found {
+			diffs = append(diffs, &protoV2.CacheInputDiff{Kind: kind, Name: name, Change: inputRemoved})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Name < diffs[j].Name })
+	return diffs
+}
+
+// buildArgsMap turns a list of `key=value` build args into a map.
+func buildArgsMap(args []string) map[string]string {
+	m := map[string]string{}
+	for _, arg := range args {
+		kv := strings.SplitN(arg, "=", 2)
+		if len(kv) == 2 {
+			m[kv[0]] = kv[1]
+		} else {
+			m[kv[0]] = ""
+		}
+	}
+	return m
+}
+
+// setMap turns a list of values into a map, to compare them regardless of their order.
+func setMap(values []string) map[string]string {
+	m := map[string]string{}
+	for _, v := range values {
+		m[v] = ""
+	}
+	return m
+}
+
+// flattenConfig turns an artifact's json configuration into a map of field paths to values.
+func flattenConfig(config string) map[string]string {
+	m := map[string]string{}
+	if config == "" {
+		return m
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(config), &v); err != nil {
+		// Not json: compare the configuration as a whole.
+		m["."] = config
+		return m
+	}
+	flatten("", v, m)
+	return m
+}
+
+func flatten(path string, v interface{}, m map[string]string) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			flatten(joinPath(path, k), child, m)
+		}
+	case []interface{}:
+		for i, child := range t {
+			flatten(joinPath(path, fmt.Sprintf("%d", i)), child, m)
+		}
+	default:
+		if v == nil {
+			return
+		}
+		buf, _ := json.Marshal(v)
+		m[path] = string(buf)
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	protoV2 "github.com/GoogleContainerTools/skaffold/proto/v2"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestDiffDigests(t *testing.T) {
+	tests := []struct {
+		description string
+		previous    *HashInputs
+		current     *HashInputs
+		expected    []*protoV2.CacheInputDiff
+	}{
+		{
+			description: "identical",
+			previous:    &HashInputs{Config: `{"docker":{"target":"a"}}`, Files: map[string]string{"a": "1"}, BuildArgs: []string{"k=v"}},
+			current:     &HashInputs{Config: `{"docker":{"target":"a"}}`, Files: map[string]string{"a": "1"}, BuildArgs: []string{"k=v"}},
+		},
+		{
+			description: "files",
+			previous:    &HashInputs{Files: map[string]string{"a": "1", "b": "2", "c": "3"}},
+			current:     &HashInputs{Files: map[string]string{"a": "1", "b": "4", "d": "5"}},
+			expected: []*protoV2.CacheInputDiff{
+				{Kind: "File", Name: "b", Change: "Modified"},
+				{Kind: "File", Name: "c", Change: "Removed"},
+				{Kind: "File", Name: "d", Change: "Added"},
+			},
+		},
+		{
+			description: "config fields",
+			previous:    &HashInputs{Config: `{"docker":{"target":"a","buildArgs":{"k":"v"}}}`},
+			current:     &HashInputs{Config: `{"docker":{"target":"b","cacheFrom":["c"]}}`},
+			expected: []*protoV2.CacheInputDiff{
+				{Kind: "Config", Name: "docker.buildArgs.k", Change: "Removed"},
+				{Kind: "Config", Name: "docker.cacheFrom.0", Change: "Added"},
+				{Kind: "Config", Name: "docker.target", Change: "Modified"},
+			},
+		},
+		{
+			description: "build args and required artifacts",
+			previous:    &HashInputs{BuildArgs: []string{"a=1", "b=2"}, Artifacts: map[string]string{"base": "hash1"}},
+			current:     &HashInputs{BuildArgs: []string{"a=1", "b=3", "c"}, Artifacts: map[string]string{"base": "hash2"}},
+			expected: []*protoV2.CacheInputDiff{
+				{Kind: "BuildArg", Name: "b", Change: "Modified"},
+				{Kind: "BuildArg", Name: "c", Change: "Added"},
+				{Kind: "Artifact", Name: "base", Change: "Modified"},
+			},
+		},
+		{
+			description: "platforms",
+			previous:    &HashInputs{Platforms: []string{"linux/amd64", "linux/arm64"}},
+			current:     &HashInputs{Platforms: []string{"linux/arm/v7", "linux/arm64"}},
+			expected: []*protoV2.CacheInputDiff{
+				{Kind: "Platform", Name: "linux/amd64", Change: "Removed"},
+				{Kind: "Platform", Name: "linux/arm/v7", Change: "Added"},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			actual := diffDigests(digestInputs(test.previous), digestInputs(test.current))
+
+			t.CheckDeepEqual(test.expected, actual)
+		})
+	}
+}
+
+func TestExplainCacheMiss(t *testing.T) {
+	older := time.Date(2021, time.May, 1, 12, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	recentInputs := &HashInputs{Files: map[string]string{"Dockerfile": "1", "main.go": "1"}}
+
+	tests := []struct {
+		description    string
+		artifactCache  ArtifactCache
+		sessionInputs  map[string]*HashInputs
+		explainCache   bool
+		expectedOutput string
+	}{
+		{
+			description:    "no previous entry",
+			artifactCache:  ArtifactCache{"other": {Image: "other", Inputs: &InputDigests{}}},
+			explainCache:   true,
+			expectedOutput: "   - no previous cache entry for this artifact\n",
+		},
+		{
+			description:    "cached image is gone",
+			artifactCache:  ArtifactCache{"hash": {Image: "image", Inputs: &InputDigests{}}},
+			explainCache:   true,
+			expectedOutput: "   - the cached image doesn't exist anymore\n",
+		},
+		{
+			description: "compare with the most recently used entry",
+			artifactCache: ArtifactCache{
+				"old":    {Image: "image", LastUsed: older, Inputs: digestInputs(&HashInputs{BuildArgs: []string{"k=v"}})},
+				"recent": {Image: "image", LastUsed: newer, Inputs: digestInputs(recentInputs)},
+			},
+			explainCache:   true,
+			expectedOutput: "   - inputs changed since the last build\n     - modified file: main.go\n",
+		},
+		{
+			description: "list the changed inputs of an entry from a previous run",
+			artifactCache: ArtifactCache{
+				"recent": {Image: "image", LastUsed: newer, Inputs: digestInputs(&HashInputs{
+					Config:    `{"docker":{"dockerfile":"Dockerfile","target":"dev"}}`,
+					Files:     map[string]string{"Dockerfile": "1", "main.go": "1", "util.go": "1"},
+					BuildArgs: []string{"VERSION=1", "DEBUG=true"},
+				})},
+			},
+			sessionInputs: map[string]*HashInputs{"hash": {
+				Config:    `{"docker":{"dockerfile":"Dockerfile","target":"prod"}}`,
+				Files:     map[string]string{"Dockerfile": "1", "main.go": "2", "handler.go": "1"},
+				BuildArgs: []string{"VERSION=2", "DEBUG=true"},
+			}},
+			explainCache: true,
+			expectedOutput: `   - inputs changed since the last build
+     - modified config field: docker.target
+     - added file: handler.go
+     - modified file: main.go
+     - removed file: util.go
+     - modified build arg: VERSION
+`,
+		},
+		{
+			description: "list the changed inputs of an entry used in this session",
+			artifactCache: ArtifactCache{
+				"recent": {Image: "image", LastUsed: newer, Inputs: digestInputs(recentInputs)},
+			},
+			sessionInputs:  map[string]*HashInputs{"recent": recentInputs},
+			explainCache:   true,
+			expectedOutput: "   - inputs changed since the last build\n     - modified file: main.go\n",
+		},
+		{
+			description: "inputs added and removed",
+			artifactCache: ArtifactCache{
+				"recent": {Image: "image", LastUsed: newer, Inputs: digestInputs(&HashInputs{Files: map[string]string{"Dockerfile": "1", "main.go": "2"}, BuildArgs: []string{"k=v"}, Platforms: []string{"linux/amd64"}})},
+			},
+			sessionInputs:  map[string]*HashInputs{"hash": {Files: map[string]string{"Dockerfile": "1", "main.go": "2"}, Config: `{"docker":{"target":"a"}}`}},
+			explainCache:   true,
+			expectedOutput: "   - inputs changed since the last build\n     - added config field: docker.target\n     - removed build arg: k\n     - removed platform: linux/amd64\n",
+		},
+		{
+			description: "unchanged inputs",
+			artifactCache: ArtifactCache{
+				"recent": {Image: "image", LastUsed: newer, Inputs: digestInputs(&HashInputs{Files: map[string]string{"Dockerfile": "1", "main.go": "2"}})},
+			},
+			explainCache:   true,
+			expectedOutput: "   - the artifact's inputs are unchanged\n",
+		},
+		{
+			description: "not requested",
+			artifactCache: ArtifactCache{
+				"recent": {Image: "image", LastUsed: newer, Inputs: digestInputs(recentInputs)},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			inputsByHash := map[string]*HashInputs{"hash": {Files: map[string]string{"Dockerfile": "1", "main.go": "2"}}}
+			for hash, inputs := range test.sessionInputs {
+				inputsByHash[hash] = inputs
+			}
+			c := &cache{
+				artifactCache: test.artifactCache,
+				inputsByHash:  inputsByHash,
+				cfg:           &mockConfig{explainCache: test.explainCache},
+			}
+
+			var out bytes.Buffer
+			c.explainCacheMiss(&out, "image", "hash")
+
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
@@ -46,22 +47,106 @@ var (
 
 type artifactHasher interface {
 	hash(ctx context.Context, a *latest_v1.Artifact) (string, error)
+	hashInputs(ctx context.Context, a *latest_v1.Artifact) (*HashInputs, error)
+}
+
+// HashInputs is the breakdown of the inputs a single artifact's hash is calculated from.
+type HashInputs struct {
+	// Config is the artifact's configuration.
+	Config string `yaml:"config,omitempty"`
+
+	// Files maps each dependency file to its digest.
+	Files map[string]string `yaml:"files,omitempty"`
+
+	// BuildArgs are the artifact's evaluated build args or environment.
+	BuildArgs []string `yaml:"buildArgs,omitempty"`
+
+	// Platforms are the platforms the artifact is built for.
+	Platforms []string `yaml:"platforms,omitempty"`
+
+	// Artifacts maps each required artifact to its hash.
+	Artifacts map[string]string `yaml:"artifacts,omitempty"`
+}
+
+// InputDigests is the breakdown of the inputs a cache entry's hash was calculated from,
+// as stored in the cache file. Values are replaced with their digest, so that the values
+// of build args aren't written to disk.
+type InputDigests struct {
+	// Config maps each field of the artifact's configuration to the digest of its value.
+	Config map[string]string `yaml:"config,omitempty"`
+
+	// Files maps each dependency file to its digest.
+	Files map[string]string `yaml:"files,omitempty"`
+
+	// BuildArgs maps each build arg or environment variable to the digest of its value.
+	BuildArgs map[string]string `yaml:"buildArgs,omitempty"`
+
+	// Platforms are the platforms the artifact is built for.
+	Platforms []string `yaml:"platforms,omitempty"`
+
+	// Artifacts maps each required artifact to its hash.
+	Artifacts map[string]string `yaml:"artifacts,omitempty"`
+}
+
+// digestInputs computes the breakdown of an artifact's hash that is stored in the cache file.
+func digestInputs(inputs *HashInputs) *InputDigests {
+	return &InputDigests{
+		Config:    digestValues(flattenConfig(inputs.Config)),
+		Files:     copyMap(inputs.Files),
+		BuildArgs: digestValues(buildArgsMap(inputs.BuildArgs)),
+		Platforms: append([]string(nil), inputs.Platforms...),
+		Artifacts: copyMap(inputs.Artifacts),
+	}
+}
+
+// digestValues replaces the values of a map with their digest. Empty maps are left out.
+func digestValues(m map[string]string) map[string]string {
+	if len(m) == 0 {
+		return nil
+	}
+	digests := map[string]string{}
+	for k, v := range m {
+		if digest, err := encode([]string{v}); err == nil {
+			digests[k] = digest
+		}
+	}
+	return digests
+}
+
+func copyMap(m map[string]string) map[string]string {
+	if len(m) == 0 {
+		return nil
+	}
+	c := map[string]string{}
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// singleArtifactHashResult is the result of hashing a single artifact.
+type singleArtifactHashResult struct {
+	hash   string
+	inputs *HashInputs
 }
 
 type artifactHasherImpl struct {
-	artifacts graph.ArtifactGraph
-	lister    DependencyLister
-	mode      config.RunMode
-	syncStore *util.SyncStore
+	artifacts    graph.ArtifactGraph
+	lister       DependencyLister
+	mode         config.RunMode
+	isLocalImage func(imageName string) (bool, error)
+	syncStore    *util.SyncStore
 }
 
 // newArtifactHasher returns a new instance of an artifactHasher. Use newArtifactHasherFunc instead of calling this function directly.
-func newArtifactHasher(artifacts graph.ArtifactGraph, lister DependencyLister, mode config.RunMode) artifactHasher {
+// `isLocalImage` tells which images are loaded into the local Docker daemon instead of being pushed. It can be nil if none are.
+func newArtifactHasher(artifacts graph.ArtifactGraph, lister DependencyLister, mode config.RunMode, isLocalImage func(imageName string) (bool, error)) artifactHasher {
 	return &artifactHasherImpl{
-		artifacts: artifacts,
-		lister:    lister,
-		mode:      mode,
-		syncStore: util.NewSyncStore(),
+		artifacts:    artifacts,
+		lister:       lister,
+		mode:         mode,
+		isLocalImage: isLocalImage,
+		syncStore:    util.NewSyncStore(),
 	}
 }
 
@@ -85,40 +170,90 @@ func (h *artifactHasherImpl) hash(ctx context.Context, a *latest_v1.Artifact) (s
 	return encode(hashes)
 }
 
+// hashInputs returns the breakdown of the inputs of an artifact's hash.
+func (h *artifactHasherImpl) hashInputs(ctx context.Context, a *latest_v1.Artifact) (*HashInputs, error) {
+	res, err := h.safeSingleHash(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	deps := sortedDependencies(a, h.artifacts)
+	if len(deps) == 0 {
+		return res.inputs, nil
+	}
+
+	inputs := *res.inputs
+	inputs.Artifacts = map[string]string{}
+	for _, dep := range deps {
+		depHash, err := h.hash(ctx, dep)
+		if err != nil {
+			return nil, err
+		}
+		inputs.Artifacts[dep.ImageName] = depHash
+	}
+	return &inputs, nil
+}
+
 func (h *artifactHasherImpl) safeHash(ctx context.Context, a *latest_v1.Artifact) (string, error) {
+	res, err := h.safeSingleHash(ctx, a)
+	if err != nil {
+		return "", err
+	}
+	return res.hash, nil
+}
+
+func (h *artifactHasherImpl) safeSingleHash(ctx context.Context, a *latest_v1.Artifact) (singleArtifactHashResult, error) {
 	val := h.syncStore.Exec(a.ImageName,
 		func() interface{} {
-			hash, err := singleArtifactHash(ctx, h.lister, a, h.mode)
+			hash, inputs, err := singleArtifactHash(ctx, h.lister, h.builtArtifact(a), h.mode)
 			if err != nil {
 				return err
 			}
-			return hash
+			return singleArtifactHashResult{hash: hash, inputs: inputs}
 		})
 	switch t := val.(type) {
 	case error:
-		return "", t
-	case string:
+		return singleArtifactHashResult{}, t
+	case singleArtifactHashResult:
 		return t, nil
 	default:
-		return "", fmt.Errorf("internal error when retrieving cache result of type %T", t)
+		return singleArtifactHashResult{}, fmt.Errorf("internal error when retrieving cache result of type %T", t)
 	}
 }
 
+// builtArtifact returns the artifact with the platforms it's actually built for.
+// Images loaded into the local Docker daemon are only built for one of their platforms.
+func (h *artifactHasherImpl) builtArtifact(a *latest_v1.Artifact) *latest_v1.Artifact {
+	if len(a.Platforms) <= 1 || h.isLocalImage == nil {
+		return a
+	}
+	if local, err := h.isLocalImage(a.ImageName); err != nil || !local {
+		return a
+	}
+
+	artifact := *a
+	artifact.Platforms = platform.ForLocalDaemon(a.Platforms)
+	return &artifact
+}
+
 // singleArtifactHash calculates the hash for a single artifact, and ignores its required artifacts.
-func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *latest_v1.Artifact, mode config.RunMode) (string, error) {
+// It also returns the breakdown of the inputs the hash is calculated from.
+func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *latest_v1.Artifact, mode config.RunMode) (string, *HashInputs, error) {
 	var inputs []string
+	breakdown := &HashInputs{}
 
 	// Append the artifact's configuration
 	config, err := artifactConfigFunc(a)
 	if err != nil {
-		return "", fmt.Errorf("getting artifact's configuration for %q: %w", a.ImageName, err)
+		return "", nil, fmt.Errorf("getting artifact's configuration for %q: %w", a.ImageName, err)
 	}
 	inputs = append(inputs, config)
+	breakdown.Config = config
 
 	// Append the digest of each input file
 	deps, err := depLister(ctx, a)
 	if err != nil {
-		return "", fmt.Errorf("getting dependencies for %q: %w", a.ImageName, err)
+		return "", nil, fmt.Errorf("getting dependencies for %q: %w", a.ImageName, err)
 	}
 	sort.Strings(deps)
 
@@ -130,20 +265,38 @@ func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *late
 				continue // Ignore files that don't exist
 			}
 
-			return "", fmt.Errorf("getting hash for %q: %w", d, err)
+			return "", nil, fmt.Errorf("getting hash for %q: %w", d, err)
 		}
 		inputs = append(inputs, h)
+		if breakdown.Files == nil {
+			breakdown.Files = map[string]string{}
+		}
+		breakdown.Files[d] = h
 	}
 
 	// add build args for the artifact if specified
 	args, err := hashBuildArgs(a, mode)
 	if err != nil {
-		return "", fmt.Errorf("hashing build args: %w", err)
+		return "", nil, fmt.Errorf("hashing build args: %w", err)
 	}
 	if args != nil {
 		inputs = append(inputs, args...)
+		breakdown.BuildArgs = args
+	}
+
+	// add the target platforms, in any order
+	if len(a.Platforms) > 0 {
+		platforms := append([]string{}, a.Platforms...)
+		sort.Strings(platforms)
+		inputs = append(inputs, platforms...)
+		breakdown.Platforms = platforms
+	}
+
+	hash, err := encode(inputs)
+	if err != nil {
+		return "", nil, err
 	}
-	return encode(inputs)
+	return hash, breakdown, nil
 }
 
 func encode(inputs []string) (string, error) {
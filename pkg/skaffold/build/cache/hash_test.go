@@ -23,6 +23,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
@@ -124,6 +125,20 @@ func TestGetHashForArtifact(t *testing.T) {
 			mode:     config.RunModes.Dev,
 			expected: "f3f710a4ec1d1bfb2a9b8ef2b4b7cc5f254102d17095a71872821b396953a4ce",
 		},
+		{
+			description:  "platforms",
+			dependencies: []string{"a", "b"},
+			artifact:     &latest_v1.Artifact{Platforms: []string{"linux/amd64", "linux/arm64"}},
+			mode:         config.RunModes.Dev,
+			expected:     "14f85bcfd809f89a140598a6e9fa58d200e0040e2049480f05a4f80fdab04437",
+		},
+		{
+			description:  "platforms in different orders",
+			dependencies: []string{"a", "b"},
+			artifact:     &latest_v1.Artifact{Platforms: []string{"linux/arm64", "linux/amd64"}},
+			mode:         config.RunModes.Dev,
+			expected:     "14f85bcfd809f89a140598a6e9fa58d200e0040e2049480f05a4f80fdab04437",
+		},
 		{
 			description:  "buildpack in dev mode",
 			dependencies: []string{"a", "b"},
@@ -159,7 +174,7 @@ func TestGetHashForArtifact(t *testing.T) {
 			}
 
 			depLister := stubDependencyLister(test.dependencies)
-			actual, err := newArtifactHasher(nil, depLister, test.mode).hash(context.Background(), test.artifact)
+			actual, err := newArtifactHasher(nil, depLister, test.mode, nil).hash(context.Background(), test.artifact)
 
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.expected, actual)
@@ -167,6 +182,66 @@ func TestGetHashForArtifact(t *testing.T) {
 	}
 }
 
+func TestHashInputs(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&fileHasherFunc, mockCacheHasher)
+		t.Override(&artifactConfigFunc, fakeArtifactConfig)
+		tmpDir := t.NewTempDir()
+		tmpDir.Write("./Dockerfile", "FROM foo")
+		artifact := &latest_v1.Artifact{
+			Workspace: tmpDir.Path("."),
+			ArtifactType: latest_v1.ArtifactType{
+				DockerArtifact: &latest_v1.DockerArtifact{
+					DockerfilePath: Dockerfile,
+					BuildArgs:      map[string]*string{"key": util.StringPtr("value")},
+				},
+			},
+		}
+
+		depLister := stubDependencyLister([]string{"b", "not-found", "a"})
+		actual, err := newArtifactHasher(nil, depLister, config.RunModes.Build, nil).hashInputs(context.Background(), artifact)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(&HashInputs{
+			Config:    "docker/target=",
+			Files:     map[string]string{"a": "a", "b": "b"},
+			BuildArgs: []string{"key=value"},
+		}, actual)
+	})
+}
+
+func TestHashInputsPlatforms(t *testing.T) {
+	tests := []struct {
+		description string
+		isLocal     bool
+		expected    []string
+	}{
+		{
+			description: "pushed image is built for all platforms",
+			expected:    []string{"linux/amd64", "linux/arm64"},
+		},
+		{
+			description: "local image is built for a single platform",
+			isLocal:     true,
+			expected:    platform.ForLocalDaemon([]string{"linux/amd64", "linux/arm64"}),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&fileHasherFunc, mockCacheHasher)
+			t.Override(&artifactConfigFunc, fakeArtifactConfig)
+			artifact := &latest_v1.Artifact{ImageName: "img", Platforms: []string{"linux/amd64", "linux/arm64"}}
+			isLocalImage := func(string) (bool, error) { return test.isLocal, nil }
+
+			actual, err := newArtifactHasher(nil, stubDependencyLister(nil), config.RunModes.Build, isLocalImage).hashInputs(context.Background(), artifact)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, actual.Platforms)
+			t.CheckDeepEqual([]string{"linux/amd64", "linux/arm64"}, artifact.Platforms)
+		})
+	}
+}
+
 func TestGetHashForArtifactWithDependencies(t *testing.T) {
 	tests := []struct {
 		description string
@@ -243,7 +318,7 @@ func TestGetHashForArtifactWithDependencies(t *testing.T) {
 				return test.fileDeps[a.ImageName], nil
 			}
 
-			actual, err := newArtifactHasher(g, depLister, test.mode).hash(context.Background(), test.artifacts[0])
+			actual, err := newArtifactHasher(g, depLister, test.mode, nil).hash(context.Background(), test.artifacts[0])
 
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.expected, actual)
@@ -306,21 +381,21 @@ func TestBuildArgs(t *testing.T) {
 			}
 			t.Override(&fileHasherFunc, mockCacheHasher)
 			t.Override(&artifactConfigFunc, fakeArtifactConfig)
-			actual, err := newArtifactHasher(nil, stubDependencyLister(nil), test.mode).hash(context.Background(), artifact)
+			actual, err := newArtifactHasher(nil, stubDependencyLister(nil), test.mode, nil).hash(context.Background(), artifact)
 
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.expected, actual)
 
 			// Change order of buildargs
 			artifact.ArtifactType.DockerArtifact.BuildArgs = map[string]*string{"two": util.StringPtr("2"), "one": util.StringPtr("1")}
-			actual, err = newArtifactHasher(nil, stubDependencyLister(nil), test.mode).hash(context.Background(), artifact)
+			actual, err = newArtifactHasher(nil, stubDependencyLister(nil), test.mode, nil).hash(context.Background(), artifact)
 
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.expected, actual)
 
 			// Change build args, get different hash
 			artifact.ArtifactType.DockerArtifact.BuildArgs = map[string]*string{"one": util.StringPtr("1")}
-			actual, err = newArtifactHasher(nil, stubDependencyLister(nil), test.mode).hash(context.Background(), artifact)
+			actual, err = newArtifactHasher(nil, stubDependencyLister(nil), test.mode, nil).hash(context.Background(), artifact)
 
 			t.CheckNoError(err)
 			if actual == test.expected {
@@ -353,7 +428,7 @@ func TestBuildArgsEnvSubstitution(t *testing.T) {
 		t.Override(&artifactConfigFunc, fakeArtifactConfig)
 
 		depLister := stubDependencyLister([]string{"graph"})
-		hash1, err := newArtifactHasher(nil, depLister, config.RunModes.Build).hash(context.Background(), artifact)
+		hash1, err := newArtifactHasher(nil, depLister, config.RunModes.Build, nil).hash(context.Background(), artifact)
 
 		t.CheckNoError(err)
 
@@ -363,7 +438,7 @@ func TestBuildArgsEnvSubstitution(t *testing.T) {
 			return []string{"FOO=baz"}
 		}
 
-		hash2, err := newArtifactHasher(nil, depLister, config.RunModes.Build).hash(context.Background(), artifact)
+		hash2, err := newArtifactHasher(nil, depLister, config.RunModes.Build, nil).hash(context.Background(), artifact)
 
 		t.CheckNoError(err)
 		if hash1 == hash2 {
@@ -420,7 +495,7 @@ func TestCacheHasher(t *testing.T) {
 			path := originalFile
 			depLister := stubDependencyLister([]string{tmpDir.Path(originalFile)})
 
-			oldHash, err := newArtifactHasher(nil, depLister, config.RunModes.Build).hash(context.Background(), &latest_v1.Artifact{})
+			oldHash, err := newArtifactHasher(nil, depLister, config.RunModes.Build, nil).hash(context.Background(), &latest_v1.Artifact{})
 			t.CheckNoError(err)
 
 			test.update(originalFile, tmpDir)
@@ -429,7 +504,7 @@ func TestCacheHasher(t *testing.T) {
 			}
 
 			depLister = stubDependencyLister([]string{tmpDir.Path(path)})
-			newHash, err := newArtifactHasher(nil, depLister, config.RunModes.Build).hash(context.Background(), &latest_v1.Artifact{})
+			newHash, err := newArtifactHasher(nil, depLister, config.RunModes.Build, nil).hash(context.Background(), &latest_v1.Artifact{})
 
 			t.CheckNoError(err)
 			t.CheckFalse(test.differentHash && oldHash == newHash)
@@ -33,7 +33,7 @@ func (c *cache) lookupArtifacts(ctx context.Context, tags tag.ImageTags, artifac
 	details := make([]cacheDetails, len(artifacts))
 	// Create a new `artifactHasher` on every new dev loop.
 	// This way every artifact hash is calculated at most once in a single dev loop, and recalculated on every dev loop.
-	h := newArtifactHasherFunc(c.artifactGraph, c.lister, c.cfg.Mode())
+	h := newArtifactHasherFunc(c.artifactGraph, c.lister, c.cfg.Mode(), c.isLocalImage)
 	var wg sync.WaitGroup
 	for i := range artifacts {
 		wg.Add(1)
@@ -54,10 +54,20 @@ func (c *cache) lookup(ctx context.Context, a *latest_v1.Artifact, tag string, h
 	if err != nil {
 		return failed{err: fmt.Errorf("getting hash for artifact %q: %s", a.ImageName, err)}
 	}
+	inputs, err := h.hashInputs(ctx, a)
+	if err != nil {
+		return failed{err: fmt.Errorf("getting hash inputs for artifact %q: %s", a.ImageName, err)}
+	}
+	c.cacheMutex.Lock()
+	c.inputsByHash[hash] = inputs
+	c.cacheMutex.Unlock()
 
 	c.cacheMutex.RLock()
 	entry, cacheHit := c.artifactCache[hash]
 	c.cacheMutex.RUnlock()
+	if !cacheHit {
+		entry, cacheHit = c.lookupShared(ctx, a, tag, hash)
+	}
 	if !cacheHit {
 		if entry, err = c.tryImport(ctx, a, tag, hash); err != nil {
 			logrus.Debugf("Could not import artifact from Docker, building instead (%s)", err)
@@ -73,6 +83,49 @@ func (c *cache) lookup(ctx context.Context, a *latest_v1.Artifact, tag string, h
 	return c.lookupRemote(ctx, hash, tag, entry)
 }
 
+// lookupShared looks for an entry in the shared cache, and adds it to the local cache if found.
+// Images that are expected in the local daemon but were built by another one are imported
+// by digest, the same way missing images are imported.
+func (c *cache) lookupShared(ctx context.Context, a *latest_v1.Artifact, tag, hash string) (ImageDetails, bool) {
+	if c.sharedStore == nil {
+		return ImageDetails{}, false
+	}
+
+	entry, found, err := c.sharedStore.Get(ctx, hash)
+	if err != nil {
+		logrus.Warnf("Error looking up shared cache, ignoring it: %v", err)
+		return ImageDetails{}, false
+	}
+	if !found {
+		return ImageDetails{}, false
+	}
+	logrus.Debugf("Found artifact hash %s in shared cache", hash)
+
+	isLocal, err := c.isLocalImage(a.ImageName)
+	if err != nil {
+		logrus.Debugf("Could not use shared cache entry for %s: %v", a.ImageName, err)
+		return ImageDetails{}, false
+	}
+	if isLocal && entry.Digest != "" && (entry.ID == "" || !c.client.ImageExists(ctx, entry.ID)) {
+		ref, err := docker.ParseReference(tag)
+		if err != nil {
+			logrus.Debugf("Could not use shared cache entry for %s: %v", a.ImageName, err)
+			return ImageDetails{}, false
+		}
+		imported, err := c.importImage(ctx, ref.BaseName+"@"+entry.Digest, hash)
+		if err != nil {
+			logrus.Debugf("Could not import artifact from the shared cache, building instead (%s)", err)
+			return ImageDetails{}, false
+		}
+		return imported, true
+	}
+
+	c.cacheMutex.Lock()
+	c.artifactCache[hash] = entry
+	c.cacheMutex.Unlock()
+	return entry, true
+}
+
 func (c *cache) lookupLocal(ctx context.Context, hash, tag string, entry ImageDetails) cacheDetails {
 	if entry.ID == "" {
 		return needsBuilding{hash: hash}
@@ -123,14 +176,19 @@ func (c *cache) lookupRemote(ctx context.Context, hash, tag string, entry ImageD
 }
 
 func (c *cache) tryImport(ctx context.Context, a *latest_v1.Artifact, tag string, hash string) (ImageDetails, error) {
-	entry := ImageDetails{}
-
 	if importMissing, err := c.importMissingImage(a.ImageName); err != nil {
-		return entry, err
+		return ImageDetails{}, err
 	} else if !importMissing {
 		return ImageDetails{}, fmt.Errorf("import of missing images disabled")
 	}
 
+	return c.importImage(ctx, tag, hash)
+}
+
+// importImage pulls an image unless it's already in the local daemon, and adds it to the local cache.
+func (c *cache) importImage(ctx context.Context, tag string, hash string) (ImageDetails, error) {
+	entry := ImageDetails{}
+
 	if !c.client.ImageExists(ctx, tag) {
 		logrus.Debugf("Importing artifact %s from docker registry", tag)
 		err := c.client.Pull(ctx, ioutil.Discard, tag)
@@ -121,11 +121,12 @@ func TestLookupLocal(t *testing.T) {
 				isLocalImage:       func(string) (bool, error) { return true, nil },
 				importMissingImage: func(imageName string) (bool, error) { return false, nil },
 				artifactCache:      test.cache,
+				inputsByHash:       map[string]*HashInputs{},
 				client:             fakeLocalDaemon(test.api),
 				cfg:                &mockConfig{mode: config.RunModes.Build},
 			}
 
-			t.Override(&newArtifactHasherFunc, func(_ graph.ArtifactGraph, _ DependencyLister, _ config.RunMode) artifactHasher { return test.hasher })
+			t.Override(&newArtifactHasherFunc, func(graph.ArtifactGraph, DependencyLister, config.RunMode, func(string) (bool, error)) artifactHasher { return test.hasher })
 			details := cache.lookupArtifacts(context.Background(), map[string]string{"artifact": "tag"}, []*latest_v1.Artifact{{
 				ImageName: "artifact",
 			}})
@@ -210,10 +211,11 @@ func TestLookupRemote(t *testing.T) {
 				isLocalImage:       func(string) (bool, error) { return false, nil },
 				importMissingImage: func(imageName string) (bool, error) { return false, nil },
 				artifactCache:      test.cache,
+				inputsByHash:       map[string]*HashInputs{},
 				client:             fakeLocalDaemon(test.api),
 				cfg:                &mockConfig{mode: config.RunModes.Build},
 			}
-			t.Override(&newArtifactHasherFunc, func(_ graph.ArtifactGraph, _ DependencyLister, _ config.RunMode) artifactHasher { return test.hasher })
+			t.Override(&newArtifactHasherFunc, func(graph.ArtifactGraph, DependencyLister, config.RunMode, func(string) (bool, error)) artifactHasher { return test.hasher })
 			details := cache.lookupArtifacts(context.Background(), map[string]string{"artifact": "tag"}, []*latest_v1.Artifact{{
 				ImageName: "artifact",
 			}})
@@ -226,6 +228,88 @@ func TestLookupRemote(t *testing.T) {
 	}
 }
 
+func TestLookupShared(t *testing.T) {
+	tests := []struct {
+		description string
+		shared      map[string]ImageDetails
+		api         *testutil.FakeAPIClient
+		expected    cacheDetails
+	}{
+		{
+			description: "miss",
+			shared:      map[string]ImageDetails{},
+			api:         &testutil.FakeAPIClient{},
+			expected:    needsBuilding{hash: "hash"},
+		},
+		{
+			description: "hit in the same daemon",
+			shared: map[string]ImageDetails{
+				"hash": {ID: "imageID", Digest: "sha256:abc"},
+			},
+			api:      (&testutil.FakeAPIClient{}).Add("tag", "imageID"),
+			expected: found{hash: "hash"},
+		},
+		{
+			description: "hit built by another daemon is imported",
+			shared: map[string]ImageDetails{
+				"hash": {ID: "otherImageID", Digest: "sha256:abc"},
+			},
+			api:      (&testutil.FakeAPIClient{}).Add("tag@sha256:abc", "imageID"),
+			expected: needsLocalTagging{hash: "hash", tag: "tag", imageID: "imageID"},
+		},
+		{
+			description: "hit built by another daemon without digest",
+			shared: map[string]ImageDetails{
+				"hash": {ID: "otherImageID"},
+			},
+			api:      &testutil.FakeAPIClient{},
+			expected: needsBuilding{hash: "hash"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&docker.RemoteDigest, func(identifier string, _ docker.Config) (string, error) {
+				if identifier == "tag@sha256:abc" {
+					return "sha256:abc", nil
+				}
+				return "", errors.New("unknown remote tag")
+			})
+
+			cache := &cache{
+				isLocalImage:       func(string) (bool, error) { return true, nil },
+				importMissingImage: func(imageName string) (bool, error) { return false, nil },
+				artifactCache:      map[string]ImageDetails{},
+				sharedStore:        mockStore(test.shared),
+				inputsByHash:       map[string]*HashInputs{},
+				client:             fakeLocalDaemon(test.api),
+				cfg:                &mockConfig{mode: config.RunModes.Build},
+			}
+			t.Override(&newArtifactHasherFunc, func(graph.ArtifactGraph, DependencyLister, config.RunMode, func(string) (bool, error)) artifactHasher {
+				return mockHasher{"hash"}
+			})
+			details := cache.lookupArtifacts(context.Background(), map[string]string{"artifact": "tag"}, []*latest_v1.Artifact{{
+				ImageName: "artifact",
+			}})
+
+			if !reflect.DeepEqual(test.expected, details[0]) {
+				t.Errorf("Expected result different from actual result. Expected: \n%v, \nActual: \n%v", test.expected, details)
+			}
+		})
+	}
+}
+
+type mockStore map[string]ImageDetails
+
+func (m mockStore) Get(_ context.Context, hash string) (ImageDetails, bool, error) {
+	entry, found := m[hash]
+	return entry, found, nil
+}
+
+func (m mockStore) Put(_ context.Context, hash string, entry ImageDetails) error {
+	m[hash] = entry
+	return nil
+}
+
 type mockHasher struct {
 	val string
 }
@@ -234,6 +318,10 @@ func (m mockHasher) hash(context.Context, *latest_v1.Artifact) (string, error) {
 	return m.val, nil
 }
 
+func (m mockHasher) hashInputs(context.Context, *latest_v1.Artifact) (*HashInputs, error) {
+	return &HashInputs{}, nil
+}
+
 type failingHasher struct {
 	err error
 }
@@ -242,6 +330,10 @@ func (f failingHasher) hash(context.Context, *latest_v1.Artifact) (string, error
 	return "", f.err
 }
 
+func (f failingHasher) hashInputs(context.Context, *latest_v1.Artifact) (*HashInputs, error) {
+	return nil, f.err
+}
+
 func fakeLocalDaemon(api client.CommonAPIClient) docker.LocalDaemon {
 	return docker.NewLocalDaemon(api, nil, false, nil)
 }
@@ -66,6 +66,7 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 
 		case needsBuilding:
 			color.Yellow.Fprintln(out, "Not found. Building")
+			c.explainCacheMiss(out, artifact.ImageName, result.Hash())
 			hashByName[artifact.ImageName] = result.Hash()
 			needToBuild = append(needToBuild, artifact)
 			continue
@@ -95,9 +96,7 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 		}
 
 		// Image is already built
-		c.cacheMutex.RLock()
-		entry := c.artifactCache[result.Hash()]
-		c.cacheMutex.RUnlock()
+		entry := c.touchArtifact(result.Hash(), artifact.ImageName)
 		tag := tags[artifact.ImageName]
 
 		var uniqueTag string
@@ -133,6 +132,8 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 		return append(bRes, alreadyBuilt...), nil
 	}
 
+	c.shareArtifacts(ctx, bRes, hashByName)
+
 	if err := saveArtifactCache(c.cacheFile, c.artifactCache); err != nil {
 		logrus.Warnf("error saving cache file; caching may not work as expected: %v", err)
 		return append(bRes, alreadyBuilt...), nil
@@ -182,6 +183,40 @@ func (c *cache) addArtifacts(ctx context.Context, bRes []graph.Artifact, hashByN
 		c.cacheMutex.Lock()
 		c.artifactCache[hashByName[a.ImageName]] = entry
 		c.cacheMutex.Unlock()
+		c.touchArtifact(hashByName[a.ImageName], a.ImageName)
 	}
 	return nil
 }
+
+// touchArtifact records that the cache entry for the given hash was just used, and returns the updated entry.
+func (c *cache) touchArtifact(hash, imageName string) ImageDetails {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	entry := c.artifactCache[hash]
+	entry.Image = imageName
+	entry.LastUsed = time.Now()
+	if inputs := c.inputsByHash[hash]; inputs != nil {
+		entry.Inputs = digestInputs(inputs)
+	}
+	c.artifactCache[hash] = entry
+	return entry
+}
+
+// shareArtifacts publishes the entries of newly built artifacts to the shared cache.
+func (c *cache) shareArtifacts(ctx context.Context, bRes []graph.Artifact, hashByName map[string]string) {
+	if c.sharedStore == nil {
+		return
+	}
+
+	for _, a := range bRes {
+		hash := hashByName[a.ImageName]
+		c.cacheMutex.RLock()
+		entry := c.artifactCache[hash]
+		c.cacheMutex.RUnlock()
+
+		if err := c.sharedStore.Put(ctx, hash, entry); err != nil {
+			logrus.Warnf("error adding %s to the shared cache: %v", a.ImageName, err)
+		}
+	}
+}
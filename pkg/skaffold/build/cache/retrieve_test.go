@@ -190,6 +190,67 @@ func TestCacheBuildLocal(t *testing.T) {
 	})
 }
 
+func TestCacheBuildShared(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("dep1", "content1").
+			Write("dep2", "content2").
+			Chdir()
+
+		tags := map[string]string{
+			"artifact1": "artifact1:tag1",
+			"artifact2": "artifact2:tag2",
+		}
+		artifacts := []*latest_v1.Artifact{
+			{ImageName: "artifact1", ArtifactType: latest_v1.ArtifactType{DockerArtifact: &latest_v1.DockerArtifact{}}},
+			{ImageName: "artifact2", ArtifactType: latest_v1.ArtifactType{DockerArtifact: &latest_v1.DockerArtifact{}}},
+		}
+		deps := depLister(map[string][]string{
+			"artifact1": {"dep1"},
+			"artifact2": {"dep2"},
+		})
+
+		// Mock Docker
+		t.Override(&docker.DefaultAuthHelper, stubAuth{})
+		dockerDaemon := fakeLocalDaemon(&testutil.FakeAPIClient{})
+		t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+			return dockerDaemon, nil
+		})
+
+		// Mock args builder
+		t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+			return args, nil
+		})
+
+		newCache := func(cacheFile string) Cache {
+			cfg := &mockConfig{
+				pipeline:    latest_v1.Pipeline{Build: latest_v1.BuildConfig{BuildType: latest_v1.BuildType{LocalBuild: &latest_v1.LocalBuild{TryImportMissing: false}}}},
+				cacheFile:   tmpDir.Path(cacheFile),
+				sharedCache: tmpDir.Path("shared"),
+			}
+			artifactCache, err := NewCache(cfg, func(imageName string) (bool, error) { return true, nil }, deps, graph.ToArtifactGraph(artifacts), make(mockArtifactStore))
+			t.CheckNoError(err)
+			return artifactCache
+		}
+
+		// First machine: Need to build both artifacts
+		builder := &mockBuilder{dockerDaemon: dockerDaemon, push: false, store: make(mockArtifactStore)}
+		bRes, err := newCache("cache1").Build(context.Background(), ioutil.Discard, tags, artifacts, builder.Build)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(2, len(builder.built))
+		t.CheckDeepEqual(2, len(bRes))
+
+		// Second machine, with an empty local cache: both artifacts are read from the shared cache
+		builder = &mockBuilder{dockerDaemon: dockerDaemon, push: false, store: make(mockArtifactStore)}
+		bRes, err = newCache("cache2").Build(context.Background(), ioutil.Discard, tags, artifacts, builder.Build)
+
+		t.CheckNoError(err)
+		t.CheckEmpty(builder.built)
+		t.CheckDeepEqual(2, len(bRes))
+	})
+}
+
 func TestCacheBuildRemote(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		tmpDir := t.NewTempDir().
@@ -342,11 +403,15 @@ func TestCacheFindMissing(t *testing.T) {
 type mockConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 	cacheFile             string
+	sharedCache           string
+	explainCache          bool
 	mode                  config.RunMode
 	pipeline              latest_v1.Pipeline
 }
 
 func (c *mockConfig) CacheArtifacts() bool                               { return true }
 func (c *mockConfig) CacheFile() string                                  { return c.cacheFile }
+func (c *mockConfig) SharedCache() string                                { return c.sharedCache }
+func (c *mockConfig) ExplainCache() bool                                 { return c.explainCache }
 func (c *mockConfig) Mode() config.RunMode                               { return c.mode }
 func (c *mockConfig) PipelineForImage(string) (latest_v1.Pipeline, bool) { return c.pipeline, true }
@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+const (
+	ociStorePrefix = "oci://"
+
+	digestLabel  = "dev.skaffold.cache.digest"
+	imageIDLabel = "dev.skaffold.cache.id"
+)
+
+// Store is a cache backend shared between machines, where entries are keyed by artifact hash.
+type Store interface {
+	// Get returns the entry for the given hash, and whether it was found.
+	Get(ctx context.Context, hash string) (ImageDetails, bool, error)
+
+	// Put stores the entry for the given hash.
+	Put(ctx context.Context, hash string, entry ImageDetails) error
+}
+
+// NewStore returns the shared cache Store for a location:
+// an image repository prefixed with `oci://`, or a directory otherwise.
+func NewStore(location string, cfg docker.Config) (Store, error) {
+	if repo := strings.TrimPrefix(location, ociStorePrefix); repo != location {
+		if repo == "" {
+			return nil, fmt.Errorf("missing image repository in shared cache location %q", location)
+		}
+		return &registryStore{repo: repo, cfg: cfg}, nil
+	}
+
+	if err := os.MkdirAll(location, 0755); err != nil {
+		return nil, fmt.Errorf("creating shared cache directory %q: %w", location, err)
+	}
+	return &dirStore{dir: location}, nil
+}
+
+// dirStore keeps one file per entry in a directory, typically on a shared volume.
+type dirStore struct {
+	dir string
+}
+
+func (s *dirStore) Get(_ context.Context, hash string) (ImageDetails, bool, error) {
+	contents, err := ioutil.ReadFile(filepath.Join(s.dir, hash))
+	if os.IsNotExist(err) {
+		return ImageDetails{}, false, nil
+	}
+	if err != nil {
+		return ImageDetails{}, false, err
+	}
+
+	var entry ImageDetails
+	if err := yaml.Unmarshal(contents, &entry); err != nil {
+		return ImageDetails{}, false, fmt.Errorf("reading shared cache entry %q: %w", hash, err)
+	}
+	return entry, true, nil
+}
+
+func (s *dirStore) Put(_ context.Context, hash string, entry ImageDetails) error {
+	data, err := yaml.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that concurrent readers never see a partial entry.
+	tmp, err := ioutil.TempFile(s.dir, "."+hash)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(s.dir, hash))
+}
+
+// registryStore keeps one empty image per entry in an image repository, tagged with the artifact hash.
+// The entry is stored in the image's config labels.
+type registryStore struct {
+	repo string
+	cfg  docker.Config
+}
+
+func (s *registryStore) Get(_ context.Context, hash string) (ImageDetails, bool, error) {
+	cf, err := docker.RetrieveRemoteConfig(s.tag(hash), s.cfg)
+	if err != nil {
+		var tErr *transport.Error
+		if errors.As(err, &tErr) && tErr.StatusCode == http.StatusNotFound {
+			return ImageDetails{}, false, nil
+		}
+		return ImageDetails{}, false, err
+	}
+
+	labels := cf.Config.Labels
+	if labels[digestLabel] == "" && labels[imageIDLabel] == "" {
+		return ImageDetails{}, false, nil
+	}
+	return ImageDetails{Digest: labels[digestLabel], ID: labels[imageIDLabel]}, true, nil
+}
+
+func (s *registryStore) Put(_ context.Context, hash string, entry ImageDetails) error {
+	cf, err := empty.Image.ConfigFile()
+	if err != nil {
+		return err
+	}
+	cf.Config.Labels = map[string]string{
+		digestLabel:  entry.Digest,
+		imageIDLabel: entry.ID,
+	}
+
+	img, err := mutate.ConfigFile(empty.Image, cf)
+	if err != nil {
+		return err
+	}
+	return docker.WriteRemoteImage(img, s.tag(hash), s.cfg)
+}
+
+func (s *registryStore) tag(hash string) string {
+	return s.repo + ":" + hash
+}
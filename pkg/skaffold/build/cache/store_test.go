@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestStore(t *testing.T) {
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(ioutil.Discard, "", 0))))
+	defer server.Close()
+	registryHost := strings.TrimPrefix(server.URL, "http://")
+
+	tests := []struct {
+		description string
+		location    func(t *testutil.T) string
+	}{
+		{
+			description: "directory",
+			location:    func(t *testutil.T) string { return t.NewTempDir().Path("shared") },
+		},
+		{
+			description: "registry",
+			location:    func(*testutil.T) string { return "oci://" + registryHost + "/skaffold-cache" },
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			ctx := context.Background()
+			store, err := NewStore(test.location(t), &runcontext.RunContext{})
+			t.CheckErrorAndFailNow(false, err)
+
+			_, found, err := store.Get(ctx, "0123abcd")
+			t.CheckNoError(err)
+			t.CheckFalse(found)
+
+			entry := ImageDetails{Digest: "sha256:cafe", ID: "sha256:beef"}
+			t.CheckNoError(store.Put(ctx, "0123abcd", entry))
+
+			actual, found, err := store.Get(ctx, "0123abcd")
+			t.CheckNoError(err)
+			t.CheckTrue(found)
+			t.CheckDeepEqual(entry, actual)
+
+			// Entries can be overwritten
+			entry = ImageDetails{Digest: "sha256:f00d"}
+			t.CheckNoError(store.Put(ctx, "0123abcd", entry))
+
+			actual, _, err = store.Get(ctx, "0123abcd")
+			t.CheckNoError(err)
+			t.CheckDeepEqual(entry, actual)
+		})
+	}
+}
+
+func TestNewStoreMissingRepository(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		_, err := NewStore("oci://", &runcontext.RunContext{})
+
+		t.CheckError(true, err)
+	})
+}
@@ -22,22 +22,26 @@ import (
 	"fmt"
 	"io"
 
+	registryv1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
 const initContainer = "kaniko-init-container"
 
-func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace string, artifactName string, artifact *latest_v1.KanikoArtifact, tag string, requiredImages map[string]*string) (string, error) {
+func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace string, artifactName string, artifact *latest_v1.KanikoArtifact, platforms []string, tag string, requiredImages map[string]*string) (string, error) {
 	generatedEnvs, err := generateEnvFromImage(tag)
 	if err != nil {
 		return "", fmt.Errorf("error processing generated env variables from image uri: %w", err)
@@ -54,20 +58,60 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 	}
 	artifact.BuildArgs = buildArgs
 
+	if len(platforms) <= 1 {
+		var targetPlatform string
+		if len(platforms) == 1 {
+			targetPlatform = platforms[0]
+		}
+		if err := b.runKanikoPod(ctx, out, workspace, artifactName, artifact, tag, targetPlatform); err != nil {
+			return "", err
+		}
+		return docker.RemoteDigest(tag, b.cfg)
+	}
+
+	// Kaniko builds for a single platform: build each platform in its own pod and combine
+	// the images into a manifest list. Each image is pushed to the final tag and then
+	// referenced by digest, so that no platform specific tag is left in the registry.
+	var images []string
+	var parsed []registryv1.Platform
+	for _, pl := range platforms {
+		p, err := platform.Parse(pl)
+		if err != nil {
+			return "", err
+		}
+
+		color.Default.Fprintf(out, "Building %s for %s\n", artifactName, pl)
+		if err := b.runKanikoPod(ctx, out, workspace, artifactName, artifact, tag, pl); err != nil {
+			return "", err
+		}
+		digest, err := docker.RemoteDigest(tag, b.cfg)
+		if err != nil {
+			return "", err
+		}
+
+		images = append(images, build.TagWithDigest(tag, digest))
+		parsed = append(parsed, p)
+	}
+
+	return docker.CreateManifestList(images, parsed, tag, b.cfg)
+}
+
+// runKanikoPod builds and pushes an image in a kaniko pod.
+func (b *Builder) runKanikoPod(ctx context.Context, out io.Writer, workspace string, artifactName string, artifact *latest_v1.KanikoArtifact, tag string, targetPlatform string) error {
 	client, err := kubernetesclient.Client()
 	if err != nil {
-		return "", fmt.Errorf("getting Kubernetes client: %w", err)
+		return fmt.Errorf("getting Kubernetes client: %w", err)
 	}
 	pods := client.CoreV1().Pods(b.Namespace)
 
-	podSpec, err := b.kanikoPodSpec(artifact, tag)
+	podSpec, err := b.kanikoPodSpec(artifact, tag, targetPlatform)
 	if err != nil {
-		return "", err
+		return err
 	}
 
 	pod, err := pods.Create(ctx, podSpec, metav1.CreateOptions{})
 	if err != nil {
-		return "", fmt.Errorf("creating kaniko pod: %w", err)
+		return fmt.Errorf("creating kaniko pod: %w", err)
 	}
 	defer func() {
 		if err := pods.Delete(ctx, pod.Name, metav1.DeleteOptions{
@@ -78,7 +122,7 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 	}()
 
 	if err := b.copyKanikoBuildContext(ctx, workspace, artifactName, artifact, pods, pod.Name); err != nil {
-		return "", fmt.Errorf("copying sources: %w", err)
+		return fmt.Errorf("copying sources: %w", err)
 	}
 
 	// Wait for the pods to succeed while streaming the logs
@@ -86,12 +130,11 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 
 	if err := kubernetes.WaitForPodSucceeded(ctx, pods, pod.Name, b.timeout); err != nil {
 		waitForLogs()
-		return "", err
+		return err
 	}
 
 	waitForLogs()
-
-	return docker.RemoteDigest(tag, b.cfg)
+	return nil
 }
 
 // first copy over the buildcontext tarball into the init container tmp dir via kubectl cp
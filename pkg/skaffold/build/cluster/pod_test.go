@@ -240,7 +240,7 @@ func TestKanikoPodSpec(t *testing.T) {
 			},
 		},
 	}
-	pod, _ := builder.kanikoPodSpec(artifact, "tag")
+	pod, _ := builder.kanikoPodSpec(artifact, "tag", "")
 
 	expectedPod := &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -383,6 +383,24 @@ func TestKanikoPodSpec(t *testing.T) {
 	testutil.CheckDeepEqual(t, expectedPod.Spec.Containers[0].Env, pod.Spec.Containers[0].Env)
 }
 
+func TestKanikoPodSpecPlatform(t *testing.T) {
+	artifact := &latest_v1.KanikoArtifact{
+		Image:          "image",
+		DockerfilePath: "Dockerfile",
+		InitImage:      "init/image",
+	}
+	builder := &Builder{
+		cfg:            &mockBuilderContext{},
+		ClusterDetails: &latest_v1.ClusterDetails{Namespace: "ns"},
+	}
+
+	pod, err := builder.kanikoPodSpec(artifact, "gcr.io/tag", "linux/arm64")
+
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, map[string]string{"kubernetes.io/os": "linux", "kubernetes.io/arch": "arm64"}, pod.Spec.NodeSelector)
+	testutil.CheckDeepEqual(t, []string{"--custom-platform", "linux/arm64"}, pod.Spec.Containers[0].Args[len(pod.Spec.Containers[0].Args)-2:])
+}
+
 func TestResourceRequirements(t *testing.T) {
 	tests := []struct {
 		description string
@@ -18,10 +18,13 @@ package docker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
@@ -30,6 +33,9 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/warnings"
 )
 
+// For testing
+var tempFile = ioutil.TempFile
+
 func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest_v1.Artifact, tag string) (string, error) {
 	// Fail fast if the Dockerfile can't be found.
 	dockerfile, err := docker.NormalizeDockerfilePath(a.Workspace, a.DockerArtifact.DockerfilePath)
@@ -45,6 +51,20 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest_v1.Artifac
 	}
 	opts := docker.BuildOptions{Tag: tag, Mode: b.mode, ExtraBuildArgs: docker.ResolveDependencyImages(a.Dependencies, b.artifacts, true)}
 
+	if len(a.Platforms) > 1 {
+		if !b.pushImages {
+			return "", fmt.Errorf("building %q for several platforms requires pushing the image", a.ImageName)
+		}
+		digest, err := b.dockerBuildxBuild(ctx, color.GetWriter(out), a.Workspace, dockerfile, a.ArtifactType.DockerArtifact, a.Platforms, opts)
+		if err != nil {
+			return "", newBuildError(err)
+		}
+		return digest, nil
+	}
+	if len(a.Platforms) == 1 {
+		opts.Platform = a.Platforms[0]
+	}
+
 	var imageID string
 
 	if b.useCLI || b.useBuildKit {
@@ -68,16 +88,15 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest_v1.Artifac
 
 func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, workspace string, dockerfilePath string, a *latest_v1.DockerArtifact, opts docker.BuildOptions) (string, error) {
 	args := []string{"build", workspace, "--file", dockerfilePath, "-t", opts.Tag}
-	ba, err := docker.EvalBuildArgs(b.mode, workspace, a.DockerfilePath, a.BuildArgs, opts.ExtraBuildArgs)
+	cliArgs, err := b.cliBuildArgs(workspace, a, opts)
 	if err != nil {
-		return "", fmt.Errorf("unable to evaluate build args: %w", err)
-	}
-	cliArgs, err := docker.ToCLIBuildArgs(a, ba)
-	if err != nil {
-		return "", fmt.Errorf("getting docker build args: %w", err)
+		return "", err
 	}
 	args = append(args, cliArgs...)
-
+	if opts.Platform != "" {
+		args = append(args, "--platform", opts.Platform)
+	}
+	// Only the classic builder leaves intermediate containers behind, `docker buildx build` has no such flag.
 	if b.prune {
 		args = append(args, "--force-rm")
 	}
@@ -97,6 +116,61 @@ func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, workspace s
 	return b.localDocker.ImageID(ctx, opts.Tag)
 }
 
+// dockerBuildxBuild builds an image for several platforms with `docker buildx` and pushes it as a manifest list.
+// The local Docker daemon can't store such images, so they are never loaded. It returns the digest of the manifest list.
+func (b *Builder) dockerBuildxBuild(ctx context.Context, out io.Writer, workspace string, dockerfilePath string, a *latest_v1.DockerArtifact, platforms []string, opts docker.BuildOptions) (string, error) {
+	metadata, err := tempFile("", "buildx-metadata")
+	if err != nil {
+		return "", fmt.Errorf("creating metadata file: %w", err)
+	}
+	metadata.Close()
+	defer os.Remove(metadata.Name())
+
+	args := []string{"buildx", "build", workspace, "--file", dockerfilePath, "-t", opts.Tag, "--platform", strings.Join(platforms, ","), "--push", "--metadata-file", metadata.Name()}
+	cliArgs, err := b.cliBuildArgs(workspace, a, opts)
+	if err != nil {
+		return "", err
+	}
+	args = append(args, cliArgs...)
+
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	cmd.Env = append(util.OSEnviron(), b.localDocker.ExtraEnv()...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	if err := util.RunCmd(cmd); err != nil {
+		return "", fmt.Errorf("running build: %w", err)
+	}
+
+	buf, err := ioutil.ReadFile(metadata.Name())
+	if err != nil {
+		return "", fmt.Errorf("reading build metadata: %w", err)
+	}
+	var result struct {
+		Digest string `json:"containerimage.digest"`
+	}
+	if err := json.Unmarshal(buf, &result); err != nil {
+		return "", fmt.Errorf("parsing build metadata: %w", err)
+	}
+	if result.Digest == "" {
+		return "", fmt.Errorf("no digest found in build metadata of %q", opts.Tag)
+	}
+	return result.Digest, nil
+}
+
+// cliBuildArgs returns the arguments shared by `docker build` and `docker buildx build`.
+func (b *Builder) cliBuildArgs(workspace string, a *latest_v1.DockerArtifact, opts docker.BuildOptions) ([]string, error) {
+	ba, err := docker.EvalBuildArgs(b.mode, workspace, a.DockerfilePath, a.BuildArgs, opts.ExtraBuildArgs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+	args, err := docker.ToCLIBuildArgs(a, ba)
+	if err != nil {
+		return nil, fmt.Errorf("getting docker build args: %w", err)
+	}
+	return args, nil
+}
+
 func (b *Builder) pullCacheFromImages(ctx context.Context, out io.Writer, a *latest_v1.DockerArtifact) error {
 	if len(a.CacheFrom) == 0 {
 		return nil
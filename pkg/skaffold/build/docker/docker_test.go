@@ -20,7 +20,9 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/docker/docker/api/types"
@@ -126,6 +128,83 @@ func TestDockerCLIBuild(t *testing.T) {
 	}
 }
 
+func TestDockerBuildPlatforms(t *testing.T) {
+	tests := []struct {
+		description string
+		platforms   []string
+		pushImages  bool
+		prune       bool
+		command     string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "single platform",
+			platforms:   []string{"linux/arm64"},
+			command:     "docker build . --file DOCKERFILE -t tag --platform linux/arm64",
+			expected:    "sha256:imageID",
+		},
+		{
+			description: "several platforms",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			pushImages:  true,
+			command:     "docker buildx build . --file DOCKERFILE -t tag --platform linux/amd64,linux/arm64 --push --metadata-file METADATA",
+			expected:    "sha256:digest",
+		},
+		{
+			description: "remove intermediate containers of the classic builder",
+			platforms:   []string{"linux/arm64"},
+			prune:       true,
+			command:     "docker build . --file DOCKERFILE -t tag --platform linux/arm64 --force-rm",
+			expected:    "sha256:imageID",
+		},
+		{
+			description: "buildx builds don't leave intermediate containers",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			pushImages:  true,
+			prune:       true,
+			command:     "docker buildx build . --file DOCKERFILE -t tag --platform linux/amd64,linux/arm64 --push --metadata-file METADATA",
+			expected:    "sha256:digest",
+		},
+		{
+			description: "several platforms without push",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Touch("Dockerfile").Write("metadata.json", `{"containerimage.digest": "sha256:digest"}`).Chdir()
+			dockerfilePath, _ := filepath.Abs("Dockerfile")
+			metadataPath := tmpDir.Path("metadata.json")
+			t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+				return args, nil
+			})
+			t.Override(&tempFile, func(string, string) (*os.File, error) {
+				return os.OpenFile(metadataPath, os.O_RDWR, 0)
+			})
+			command := strings.NewReplacer("DOCKERFILE", dockerfilePath, "METADATA", metadataPath).Replace(test.command)
+			t.Override(&util.DefaultExecCommand, testutil.CmdRun(command))
+
+			localDocker := docker.NewLocalDaemon((&testutil.FakeAPIClient{}).Add("tag", "sha256:imageID"), nil, false, nil)
+			builder := NewArtifactBuilder(localDocker, true, false, test.pushImages, test.prune, config.RunModes.Build, nil, mockArtifactResolver{make(map[string]string)}, nil)
+
+			artifact := &latest_v1.Artifact{
+				Workspace: ".",
+				ArtifactType: latest_v1.ArtifactType{
+					DockerArtifact: &latest_v1.DockerArtifact{
+						DockerfilePath: "Dockerfile",
+					},
+				},
+				Platforms: test.platforms,
+			}
+
+			digestOrImageID, err := builder.Build(context.Background(), ioutil.Discard, artifact, "tag")
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, digestOrImageID)
+		})
+	}
+}
+
 func fakeLocalDaemonWithExtraEnv(extraEnv []string) docker.LocalDaemon {
 	return docker.NewLocalDaemon(&testutil.FakeAPIClient{}, extraEnv, false, nil)
 }
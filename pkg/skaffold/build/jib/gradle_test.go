@@ -103,6 +103,7 @@ func TestBuildJibGradleToRegistry(t *testing.T) {
 	tests := []struct {
 		description   string
 		artifact      *latest_v1.JibArtifact
+		platforms     []string
 		commands      util.Command
 		shouldErr     bool
 		expectedError string
@@ -128,6 +129,12 @@ func TestBuildJibGradleToRegistry(t *testing.T) {
 				"gradle fake-gradleBuildArgs-for-jib -Djib.from.image=docker://busybox --image=img:tag",
 			),
 		},
+		{
+			description: "build for several platforms",
+			artifact:    &latest_v1.JibArtifact{},
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			commands:    testutil.CmdRun("gradle fake-gradleBuildArgs-for-jib --image=img:tag -Djib.from.platforms=linux/amd64,linux/arm64"),
+		},
 		{
 			description: "fail build",
 			artifact:    &latest_v1.JibArtifact{},
@@ -158,6 +165,7 @@ func TestBuildJibGradleToRegistry(t *testing.T) {
 				ArtifactType: latest_v1.ArtifactType{
 					JibArtifact: test.artifact,
 				},
+				Platforms: test.platforms,
 			}, "img:tag")
 
 			t.CheckError(test.shouldErr, err)
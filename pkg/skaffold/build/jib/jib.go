@@ -348,3 +348,11 @@ func baseImageArg(a *latest_v1.JibArtifact, r ArtifactResolver, deps []*latest_v
 	}
 	return fmt.Sprintf("-Djib.from.image=%s", a.BaseImage), true
 }
+
+// platformsArgs formats the platforms as build arguments. Images built for several platforms must be pushed.
+func platformsArgs(platforms []string) []string {
+	if len(platforms) == 0 {
+		return nil
+	}
+	return []string{fmt.Sprintf("-Djib.from.platforms=%s", strings.Join(platforms, ","))}
+}
@@ -103,6 +103,7 @@ func TestBuildJibMavenToRegistry(t *testing.T) {
 	tests := []struct {
 		description   string
 		artifact      *latest_v1.JibArtifact
+		platforms     []string
 		commands      util.Command
 		shouldErr     bool
 		expectedError string
@@ -122,6 +123,12 @@ func TestBuildJibMavenToRegistry(t *testing.T) {
 			artifact:    &latest_v1.JibArtifact{BaseImage: "docker://busybox"},
 			commands:    testutil.CmdRun("mvn fake-mavenBuildArgs-for-build -Djib.from.image=docker://busybox -Dimage=img:tag"),
 		},
+		{
+			description: "build for several platforms",
+			artifact:    &latest_v1.JibArtifact{},
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			commands:    testutil.CmdRun("mvn fake-mavenBuildArgs-for-build -Dimage=img:tag -Djib.from.platforms=linux/amd64,linux/arm64"),
+		},
 		{
 			description: "fail build",
 			artifact:    &latest_v1.JibArtifact{},
@@ -152,6 +159,7 @@ func TestBuildJibMavenToRegistry(t *testing.T) {
 				ArtifactType: latest_v1.ArtifactType{
 					JibArtifact: test.artifact,
 				},
+				Platforms: test.platforms,
 			}, "img:tag")
 
 			t.CheckError(test.shouldErr, err)
@@ -0,0 +1,340 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	defaultBaseImage = "gcr.io/distroless/static:nonroot"
+
+	// appDir is where the binary is installed in the image.
+	appDir = "/ko-app"
+
+	// dataDir is where the content of the `kodata` directory is installed in the image.
+	dataDir = "/var/run/ko"
+)
+
+// For testing
+var (
+	retrieveBaseImage = docker.RetrieveRemoteImageForPlatform
+	writeRemoteImage  = docker.WriteRemoteImage
+	writeRemoteIndex  = docker.WriteRemoteIndex
+)
+
+// Build builds an artifact with `go build` and assembles its image in-process, like ko does.
+func (b *Builder) Build(ctx context.Context, out io.Writer, artifact *latest_v1.Artifact, tag string) (string, error) {
+	platforms := artifact.Platforms
+	if len(platforms) == 0 {
+		platforms = []string{platform.Host()}
+	}
+	if len(platforms) > 1 && !b.pushImages {
+		return "", fmt.Errorf("images built for several platforms must be pushed: %s", strings.Join(platforms, ", "))
+	}
+
+	var images []v1.Image
+	var parsed []v1.Platform
+	for _, pl := range platforms {
+		p, err := platform.Parse(pl)
+		if err != nil {
+			return "", err
+		}
+		img, err := b.buildImage(ctx, out, artifact.Workspace, artifact.KoArtifact, p)
+		if err != nil {
+			return "", err
+		}
+		images = append(images, img)
+		parsed = append(parsed, p)
+	}
+
+	if !b.pushImages {
+		return b.loadImage(ctx, out, images[0], tag)
+	}
+
+	if len(images) == 1 {
+		if err := writeRemoteImage(images[0], tag, b.cfg); err != nil {
+			return "", fmt.Errorf("pushing image %q: %w", tag, err)
+		}
+		return digest(images[0])
+	}
+
+	idx, err := docker.NewManifestList(images, parsed)
+	if err != nil {
+		return "", err
+	}
+	if err := writeRemoteIndex(idx, tag, b.cfg); err != nil {
+		return "", err
+	}
+	return digest(idx)
+}
+
+// buildImage builds the image of an artifact for one platform: the binary, and the `kodata`
+// directory if there's one, are added as a single layer on top of the base image.
+func (b *Builder) buildImage(ctx context.Context, out io.Writer, workspace string, a *latest_v1.KoArtifact, p v1.Platform) (v1.Image, error) {
+	baseImage := a.BaseImage
+	if baseImage == "" {
+		baseImage = defaultBaseImage
+	}
+	base, err := retrieveBaseImage(baseImage, p, b.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("getting base image %q: %w", baseImage, err)
+	}
+
+	tmpDir, err := ioutil.TempDir("", "skaffold-ko")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	binary := filepath.Join(tmpDir, binaryName(workspace, a))
+	if err := b.goBuild(ctx, out, workspace, a, p, binary); err != nil {
+		return nil, err
+	}
+
+	// The layer is kept in memory since the temporary directory is removed before the image is written.
+	var buf bytes.Buffer
+	appPath := path.Join(appDir, filepath.Base(binary))
+	if err := writeLayer(&buf, binary, appPath, filepath.Join(workspace, mainPackage(a), "kodata")); err != nil {
+		return nil, fmt.Errorf("creating image layer: %w", err)
+	}
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(buf.Bytes())), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating image layer: %w", err)
+	}
+
+	img, err := mutate.Append(base, mutate.Addendum{
+		Layer: layer,
+		History: v1.History{
+			Author:    "skaffold",
+			CreatedBy: "skaffold ko builder",
+			Comment:   "go build output, at " + appPath,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("adding image layer: %w", err)
+	}
+
+	cf, err := img.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("reading image config: %w", err)
+	}
+	cf = cf.DeepCopy()
+	cf.OS = p.OS
+	cf.Architecture = p.Architecture
+	cf.Config.Entrypoint = []string{appPath}
+	cf.Config.Cmd = nil
+	cf.Config.Env = append(cf.Config.Env, "KO_DATA_PATH="+dataDir)
+
+	return mutate.ConfigFile(img, cf)
+}
+
+// goBuild builds a statically linked binary for the given platform.
+func (b *Builder) goBuild(ctx context.Context, out io.Writer, workspace string, a *latest_v1.KoArtifact, p v1.Platform, binary string) error {
+	args := []string{"build", "-o", binary}
+	if b.cfg.Mode() == config.RunModes.Debug {
+		// disable build optimization for Golang
+		args = append(args, "-gcflags", "all=-N -l")
+	}
+	args = append(args, a.Flags...)
+	if len(a.Ldflags) > 0 {
+		var ldflags []string
+		for _, flag := range a.Ldflags {
+			expanded, err := util.ExpandEnvTemplate(flag, nil)
+			if err != nil {
+				return fmt.Errorf("expanding ldflags %q: %w", flag, err)
+			}
+			ldflags = append(ldflags, expanded)
+		}
+		args = append(args, "-ldflags", strings.Join(ldflags, " "))
+	}
+	args = append(args, mainPackage(a))
+
+	env, err := buildEnv(a, p)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = workspace
+	cmd.Env = env
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := util.RunCmd(cmd); err != nil {
+		return fmt.Errorf("running go build: %w", err)
+	}
+	return nil
+}
+
+// buildEnv is the environment of `go build`: cgo is disabled by default, and the target
+// platform always overrides the user's environment.
+func buildEnv(a *latest_v1.KoArtifact, p v1.Platform) ([]string, error) {
+	env := append(util.OSEnviron(), "CGO_ENABLED=0")
+	for _, kv := range a.Env {
+		expanded, err := util.ExpandEnvTemplate(kv, nil)
+		if err != nil {
+			return nil, fmt.Errorf("expanding env %q: %w", kv, err)
+		}
+		env = append(env, expanded)
+	}
+
+	env = append(env, "GOOS="+p.OS, "GOARCH="+p.Architecture)
+	if p.Variant != "" && p.Architecture == "arm" {
+		env = append(env, "GOARM="+strings.TrimPrefix(p.Variant, "v"))
+	}
+	return env, nil
+}
+
+// writeLayer writes a layer with the binary and the content of the `kodata` directory.
+func writeLayer(w io.Writer, binary, appPath, kodata string) error {
+	tw := tar.NewWriter(w)
+	defer tw.Close()
+
+	if err := addDir(tw, appDir); err != nil {
+		return err
+	}
+	if err := addFile(tw, binary, appPath, 0755); err != nil {
+		return err
+	}
+
+	if err := addDir(tw, dataDir); err != nil {
+		return err
+	}
+	if !util.IsDir(kodata) {
+		return nil
+	}
+	return filepath.Walk(kodata, func(p string, info os.FileInfo, err error) error {
+		if err != nil || p == kodata {
+			return err
+		}
+		rel, err := filepath.Rel(kodata, p)
+		if err != nil {
+			return err
+		}
+		target := path.Join(dataDir, filepath.ToSlash(rel))
+		if info.IsDir() {
+			return addDir(tw, target)
+		}
+		return addFile(tw, p, target, 0644)
+	})
+}
+
+func addDir(tw *tar.Writer, dir string) error {
+	return tw.WriteHeader(&tar.Header{
+		Name:     strings.TrimPrefix(dir, "/") + "/",
+		Typeflag: tar.TypeDir,
+		Mode:     0755,
+	})
+}
+
+// addFile adds a file with a fixed mode and no timestamp, so that images are reproducible.
+func addFile(tw *tar.Writer, src, target string, mode int64) error {
+	// Follow symlinks, like ko does for the content of `kodata`.
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     strings.TrimPrefix(target, "/"),
+		Typeflag: tar.TypeReg,
+		Size:     info.Size(),
+		Mode:     mode,
+	}); err != nil {
+		return err
+	}
+
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+func (b *Builder) loadImage(ctx context.Context, out io.Writer, img v1.Image, tag string) (string, error) {
+	ref, err := name.NewTag(tag, name.WeakValidation)
+	if err != nil {
+		return "", fmt.Errorf("parsing tag %q: %w", tag, err)
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		w.CloseWithError(tarball.Write(ref, img, w))
+	}()
+
+	imageID, err := b.localDocker.Load(ctx, out, r, tag)
+	r.Close()
+	if err != nil {
+		return "", fmt.Errorf("loading image into docker daemon: %w", err)
+	}
+	return imageID, nil
+}
+
+// mainPackage is the path of the `main` package, relative to the artifact's context.
+func mainPackage(a *latest_v1.KoArtifact) string {
+	if a.Main == "" {
+		return "."
+	}
+	return a.Main
+}
+
+// binaryName is the name of the binary: the name of the `main` package's directory, like `go build` does.
+func binaryName(workspace string, a *latest_v1.KoArtifact) string {
+	dir, err := filepath.Abs(filepath.Join(workspace, mainPackage(a)))
+	if err != nil || filepath.Base(dir) == string(filepath.Separator) {
+		return "app"
+	}
+	return filepath.Base(dir)
+}
+
+type digester interface {
+	Digest() (v1.Hash, error)
+}
+
+func digest(d digester) (string, error) {
+	h, err := d.Digest()
+	if err != nil {
+		return "", fmt.Errorf("computing image digest: %w", err)
+	}
+	return h.String(), nil
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os/exec"
+	"sort"
+	"strings"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		description   string
+		artifact      latest_v1.KoArtifact
+		platforms     []string
+		mode          config.RunMode
+		pushImages    bool
+		expectedArgs  []string
+		expectedEnv   []string
+		expectedFiles []string
+		shouldErr     bool
+	}{
+		{
+			description:   "push",
+			pushImages:    true,
+			expectedArgs:  []string{"go", "build", "-o", "app", "."},
+			expectedEnv:   []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=amd64"},
+			expectedFiles: []string{"ko-app/", "ko-app/app", "var/run/ko/", "var/run/ko/index.html", "var/run/ko/static/", "var/run/ko/static/app.js"},
+		},
+		{
+			description:   "load into the local daemon",
+			expectedArgs:  []string{"go", "build", "-o", "app", "."},
+			expectedEnv:   []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=amd64"},
+			expectedFiles: []string{"ko-app/", "ko-app/app", "var/run/ko/", "var/run/ko/index.html", "var/run/ko/static/", "var/run/ko/static/app.js"},
+		},
+		{
+			description: "flags, ldflags and env",
+			artifact: latest_v1.KoArtifact{
+				Main:    "./cmd/server",
+				Flags:   []string{"-trimpath"},
+				Ldflags: []string{"-s", "-w", "-X main.version={{.VERSION}}"},
+				Env:     []string{"CGO_ENABLED=1", "GOPRIVATE={{.VERSION}}"},
+			},
+			pushImages:    true,
+			expectedArgs:  []string{"go", "build", "-o", "server", "-trimpath", "-ldflags", "-s -w -X main.version=1.2.3", "./cmd/server"},
+			expectedEnv:   []string{"CGO_ENABLED=0", "CGO_ENABLED=1", "GOPRIVATE=1.2.3", "GOOS=linux", "GOARCH=amd64"},
+			expectedFiles: []string{"ko-app/", "ko-app/server", "var/run/ko/"},
+		},
+		{
+			description:   "debug",
+			mode:          config.RunModes.Debug,
+			pushImages:    true,
+			expectedArgs:  []string{"go", "build", "-o", "app", "-gcflags", "all=-N -l", "."},
+			expectedEnv:   []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=amd64"},
+			expectedFiles: []string{"ko-app/", "ko-app/app", "var/run/ko/", "var/run/ko/index.html", "var/run/ko/static/", "var/run/ko/static/app.js"},
+		},
+		{
+			description:   "arm variant",
+			platforms:     []string{"linux/arm/v7"},
+			pushImages:    true,
+			expectedArgs:  []string{"go", "build", "-o", "app", "."},
+			expectedEnv:   []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=arm", "GOARM=7"},
+			expectedFiles: []string{"ko-app/", "ko-app/app", "var/run/ko/", "var/run/ko/index.html", "var/run/ko/static/", "var/run/ko/static/app.js"},
+		},
+		{
+			description: "several platforms can't be loaded",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid platform",
+			platforms:   []string{"linux"},
+			pushImages:  true,
+			shouldErr:   true,
+		},
+		{
+			description: "invalid ldflags template",
+			artifact:    latest_v1.KoArtifact{Ldflags: []string{"{{.VERSION"}},
+			pushImages:  true,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().
+				Write("app/main.go", "package main").
+				Write("app/kodata/index.html", "<html/>").
+				Write("app/kodata/static/app.js", "").
+				Write("app/cmd/server/main.go", "package main")
+			t.Override(&util.OSEnviron, func() []string { return []string{"VERSION=1.2.3"} })
+			t.Override(&retrieveBaseImage, func(string, v1.Platform, docker.Config) (v1.Image, error) {
+				return random.Image(1024, 1)
+			})
+			var pushed v1.Image
+			t.Override(&writeRemoteImage, func(img v1.Image, tag string, _ docker.Config) error {
+				t.CheckDeepEqual("gcr.io/project/app:tag", tag)
+				pushed = img
+				return nil
+			})
+			goCmd := &fakeGo{}
+			t.Override(&util.DefaultExecCommand, goCmd)
+			localDocker := &fakeLocalDaemon{}
+
+			artifact := &latest_v1.Artifact{
+				Workspace: tmpDir.Path("app"),
+				Platforms: test.platforms,
+				ArtifactType: latest_v1.ArtifactType{
+					KoArtifact: &test.artifact,
+				},
+			}
+			if len(test.platforms) == 0 {
+				artifact.Platforms = []string{"linux/amd64"}
+			}
+			builder := NewArtifactBuilder(localDocker, &mockConfig{mode: test.mode}, test.pushImages)
+			id, err := builder.Build(context.Background(), ioutil.Discard, artifact, "gcr.io/project/app:tag")
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+
+			t.CheckDeepEqual(test.expectedArgs, goCmd.args)
+			t.CheckDeepEqual(test.expectedEnv, goCmd.env[1:])
+			t.CheckDeepEqual(tmpDir.Path("app"), goCmd.dir)
+
+			img := pushed
+			if test.pushImages {
+				digest, _ := pushed.Digest()
+				t.CheckDeepEqual(digest.String(), id)
+			} else {
+				t.CheckDeepEqual("sha256:loaded", id)
+				img = localDocker.loaded
+			}
+
+			cf, err := img.ConfigFile()
+			t.CheckNoError(err)
+			binary := test.expectedFiles[1]
+			t.CheckDeepEqual([]string{"/" + binary}, cf.Config.Entrypoint)
+			t.CheckDeepEqual("KO_DATA_PATH=/var/run/ko", cf.Config.Env[len(cf.Config.Env)-1])
+			t.CheckTrue(strings.HasPrefix(artifact.Platforms[0], cf.OS+"/"+cf.Architecture))
+			t.CheckDeepEqual(test.expectedFiles, lastLayerFiles(t, img))
+		})
+	}
+}
+
+func TestBuildPlatforms(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("main.go", "package main")
+		t.Override(&retrieveBaseImage, func(string, v1.Platform, docker.Config) (v1.Image, error) {
+			return random.Image(1024, 1)
+		})
+		var pushed v1.ImageIndex
+		t.Override(&writeRemoteIndex, func(idx v1.ImageIndex, tag string, _ docker.Config) error {
+			t.CheckDeepEqual("gcr.io/project/app:tag", tag)
+			pushed = idx
+			return nil
+		})
+		t.Override(&util.DefaultExecCommand, &fakeGo{})
+
+		artifact := &latest_v1.Artifact{
+			Workspace: tmpDir.Root(),
+			Platforms: []string{"linux/amd64", "linux/arm64"},
+			ArtifactType: latest_v1.ArtifactType{
+				KoArtifact: &latest_v1.KoArtifact{},
+			},
+		}
+		builder := NewArtifactBuilder(nil, &mockConfig{}, true)
+		id, err := builder.Build(context.Background(), ioutil.Discard, artifact, "gcr.io/project/app:tag")
+		t.CheckNoError(err)
+
+		digest, _ := pushed.Digest()
+		t.CheckDeepEqual(digest.String(), id)
+		manifest, _ := pushed.IndexManifest()
+		t.CheckDeepEqual(2, len(manifest.Manifests))
+		t.CheckDeepEqual(&v1.Platform{OS: "linux", Architecture: "amd64"}, manifest.Manifests[0].Platform)
+		t.CheckDeepEqual(&v1.Platform{OS: "linux", Architecture: "arm64"}, manifest.Manifests[1].Platform)
+	})
+}
+
+// fakeGo fakes `go build` by writing a fake binary.
+type fakeGo struct {
+	args []string
+	env  []string
+	dir  string
+}
+
+func (f *fakeGo) RunCmdOut(cmd *exec.Cmd) ([]byte, error) {
+	return nil, f.RunCmd(cmd)
+}
+
+func (f *fakeGo) RunCmd(cmd *exec.Cmd) error {
+	// The binary is written to a temporary directory: only keep its name.
+	output := cmd.Args[3]
+	f.args = append([]string{}, cmd.Args...)
+	f.args[3] = output[strings.LastIndexAny(output, `/\`)+1:]
+	f.env = cmd.Env
+	f.dir = cmd.Dir
+	return ioutil.WriteFile(output, []byte("binary"), 0755)
+}
+
+type fakeLocalDaemon struct {
+	docker.LocalDaemon
+	loaded v1.Image
+}
+
+func (f *fakeLocalDaemon) Load(_ context.Context, _ io.Writer, input io.Reader, _ string) (string, error) {
+	buf, err := ioutil.ReadAll(input)
+	if err != nil {
+		return "", err
+	}
+	f.loaded, err = tarball.Image(func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(buf)), nil
+	}, nil)
+	return "sha256:loaded", err
+}
+
+type mockConfig struct {
+	docker.Config
+	mode config.RunMode
+}
+
+func (c *mockConfig) GetInsecureRegistries() map[string]bool { return nil }
+func (c *mockConfig) Mode() config.RunMode                   { return c.mode }
+
+func lastLayerFiles(t *testutil.T, img v1.Image) []string {
+	layers, err := img.Layers()
+	t.CheckNoError(err)
+	rc, err := layers[len(layers)-1].Uncompressed()
+	t.CheckNoError(err)
+	defer rc.Close()
+
+	var files []string
+	tr := tar.NewReader(rc)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		t.CheckNoError(err)
+		files = append(files, hdr.Name)
+	}
+	sort.Strings(files)
+	return files
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/list"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// goPackage is the subset of the output of `go list -json` that's used to find the dependencies.
+type goPackage struct {
+	Dir        string
+	Standard   bool
+	Module     *goModule
+	GoFiles    []string
+	CgoFiles   []string
+	CFiles     []string
+	CXXFiles   []string
+	HFiles     []string
+	SFiles     []string
+	EmbedFiles []string
+}
+
+type goModule struct {
+	Main  bool
+	GoMod string
+}
+
+// GetDependencies finds the source dependencies of a ko artifact: the files of the packages imported
+// by its `main` package that belong to the main module, the module's `go.mod` and `go.sum`, and
+// the content of the `kodata` directory. The packages are listed for each target platform, since
+// they can import different files. All paths are relative to the workspace.
+func GetDependencies(ctx context.Context, workspace string, a *latest_v1.KoArtifact, platforms []string) ([]string, error) {
+	if a.Dependencies != nil && len(a.Dependencies.Paths) > 0 {
+		return list.Files(workspace, a.Dependencies.Paths, a.Dependencies.Ignore)
+	}
+
+	absWorkspace, err := filepath.Abs(workspace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find absolute path for %q: %w", workspace, err)
+	}
+
+	if len(platforms) == 0 {
+		platforms = []string{platform.Host()}
+	}
+
+	files := map[string]bool{}
+	for _, pl := range platforms {
+		p, err := platform.Parse(pl)
+		if err != nil {
+			return nil, err
+		}
+		if err := listPackageFiles(ctx, workspace, a, p, files); err != nil {
+			return nil, err
+		}
+	}
+	if err := listDataFiles(filepath.Join(absWorkspace, mainPackage(a), "kodata"), files); err != nil {
+		return nil, err
+	}
+
+	var deps []string
+	for file := range files {
+		rel, err := filepath.Rel(absWorkspace, file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find relative path for %q: %w", file, err)
+		}
+		deps = append(deps, rel)
+	}
+	sort.Strings(deps)
+	return deps, nil
+}
+
+// listPackageFiles adds the files of the main module's packages that are imported by the `main`
+// package when it's built for the given platform.
+func listPackageFiles(ctx context.Context, workspace string, a *latest_v1.KoArtifact, p v1.Platform, files map[string]bool) error {
+	env, err := buildEnv(a, p)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "go", "list", "-deps", "-json", mainPackage(a))
+	cmd.Dir = workspace
+	cmd.Env = env
+	stdout, err := util.RunCmdOut(cmd)
+	if err != nil {
+		return fmt.Errorf("listing go packages: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(stdout))
+	for {
+		var pkg goPackage
+		err := decoder.Decode(&pkg)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("parsing output of go list: %w", err)
+		}
+		if pkg.Standard || pkg.Module == nil || !pkg.Module.Main {
+			continue
+		}
+
+		for _, names := range [][]string{pkg.GoFiles, pkg.CgoFiles, pkg.CFiles, pkg.CXXFiles, pkg.HFiles, pkg.SFiles, pkg.EmbedFiles} {
+			for _, name := range names {
+				files[filepath.Join(pkg.Dir, name)] = true
+			}
+		}
+		if pkg.Module.GoMod != "" {
+			files[pkg.Module.GoMod] = true
+			if goSum := filepath.Join(filepath.Dir(pkg.Module.GoMod), "go.sum"); util.IsFile(goSum) {
+				files[goSum] = true
+			}
+		}
+	}
+}
+
+// listDataFiles adds the files of the `kodata` directory, which are copied into the image.
+func listDataFiles(kodata string, files map[string]bool) error {
+	if !util.IsDir(kodata) {
+		return nil
+	}
+	return filepath.Walk(kodata, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			files[p] = true
+		}
+		return nil
+	})
+}
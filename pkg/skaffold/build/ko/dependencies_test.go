@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGetDependencies(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    latest_v1.KoArtifact
+		platforms   []string
+		command     string
+		expected    []string
+	}{
+		{
+			description: "main package",
+			platforms:   []string{"linux/amd64"},
+			command:     "go list -deps -json .",
+			expected:    []string{"go.mod", "go.sum", filepath.Join("kodata", "index.html"), filepath.Join("kodata", "static", "app.js"), "main.go", filepath.Join("pkg", "lib", "embed.txt"), filepath.Join("pkg", "lib", "lib.go")},
+		},
+		{
+			description: "main package in a sub-directory",
+			artifact:    latest_v1.KoArtifact{Main: "./cmd/server"},
+			platforms:   []string{"linux/amd64"},
+			command:     "go list -deps -json ./cmd/server",
+			expected:    []string{filepath.Join("cmd", "server", "kodata", "favicon.ico"), "go.mod", "go.sum", "main.go", filepath.Join("pkg", "lib", "embed.txt"), filepath.Join("pkg", "lib", "lib.go")},
+		},
+		{
+			description: "explicit dependencies",
+			artifact: latest_v1.KoArtifact{Dependencies: &latest_v1.KoDependencies{
+				Paths:  []string{"main.go", "pkg"},
+				Ignore: []string{"pkg/lib/embed.txt"},
+			}},
+			expected: []string{"main.go", filepath.Join("pkg", "lib", "lib.go")},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Touch("go.mod", "go.sum", "main.go", "other.go", "pkg/lib/lib.go", "pkg/lib/embed.txt",
+				"kodata/index.html", "kodata/static/app.js", "cmd/server/kodata/favicon.ico")
+			goList := fmt.Sprintf(`{"Dir": %q, "Standard": true, "GoFiles": ["fmt.go"]}
+{"Dir": %q, "Module": {"Path": "example.com/dep", "GoMod": %q}, "GoFiles": ["dep.go"]}
+{"Dir": %q, "Module": {"Main": true, "GoMod": %q}, "GoFiles": ["lib.go"], "EmbedFiles": ["embed.txt"]}
+{"Dir": %q, "Module": {"Main": true, "GoMod": %q}, "GoFiles": ["main.go"]}
+`, "/usr/local/go/src/fmt", "/go/pkg/mod/example.com/dep", "/go/pkg/mod/example.com/dep/go.mod",
+				tmpDir.Path("pkg/lib"), tmpDir.Path("go.mod"), tmpDir.Root(), tmpDir.Path("go.mod"))
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOutEnv(test.command, goList, []string{"GOOS=linux", "GOARCH=amd64"}))
+
+			deps, err := GetDependencies(context.Background(), tmpDir.Root(), &test.artifact, test.platforms)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, deps)
+		})
+	}
+}
+
+func TestGetDependenciesPlatforms(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("go.mod", "main.go", "main_linux.go", "main_windows.go")
+		goList := func(file string) string {
+			return fmt.Sprintf(`{"Dir": %q, "Module": {"Main": true, "GoMod": %q}, "GoFiles": ["main.go", %q]}`, tmpDir.Root(), tmpDir.Path("go.mod"), file)
+		}
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunOutEnv("go list -deps -json .", goList("main_linux.go"), []string{"GOOS=linux", "GOARCH=arm64"}).
+			AndRunOutEnv("go list -deps -json .", goList("main_windows.go"), []string{"GOOS=windows", "GOARCH=amd64"}))
+
+		deps, err := GetDependencies(context.Background(), tmpDir.Root(), &latest_v1.KoArtifact{}, []string{"linux/arm64", "windows/amd64"})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"go.mod", "main.go", "main_linux.go", "main_windows.go"}, deps)
+	})
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// For testing
+var (
+	Validate = validate
+)
+
+// Name is the name of the ko builder
+var Name = "Ko"
+
+var (
+	packageMainRegex = regexp.MustCompile(`(?m)^package main\b`)
+	funcMainRegex    = regexp.MustCompile(`(?m)^func main\(\)`)
+)
+
+// ArtifactConfig holds information about a Go `main` package
+type ArtifactConfig struct {
+	File string `json:"path,omitempty"`
+}
+
+// Name returns the name of the builder
+func (c ArtifactConfig) Name() string {
+	return Name
+}
+
+// Describe returns the initBuilder's string representation, used when prompting the user to choose a builder.
+func (c ArtifactConfig) Describe() string {
+	return fmt.Sprintf("%s (%s)", c.Name(), c.File)
+}
+
+// ArtifactType returns the type of the artifact to be built.
+func (c ArtifactConfig) ArtifactType(_ string) latest_v1.ArtifactType {
+	return latest_v1.ArtifactType{
+		KoArtifact: &latest_v1.KoArtifact{},
+	}
+}
+
+// ConfiguredImage returns the target image configured by the builder, or empty string if no image is configured
+func (c ArtifactConfig) ConfiguredImage() string {
+	// Target image is not configured in ko
+	return ""
+}
+
+// Path returns the path to the build definition
+func (c ArtifactConfig) Path() string {
+	return c.File
+}
+
+// validate checks if a file is the `main` function of a Go `main` package.
+func validate(path string) bool {
+	if filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+		return false
+	}
+
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	return packageMainRegex.Match(buf) && funcMainRegex.Match(buf)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestValidate(t *testing.T) {
+	var tests = []struct {
+		description   string
+		path          string
+		content       string
+		expectedValid bool
+	}{
+		{
+			description:   "main package",
+			path:          "cmd/server/main.go",
+			content:       "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println()\n}\n",
+			expectedValid: true,
+		},
+		{
+			description:   "main function in another file",
+			path:          "server.go",
+			content:       "// Command server.\npackage main\n\nfunc main() {}\n",
+			expectedValid: true,
+		},
+		{
+			description:   "library package",
+			path:          "pkg/lib.go",
+			content:       "package lib\n\nfunc main() {}\n",
+			expectedValid: false,
+		},
+		{
+			description:   "main package without main function",
+			path:          "util.go",
+			content:       "package main\n\nfunc helper() {}\n",
+			expectedValid: false,
+		},
+		{
+			description:   "test file",
+			path:          "main_test.go",
+			content:       "package main\n\nfunc main() {}\n",
+			expectedValid: false,
+		},
+		{
+			description:   "not a go file",
+			path:          "main.txt",
+			content:       "package main\n\nfunc main() {}\n",
+			expectedValid: false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write(test.path, test.content)
+
+			isValid := Validate(tmpDir.Path(test.path))
+
+			t.CheckDeepEqual(test.expectedValid, isValid)
+		})
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	config := ArtifactConfig{File: "cmd/server/main.go"}
+
+	testutil.CheckDeepEqual(t, "Ko (cmd/server/main.go)", config.Describe())
+}
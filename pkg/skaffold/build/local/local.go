@@ -25,7 +25,9 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/warnings"
 )
 
 // Build runs a docker build on the host and tags the resulting image with
@@ -65,6 +67,16 @@ func (b *Builder) Concurrency() int {
 }
 
 func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest_v1.Artifact, tag string) (string, error) {
+	if !b.pushImages && len(a.Platforms) > 1 {
+		// The local Docker daemon can't store images for several platforms.
+		platforms := platform.ForLocalDaemon(a.Platforms)
+		warnings.Printf("Building %s for %s only: images built for several platforms must be pushed", a.ImageName, platforms[0])
+
+		artifact := *a
+		artifact.Platforms = platforms
+		a = &artifact
+	}
+
 	digestOrImageID, err := b.runBuildForArtifact(ctx, out, a, tag)
 	if err != nil {
 		return "", err
@@ -73,7 +85,8 @@ func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest_v1
 	if b.pushImages {
 		// only track images for pruning when building with docker
 		// if we're pushing a bazel image, it was built directly to the registry
-		if a.DockerArtifact != nil {
+		// images built for several platforms are never loaded into the local Docker daemon
+		if a.DockerArtifact != nil && len(a.Platforms) <= 1 {
 			imageID, err := b.getImageIDForTag(ctx, tag)
 			if err != nil {
 				logrus.Warnf("unable to inspect image: built images may not be cleaned up correctly by skaffold")
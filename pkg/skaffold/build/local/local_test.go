@@ -77,6 +77,20 @@ func TestLocalRun(t *testing.T) {
 			pushImages: false,
 			expected:   "gcr.io/test/image:1",
 		},
+		{
+			description: "several platforms (local)",
+			artifact: &latest_v1.Artifact{
+				ImageName: "gcr.io/test/image",
+				ArtifactType: latest_v1.ArtifactType{
+					DockerArtifact: &latest_v1.DockerArtifact{},
+				},
+				Platforms: []string{"linux/s390x", "linux/ppc64le"},
+			},
+			tag:              "gcr.io/test/image:tag",
+			api:              &testutil.FakeAPIClient{},
+			expected:         "gcr.io/test/image:1",
+			expectedWarnings: []string{"Building gcr.io/test/image for linux/s390x only: images built for several platforms must be pushed"},
+		},
 		{
 			description: "error getting image digest",
 			artifact: &latest_v1.Artifact{
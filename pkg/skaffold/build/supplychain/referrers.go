@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supplychain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/partial"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+)
+
+// emptyConfigMediaType is the media type of the empty config of OCI artifacts.
+const emptyConfigMediaType types.MediaType = "application/vnd.oci.empty.v1+json"
+
+var emptyConfig = []byte("{}")
+
+// descriptor is an OCI content descriptor, with the fields added by OCI 1.1 for artifacts.
+type descriptor struct {
+	MediaType    types.MediaType   `json:"mediaType"`
+	ArtifactType string            `json:"artifactType,omitempty"`
+	Digest       v1.Hash           `json:"digest"`
+	Size         int64             `json:"size"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+}
+
+// manifest is an OCI image manifest that refers to another manifest, its subject.
+type manifest struct {
+	SchemaVersion int64             `json:"schemaVersion"`
+	MediaType     types.MediaType   `json:"mediaType"`
+	ArtifactType  string            `json:"artifactType,omitempty"`
+	Config        descriptor        `json:"config"`
+	Layers        []descriptor      `json:"layers"`
+	Subject       *descriptor       `json:"subject,omitempty"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// index lists the referrers of a manifest, for registries that don't support the referrers API.
+type index struct {
+	SchemaVersion int64           `json:"schemaVersion"`
+	MediaType     types.MediaType `json:"mediaType"`
+	Manifests     []descriptor    `json:"manifests"`
+}
+
+// blob is an in-memory blob.
+type blob struct {
+	content   []byte
+	mediaType types.MediaType
+}
+
+func (b *blob) Digest() (v1.Hash, error) {
+	h, _, err := v1.SHA256(bytes.NewReader(b.content))
+	return h, err
+}
+
+func (b *blob) Compressed() (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(b.content)), nil
+}
+
+func (b *blob) Size() (int64, error) {
+	return int64(len(b.content)), nil
+}
+
+func (b *blob) MediaType() (types.MediaType, error) {
+	return b.mediaType, nil
+}
+
+func (b *blob) descriptor() (descriptor, error) {
+	h, err := b.Digest()
+	if err != nil {
+		return descriptor{}, err
+	}
+	return descriptor{MediaType: b.mediaType, Digest: h, Size: int64(len(b.content))}, nil
+}
+
+// referrer is an OCI artifact with a single layer, that refers to a subject.
+// It implements partial.CompressedImageCore so that it can be pushed like an image.
+type referrer struct {
+	raw    []byte
+	config *blob
+	layer  *blob
+}
+
+func newReferrer(subject descriptor, artifactType string, configBlob *blob, layer *blob, annotations map[string]string) (*referrer, error) {
+	config, err := configBlob.descriptor()
+	if err != nil {
+		return nil, err
+	}
+	layerDesc, err := layer.descriptor()
+	if err != nil {
+		return nil, err
+	}
+	layerDesc.Annotations = annotations
+
+	raw, err := json.Marshal(manifest{
+		SchemaVersion: 2,
+		MediaType:     types.OCIManifestSchema1,
+		ArtifactType:  artifactType,
+		Config:        config,
+		Layers:        []descriptor{layerDesc},
+		Subject:       &subject,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &referrer{raw: raw, config: configBlob, layer: layer}, nil
+}
+
+func (r *referrer) RawConfigFile() ([]byte, error)      { return r.config.content, nil }
+func (r *referrer) MediaType() (types.MediaType, error) { return types.OCIManifestSchema1, nil }
+func (r *referrer) RawManifest() ([]byte, error)        { return r.raw, nil }
+
+func (r *referrer) LayerByDigest(h v1.Hash) (partial.CompressedLayer, error) {
+	if d, err := r.layer.Digest(); err == nil && d == h {
+		return r.layer, nil
+	}
+	return nil, fmt.Errorf("unknown blob %s", h)
+}
+
+// push pushes a referrer by digest to the repository of its subject, and returns its descriptor.
+func (r *referrer) push(repository, artifactType string, cfg docker.Config) (descriptor, error) {
+	h, size, err := v1.SHA256(bytes.NewReader(r.raw))
+	if err != nil {
+		return descriptor{}, err
+	}
+
+	img, err := partial.CompressedToImage(r)
+	if err != nil {
+		return descriptor{}, err
+	}
+	if err := docker.WriteRemoteImage(img, repository+"@"+h.String(), cfg); err != nil {
+		return descriptor{}, fmt.Errorf("pushing %s: %w", artifactType, err)
+	}
+
+	return descriptor{
+		MediaType:    types.OCIManifestSchema1,
+		ArtifactType: artifactType,
+		Digest:       h,
+		Size:         size,
+	}, nil
+}
+
+// digestTag returns the tag derived from a digest, following the tag schemas
+// of cosign and of the OCI referrers fallback.
+func digestTag(repository string, digest v1.Hash, suffix string) string {
+	tag := repository + ":" + strings.Replace(digest.String(), ":", "-", 1)
+	if suffix != "" {
+		tag += "." + suffix
+	}
+	return tag
+}
+
+// updateReferrersIndex adds referrers to the index tagged with the digest of their subject.
+// A new referrer replaces the previous referrer of the same artifact type, such as the SBOM
+// in the same format or the signature, so that the index doesn't grow with each build.
+// Registries that implement the referrers API list them without this index.
+func updateReferrersIndex(repository string, subject v1.Hash, referrers []descriptor, cfg docker.Config) error {
+	tag := digestTag(repository, subject, "")
+
+	idx := index{
+		SchemaVersion: 2,
+		MediaType:     types.OCIImageIndex,
+	}
+	raw, err := docker.RetrieveRemoteManifest(tag, cfg)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(raw, &idx); err != nil {
+			return fmt.Errorf("parsing referrers index %q: %w", tag, err)
+		}
+	case !isNotFound(err):
+		return fmt.Errorf("getting referrers index %q: %w", tag, err)
+	}
+
+	for _, r := range referrers {
+		var manifests []descriptor
+		for _, m := range idx.Manifests {
+			if m.Digest != r.Digest && m.ArtifactType != r.ArtifactType {
+				manifests = append(manifests, m)
+			}
+		}
+		idx.Manifests = append(manifests, r)
+	}
+
+	raw, err = json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	return docker.WriteRemoteManifest(raw, types.OCIImageIndex, tag, cfg)
+}
+
+func isNotFound(err error) bool {
+	var tErr *transport.Error
+	return errors.As(err, &tErr) && tErr.StatusCode == http.StatusNotFound
+}
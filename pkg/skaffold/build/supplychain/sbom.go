@@ -0,0 +1,341 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supplychain
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+const (
+	SPDX      = "spdx"
+	CycloneDX = "cyclonedx"
+
+	spdxMediaType      types.MediaType = "application/spdx+json"
+	cycloneDXMediaType types.MediaType = "application/vnd.cyclonedx+json"
+
+	// sourceDateEpochEnv overrides the creation time of SBOMs, for reproducible builds.
+	sourceDateEpochEnv = "SOURCE_DATE_EPOCH"
+)
+
+// osPackage is a package installed by the package manager of the image's distribution.
+type osPackage struct {
+	Type    string // deb or apk
+	Name    string
+	Version string
+	Arch    string
+}
+
+// purl returns the package URL of the package.
+func (p osPackage) purl() string {
+	purl := fmt.Sprintf("pkg:%s/%s@%s", p.Type, p.Name, p.Version)
+	if p.Arch != "" {
+		purl += "?arch=" + p.Arch
+	}
+	return purl
+}
+
+// listPackages lists the packages installed in the filesystem of an image.
+// It supports Debian based images, including distroless, and Alpine images.
+func listPackages(img v1.Image) ([]osPackage, error) {
+	rc := mutate.Extract(img)
+	defer rc.Close()
+
+	var packages []osPackage
+	tr := tar.NewReader(rc)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading image filesystem: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := path.Clean("/" + hdr.Name)
+		switch {
+		case name == "/var/lib/dpkg/status" || path.Dir(name) == "/var/lib/dpkg/status.d":
+			buf, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			packages = append(packages, parseDpkgStatus(buf)...)
+		case name == "/lib/apk/db/installed":
+			buf, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			packages = append(packages, parseApkInstalled(buf)...)
+		}
+	}
+
+	sort.Slice(packages, func(i, j int) bool {
+		if packages[i].Name != packages[j].Name {
+			return packages[i].Name < packages[j].Name
+		}
+		return packages[i].Version < packages[j].Version
+	})
+	return packages, nil
+}
+
+// parseDpkgStatus parses the paragraphs of a dpkg status file.
+func parseDpkgStatus(buf []byte) []osPackage {
+	var packages []osPackage
+
+	current := osPackage{Type: "deb"}
+	installed := true
+	flush := func() {
+		if current.Name != "" && installed {
+			packages = append(packages, current)
+		}
+		current = osPackage{Type: "deb"}
+		installed = true
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(buf))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+
+		key, value, found := splitField(line, ":")
+		if !found {
+			continue
+		}
+		switch key {
+		case "Package":
+			current.Name = value
+		case "Version":
+			current.Version = value
+		case "Architecture":
+			current.Arch = value
+		case "Status":
+			installed = strings.HasSuffix(value, " installed")
+		}
+	}
+	flush()
+
+	return packages
+}
+
+// parseApkInstalled parses the entries of an apk installed database.
+func parseApkInstalled(buf []byte) []osPackage {
+	var packages []osPackage
+
+	current := osPackage{Type: "apk"}
+	flush := func() {
+		if current.Name != "" {
+			packages = append(packages, current)
+		}
+		current = osPackage{Type: "apk"}
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(buf))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+
+		key, value, found := splitField(line, ":")
+		if !found {
+			continue
+		}
+		switch key {
+		case "P":
+			current.Name = value
+		case "V":
+			current.Version = value
+		case "A":
+			current.Arch = value
+		}
+	}
+	flush()
+
+	return packages
+}
+
+func splitField(line, sep string) (string, string, bool) {
+	i := strings.Index(line, sep)
+	if i < 0 {
+		return "", "", false
+	}
+	return line[:i], strings.TrimSpace(line[i+1:]), true
+}
+
+// sbomTime is the creation time of the SBOM of an image: the time given by SOURCE_DATE_EPOCH,
+// or the creation time of the image. The SBOM of an image doesn't change when it's rebuilt.
+func sbomTime(imageCreated time.Time) (time.Time, error) {
+	epoch := os.Getenv(sourceDateEpochEnv)
+	if epoch == "" {
+		return imageCreated, nil
+	}
+
+	seconds, err := strconv.ParseInt(epoch, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing %s %q: %w", sourceDateEpochEnv, epoch, err)
+	}
+	return time.Unix(seconds, 0), nil
+}
+
+// generateSBOM renders the software bill of materials of an image in the given format.
+func generateSBOM(format, image string, created time.Time, packages []osPackage) ([]byte, types.MediaType, error) {
+	switch format {
+	case SPDX:
+		buf, err := spdxDocument(image, created, packages)
+		return buf, spdxMediaType, err
+	case CycloneDX:
+		buf, err := cycloneDXDocument(image, created, packages)
+		return buf, cycloneDXMediaType, err
+	default:
+		return nil, "", fmt.Errorf("unsupported SBOM format %q", format)
+	}
+}
+
+type spdxDoc struct {
+	SPDXVersion       string        `json:"spdxVersion"`
+	DataLicense       string        `json:"dataLicense"`
+	SPDXID            string        `json:"SPDXID"`
+	Name              string        `json:"name"`
+	DocumentNamespace string        `json:"documentNamespace"`
+	CreationInfo      spdxCreation  `json:"creationInfo"`
+	Packages          []spdxPackage `json:"packages"`
+}
+
+type spdxCreation struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string            `json:"name"`
+	SPDXID           string            `json:"SPDXID"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	LicenseConcluded string            `json:"licenseConcluded"`
+	LicenseDeclared  string            `json:"licenseDeclared"`
+	CopyrightText    string            `json:"copyrightText"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+func spdxDocument(image string, created time.Time, packages []osPackage) ([]byte, error) {
+	doc := spdxDoc{
+		SPDXVersion:       "SPDX-2.2",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              image,
+		DocumentNamespace: "https://skaffold.dev/spdx/" + image,
+		CreationInfo: spdxCreation{
+			Created:  created.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: skaffold"},
+		},
+		Packages: []spdxPackage{},
+	}
+	for i, p := range packages {
+		doc.Packages = append(doc.Packages, spdxPackage{
+			Name:             p.Name,
+			SPDXID:           fmt.Sprintf("SPDXRef-Package-%d", i),
+			VersionInfo:      p.Version,
+			DownloadLocation: "NOASSERTION",
+			LicenseConcluded: "NOASSERTION",
+			LicenseDeclared:  "NOASSERTION",
+			CopyrightText:    "NOASSERTION",
+			ExternalRefs: []spdxExternalRef{{
+				ReferenceCategory: "PACKAGE_MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  p.purl(),
+			}},
+		})
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+type cycloneDXDoc struct {
+	BOMFormat   string               `json:"bomFormat"`
+	SpecVersion string               `json:"specVersion"`
+	Version     int                  `json:"version"`
+	Metadata    cycloneDXMetadata    `json:"metadata"`
+	Components  []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     []cycloneDXTool    `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTool struct {
+	Name string `json:"name"`
+}
+
+type cycloneDXComponent struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	PURL    string `json:"purl,omitempty"`
+}
+
+func cycloneDXDocument(image string, created time.Time, packages []osPackage) ([]byte, error) {
+	doc := cycloneDXDoc{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.3",
+		Version:     1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: created.UTC().Format(time.RFC3339),
+			Tools:     []cycloneDXTool{{Name: "skaffold"}},
+			Component: cycloneDXComponent{Type: "container", Name: image},
+		},
+		Components: []cycloneDXComponent{},
+	}
+	for _, p := range packages {
+		doc.Components = append(doc.Components, cycloneDXComponent{
+			Type:    "library",
+			Name:    p.Name,
+			Version: p.Version,
+			PURL:    p.purl(),
+		})
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supplychain
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const dpkgStatus = `Package: base-files
+Status: install ok installed
+Architecture: amd64
+Version: 10.3+deb10u9
+
+Package: removed
+Status: deinstall ok config-files
+Architecture: amd64
+Version: 1.0
+
+Package: tzdata
+Status: install ok installed
+Architecture: all
+Version: 2021a-0+deb10u1
+`
+
+const apkInstalled = `C:Q1abc=
+P:musl
+V:1.2.2-r0
+A:x86_64
+
+C:Q1def=
+P:busybox
+V:1.32.1-r6
+A:x86_64
+`
+
+func TestParseDpkgStatus(t *testing.T) {
+	testutil.CheckDeepEqual(t, []osPackage{
+		{Type: "deb", Name: "base-files", Version: "10.3+deb10u9", Arch: "amd64"},
+		{Type: "deb", Name: "tzdata", Version: "2021a-0+deb10u1", Arch: "all"},
+	}, parseDpkgStatus([]byte(dpkgStatus)))
+}
+
+func TestParseApkInstalled(t *testing.T) {
+	testutil.CheckDeepEqual(t, []osPackage{
+		{Type: "apk", Name: "musl", Version: "1.2.2-r0", Arch: "x86_64"},
+		{Type: "apk", Name: "busybox", Version: "1.32.1-r6", Arch: "x86_64"},
+	}, parseApkInstalled([]byte(apkInstalled)))
+}
+
+func TestListPackages(t *testing.T) {
+	tests := []struct {
+		description string
+		files       map[string]string
+		expected    []osPackage
+	}{
+		{
+			description: "debian",
+			files:       map[string]string{"var/lib/dpkg/status": dpkgStatus},
+			expected: []osPackage{
+				{Type: "deb", Name: "base-files", Version: "10.3+deb10u9", Arch: "amd64"},
+				{Type: "deb", Name: "tzdata", Version: "2021a-0+deb10u1", Arch: "all"},
+			},
+		},
+		{
+			description: "distroless",
+			files: map[string]string{
+				"var/lib/dpkg/status.d/tzdata":   "Package: tzdata\nVersion: 2021a-0+deb10u1\nArchitecture: all\n",
+				"var/lib/dpkg/status.d/netbase":  "Package: netbase\nVersion: 5.6\nArchitecture: all\n",
+				"var/lib/dpkg/status.d/ignored/": "",
+			},
+			expected: []osPackage{
+				{Type: "deb", Name: "netbase", Version: "5.6", Arch: "all"},
+				{Type: "deb", Name: "tzdata", Version: "2021a-0+deb10u1", Arch: "all"},
+			},
+		},
+		{
+			description: "alpine",
+			files:       map[string]string{"lib/apk/db/installed": apkInstalled},
+			expected: []osPackage{
+				{Type: "apk", Name: "busybox", Version: "1.32.1-r6", Arch: "x86_64"},
+				{Type: "apk", Name: "musl", Version: "1.2.2-r0", Arch: "x86_64"},
+			},
+		},
+		{
+			description: "scratch",
+			files:       map[string]string{"app": "binary"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			img := imageWithFiles(t, test.files)
+
+			packages, err := listPackages(img)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, packages)
+		})
+	}
+}
+
+func TestGenerateSBOM(t *testing.T) {
+	packages := []osPackage{{Type: "deb", Name: "tzdata", Version: "2021a-0+deb10u1", Arch: "all"}}
+
+	tests := []struct {
+		description       string
+		format            string
+		expected          map[string]interface{}
+		expectedMediaType string
+		shouldErr         bool
+	}{
+		{
+			description:       "spdx",
+			format:            SPDX,
+			expectedMediaType: "application/spdx+json",
+			expected: map[string]interface{}{
+				"spdxVersion":       "SPDX-2.2",
+				"dataLicense":       "CC0-1.0",
+				"SPDXID":            "SPDXRef-DOCUMENT",
+				"name":              "registry/img@sha256:abc",
+				"documentNamespace": "https://skaffold.dev/spdx/registry/img@sha256:abc",
+				"creationInfo": map[string]interface{}{
+					"created":  "2021-04-01T10:00:00Z",
+					"creators": []interface{}{"Tool: skaffold"},
+				},
+				"packages": []interface{}{map[string]interface{}{
+					"name":             "tzdata",
+					"SPDXID":           "SPDXRef-Package-0",
+					"versionInfo":      "2021a-0+deb10u1",
+					"downloadLocation": "NOASSERTION",
+					"filesAnalyzed":    false,
+					"licenseConcluded": "NOASSERTION",
+					"licenseDeclared":  "NOASSERTION",
+					"copyrightText":    "NOASSERTION",
+					"externalRefs": []interface{}{map[string]interface{}{
+						"referenceCategory": "PACKAGE_MANAGER",
+						"referenceType":     "purl",
+						"referenceLocator":  "pkg:deb/tzdata@2021a-0+deb10u1?arch=all",
+					}},
+				}},
+			},
+		},
+		{
+			description:       "cyclonedx",
+			format:            CycloneDX,
+			expectedMediaType: "application/vnd.cyclonedx+json",
+			expected: map[string]interface{}{
+				"bomFormat":   "CycloneDX",
+				"specVersion": "1.3",
+				"version":     float64(1),
+				"metadata": map[string]interface{}{
+					"timestamp": "2021-04-01T10:00:00Z",
+					"tools":     []interface{}{map[string]interface{}{"name": "skaffold"}},
+					"component": map[string]interface{}{"type": "container", "name": "registry/img@sha256:abc"},
+				},
+				"components": []interface{}{map[string]interface{}{
+					"type":    "library",
+					"name":    "tzdata",
+					"version": "2021a-0+deb10u1",
+					"purl":    "pkg:deb/tzdata@2021a-0+deb10u1?arch=all",
+				}},
+			},
+		},
+		{
+			description: "unsupported",
+			format:      "unknown",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			created := time.Date(2021, 4, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+			buf, mediaType, err := generateSBOM(test.format, "registry/img@sha256:abc", created, packages)
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+
+			var doc map[string]interface{}
+			t.CheckNoError(json.Unmarshal(buf, &doc))
+			t.CheckDeepEqual(test.expected, doc)
+			t.CheckDeepEqual(test.expectedMediaType, string(mediaType))
+		})
+	}
+}
+
+func TestSBOMTime(t *testing.T) {
+	created := time.Date(2021, 4, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description     string
+		sourceDateEpoch string
+		expected        time.Time
+		shouldErr       bool
+	}{
+		{
+			description: "image creation time",
+			expected:    created,
+		},
+		{
+			description:     "SOURCE_DATE_EPOCH",
+			sourceDateEpoch: "1609459200",
+			expected:        time.Unix(1609459200, 0),
+		},
+		{
+			description:     "invalid SOURCE_DATE_EPOCH",
+			sourceDateEpoch: "yesterday",
+			shouldErr:       true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(map[string]string{"SOURCE_DATE_EPOCH": test.sourceDateEpoch})
+
+			sbomCreated, err := sbomTime(created)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, sbomCreated)
+		})
+	}
+}
+
+// imageWithFiles creates an image with a single layer that contains the given files.
+// Names ending with a slash are directories.
+func imageWithFiles(t *testutil.T, files map[string]string) v1.Image {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, content := range files {
+		hdr := &tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		if name[len(name)-1] == '/' {
+			hdr = &tar.Header{Name: name, Mode: 0755, Typeflag: tar.TypeDir}
+		}
+		t.CheckNoError(tw.WriteHeader(hdr))
+		_, err := io.WriteString(tw, content)
+		t.CheckNoError(err)
+	}
+	t.CheckNoError(tw.Close())
+
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(buf.Bytes())), nil
+	})
+	t.CheckNoError(err)
+
+	img, err := mutate.AppendLayers(empty.Image, layer)
+	t.CheckNoError(err)
+	return img
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supplychain
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// signatureMediaType is the media type of the payload signed by cosign.
+	signatureMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+
+	// signatureAnnotation is the annotation that holds the base64 encoded signature of the payload.
+	signatureAnnotation = "dev.cosignproject.cosign/signature"
+
+	// passwordEnv holds the password of encrypted cosign private keys.
+	passwordEnv = "COSIGN_PASSWORD"
+)
+
+// simpleSigning is the payload that cosign signs for a container image.
+type simpleSigning struct {
+	Critical critical    `json:"critical"`
+	Optional interface{} `json:"optional"`
+}
+
+type critical struct {
+	Identity identity `json:"identity"`
+	Image    image    `json:"image"`
+	Type     string   `json:"type"`
+}
+
+type identity struct {
+	DockerReference string `json:"docker-reference"`
+}
+
+type image struct {
+	DockerManifestDigest string `json:"docker-manifest-digest"`
+}
+
+// signaturePayload returns the payload that signs the image with the given repository and digest.
+func signaturePayload(repository, digest string) ([]byte, error) {
+	return json.Marshal(simpleSigning{
+		Critical: critical{
+			Identity: identity{DockerReference: repository},
+			Image:    image{DockerManifestDigest: digest},
+			Type:     "cosign container image signature",
+		},
+	})
+}
+
+// signatureConfig returns the config of a signature manifest. cosign expects an OCI image config
+// whose root filesystem is the signed payload.
+func signatureConfig(payload *blob) (*blob, error) {
+	h, err := payload.Digest()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := json.Marshal(v1.ConfigFile{
+		RootFS: v1.RootFS{Type: "layers", DiffIDs: []v1.Hash{h}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &blob{content: raw, mediaType: types.OCIConfigJSON}, nil
+}
+
+// sign returns the base64 encoded ECDSA signature of a payload.
+func sign(key *ecdsa.PrivateKey, payload []byte) (string, error) {
+	h := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, h[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// loadPrivateKey reads an ECDSA private key from a PEM file.
+// Both plain keys and keys generated by `cosign generate-key-pair` are supported.
+// The password of cosign keys is read from the COSIGN_PASSWORD environment variable.
+func loadPrivateKey(keyFile string) (*ecdsa.PrivateKey, error) {
+	buf, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading private key: %w", err)
+	}
+
+	block, _ := pem.Decode(buf)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %q", keyFile)
+	}
+
+	var key interface{}
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "ENCRYPTED COSIGN PRIVATE KEY", "ENCRYPTED SIGSTORE PRIVATE KEY":
+		var der []byte
+		der, err = decrypt(block.Bytes, []byte(os.Getenv(passwordEnv)))
+		if err == nil {
+			key, err = x509.ParsePKCS8PrivateKey(der)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q in %q", block.Type, keyFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key %q: %w", keyFile, err)
+	}
+
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key %q is not an ECDSA key", keyFile)
+	}
+	return ecKey, nil
+}
+
+// encryptedKey is the format of the private keys encrypted by cosign.
+type encryptedKey struct {
+	KDF struct {
+		Name   string `json:"name"`
+		Params struct {
+			N int `json:"N"`
+			R int `json:"r"`
+			P int `json:"p"`
+		} `json:"params"`
+		Salt []byte `json:"salt"`
+	} `json:"kdf"`
+	Cipher struct {
+		Name  string `json:"name"`
+		Nonce []byte `json:"nonce"`
+	} `json:"cipher"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// decrypt decrypts a private key encrypted with scrypt and nacl/secretbox.
+func decrypt(data, password []byte) ([]byte, error) {
+	var k encryptedKey
+	if err := json.Unmarshal(data, &k); err != nil {
+		return nil, err
+	}
+	if k.KDF.Name != "scrypt" || k.Cipher.Name != "nacl/secretbox" {
+		return nil, fmt.Errorf("unsupported key encryption %s/%s", k.KDF.Name, k.Cipher.Name)
+	}
+	if len(k.Cipher.Nonce) != 24 {
+		return nil, errors.New("invalid nonce")
+	}
+
+	derived, err := scrypt.Key(password, k.KDF.Salt, k.KDF.Params.N, k.KDF.Params.R, k.KDF.Params.P, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	var key [32]byte
+	var nonce [24]byte
+	copy(key[:], derived)
+	copy(nonce[:], k.Cipher.Nonce)
+
+	plain, ok := secretbox.Open(nil, k.Ciphertext, &nonce, &key)
+	if !ok {
+		return nil, fmt.Errorf("decrypting key: invalid password, set it with %s", passwordEnv)
+	}
+	return plain, nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supplychain
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSignaturePayload(t *testing.T) {
+	payload, err := signaturePayload("gcr.io/project/img", "sha256:abc")
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, `{"critical":{"identity":{"docker-reference":"gcr.io/project/img"},"image":{"docker-manifest-digest":"sha256:abc"},"type":"cosign container image signature"},"optional":null}`, string(payload))
+}
+
+func TestSign(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		t.CheckNoError(err)
+
+		signature, err := sign(key, []byte("payload"))
+		t.CheckNoError(err)
+
+		sig, err := base64.StdEncoding.DecodeString(signature)
+		t.CheckNoError(err)
+		h := sha256.Sum256([]byte("payload"))
+		t.CheckTrue(ecdsa.VerifyASN1(&key.PublicKey, h[:], sig))
+	})
+}
+
+func TestLoadPrivateKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8DER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		description string
+		pem         []byte
+		password    string
+		shouldErr   bool
+	}{
+		{
+			description: "EC private key",
+			pem:         pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecDER}),
+		},
+		{
+			description: "PKCS8 private key",
+			pem:         pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8DER}),
+		},
+		{
+			description: "cosign private key",
+			pem:         pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED COSIGN PRIVATE KEY", Bytes: encrypt(t, pkcs8DER, "secret")}),
+			password:    "secret",
+		},
+		{
+			description: "cosign private key with wrong password",
+			pem:         pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED COSIGN PRIVATE KEY", Bytes: encrypt(t, pkcs8DER, "secret")}),
+			password:    "wrong",
+			shouldErr:   true,
+		},
+		{
+			description: "public key",
+			pem:         pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("key")}),
+			shouldErr:   true,
+		},
+		{
+			description: "not PEM",
+			pem:         []byte("not a key"),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(map[string]string{"COSIGN_PASSWORD": test.password})
+			keyFile := t.TempFile("key", test.pem)
+
+			loaded, err := loadPrivateKey(keyFile)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckTrue(key.Equal(loaded))
+			}
+		})
+	}
+}
+
+// encrypt encrypts a private key the way cosign does, with cheaper scrypt parameters.
+func encrypt(t *testing.T, der []byte, password string) []byte {
+	var k encryptedKey
+	k.KDF.Name = "scrypt"
+	k.KDF.Params.N = 1024
+	k.KDF.Params.R = 8
+	k.KDF.Params.P = 1
+	k.KDF.Salt = []byte("0123456789abcdef0123456789abcdef")
+	k.Cipher.Name = "nacl/secretbox"
+	k.Cipher.Nonce = []byte("0123456789abcdef01234567")
+
+	derived, err := scrypt.Key([]byte(password), k.KDF.Salt, k.KDF.Params.N, k.KDF.Params.R, k.KDF.Params.P, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var secret [32]byte
+	var nonce [24]byte
+	copy(secret[:], derived)
+	copy(nonce[:], k.Cipher.Nonce)
+	k.Ciphertext = secretbox.Seal(nil, der, &nonce, &secret)
+
+	buf, err := json.Marshal(k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supplychain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// Attach generates the SBOM and the signature of a pushed image, as configured,
+// and attaches them to the image in its registry as OCI referrers.
+// Images that were not pushed are skipped.
+func Attach(out io.Writer, cfg docker.Config, sc *latest_v1.SupplyChain, a graph.Artifact) error {
+	if sc == nil || (sc.SBOM == "" && sc.KeyFile == "") {
+		return nil
+	}
+
+	ref, err := docker.ParseReference(a.Tag)
+	if err != nil {
+		return fmt.Errorf("parsing image %q: %w", a.Tag, err)
+	}
+	if ref.Digest == "" {
+		logrus.Debugf("Skipping SBOM and signature of %s: image was not pushed", a.Tag)
+		return nil
+	}
+
+	digest, err := v1.NewHash(ref.Digest)
+	if err != nil {
+		return fmt.Errorf("parsing digest of %q: %w", a.Tag, err)
+	}
+	image := ref.BaseName + "@" + ref.Digest
+	// The subject is resolved from the raw manifest so that both images and
+	// multi-platform image indexes are supported.
+	raw, err := docker.RetrieveRemoteManifest(image, cfg)
+	if err != nil {
+		return fmt.Errorf("getting manifest of %q: %w", image, err)
+	}
+	subject, err := subjectDescriptor(raw, digest)
+	if err != nil {
+		return fmt.Errorf("parsing manifest of %q: %w", image, err)
+	}
+
+	var referrers []descriptor
+
+	if sc.SBOM != "" {
+		color.Default.Fprintf(out, "Attaching %s SBOM to %s\n", sc.SBOM, image)
+
+		packages, created, err := inspectImages(ref.BaseName, subject, raw, cfg)
+		if err != nil {
+			return fmt.Errorf("listing packages of %q: %w", image, err)
+		}
+		created, err = sbomTime(created)
+		if err != nil {
+			return err
+		}
+		sbom, mediaType, err := generateSBOM(sc.SBOM, image, created, packages)
+		if err != nil {
+			return err
+		}
+
+		config := &blob{content: emptyConfig, mediaType: emptyConfigMediaType}
+		r, err := newReferrer(subject, string(mediaType), config, &blob{content: sbom, mediaType: mediaType}, nil)
+		if err != nil {
+			return err
+		}
+		desc, err := r.push(ref.BaseName, string(mediaType), cfg)
+		if err != nil {
+			return err
+		}
+		referrers = append(referrers, desc)
+	}
+
+	if sc.KeyFile != "" {
+		color.Default.Fprintf(out, "Signing %s\n", image)
+
+		key, err := loadPrivateKey(sc.KeyFile)
+		if err != nil {
+			return err
+		}
+		payload, err := signaturePayload(ref.BaseName, ref.Digest)
+		if err != nil {
+			return err
+		}
+		signature, err := sign(key, payload)
+		if err != nil {
+			return fmt.Errorf("signing %q: %w", image, err)
+		}
+
+		layer := &blob{content: payload, mediaType: signatureMediaType}
+		config, err := signatureConfig(layer)
+		if err != nil {
+			return err
+		}
+		r, err := newReferrer(subject, signatureMediaType, config, layer, map[string]string{
+			signatureAnnotation: signature,
+		})
+		if err != nil {
+			return err
+		}
+		desc, err := r.push(ref.BaseName, signatureMediaType, cfg)
+		if err != nil {
+			return err
+		}
+		referrers = append(referrers, desc)
+
+		// cosign looks signatures up by tag.
+		if err := docker.WriteRemoteManifest(r.raw, types.OCIManifestSchema1, digestTag(ref.BaseName, digest, "sig"), cfg); err != nil {
+			return fmt.Errorf("tagging signature of %q: %w", image, err)
+		}
+	}
+
+	return updateReferrersIndex(ref.BaseName, digest, referrers, cfg)
+}
+
+// subjectDescriptor describes the manifest, or index, of the image that referrers are attached to.
+func subjectDescriptor(raw []byte, digest v1.Hash) (descriptor, error) {
+	var m struct {
+		MediaType types.MediaType `json:"mediaType"`
+		Manifests []interface{}   `json:"manifests"`
+	}
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return descriptor{}, err
+	}
+
+	mediaType := m.MediaType
+	if mediaType == "" {
+		// The media type is optional in OCI manifests and indexes.
+		mediaType = types.OCIManifestSchema1
+		if m.Manifests != nil {
+			mediaType = types.OCIImageIndex
+		}
+	}
+	return descriptor{MediaType: mediaType, Digest: digest, Size: int64(len(raw))}, nil
+}
+
+// inspectImages lists the OS packages of an image and returns its creation time. For an image index,
+// the packages of all the images it references are listed, and the most recent creation time is returned.
+func inspectImages(repository string, subject descriptor, raw []byte, cfg docker.Config) ([]osPackage, time.Time, error) {
+	digests := []v1.Hash{subject.Digest}
+	if subject.MediaType.IsIndex() {
+		index, err := v1.ParseIndexManifest(bytes.NewReader(raw))
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+
+		digests = nil
+		for _, m := range index.Manifests {
+			if m.MediaType.IsImage() {
+				digests = append(digests, m.Digest)
+			}
+		}
+	}
+
+	var packages []osPackage
+	var created time.Time
+	seen := map[osPackage]bool{}
+	for _, digest := range digests {
+		img, err := docker.RetrieveRemoteImage(repository+"@"+digest.String(), cfg)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		cf, err := img.ConfigFile()
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		if cf.Created.After(created) {
+			created = cf.Created.Time
+		}
+		imagePackages, err := listPackages(img)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		for _, p := range imagePackages {
+			if !seen[p] {
+				seen[p] = true
+				packages = append(packages, p)
+			}
+		}
+	}
+	return packages, created, nil
+}
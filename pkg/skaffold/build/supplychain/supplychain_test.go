@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supplychain
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type mockConfig struct {
+	docker.Config
+}
+
+func (c *mockConfig) GetInsecureRegistries() map[string]bool { return nil }
+
+func TestAttach(t *testing.T) {
+	tests := []struct {
+		description       string
+		sc                *latest_v1.SupplyChain
+		index             bool
+		expectedReferrers []string
+		expectedOutput    string
+	}{
+		{
+			description: "no supply chain",
+			sc:          &latest_v1.SupplyChain{},
+		},
+		{
+			description:       "sbom",
+			sc:                &latest_v1.SupplyChain{SBOM: "spdx"},
+			expectedReferrers: []string{"application/spdx+json"},
+			expectedOutput:    "Attaching spdx SBOM to",
+		},
+		{
+			description:       "signature",
+			sc:                &latest_v1.SupplyChain{KeyFile: "cosign.key"},
+			expectedReferrers: []string{"application/vnd.dev.cosign.simplesigning.v1+json"},
+			expectedOutput:    "Signing",
+		},
+		{
+			description:       "sbom and signature",
+			sc:                &latest_v1.SupplyChain{SBOM: "cyclonedx", KeyFile: "cosign.key"},
+			expectedReferrers: []string{"application/vnd.cyclonedx+json", "application/vnd.dev.cosign.simplesigning.v1+json"},
+		},
+		{
+			description:       "image index without linux/amd64 image",
+			sc:                &latest_v1.SupplyChain{SBOM: "spdx", KeyFile: "cosign.key"},
+			index:             true,
+			expectedReferrers: []string{"application/spdx+json", "application/vnd.dev.cosign.simplesigning.v1+json"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			server := httptest.NewServer(registry.New())
+			t.Cleanup(server.Close)
+
+			repository := strings.TrimPrefix(server.URL, "http://") + "/img"
+			img := imageWithFiles(t, map[string]string{"var/lib/dpkg/status": dpkgStatus})
+			ref, err := name.ParseReference(repository + ":latest")
+			t.CheckNoError(err)
+			var digest v1.Hash
+			if test.index {
+				idx := mutate.AppendManifests(empty.Index, mutate.IndexAddendum{
+					Add:        img,
+					Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "arm64"}},
+				})
+				t.CheckNoError(remote.WriteIndex(ref, idx))
+				digest, err = idx.Digest()
+			} else {
+				t.CheckNoError(remote.Write(ref, img))
+				digest, err = img.Digest()
+			}
+			t.CheckNoError(err)
+
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			t.CheckNoError(err)
+			der, err := x509.MarshalECPrivateKey(key)
+			t.CheckNoError(err)
+			tmpDir := t.NewTempDir().Write("cosign.key", string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})))
+			if test.sc.KeyFile != "" {
+				test.sc.KeyFile = tmpDir.Path(test.sc.KeyFile)
+			}
+
+			var out strings.Builder
+			err = Attach(&out, &mockConfig{}, test.sc, graph.Artifact{
+				ImageName: "img",
+				Tag:       repository + ":latest@" + digest.String(),
+			})
+			t.CheckNoError(err)
+			t.CheckContains(test.expectedOutput, out.String())
+
+			var artifactTypes []string
+			for _, r := range referrers(t, repository, digest) {
+				artifactTypes = append(artifactTypes, r.ArtifactType)
+
+				var m manifest
+				t.CheckNoError(json.Unmarshal(fetch(t, repository+"@"+r.Digest.String()), &m))
+				t.CheckDeepEqual(digest, m.Subject.Digest)
+			}
+			t.CheckDeepEqual(test.expectedReferrers, artifactTypes)
+
+			if test.sc.KeyFile != "" {
+				var m manifest
+				t.CheckNoError(json.Unmarshal(fetch(t, digestTag(repository, digest, "sig")), &m))
+				t.CheckNotNil(m.Layers[0].Annotations[signatureAnnotation])
+				t.CheckDeepEqual(types.OCIConfigJSON, m.Config.MediaType)
+			}
+		})
+	}
+}
+
+func TestAttachReplacesSBOM(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		server := httptest.NewServer(registry.New())
+		t.Cleanup(server.Close)
+
+		repository := strings.TrimPrefix(server.URL, "http://") + "/img"
+		img := imageWithFiles(t, map[string]string{"var/lib/dpkg/status": dpkgStatus})
+		ref, err := name.ParseReference(repository + ":latest")
+		t.CheckNoError(err)
+		t.CheckNoError(remote.Write(ref, img))
+		digest, err := img.Digest()
+		t.CheckNoError(err)
+		artifact := graph.Artifact{ImageName: "img", Tag: repository + ":latest@" + digest.String()}
+
+		// Attaching the SBOM of the same image again doesn't add a referrer
+		for i := 0; i < 2; i++ {
+			t.CheckNoError(Attach(ioutil.Discard, &mockConfig{}, &latest_v1.SupplyChain{SBOM: "spdx"}, artifact))
+		}
+		first := referrers(t, repository, digest)
+		t.CheckDeepEqual(1, len(first))
+
+		// A different SBOM replaces the previous one
+		t.SetEnvs(map[string]string{"SOURCE_DATE_EPOCH": "1609459200"})
+		t.CheckNoError(Attach(ioutil.Discard, &mockConfig{}, &latest_v1.SupplyChain{SBOM: "spdx"}, artifact))
+		second := referrers(t, repository, digest)
+		t.CheckDeepEqual(1, len(second))
+		t.CheckFalse(first[0].Digest == second[0].Digest)
+
+		// SBOMs in other formats are kept
+		t.CheckNoError(Attach(ioutil.Discard, &mockConfig{}, &latest_v1.SupplyChain{SBOM: "cyclonedx"}, artifact))
+		t.CheckDeepEqual(2, len(referrers(t, repository, digest)))
+	})
+}
+
+func TestAttachReplacesSignature(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		server := httptest.NewServer(registry.New())
+		t.Cleanup(server.Close)
+
+		repository := strings.TrimPrefix(server.URL, "http://") + "/img"
+		img := imageWithFiles(t, map[string]string{"var/lib/dpkg/status": dpkgStatus})
+		ref, err := name.ParseReference(repository + ":latest")
+		t.CheckNoError(err)
+		t.CheckNoError(remote.Write(ref, img))
+		digest, err := img.Digest()
+		t.CheckNoError(err)
+		artifact := graph.Artifact{ImageName: "img", Tag: repository + ":latest@" + digest.String()}
+
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		t.CheckNoError(err)
+		der, err := x509.MarshalECPrivateKey(key)
+		t.CheckNoError(err)
+		keyFile := t.NewTempDir().Write("cosign.key", string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))).Path("cosign.key")
+		sc := &latest_v1.SupplyChain{SBOM: "spdx", KeyFile: keyFile}
+
+		// Signing the same image twice keeps a single signature
+		t.CheckNoError(Attach(ioutil.Discard, &mockConfig{}, sc, artifact))
+		first := referrers(t, repository, digest)
+		t.CheckNoError(Attach(ioutil.Discard, &mockConfig{}, sc, artifact))
+		second := referrers(t, repository, digest)
+
+		t.CheckDeepEqual(2, len(second))
+		t.CheckDeepEqual("application/spdx+json", second[0].ArtifactType)
+		t.CheckDeepEqual("application/vnd.dev.cosign.simplesigning.v1+json", second[1].ArtifactType)
+		t.CheckFalse(first[1].Digest == second[1].Digest)
+	})
+}
+
+func TestAttachSkipsImagesNotPushed(t *testing.T) {
+	err := Attach(ioutil.Discard, &mockConfig{}, &latest_v1.SupplyChain{SBOM: "spdx"}, graph.Artifact{
+		ImageName: "img",
+		Tag:       "img:latest",
+	})
+
+	testutil.CheckError(t, false, err)
+}
+
+// referrers returns the referrers listed in the fallback index of an image, if any.
+func referrers(t *testutil.T, repository string, digest v1.Hash) []descriptor {
+	raw, err := docker.RetrieveRemoteManifest(digestTag(repository, digest, ""), &mockConfig{})
+	if isNotFound(err) {
+		return nil
+	}
+	t.CheckNoError(err)
+
+	var idx index
+	t.CheckNoError(json.Unmarshal(raw, &idx))
+	return idx.Manifests
+}
+
+func fetch(t *testutil.T, reference string) []byte {
+	raw, err := docker.RetrieveRemoteManifest(reference, &mockConfig{})
+	t.CheckNoError(err)
+	return raw
+}
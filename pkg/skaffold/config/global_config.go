@@ -37,6 +37,8 @@ type ContextConfig struct {
 	KindDisableLoad      *bool         `yaml:"kind-disable-load,omitempty"`
 	K3dDisableLoad       *bool         `yaml:"k3d-disable-load,omitempty"`
 	CollectMetrics       *bool         `yaml:"collect-metrics,omitempty"`
+	// PortForwarder selects how ports are forwarded: with `kubectl port-forward` processes or natively.
+	PortForwarder string `yaml:"port-forwarder,omitempty"`
 }
 
 // SurveyConfig is the survey config information
@@ -44,8 +44,12 @@ type SkaffoldOptions struct {
 	Cleanup               bool
 	Notification          bool
 	Tail                  bool
+	PersistLogs           bool
 	SkipTests             bool
+	Verify                bool
 	CacheArtifacts        bool
+	ExplainCache          bool
+	DetectDrift           bool
 	EnableRPC             bool
 	Force                 bool
 	NoPrune               bool
@@ -53,6 +57,7 @@ type SkaffoldOptions struct {
 	AutoBuild             bool
 	AutoSync              bool
 	AutoDeploy            bool
+	NativeSync            bool
 	RenderOnly            bool
 	AutoCreateConfig      bool
 	AssumeYes             bool
@@ -74,6 +79,7 @@ type SkaffoldOptions struct {
 	CustomTag          string
 	Namespace          string
 	CacheFile          string
+	SharedCache        string
 	Trigger            string
 	KubeContext        string
 	KubeConfig         string
@@ -39,6 +39,14 @@ const (
 	off = "off"
 )
 
+// These are the implementations of port forwarding that can be selected in the global config.
+const (
+	// KubectlPortForwarder runs a `kubectl port-forward` process for each forwarded port.
+	KubectlPortForwarder = "kubectl"
+	// NativePortForwarder forwards ports with the Kubernetes API, in-process.
+	NativePortForwarder = "native"
+)
+
 // PortForwardOptions are options set by the command line for port forwarding.
 // `off` is intended to be a single standalone option.
 type PortForwardOptions struct {
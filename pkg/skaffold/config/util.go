@@ -193,6 +193,24 @@ func GetDebugHelpersRegistry(configFile string) (string, error) {
 	return constants.DefaultDebugHelpersRegistry, nil
 }
 
+// GetPortForwarder returns the port forwarding implementation selected in the global config.
+func GetPortForwarder(configFile string) (string, error) {
+	cfg, err := GetConfigForCurrentKubectx(configFile)
+	if err != nil {
+		return "", err
+	}
+
+	switch cfg.PortForwarder {
+	case "":
+		return KubectlPortForwarder, nil
+	case KubectlPortForwarder, NativePortForwarder:
+		logrus.Infof("Using port-forwarder=%s from config", cfg.PortForwarder)
+		return cfg.PortForwarder, nil
+	default:
+		return "", fmt.Errorf("invalid port-forwarder %q in config, must be one of %q or %q", cfg.PortForwarder, KubectlPortForwarder, NativePortForwarder)
+	}
+}
+
 func GetCluster(configFile string, minikubeProfile string, detectMinikube bool) (Cluster, error) {
 	cfg, err := GetConfigForCurrentKubectx(configFile)
 	if err != nil {
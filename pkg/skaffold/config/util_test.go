@@ -600,6 +600,45 @@ func TestShouldDisplayPrompt(t *testing.T) {
 	}
 }
 
+func TestGetPortForwarder(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         *ContextConfig
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "default",
+			cfg:         &ContextConfig{},
+			expected:    "kubectl",
+		},
+		{
+			description: "kubectl",
+			cfg:         &ContextConfig{PortForwarder: "kubectl"},
+			expected:    "kubectl",
+		},
+		{
+			description: "native",
+			cfg:         &ContextConfig{PortForwarder: "native"},
+			expected:    "native",
+		},
+		{
+			description: "invalid",
+			cfg:         &ContextConfig{PortForwarder: "ssh"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&GetConfigForCurrentKubectx, func(string) (*ContextConfig, error) { return test.cfg, nil })
+
+			forwarder, err := GetPortForwarder("dummyconfig")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, forwarder)
+		})
+	}
+}
+
 func TestGetDefaultRepo(t *testing.T) {
 	tests := []struct {
 		description  string
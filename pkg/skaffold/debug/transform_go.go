@@ -59,7 +59,8 @@ func isLaunchingDlv(args []string) bool {
 }
 
 func (t dlvTransformer) IsApplicable(config imageConfiguration) bool {
-	for _, name := range []string{"GODEBUG", "GOGC", "GOMAXPROCS", "GOTRACEBACK"} {
+	// KO_DATA_PATH is set in the images built by ko.
+	for _, name := range []string{"GODEBUG", "GOGC", "GOMAXPROCS", "GOTRACEBACK", "KO_DATA_PATH"} {
 		if _, found := config.env[name]; found {
 			logrus.Infof("Artifact %q has Go runtime: has env %q", config.artifact, name)
 			return true
@@ -91,6 +91,11 @@ func TestDlvTransformer_IsApplicable(t *testing.T) {
 			source:      imageConfiguration{env: map[string]string{"GOTRACEBACK": "off"}},
 			result:      true,
 		},
+		{
+			description: "KO_DATA_PATH",
+			source:      imageConfiguration{env: map[string]string{"KO_DATA_PATH": "/var/run/ko"}},
+			result:      true,
+		},
 		{
 			description: "entrypoint with dlv",
 			source:      imageConfiguration{entrypoint: []string{"dlv", "exec", "--headless"}},
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// nativeTransformer configures native binaries, such as Rust, C and C++ programs, for debugging with gdbserver.
+type nativeTransformer struct{}
+
+func init() {
+	containerTransforms = append(containerTransforms, nativeTransformer{})
+}
+
+const (
+	// gdbserver and lldb-server examples use 1234
+	defaultGdbserverPort = 1234
+)
+
+// gdbserverSpec captures the address of a gdbserver or lldb-server
+type gdbserverSpec struct {
+	host string
+	port int32
+}
+
+// isLaunchingGdbserver determines if the arguments seems to be invoking gdbserver or lldb-server
+func isLaunchingGdbserver(args []string) bool {
+	return len(args) > 0 && (args[0] == "gdbserver" || strings.HasSuffix(args[0], "/gdbserver") ||
+		args[0] == "lldb-server" || strings.HasSuffix(args[0], "/lldb-server"))
+}
+
+func (t nativeTransformer) IsApplicable(config imageConfiguration) bool {
+	// Native binaries can't be told apart, except for Rust programs which commonly set these variables
+	for _, v := range []string{"RUST_BACKTRACE", "RUST_LIB_BACKTRACE", "RUST_LOG"} {
+		if _, found := config.env[v]; found {
+			logrus.Infof("Artifact %q has Rust runtime: has env %q", config.artifact, v)
+			return true
+		}
+	}
+	if len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint) {
+		return isLaunchingGdbserver(config.entrypoint)
+	}
+	return isLaunchingGdbserver(config.arguments)
+}
+
+// Apply configures a container definition for a native binary with gdbserver, which must be installed in the image.
+// Returns a simple map describing the debug configuration details.
+func (t nativeTransformer) Apply(container *v1.Container, config imageConfiguration, portAlloc portAllocator) (ContainerDebugConfiguration, string, error) {
+	logrus.Infof("Configuring %q for native debugging with gdbserver", container.Name)
+
+	// try to find an existing `gdbserver` or `lldb-server` command
+	spec := retrieveGdbserverSpec(config)
+	if spec == nil {
+		spec = &gdbserverSpec{port: portAlloc(defaultGdbserverPort)}
+		switch {
+		case len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint):
+			container.Command = rewriteGdbserverCommandLine(config.entrypoint, *spec)
+
+		case (len(config.entrypoint) == 0 || isEntrypointLauncher(config.entrypoint)) && len(config.arguments) > 0:
+			container.Args = rewriteGdbserverCommandLine(config.arguments, *spec)
+
+		default:
+			return ContainerDebugConfiguration{}, "", fmt.Errorf("container %q has no command-line", container.Name)
+		}
+	}
+
+	container.Ports = exposePort(container.Ports, "gdbserver", spec.port)
+
+	return ContainerDebugConfiguration{
+		Runtime: "native",
+		Ports:   map[string]uint32{"gdbserver": uint32(spec.port)},
+	}, "", nil
+}
+
+func retrieveGdbserverSpec(config imageConfiguration) *gdbserverSpec {
+	if spec := extractGdbserverSpec(config.entrypoint); spec != nil {
+		return spec
+	}
+	if spec := extractGdbserverSpec(config.arguments); spec != nil {
+		return spec
+	}
+	return nil
+}
+
+// extractGdbserverSpec extracts the address from a `gdbserver [options] host:port program` or
+// `lldb-server gdbserver [options] host:port -- program` command-line.
+func extractGdbserverSpec(args []string) *gdbserverSpec {
+	if !isLaunchingGdbserver(args) {
+		return nil
+	}
+	for _, arg := range args[1:] {
+		if arg == "--" {
+			break
+		}
+		if strings.HasPrefix(arg, "-") || !strings.Contains(arg, ":") {
+			continue
+		}
+		split := strings.Split(arg, ":")
+		port, err := strconv.ParseInt(split[len(split)-1], 10, 32)
+		if err != nil {
+			// not an address, such as the program to debug
+			break
+		}
+		return &gdbserverSpec{host: strings.Join(split[:len(split)-1], ":"), port: int32(port)}
+	}
+	return nil
+}
+
+// rewriteGdbserverCommandLine rewrites a command-line to launch the program with gdbserver
+func rewriteGdbserverCommandLine(commandLine []string, spec gdbserverSpec) []string {
+	return util.StrSliceInsert(commandLine, 0, spec.asArguments())
+}
+
+func (spec gdbserverSpec) asArguments() []string {
+	return []string{"gdbserver", fmt.Sprintf("%s:%d", spec.host, spec.port)}
+}
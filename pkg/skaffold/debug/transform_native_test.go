@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestExtractGdbserverSpec(t *testing.T) {
+	tests := []struct {
+		in     []string
+		result *gdbserverSpec
+	}{
+		{nil, nil},
+		{[]string{"/app/server"}, nil},
+		{[]string{"gdbserver", ":1234", "/app/server"}, &gdbserverSpec{port: 1234}},
+		{[]string{"/usr/bin/gdbserver", "--once", "localhost:2345", "/app/server", "--port", "8080"}, &gdbserverSpec{host: "localhost", port: 2345}},
+		{[]string{"gdbserver", "--multi", "0.0.0.0:2345"}, &gdbserverSpec{host: "0.0.0.0", port: 2345}},
+		{[]string{"lldb-server", "gdbserver", "*:1234", "--", "/app/server"}, &gdbserverSpec{host: "*", port: 1234}},
+		{[]string{"lldb-server", "g", "--", "/app/server", "localhost:1234"}, nil},
+		{[]string{"gdbserver", "/dev/ttyS0", "/app/server"}, nil},
+	}
+	for _, test := range tests {
+		testutil.Run(t, strings.Join(test.in, " "), func(t *testutil.T) {
+			if test.result == nil {
+				t.CheckDeepEqual(test.result, extractGdbserverSpec(test.in))
+			} else {
+				t.CheckDeepEqual(*test.result, *extractGdbserverSpec(test.in), cmp.AllowUnexported(gdbserverSpec{}))
+			}
+		})
+	}
+}
+
+func TestNativeTransformer_IsApplicable(t *testing.T) {
+	tests := []struct {
+		description string
+		source      imageConfiguration
+		launcher    string
+		result      bool
+	}{
+		{
+			description: "RUST_BACKTRACE",
+			source:      imageConfiguration{env: map[string]string{"RUST_BACKTRACE": "1"}},
+			result:      true,
+		},
+		{
+			description: "RUST_LOG",
+			source:      imageConfiguration{env: map[string]string{"RUST_LOG": "info"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint gdbserver",
+			source:      imageConfiguration{entrypoint: []string{"gdbserver", ":1234", "/app/server"}},
+			result:      true,
+		},
+		{
+			description: "no entrypoint, args lldb-server",
+			source:      imageConfiguration{arguments: []string{"/usr/bin/lldb-server", "g", ":1234", "--", "/app/server"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint launcher",
+			source:      imageConfiguration{entrypoint: []string{"launcher"}, arguments: []string{"gdbserver", ":1234", "/app/server"}},
+			launcher:    "launcher",
+			result:      true,
+		},
+		{
+			description: "native binary",
+			source:      imageConfiguration{entrypoint: []string{"/app/server"}},
+			result:      false,
+		},
+		{
+			description: "nothing",
+			source:      imageConfiguration{},
+			result:      false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&entrypointLaunchers, []string{test.launcher})
+			result := nativeTransformer{}.IsApplicable(test.source)
+
+			t.CheckDeepEqual(test.result, result)
+		})
+	}
+}
+
+func TestNativeTransformer_Apply(t *testing.T) {
+	tests := []struct {
+		description   string
+		containerSpec v1.Container
+		configuration imageConfiguration
+		shouldErr     bool
+		result        v1.Container
+		debugConfig   ContainerDebugConfiguration
+	}{
+		{
+			description:   "empty",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{env: map[string]string{"RUST_BACKTRACE": "1"}},
+			result:        v1.Container{},
+			shouldErr:     true,
+		},
+		{
+			description:   "entrypoint",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{entrypoint: []string{"/app/server", "--port", "8080"}},
+			result: v1.Container{
+				Command: []string{"gdbserver", ":1234", "/app/server", "--port", "8080"},
+				Ports:   []v1.ContainerPort{{Name: "gdbserver", ContainerPort: 1234}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "native", Ports: map[string]uint32{"gdbserver": 1234}},
+		},
+		{
+			description:   "arguments",
+			containerSpec: v1.Container{Ports: []v1.ContainerPort{{Name: "http-server", ContainerPort: 8080}}},
+			configuration: imageConfiguration{arguments: []string{"/app/server"}},
+			result: v1.Container{
+				Args:  []string{"gdbserver", ":1234", "/app/server"},
+				Ports: []v1.ContainerPort{{Name: "http-server", ContainerPort: 8080}, {Name: "gdbserver", ContainerPort: 1234}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "native", Ports: map[string]uint32{"gdbserver": 1234}},
+		},
+		{
+			description:   "existing gdbserver command",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{entrypoint: []string{"gdbserver", ":2345", "/app/server"}},
+			result: v1.Container{
+				Ports: []v1.ContainerPort{{Name: "gdbserver", ContainerPort: 2345}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "native", Ports: map[string]uint32{"gdbserver": 2345}},
+		},
+	}
+	var identity portAllocator = func(port int32) int32 {
+		return port
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			config, image, err := nativeTransformer{}.Apply(&test.containerSpec, test.configuration, identity)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.result, test.containerSpec)
+			t.CheckDeepEqual(test.debugConfig, config)
+			t.CheckDeepEqual("", image)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+)
+
+type phpTransformer struct{}
+
+func init() {
+	containerTransforms = append(containerTransforms, phpTransformer{})
+
+	// the `php` image's "docker-php-entrypoint" launches the command
+	entrypointLaunchers = append(entrypointLaunchers, "docker-php-entrypoint")
+}
+
+const (
+	// Xdebug 3 connects to port 9003 by default
+	defaultXdebugPort = 9003
+)
+
+// isLaunchingPhp determines if the arguments seems to be invoking php, php-fpm or an apache with mod_php
+func isLaunchingPhp(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	base := args[0][strings.LastIndex(args[0], "/")+1:]
+	return base == "php" || strings.HasPrefix(base, "php-fpm") || base == "apache2-foreground"
+}
+
+func (t phpTransformer) IsApplicable(config imageConfiguration) bool {
+	// PHP_VERSION and PHP_INI_DIR are defined in the Official Docker `php` image
+	for _, v := range []string{"PHP_VERSION", "PHP_INI_DIR"} {
+		if _, found := config.env[v]; found {
+			return true
+		}
+	}
+	if len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint) {
+		return isLaunchingPhp(config.entrypoint)
+	}
+	return isLaunchingPhp(config.arguments)
+}
+
+// Apply configures a container definition for PHP with Xdebug, which must be installed and enabled in the image.
+// Unlike other debuggers, Xdebug connects to the IDE: the port is the one the IDE listens on, at the
+// `client_host` of `XDEBUG_CONFIG`, and isn't exposed by the container.
+// Returns a simple map describing the debug configuration details.
+func (t phpTransformer) Apply(container *v1.Container, config imageConfiguration, portAlloc portAllocator) (ContainerDebugConfiguration, string, error) {
+	logrus.Infof("Configuring %q for PHP/Xdebug debugging", container.Name)
+
+	// XDEBUG_CONFIG holds space-separated `key=value` settings
+	xdebugConfig := strings.Fields(config.env["XDEBUG_CONFIG"])
+	port, err := xdebugPort(xdebugConfig)
+	if err != nil {
+		return ContainerDebugConfiguration{}, "", err
+	}
+	if port < 0 {
+		port = portAlloc(defaultXdebugPort)
+		xdebugConfig = append(xdebugConfig, "client_port="+strconv.Itoa(int(port)))
+	}
+	if xdebugSetting(xdebugConfig, "start_with_request") == "" {
+		xdebugConfig = append(xdebugConfig, "start_with_request=yes")
+	}
+	container.Env = setEnvVar(container.Env, "XDEBUG_CONFIG", strings.Join(xdebugConfig, " "))
+
+	mode := config.env["XDEBUG_MODE"]
+	switch {
+	case mode == "" || mode == "off":
+		mode = "debug"
+	case !hasXdebugMode(mode, "debug"):
+		mode += ",debug"
+	}
+	container.Env = setEnvVar(container.Env, "XDEBUG_MODE", mode)
+
+	return ContainerDebugConfiguration{
+		Runtime: "php",
+		Ports:   map[string]uint32{"dbgp": uint32(port)},
+	}, "", nil
+}
+
+func xdebugSetting(settings []string, key string) string {
+	for _, setting := range settings {
+		if split := strings.SplitN(setting, "=", 2); len(split) == 2 && split[0] == key {
+			return split[1]
+		}
+	}
+	return ""
+}
+
+// xdebugPort returns the port configured in `XDEBUG_CONFIG`, or -1 if there's none.
+func xdebugPort(settings []string) (int32, error) {
+	value := xdebugSetting(settings, "client_port")
+	if value == "" {
+		return -1, nil
+	}
+	port, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid Xdebug client_port %q: %w", value, err)
+	}
+	return int32(port), nil
+}
+
+func hasXdebugMode(modes, mode string) bool {
+	for _, m := range strings.Split(modes, ",") {
+		if strings.TrimSpace(m) == mode {
+			return true
+		}
+	}
+	return false
+}
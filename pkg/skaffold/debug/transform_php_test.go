@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestPhpTransformer_IsApplicable(t *testing.T) {
+	tests := []struct {
+		description string
+		source      imageConfiguration
+		launcher    string
+		result      bool
+	}{
+		{
+			description: "PHP_VERSION",
+			source:      imageConfiguration{env: map[string]string{"PHP_VERSION": "8.1.0"}},
+			result:      true,
+		},
+		{
+			description: "PHP_INI_DIR",
+			source:      imageConfiguration{env: map[string]string{"PHP_INI_DIR": "/usr/local/etc/php"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint php",
+			source:      imageConfiguration{entrypoint: []string{"php", "index.php"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint /usr/sbin/php-fpm7.4",
+			source:      imageConfiguration{entrypoint: []string{"/usr/sbin/php-fpm7.4", "-F"}},
+			result:      true,
+		},
+		{
+			description: "docker-php-entrypoint, args apache2-foreground",
+			source:      imageConfiguration{entrypoint: []string{"docker-php-entrypoint"}, arguments: []string{"apache2-foreground"}},
+			launcher:    "docker-php-entrypoint",
+			result:      true,
+		},
+		{
+			description: "entrypoint /bin/sh",
+			source:      imageConfiguration{entrypoint: []string{"/bin/sh"}},
+			result:      false,
+		},
+		{
+			description: "nothing",
+			source:      imageConfiguration{},
+			result:      false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&entrypointLaunchers, []string{test.launcher})
+			result := phpTransformer{}.IsApplicable(test.source)
+
+			t.CheckDeepEqual(test.result, result)
+		})
+	}
+}
+
+func TestPhpTransformer_Apply(t *testing.T) {
+	tests := []struct {
+		description   string
+		containerSpec v1.Container
+		configuration imageConfiguration
+		shouldErr     bool
+		result        v1.Container
+		debugConfig   ContainerDebugConfiguration
+	}{
+		{
+			description:   "basic",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{entrypoint: []string{"php", "index.php"}},
+			result: v1.Container{
+				Env: []v1.EnvVar{
+					{Name: "XDEBUG_CONFIG", Value: "client_port=9003 start_with_request=yes"},
+					{Name: "XDEBUG_MODE", Value: "debug"},
+				},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "php", Ports: map[string]uint32{"dbgp": 9003}},
+		},
+		{
+			description:   "existing configuration",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{env: map[string]string{
+				"PHP_VERSION":   "8.1.0",
+				"XDEBUG_CONFIG": "client_host=10.0.0.1 client_port=9000 start_with_request=trigger",
+				"XDEBUG_MODE":   "develop,coverage",
+			}},
+			result: v1.Container{
+				Env: []v1.EnvVar{
+					{Name: "XDEBUG_CONFIG", Value: "client_host=10.0.0.1 client_port=9000 start_with_request=trigger"},
+					{Name: "XDEBUG_MODE", Value: "develop,coverage,debug"},
+				},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "php", Ports: map[string]uint32{"dbgp": 9000}},
+		},
+		{
+			description:   "debug mode already enabled",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{env: map[string]string{"PHP_VERSION": "8.1.0", "XDEBUG_MODE": "develop, debug"}},
+			result: v1.Container{
+				Env: []v1.EnvVar{
+					{Name: "XDEBUG_CONFIG", Value: "client_port=9003 start_with_request=yes"},
+					{Name: "XDEBUG_MODE", Value: "develop, debug"},
+				},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "php", Ports: map[string]uint32{"dbgp": 9003}},
+		},
+		{
+			description:   "invalid port",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{env: map[string]string{"XDEBUG_CONFIG": "client_port=abc"}},
+			result:        v1.Container{},
+			shouldErr:     true,
+		},
+	}
+	var identity portAllocator = func(port int32) int32 {
+		return port
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			config, image, err := phpTransformer{}.Apply(&test.containerSpec, test.configuration, identity)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.result, test.containerSpec)
+			t.CheckDeepEqual(test.debugConfig, config)
+			t.CheckDeepEqual("", image)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+)
+
+type rubyTransformer struct{}
+
+func init() {
+	containerTransforms = append(containerTransforms, rubyTransformer{})
+}
+
+const (
+	// most examples of the `debug` gem use 12345
+	defaultRdbgPort = 12345
+)
+
+// rdbgSpec captures the useful options of the `debug` gem
+type rdbgSpec struct {
+	host string
+	port int32
+}
+
+// isLaunchingRuby determines if the arguments seems to be invoking ruby, or a common ruby launcher
+func isLaunchingRuby(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	for _, command := range []string{"ruby", "bundle", "rails", "rackup", "puma", "rake", "rdbg"} {
+		if args[0] == command || strings.HasSuffix(args[0], "/"+command) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t rubyTransformer) IsApplicable(config imageConfiguration) bool {
+	// RUBY_VERSION and RUBY_MAJOR are defined in the Official Docker `ruby` image
+	for _, v := range []string{"RUBY_VERSION", "RUBY_MAJOR"} {
+		if _, found := config.env[v]; found {
+			return true
+		}
+	}
+	if len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint) {
+		return isLaunchingRuby(config.entrypoint)
+	}
+	return isLaunchingRuby(config.arguments)
+}
+
+// Apply configures a container definition for Ruby with the `debug` gem, which is bundled with Ruby 3.1 and later.
+// The debugger is loaded through `RUBYOPT` so that applications launched by `bundle` or `rails` are debugged too.
+// Returns a simple map describing the debug configuration details.
+func (t rubyTransformer) Apply(container *v1.Container, config imageConfiguration, portAlloc portAllocator) (ContainerDebugConfiguration, string, error) {
+	logrus.Infof("Configuring %q for Ruby debugging", container.Name)
+
+	// try to find an existing `rdbg --open` command or debugger configuration
+	spec := retrieveRdbgSpec(config)
+	if spec == nil {
+		spec = &rdbgSpec{host: "0.0.0.0", port: portAlloc(defaultRdbgPort)}
+
+		rubyOpt := "-rdebug/open_nonstop"
+		if existing, found := config.env["RUBYOPT"]; found {
+			rubyOpt = existing + " " + rubyOpt
+		}
+		container.Env = setEnvVar(container.Env, "RUBYOPT", rubyOpt)
+		container.Env = setEnvVar(container.Env, "RUBY_DEBUG_HOST", spec.host)
+		container.Env = setEnvVar(container.Env, "RUBY_DEBUG_PORT", strconv.Itoa(int(spec.port)))
+	}
+
+	container.Ports = exposePort(container.Ports, "dap", spec.port)
+
+	return ContainerDebugConfiguration{
+		Runtime: "ruby",
+		Ports:   map[string]uint32{"dap": uint32(spec.port)},
+	}, "", nil
+}
+
+func retrieveRdbgSpec(config imageConfiguration) *rdbgSpec {
+	if spec := extractRdbgSpec(config.entrypoint); spec != nil {
+		return spec
+	}
+	if spec := extractRdbgSpec(config.arguments); spec != nil {
+		return spec
+	}
+	if value, found := config.env["RUBY_DEBUG_PORT"]; found {
+		port, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			logrus.Errorf("Invalid RUBY_DEBUG_PORT %q: %s\n", value, err)
+			return nil
+		}
+		return &rdbgSpec{host: config.env["RUBY_DEBUG_HOST"], port: int32(port)}
+	}
+	return nil
+}
+
+// extractRdbgSpec extracts the debugger's address from a `rdbg --open --port=12345` command-line.
+// Without a port, `rdbg` listens on a UNIX domain socket, which can't be forwarded.
+func extractRdbgSpec(args []string) *rdbgSpec {
+	if len(args) == 0 || (args[0] != "rdbg" && !strings.HasSuffix(args[0], "/rdbg")) {
+		return nil
+	}
+	spec := rdbgSpec{port: -1}
+arguments:
+	for i, arg := range args {
+		var flag, value string
+		switch {
+		case arg == "--":
+			break arguments
+		case strings.HasPrefix(arg, "--port=") || strings.HasPrefix(arg, "--host="):
+			split := strings.SplitN(arg, "=", 2)
+			flag, value = split[0], split[1]
+		case (arg == "--port" || arg == "--host") && i < len(args)-1:
+			flag, value = arg, args[i+1]
+		default:
+			continue
+		}
+
+		if flag == "--host" {
+			spec.host = value
+			continue
+		}
+		port, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			logrus.Errorf("Invalid rdbg port %q: %s\n", value, err)
+			return nil
+		}
+		spec.port = int32(port)
+	}
+	if spec.port < 0 {
+		return nil
+	}
+	return &spec
+}
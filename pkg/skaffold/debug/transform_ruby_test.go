@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestExtractRdbgSpec(t *testing.T) {
+	tests := []struct {
+		in     []string
+		result *rdbgSpec
+	}{
+		{nil, nil},
+		{[]string{"ruby", "app.rb"}, nil},
+		// without a port, rdbg listens on a UNIX domain socket
+		{[]string{"rdbg", "--open", "app.rb"}, nil},
+		{[]string{"rdbg", "--open", "--port=12345", "app.rb"}, &rdbgSpec{port: 12345}},
+		{[]string{"/usr/local/bin/rdbg", "-O", "--host", "0.0.0.0", "--port", "4000", "-c", "--", "rails", "s"}, &rdbgSpec{host: "0.0.0.0", port: 4000}},
+		{[]string{"rdbg", "-O", "-c", "--", "rails", "s", "--port", "3000"}, nil},
+		{[]string{"rdbg", "--open", "--port=abc"}, nil},
+	}
+	for _, test := range tests {
+		testutil.Run(t, strings.Join(test.in, " "), func(t *testutil.T) {
+			if test.result == nil {
+				t.CheckDeepEqual(test.result, extractRdbgSpec(test.in))
+			} else {
+				t.CheckDeepEqual(*test.result, *extractRdbgSpec(test.in), cmp.AllowUnexported(rdbgSpec{}))
+			}
+		})
+	}
+}
+
+func TestRubyTransformer_IsApplicable(t *testing.T) {
+	tests := []struct {
+		description string
+		source      imageConfiguration
+		launcher    string
+		result      bool
+	}{
+		{
+			description: "RUBY_VERSION",
+			source:      imageConfiguration{env: map[string]string{"RUBY_VERSION": "3.1.2"}},
+			result:      true,
+		},
+		{
+			description: "RUBY_MAJOR",
+			source:      imageConfiguration{env: map[string]string{"RUBY_MAJOR": "3.1"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint ruby",
+			source:      imageConfiguration{entrypoint: []string{"ruby", "app.rb"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint /usr/local/bin/bundle",
+			source:      imageConfiguration{entrypoint: []string{"/usr/local/bin/bundle", "exec", "puma"}},
+			result:      true,
+		},
+		{
+			description: "no entrypoint, args rails",
+			source:      imageConfiguration{arguments: []string{"rails", "server"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint launcher",
+			source:      imageConfiguration{entrypoint: []string{"launcher"}, arguments: []string{"rackup"}},
+			launcher:    "launcher",
+			result:      true,
+		},
+		{
+			description: "entrypoint /bin/sh",
+			source:      imageConfiguration{entrypoint: []string{"/bin/sh"}},
+			result:      false,
+		},
+		{
+			description: "nothing",
+			source:      imageConfiguration{},
+			result:      false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&entrypointLaunchers, []string{test.launcher})
+			result := rubyTransformer{}.IsApplicable(test.source)
+
+			t.CheckDeepEqual(test.result, result)
+		})
+	}
+}
+
+func TestRubyTransformer_Apply(t *testing.T) {
+	tests := []struct {
+		description   string
+		containerSpec v1.Container
+		configuration imageConfiguration
+		result        v1.Container
+		debugConfig   ContainerDebugConfiguration
+	}{
+		{
+			description:   "basic",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{entrypoint: []string{"ruby", "app.rb"}},
+			result: v1.Container{
+				Env: []v1.EnvVar{
+					{Name: "RUBYOPT", Value: "-rdebug/open_nonstop"},
+					{Name: "RUBY_DEBUG_HOST", Value: "0.0.0.0"},
+					{Name: "RUBY_DEBUG_PORT", Value: "12345"},
+				},
+				Ports: []v1.ContainerPort{{Name: "dap", ContainerPort: 12345}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"dap": 12345}},
+		},
+		{
+			description:   "existing RUBYOPT",
+			containerSpec: v1.Container{Ports: []v1.ContainerPort{{Name: "http-server", ContainerPort: 8080}}},
+			configuration: imageConfiguration{env: map[string]string{"RUBY_VERSION": "3.1.2", "RUBYOPT": "-W0"}},
+			result: v1.Container{
+				Env: []v1.EnvVar{
+					{Name: "RUBYOPT", Value: "-W0 -rdebug/open_nonstop"},
+					{Name: "RUBY_DEBUG_HOST", Value: "0.0.0.0"},
+					{Name: "RUBY_DEBUG_PORT", Value: "12345"},
+				},
+				Ports: []v1.ContainerPort{{Name: "http-server", ContainerPort: 8080}, {Name: "dap", ContainerPort: 12345}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"dap": 12345}},
+		},
+		{
+			description:   "existing debugger port",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{env: map[string]string{"RUBY_DEBUG_PORT": "4000"}, arguments: []string{"rails", "s"}},
+			result: v1.Container{
+				Ports: []v1.ContainerPort{{Name: "dap", ContainerPort: 4000}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"dap": 4000}},
+		},
+		{
+			description:   "existing rdbg command",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{entrypoint: []string{"rdbg", "--open", "--port=4000", "app.rb"}},
+			result: v1.Container{
+				Ports: []v1.ContainerPort{{Name: "dap", ContainerPort: 4000}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"dap": 4000}},
+		},
+	}
+	var identity portAllocator = func(port int32) int32 {
+		return port
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			config, image, err := rubyTransformer{}.Apply(&test.containerSpec, test.configuration, identity)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.result, test.containerSpec)
+			t.CheckDeepEqual(test.debugConfig, config)
+			t.CheckDeepEqual("", image)
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"io"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 )
 
 // Deployer is the Deploy API of skaffold and responsible for deploying
@@ -41,3 +42,12 @@ type Deployer interface {
 	// writes them to the given file path
 	Render(context.Context, io.Writer, []graph.Artifact, bool, string) error
 }
+
+// ManifestTracker is implemented by deployers that keep track of the manifests they applied.
+type ManifestTracker interface {
+	// AppliedManifests returns the manifests applied by the last deployment.
+	AppliedManifests() manifest.ManifestList
+
+	// ForgetAppliedManifests makes the next deployment apply all the manifests, even the unchanged ones.
+	ForgetAppliedManifests()
+}
@@ -80,3 +80,21 @@ func (m DeployerMux) Render(ctx context.Context, w io.Writer, as []graph.Artifac
 	allResources := strings.Join(resources, "\n---\n")
 	return manifest.Write(allResources, filepath, w)
 }
+
+func (m DeployerMux) AppliedManifests() manifest.ManifestList {
+	var manifests manifest.ManifestList
+	for _, deployer := range m {
+		if tracker, ok := deployer.(ManifestTracker); ok {
+			manifests = append(manifests, tracker.AppliedManifests()...)
+		}
+	}
+	return manifests
+}
+
+func (m DeployerMux) ForgetAppliedManifests() {
+	for _, deployer := range m {
+		if tracker, ok := deployer.(ManifestTracker); ok {
+			tracker.ForgetAppliedManifests()
+		}
+	}
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/docker/go-connections/nat"
+	shell "github.com/kballard/go-shellquote"
+	yamlv3 "gopkg.in/yaml.v3"
+
+	pkgdocker "github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// composeFile is the subset of the Compose file format that the Docker deployer supports.
+type composeFile struct {
+	Services map[string]composeService `yaml:"services"`
+}
+
+type composeService struct {
+	Image         string       `yaml:"image"`
+	ContainerName string       `yaml:"container_name"`
+	User          string       `yaml:"user"`
+	Entrypoint    shellCommand `yaml:"entrypoint"`
+	Command       shellCommand `yaml:"command"`
+	Environment   environment  `yaml:"environment"`
+	Ports         []string     `yaml:"ports"`
+	DependsOn     dependencies `yaml:"depends_on"`
+}
+
+// shellCommand is either a list of arguments or a string that is split like a shell would.
+type shellCommand []string
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *shellCommand) UnmarshalYAML(value *yamlv3.Node) error {
+	if value.Kind == yamlv3.ScalarNode {
+		args, err := shell.Split(value.Value)
+		if err != nil {
+			return fmt.Errorf("parsing command %q: %w", value.Value, err)
+		}
+		*c = args
+		return nil
+	}
+
+	var args []string
+	if err := value.Decode(&args); err != nil {
+		return err
+	}
+	*c = args
+	return nil
+}
+
+// environment is either a list of `KEY=VALUE` or a map of values.
+// Variables without a value are taken from the host environment.
+type environment []string
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (e *environment) UnmarshalYAML(value *yamlv3.Node) error {
+	values := map[string]*string{}
+	if value.Kind == yamlv3.MappingNode {
+		if err := value.Decode(&values); err != nil {
+			return err
+		}
+	} else {
+		var list []string
+		if err := value.Decode(&list); err != nil {
+			return err
+		}
+		for _, kv := range list {
+			if i := strings.Index(kv, "="); i >= 0 {
+				v := kv[i+1:]
+				values[kv[:i]] = &v
+			} else {
+				values[kv] = nil
+			}
+		}
+	}
+
+	var env []string
+	for k, v := range values {
+		if v == nil {
+			hostValue, found := os.LookupEnv(k)
+			if !found {
+				continue
+			}
+			v = &hostValue
+		}
+		env = append(env, fmt.Sprintf("%s=%s", k, *v))
+	}
+	sort.Strings(env)
+
+	*e = env
+	return nil
+}
+
+// dependencies is either a list of services or a map keyed by service.
+type dependencies []string
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (d *dependencies) UnmarshalYAML(value *yamlv3.Node) error {
+	if value.Kind == yamlv3.MappingNode {
+		var deps map[string]interface{}
+		if err := value.Decode(&deps); err != nil {
+			return err
+		}
+		for dep := range deps {
+			*d = append(*d, dep)
+		}
+		sort.Strings(*d)
+		return nil
+	}
+
+	var deps []string
+	if err := value.Decode(&deps); err != nil {
+		return err
+	}
+	*d = deps
+	return nil
+}
+
+// readComposeFile lists the containers described by a Compose file, in the order they
+// must be started. Images built by Skaffold are replaced with their tags.
+func readComposeFile(path string, builds []graph.Artifact) ([]container, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading compose file: %w", err)
+	}
+
+	var compose composeFile
+	if err := yaml.Unmarshal([]byte(os.Expand(string(buf), expandVariable)), &compose); err != nil {
+		return nil, fmt.Errorf("parsing compose file %q: %w", path, err)
+	}
+
+	order, err := startOrder(compose.Services)
+	if err != nil {
+		return nil, err
+	}
+
+	var containers []container
+	for _, name := range order {
+		service := compose.Services[name]
+		if service.Image == "" {
+			return nil, fmt.Errorf("service %q of compose file %q has no image", name, path)
+		}
+
+		_, ports, err := nat.ParsePortSpecs(service.Ports)
+		if err != nil {
+			return nil, fmt.Errorf("parsing ports of service %q: %w", name, err)
+		}
+		if len(ports) == 0 {
+			ports = nil
+		}
+
+		containerName := service.ContainerName
+		if containerName == "" {
+			containerName = name
+		}
+
+		containers = append(containers, container{
+			Name:       containerName,
+			Image:      replaceBuiltImage(service.Image, builds),
+			Aliases:    []string{name},
+			User:       service.User,
+			Entrypoint: service.Entrypoint,
+			Command:    service.Command,
+			Env:        service.Environment,
+			Ports:      ports,
+		})
+	}
+	return containers, nil
+}
+
+// expandVariable resolves `$VAR`, `${VAR}`, `${VAR:-default}` and `${VAR-default}` from the
+// host environment. `$$` is an escaped `$`.
+func expandVariable(name string) string {
+	if name == "$" {
+		return "$"
+	}
+	if i := strings.Index(name, ":-"); i >= 0 {
+		if value := os.Getenv(name[:i]); value != "" {
+			return value
+		}
+		return name[i+2:]
+	}
+	if i := strings.Index(name, "-"); i >= 0 {
+		if value, found := os.LookupEnv(name[:i]); found {
+			return value
+		}
+		return name[i+1:]
+	}
+	return os.Getenv(name)
+}
+
+// replaceBuiltImage replaces an image with the tag of the artifact that builds it, if any.
+func replaceBuiltImage(image string, builds []graph.Artifact) string {
+	ref, err := pkgdocker.ParseReference(image)
+	if err != nil {
+		return image
+	}
+	for _, b := range builds {
+		if b.ImageName == ref.BaseName {
+			return b.Tag
+		}
+	}
+	return image
+}
+
+// startOrder sorts the services so that each service starts after its dependencies.
+func startOrder(services map[string]composeService) ([]string, error) {
+	var names []string
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var order []string
+	state := map[string]int{} // 1: visiting, 2: visited
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case 1:
+			return fmt.Errorf("circular dependency between compose services involving %q", name)
+		case 2:
+			return nil
+		}
+		state[name] = 1
+
+		for _, dep := range services[name].DependsOn {
+			if _, found := services[dep]; !found {
+				return fmt.Errorf("service %q depends on unknown service %q", name, dep)
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+
+		state[name] = 2
+		order = append(order, name)
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
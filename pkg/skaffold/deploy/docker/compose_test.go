@@ -0,0 +1,180 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestReadComposeFile(t *testing.T) {
+	tests := []struct {
+		description string
+		compose     string
+		env         map[string]string
+		builds      []graph.Artifact
+		expected    []container
+		shouldErr   bool
+	}{
+		{
+			description: "built images are replaced with their tags",
+			compose: `services:
+  web:
+    image: gcr.io/project/web
+  redis:
+    image: redis:6
+`,
+			builds: []graph.Artifact{{ImageName: "gcr.io/project/web", Tag: "gcr.io/project/web:v1"}},
+			expected: []container{
+				{Name: "redis", Image: "redis:6", Aliases: []string{"redis"}},
+				{Name: "web", Image: "gcr.io/project/web:v1", Aliases: []string{"web"}},
+			},
+		},
+		{
+			description: "services start after their dependencies",
+			compose: `services:
+  a:
+    image: a
+    depends_on: [c]
+  b:
+    image: b
+  c:
+    image: c
+    depends_on:
+      b:
+        condition: service_started
+`,
+			expected: []container{
+				{Name: "b", Image: "b", Aliases: []string{"b"}},
+				{Name: "c", Image: "c", Aliases: []string{"c"}},
+				{Name: "a", Image: "a", Aliases: []string{"a"}},
+			},
+		},
+		{
+			description: "commands, environment and ports",
+			compose: `services:
+  web:
+    image: web
+    container_name: my-web
+    user: nobody
+    entrypoint: ["/bin/sh", "-c"]
+    command: npm run "dev server"
+    environment:
+      - MODE=dev
+      - FROM_HOST
+      - MISSING
+    ports:
+      - "127.0.0.1:3000:3000"
+      - 9229
+  db:
+    image: postgres
+    environment:
+      POSTGRES_PASSWORD: secret
+      FROM_HOST:
+`,
+			env: map[string]string{"FROM_HOST": "host"},
+			expected: []container{
+				{
+					Name:    "db",
+					Image:   "postgres",
+					Aliases: []string{"db"},
+					Env:     []string{"FROM_HOST=host", "POSTGRES_PASSWORD=secret"},
+				},
+				{
+					Name:       "my-web",
+					Image:      "web",
+					Aliases:    []string{"web"},
+					User:       "nobody",
+					Entrypoint: []string{"/bin/sh", "-c"},
+					Command:    []string{"npm", "run", "dev server"},
+					Env:        []string{"FROM_HOST=host", "MODE=dev"},
+					Ports: nat.PortMap{
+						"3000/tcp": {{HostIP: "127.0.0.1", HostPort: "3000"}},
+						"9229/tcp": {{HostIP: "", HostPort: ""}},
+					},
+				},
+			},
+		},
+		{
+			description: "variables are expanded",
+			compose: `services:
+  web:
+    image: web:${TAG}
+    environment:
+      - LEVEL=${LEVEL:-info}
+      - PRICE=$$5
+      - MODE=${MODE-prod}
+`,
+			env: map[string]string{"TAG": "v2", "MODE": ""},
+			expected: []container{
+				{Name: "web", Image: "web:v2", Aliases: []string{"web"}, Env: []string{"LEVEL=info", "MODE=", "PRICE=$5"}},
+			},
+		},
+		{
+			description: "missing image",
+			compose: `services:
+  web:
+    build: .
+`,
+			shouldErr: true,
+		},
+		{
+			description: "unknown dependency",
+			compose: `services:
+  web:
+    image: web
+    depends_on: [db]
+`,
+			shouldErr: true,
+		},
+		{
+			description: "circular dependency",
+			compose: `services:
+  a:
+    image: a
+    depends_on: [b]
+  b:
+    image: b
+    depends_on: [a]
+`,
+			shouldErr: true,
+		},
+		{
+			description: "invalid port",
+			compose: `services:
+  web:
+    image: web
+    ports: ["invalid"]
+`,
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(test.env)
+			tmpDir := t.NewTempDir().Write("docker-compose.yaml", test.compose)
+
+			containers, err := readComposeFile(tmpDir.Path("docker-compose.yaml"), test.builds)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, containers)
+		})
+	}
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/go-connections/nat"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	pkgdocker "github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	// DefaultNetwork is the network containers are attached to when none is configured.
+	DefaultNetwork = "skaffold-network"
+
+	networkLabel = "skaffold.dev/docker-network"
+	configLabel  = "skaffold.dev/docker-config"
+)
+
+// Config contains the configuration needed by the Docker deployer.
+type Config interface {
+	pkgdocker.Config
+	kubernetes.Config
+
+	Tail() bool
+	PortForward() bool
+}
+
+// Deployer runs containers with the local Docker daemon.
+type Deployer struct {
+	*latest_v1.DockerDeploy
+
+	client      pkgdocker.LocalDaemon
+	cfg         Config
+	labels      map[string]string
+	network     string
+	tail        bool
+	portForward bool
+
+	lock           sync.Mutex
+	createdNetwork bool                       // whether the network was created by this deployer
+	containers     map[string]types.Container // running containers, by name
+	streaming      map[string]bool            // ids of the containers whose logs are streamed
+	forwarded      map[string]int             // local ports, by container name and container port
+	ports          util.PortSet
+}
+
+// container describes a container to run.
+type container struct {
+	Name       string      `json:"name"`
+	Image      string      `json:"image"`
+	Aliases    []string    `json:"aliases,omitempty"`
+	User       string      `json:"user,omitempty"`
+	Entrypoint []string    `json:"entrypoint,omitempty"`
+	Command    []string    `json:"command,omitempty"`
+	Env        []string    `json:"env,omitempty"`
+	Ports      nat.PortMap `json:"ports,omitempty"`
+}
+
+// NewDeployer returns a new Deployer for a DockerDeploy config.
+func NewDeployer(cfg Config, labels map[string]string, d *latest_v1.DockerDeploy) (*Deployer, error) {
+	client, err := pkgdocker.NewAPIClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	network := d.Network
+	if network == "" {
+		network = DefaultNetwork
+	}
+
+	return &Deployer{
+		DockerDeploy: d,
+		client:       client,
+		cfg:          cfg,
+		labels:       labels,
+		network:      network,
+		tail:         cfg.Tail(),
+		portForward:  cfg.PortForward(),
+		containers:   map[string]types.Container{},
+		streaming:    map[string]bool{},
+		forwarded:    map[string]int{},
+	}, nil
+}
+
+// Deploy runs a container for each image or Compose service, on a dedicated network.
+// Containers that are already running with the same configuration are kept.
+func (d *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Artifact) ([]string, error) {
+	wanted, err := d.wantedContainers(ctx, builds)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := d.client.NetworkCreate(ctx, d.network, map[string]string{networkLabel: d.network})
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		d.lock.Lock()
+		d.createdNetwork = true
+		d.lock.Unlock()
+	}
+
+	existing, err := d.existingContainers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	running := map[string]types.Container{}
+	for _, c := range wanted {
+		hash, err := configHash(c)
+		if err != nil {
+			return nil, err
+		}
+
+		// A container with the same name, started by a previous run or on another network, is replaced.
+		if current, found := existing[c.Name]; found {
+			delete(existing, c.Name)
+			if current.State == "running" && current.Image == c.Image && current.Labels[configLabel] == hash && current.Labels[networkLabel] == d.network {
+				fmt.Fprintf(out, " - container %s unchanged\n", c.Name)
+				running[c.Name] = current
+				continue
+			}
+			if err := d.client.ContainerRemove(ctx, current.ID); err != nil {
+				return nil, err
+			}
+		}
+
+		id, err := d.client.Run(ctx, out, pkgdocker.ContainerRun{
+			Name:           c.Name,
+			Image:          c.Image,
+			User:           c.User,
+			Entrypoint:     c.Entrypoint,
+			Command:        c.Command,
+			Env:            c.Env,
+			Labels:         d.containerLabels(hash),
+			Network:        d.network,
+			NetworkAliases: c.Aliases,
+			Ports:          c.Ports,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("running container %q: %w", c.Name, err)
+		}
+		fmt.Fprintf(out, " - container %s created\n", c.Name)
+		printForwardedPorts(out, c)
+
+		running[c.Name] = types.Container{ID: id, Image: c.Image, Labels: d.containerLabels(hash)}
+	}
+
+	// Remove the containers that are no longer configured.
+	for _, name := range sortedNames(d.onNetwork(existing)) {
+		if err := d.client.ContainerRemove(ctx, existing[name].ID); err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(out, " - container %s removed\n", name)
+	}
+
+	d.lock.Lock()
+	d.containers = running
+	d.lock.Unlock()
+
+	if d.tail {
+		d.streamLogs(ctx, out, builds)
+	}
+
+	// Nothing is deployed to Kubernetes namespaces.
+	return nil, nil
+}
+
+// Dependencies returns the Compose file, if any.
+func (d *Deployer) Dependencies() ([]string, error) {
+	if d.ComposeFile == "" {
+		return []string{}, nil
+	}
+	return []string{d.ComposeFile}, nil
+}
+
+// Cleanup removes the containers on the deployer's network.
+// The network is removed too if it was created by Deploy and no other container uses it.
+func (d *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
+	existing, err := d.existingContainers(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range sortedNames(d.onNetwork(existing)) {
+		if err := d.client.ContainerRemove(ctx, existing[name].ID); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, " - container %s removed\n", name)
+	}
+
+	d.lock.Lock()
+	d.containers = map[string]types.Container{}
+	created := d.createdNetwork
+	d.createdNetwork = false
+	d.lock.Unlock()
+
+	if !created {
+		return nil
+	}
+	return d.client.NetworkRemove(ctx, d.network)
+}
+
+// Render is a no-op since the Docker deployer doesn't use Kubernetes manifests.
+func (d *Deployer) Render(context.Context, io.Writer, []graph.Artifact, bool, string) error {
+	logrus.Debugln("Nothing to render for the docker deployer")
+	return nil
+}
+
+// wantedContainers lists the containers to run, either from the Compose file or from the images.
+func (d *Deployer) wantedContainers(ctx context.Context, builds []graph.Artifact) ([]container, error) {
+	if d.ComposeFile != "" {
+		return readComposeFile(d.ComposeFile, builds)
+	}
+
+	var containers []container
+	for _, b := range d.imagesToRun(builds) {
+		c := container{
+			Name:    containerName(b.ImageName),
+			Image:   b.Tag,
+			Aliases: []string{containerName(b.ImageName)},
+		}
+		if d.portForward {
+			ports, err := d.exposedPorts(ctx, c.Name, b.Tag)
+			if err != nil {
+				return nil, err
+			}
+			c.Ports = ports
+		}
+		containers = append(containers, c)
+	}
+	return containers, nil
+}
+
+// imagesToRun returns the built artifacts matching the configured images, or all of them
+// if no image is configured.
+func (d *Deployer) imagesToRun(builds []graph.Artifact) []graph.Artifact {
+	if len(d.Images) == 0 {
+		return builds
+	}
+
+	var selected []graph.Artifact
+	for _, b := range builds {
+		if util.StrSliceContains(d.Images, b.ImageName) {
+			selected = append(selected, b)
+		}
+	}
+	return selected
+}
+
+// exposedPorts publishes the ports exposed by an image on available local ports.
+// A container keeps its local ports across deployments.
+func (d *Deployer) exposedPorts(ctx context.Context, name, tag string) (nat.PortMap, error) {
+	cfg, err := d.client.ConfigFile(ctx, tag)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving image config of %q: %w", tag, err)
+	}
+
+	ports := nat.PortMap{}
+	for exposed := range cfg.Config.ExposedPorts {
+		port, err := nat.NewPort(nat.SplitProtoPort(exposed))
+		if err != nil {
+			return nil, err
+		}
+		key := fmt.Sprintf("%s/%s", name, port)
+		localPort, found := d.forwarded[key]
+		if !found {
+			localPort = util.GetAvailablePort(util.Loopback, port.Int(), &d.ports)
+			d.forwarded[key] = localPort
+		}
+		ports[port] = []nat.PortBinding{{HostIP: util.Loopback, HostPort: strconv.Itoa(localPort)}}
+	}
+	return ports, nil
+}
+
+// existingContainers lists the containers started by the Docker deployer, by name.
+// This includes the containers of previous runs and the containers on other networks,
+// since container names are unique to the Docker daemon.
+func (d *Deployer) existingContainers(ctx context.Context) (map[string]types.Container, error) {
+	list, err := d.client.ContainerList(ctx, map[string]string{networkLabel: ""})
+	if err != nil {
+		return nil, fmt.Errorf("listing containers: %w", err)
+	}
+
+	containers := map[string]types.Container{}
+	for _, c := range list {
+		if len(c.Names) > 0 {
+			containers[strings.TrimPrefix(c.Names[0], "/")] = c
+		}
+	}
+	return containers, nil
+}
+
+// onNetwork selects the containers attached to the deployer's network.
+func (d *Deployer) onNetwork(containers map[string]types.Container) map[string]types.Container {
+	selected := map[string]types.Container{}
+	for name, c := range containers {
+		if c.Labels[networkLabel] == d.network {
+			selected[name] = c
+		}
+	}
+	return selected
+}
+
+func sortedNames(containers map[string]types.Container) []string {
+	var names []string
+	for name := range containers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (d *Deployer) containerLabels(hash string) map[string]string {
+	labels := map[string]string{}
+	for k, v := range d.labels {
+		labels[k] = v
+	}
+	labels[networkLabel] = d.network
+	labels[configLabel] = hash
+	return labels
+}
+
+func printForwardedPorts(out io.Writer, c container) {
+	var ports []string
+	for port := range c.Ports {
+		ports = append(ports, string(port))
+	}
+	sort.Strings(ports)
+
+	for _, port := range ports {
+		for _, binding := range c.Ports[nat.Port(port)] {
+			address := binding.HostIP
+			if address == "" {
+				address = "0.0.0.0"
+			}
+			color.Green.Fprintf(out, "Port forwarding container %s, remote port %s -> %s:%s\n", c.Name, port, address, binding.HostPort)
+		}
+	}
+}
+
+// configHash identifies the configuration of a container, to detect when it must be recreated.
+func configHash(c container) (string, error) {
+	buf, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(buf))[:16], nil
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+// containerName derives a valid container name from an image name.
+func containerName(imageName string) string {
+	name := imageName
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return invalidNameChars.ReplaceAllString(name, "-")
+}
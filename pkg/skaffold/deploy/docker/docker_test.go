@@ -0,0 +1,461 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/go-connections/nat"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	pkgdocker "github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type fakeDaemon struct {
+	pkgdocker.LocalDaemon
+
+	containers      []types.Container
+	listFilter      map[string]string
+	existingNetwork bool
+	exposedPorts    map[string]struct{}
+	logs            string
+
+	networks []string
+	runs     []pkgdocker.ContainerRun
+	removed  []string
+	copied   []string
+	execs    [][]string
+}
+
+func (f *fakeDaemon) NetworkCreate(_ context.Context, name string, _ map[string]string) (bool, error) {
+	if f.existingNetwork {
+		return false, nil
+	}
+	f.networks = append(f.networks, "+"+name)
+	return true, nil
+}
+
+func (f *fakeDaemon) NetworkRemove(_ context.Context, name string) error {
+	f.networks = append(f.networks, "-"+name)
+	return nil
+}
+
+func (f *fakeDaemon) Run(_ context.Context, _ io.Writer, opts pkgdocker.ContainerRun) (string, error) {
+	f.runs = append(f.runs, opts)
+	return "id-" + opts.Name, nil
+}
+
+func (f *fakeDaemon) ContainerList(_ context.Context, labels map[string]string) ([]types.Container, error) {
+	f.listFilter = labels
+	return f.containers, nil
+}
+
+func (f *fakeDaemon) ContainerLogs(_ context.Context, w io.Writer, _ string) error {
+	_, err := io.WriteString(w, f.logs)
+	return err
+}
+
+func (f *fakeDaemon) ContainerRemove(_ context.Context, id string) error {
+	f.removed = append(f.removed, id)
+	return nil
+}
+
+func (f *fakeDaemon) CopyToContainer(_ context.Context, id string, dir string, content io.Reader) error {
+	if _, err := io.Copy(ioutil.Discard, content); err != nil {
+		return err
+	}
+	f.copied = append(f.copied, id+":"+dir)
+	return nil
+}
+
+func (f *fakeDaemon) ContainerExec(_ context.Context, id string, cmd []string) error {
+	f.execs = append(f.execs, append([]string{id}, cmd...))
+	return nil
+}
+
+func (f *fakeDaemon) ConfigFile(context.Context, string) (*v1.ConfigFile, error) {
+	return &v1.ConfigFile{Config: v1.Config{ExposedPorts: f.exposedPorts}}, nil
+}
+
+type dockerConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+	tail                  bool
+	portForward           bool
+}
+
+func (c *dockerConfig) Tail() bool        { return c.tail }
+func (c *dockerConfig) PortForward() bool { return c.portForward }
+
+func newTestDeployer(t *testutil.T, cfg *dockerConfig, d *latest_v1.DockerDeploy, fake *fakeDaemon) *Deployer {
+	t.Override(&pkgdocker.NewAPIClient, func(pkgdocker.Config) (pkgdocker.LocalDaemon, error) {
+		return fake, nil
+	})
+	deployer, err := NewDeployer(cfg, map[string]string{"skaffold.dev/run-id": "run"}, d)
+	t.RequireNoError(err)
+	return deployer
+}
+
+func existingContainer(t *testutil.T, c container, state string) types.Container {
+	hash, err := configHash(c)
+	t.RequireNoError(err)
+	return types.Container{
+		ID:     "old-" + c.Name,
+		Names:  []string{"/" + c.Name},
+		Image:  c.Image,
+		State:  state,
+		Labels: map[string]string{configLabel: hash, networkLabel: DefaultNetwork, "skaffold.dev/run-id": "run"},
+	}
+}
+
+func onNetwork(c types.Container, network string) types.Container {
+	c.Labels[networkLabel] = network
+	return c
+}
+
+func TestDockerDeploy(t *testing.T) {
+	builds := []graph.Artifact{
+		{ImageName: "gcr.io/project/app", Tag: "gcr.io/project/app:v2"},
+		{ImageName: "worker", Tag: "worker:v2"},
+	}
+	app := container{Name: "app", Image: "gcr.io/project/app:v2", Aliases: []string{"app"}}
+	worker := container{Name: "worker", Image: "worker:v2", Aliases: []string{"worker"}}
+
+	tests := []struct {
+		description     string
+		deploy          latest_v1.DockerDeploy
+		existing        func(t *testutil.T) []types.Container
+		expectedRuns    []string
+		expectedRemoved []string
+		expectedOutput  string
+	}{
+		{
+			description:    "run all artifacts",
+			expectedRuns:   []string{"app=gcr.io/project/app:v2", "worker=worker:v2"},
+			expectedOutput: " - container app created\n - container worker created\n",
+		},
+		{
+			description:    "run selected images",
+			deploy:         latest_v1.DockerDeploy{Images: []string{"worker"}},
+			expectedRuns:   []string{"worker=worker:v2"},
+			expectedOutput: " - container worker created\n",
+		},
+		{
+			description: "keep unchanged containers",
+			existing: func(t *testutil.T) []types.Container {
+				return []types.Container{existingContainer(t, app, "running")}
+			},
+			expectedRuns:   []string{"worker=worker:v2"},
+			expectedOutput: " - container app unchanged\n - container worker created\n",
+		},
+		{
+			description: "recreate stopped containers",
+			existing: func(t *testutil.T) []types.Container {
+				return []types.Container{existingContainer(t, app, "exited")}
+			},
+			expectedRuns:    []string{"app=gcr.io/project/app:v2", "worker=worker:v2"},
+			expectedRemoved: []string{"old-app"},
+		},
+		{
+			description: "recreate containers with a new image",
+			existing: func(t *testutil.T) []types.Container {
+				return []types.Container{existingContainer(t, container{Name: "worker", Image: "worker:v1", Aliases: []string{"worker"}}, "running")}
+			},
+			expectedRuns:    []string{"app=gcr.io/project/app:v2", "worker=worker:v2"},
+			expectedRemoved: []string{"old-worker"},
+		},
+		{
+			description: "remove containers that are no longer configured",
+			deploy:      latest_v1.DockerDeploy{Images: []string{"worker"}},
+			existing: func(t *testutil.T) []types.Container {
+				return []types.Container{existingContainer(t, app, "running"), existingContainer(t, worker, "running")}
+			},
+			expectedRemoved: []string{"old-app"},
+			expectedOutput:  " - container worker unchanged\n - container app removed\n",
+		},
+		{
+			description: "keep unchanged containers of a previous run",
+			existing: func(t *testutil.T) []types.Container {
+				c := existingContainer(t, app, "running")
+				c.Labels["skaffold.dev/run-id"] = "previous-run"
+				return []types.Container{c}
+			},
+			expectedRuns:   []string{"worker=worker:v2"},
+			expectedOutput: " - container app unchanged\n - container worker created\n",
+		},
+		{
+			description: "recreate containers on another network",
+			existing: func(t *testutil.T) []types.Container {
+				return []types.Container{onNetwork(existingContainer(t, app, "running"), "other-network")}
+			},
+			expectedRuns:    []string{"app=gcr.io/project/app:v2", "worker=worker:v2"},
+			expectedRemoved: []string{"old-app"},
+		},
+		{
+			description: "keep the containers of other networks",
+			deploy:      latest_v1.DockerDeploy{Images: []string{"worker"}},
+			existing: func(t *testutil.T) []types.Container {
+				return []types.Container{onNetwork(existingContainer(t, app, "running"), "other-network"), existingContainer(t, worker, "running")}
+			},
+			expectedOutput: " - container worker unchanged\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			fake := &fakeDaemon{}
+			if test.existing != nil {
+				fake.containers = test.existing(t)
+			}
+			d := newTestDeployer(t, &dockerConfig{}, &test.deploy, fake)
+
+			var out bytes.Buffer
+			namespaces, err := d.Deploy(context.Background(), &out, builds)
+
+			t.CheckNoError(err)
+			t.CheckEmpty(namespaces)
+			var runs []string
+			for _, r := range fake.runs {
+				t.CheckDeepEqual(DefaultNetwork, r.Network)
+				t.CheckDeepEqual([]string{r.Name}, r.NetworkAliases)
+				t.CheckDeepEqual("run", r.Labels["skaffold.dev/run-id"])
+				t.CheckDeepEqual(DefaultNetwork, r.Labels[networkLabel])
+				runs = append(runs, fmt.Sprintf("%s=%s", r.Name, r.Image))
+			}
+			t.CheckDeepEqual(test.expectedRuns, runs)
+			t.CheckDeepEqual(test.expectedRemoved, fake.removed)
+			t.CheckDeepEqual([]string{"+" + DefaultNetwork}, fake.networks)
+			t.CheckDeepEqual(map[string]string{networkLabel: ""}, fake.listFilter)
+			if test.expectedOutput != "" {
+				t.CheckDeepEqual(test.expectedOutput, out.String())
+			}
+		})
+	}
+}
+
+func TestDockerDeployPortForward(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		fake := &fakeDaemon{exposedPorts: map[string]struct{}{"8080/tcp": {}}}
+		d := newTestDeployer(t, &dockerConfig{portForward: true}, &latest_v1.DockerDeploy{Network: "net"}, fake)
+
+		var out bytes.Buffer
+		_, err := d.Deploy(context.Background(), &out, []graph.Artifact{{ImageName: "app", Tag: "app:v1"}})
+		t.CheckNoError(err)
+
+		t.CheckDeepEqual(1, len(fake.runs))
+		t.CheckDeepEqual("net", fake.runs[0].Network)
+		bindings := fake.runs[0].Ports[nat.Port("8080/tcp")]
+		t.CheckDeepEqual(1, len(bindings))
+		t.CheckDeepEqual("127.0.0.1", bindings[0].HostIP)
+		t.CheckContains(fmt.Sprintf("Port forwarding container app, remote port 8080/tcp -> 127.0.0.1:%s", bindings[0].HostPort), out.String())
+
+		// The same local port is used when the container is recreated.
+		_, err = d.Deploy(context.Background(), ioutil.Discard, []graph.Artifact{{ImageName: "app", Tag: "app:v2"}})
+		t.CheckNoError(err)
+		t.CheckDeepEqual(bindings, fake.runs[1].Ports[nat.Port("8080/tcp")])
+	})
+}
+
+func TestDockerCleanup(t *testing.T) {
+	tests := []struct {
+		description      string
+		existingNetwork  bool
+		expectedNetworks []string
+	}{
+		{
+			description:      "remove the network created by deploy",
+			expectedNetworks: []string{"+" + DefaultNetwork, "-" + DefaultNetwork},
+		},
+		{
+			description:     "keep a network that already existed",
+			existingNetwork: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			fake := &fakeDaemon{existingNetwork: test.existingNetwork}
+			d := newTestDeployer(t, &dockerConfig{}, &latest_v1.DockerDeploy{}, fake)
+			_, err := d.Deploy(context.Background(), ioutil.Discard, nil)
+			t.RequireNoError(err)
+
+			fake.containers = []types.Container{
+				{ID: "id-worker", Names: []string{"/worker"}, Labels: map[string]string{networkLabel: DefaultNetwork}},
+				{ID: "id-app", Names: []string{"/app"}, Labels: map[string]string{networkLabel: DefaultNetwork}},
+				{ID: "id-other", Names: []string{"/other"}, Labels: map[string]string{networkLabel: "other-network"}},
+			}
+			var out bytes.Buffer
+			err = d.Cleanup(context.Background(), &out)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual([]string{"id-app", "id-worker"}, fake.removed)
+			t.CheckDeepEqual(map[string]string{networkLabel: ""}, fake.listFilter)
+			t.CheckDeepEqual(test.expectedNetworks, fake.networks)
+			t.CheckDeepEqual(" - container app removed\n - container worker removed\n", out.String())
+		})
+	}
+}
+
+func TestDockerDependencies(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		d := newTestDeployer(t, &dockerConfig{}, &latest_v1.DockerDeploy{ComposeFile: "docker-compose.yaml"}, &fakeDaemon{})
+
+		deps, err := d.Dependencies()
+
+		t.CheckErrorAndDeepEqual(false, err, []string{"docker-compose.yaml"}, deps)
+	})
+}
+
+func TestDockerSync(t *testing.T) {
+	tests := []struct {
+		description    string
+		item           *sync.Item
+		expectedCopies []string
+		expectedExecs  [][]string
+		shouldErr      bool
+	}{
+		{
+			description: "copy and delete files",
+			item: &sync.Item{
+				Image:  "app:v1",
+				Copy:   map[string][]string{"main.go": {"/app/main.go"}},
+				Delete: map[string][]string{"old.go": {"/app/old.go"}},
+			},
+			expectedCopies: []string{"id-app:/"},
+			expectedExecs:  [][]string{{"id-app", "rm", "-rf", "--", "/app/old.go"}},
+		},
+		{
+			description: "run sync hooks in the containers",
+			item: &sync.Item{
+				Image: "app:v1",
+				Artifact: &latest_v1.Artifact{ImageName: "app", Sync: &latest_v1.Sync{
+					LifecycleHooks: latest_v1.SyncHooks{
+						PreHooks:  []latest_v1.SyncHookItem{{ContainerHook: &latest_v1.ContainerHook{Command: []string{"echo", "pre"}}}},
+						PostHooks: []latest_v1.SyncHookItem{{ContainerHook: &latest_v1.ContainerHook{Command: []string{"kill", "-HUP", "1"}}}},
+					},
+				}},
+				Copy: map[string][]string{"main.go": {"/app/main.go"}},
+			},
+			expectedCopies: []string{"id-app:/"},
+			expectedExecs:  [][]string{{"id-app", "echo", "pre"}, {"id-app", "kill", "-HUP", "1"}},
+		},
+		{
+			description: "no running container",
+			item: &sync.Item{
+				Image: "other:v1",
+				Copy:  map[string][]string{"main.go": {"/app/main.go"}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Touch("main.go").Chdir()
+			test.item.Copy = absolutePaths(tmpDir, test.item.Copy)
+
+			fake := &fakeDaemon{}
+			d := newTestDeployer(t, &dockerConfig{}, &latest_v1.DockerDeploy{}, fake)
+			_, err := d.Deploy(context.Background(), ioutil.Discard, []graph.Artifact{{ImageName: "app", Tag: "app:v1"}})
+			t.RequireNoError(err)
+
+			err = d.Sync(context.Background(), ioutil.Discard, test.item)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedCopies, fake.copied)
+			t.CheckDeepEqual(test.expectedExecs, fake.execs)
+		})
+	}
+}
+
+func absolutePaths(tmpDir *testutil.TempDir, files map[string][]string) map[string][]string {
+	abs := map[string][]string{}
+	for src, dsts := range files {
+		abs[tmpDir.Path(src)] = dsts
+	}
+	return abs
+}
+
+func TestDockerLogs(t *testing.T) {
+	tests := []struct {
+		description string
+		logPrefix   string
+		logFilter   *latest_v1.LogFilter
+		expected    string
+	}{
+		{
+			description: "container prefix",
+			logPrefix:   "container",
+			expected:    "[app] first\n[app] second\n",
+		},
+		{
+			description: "no prefix",
+			logPrefix:   "none",
+			expected:    "first\nsecond\n",
+		},
+		{
+			description: "filtered",
+			logPrefix:   "container",
+			logFilter:   &latest_v1.LogFilter{Regex: "sec"},
+			expected:    "[app] second\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			cfg := &dockerConfig{RunContext: runcontext.RunContext{
+				Pipelines: runcontext.NewPipelines([]latest_v1.Pipeline{{
+					Build:  latest_v1.BuildConfig{Artifacts: []*latest_v1.Artifact{{ImageName: "app"}}},
+					Deploy: latest_v1.DeployConfig{Logs: latest_v1.LogsConfig{Prefix: test.logPrefix, Filter: test.logFilter}},
+				}}),
+			}}
+			d := newTestDeployer(t, cfg, &latest_v1.DockerDeploy{}, &fakeDaemon{logs: "first\nsecond\n"})
+			builds := []graph.Artifact{{ImageName: "app", Tag: "app:v1"}}
+
+			var out bytes.Buffer
+			formatter := kubernetes.NewLogFormatter(color.None, "app", d.pipeline("app:v1", builds).Deploy.Logs)
+			d.streamContainerLogs(context.Background(), &logWriter{out: &out}, formatter, "id-app")
+
+			t.CheckDeepEqual(test.expected, out.String())
+		})
+	}
+}
+
+func TestContainerName(t *testing.T) {
+	tests := []struct {
+		image    string
+		expected string
+	}{
+		{image: "app", expected: "app"},
+		{image: "gcr.io/project/app", expected: "app"},
+		{image: "localhost:5000/app", expected: "app"},
+		{image: "my_app.v2", expected: "my_app.v2"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.image, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, containerName(test.image))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// logWriter prints the log lines of all the containers, without intermixing them.
+type logWriter struct {
+	out  io.Writer
+	lock sync.Mutex
+}
+
+func (w *logWriter) printLogLine(formatter kubernetes.LogFormatter, text string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	formatter.PrintLine(w.out, text)
+}
+
+// streamLogs starts streaming the logs of the running containers that are not streamed yet.
+func (d *Deployer) streamLogs(ctx context.Context, out io.Writer, builds []graph.Artifact) {
+	var imageNames []string
+	for _, b := range builds {
+		imageNames = append(imageNames, b.Tag)
+	}
+	colorPicker := kubernetes.NewColorPicker(imageNames)
+	w := &logWriter{out: out}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	for _, name := range sortedNames(d.containers) {
+		c := d.containers[name]
+		if d.streaming[c.ID] {
+			continue
+		}
+		d.streaming[c.ID] = true
+
+		logs := d.pipeline(c.Image, builds).Deploy.Logs
+		formatter := kubernetes.NewLogFormatter(colorPicker.PickForImage(c.Image), name, logs)
+		go d.streamContainerLogs(ctx, w, formatter, c.ID)
+	}
+}
+
+func (d *Deployer) streamContainerLogs(ctx context.Context, w *logWriter, formatter kubernetes.LogFormatter, id string) {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(d.client.ContainerLogs(ctx, pw, id))
+	}()
+
+	r := bufio.NewReader(pr)
+	for {
+		line, err := r.ReadString('\n')
+		if line != "" {
+			w.printLogLine(formatter, line)
+		}
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				logrus.Debugf("streaming logs of container %s: %v", id, err)
+			}
+			return
+		}
+	}
+}
+
+// pipeline returns the pipeline that builds the image of a container, whose
+// `deploy.logs` configuration applies to the container's logs.
+func (d *Deployer) pipeline(image string, builds []graph.Artifact) latest_v1.Pipeline {
+	for _, b := range builds {
+		if b.Tag == image {
+			if pipeline, found := d.cfg.PipelineForImage(b.ImageName); found {
+				return pipeline
+			}
+			break
+		}
+	}
+	return d.cfg.DefaultPipeline()
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/docker/docker/api/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// Sync copies and deletes files in the running containers of the item's image.
+// The artifact's sync hooks run before and after the files are synced.
+func (d *Deployer) Sync(ctx context.Context, out io.Writer, item *sync.Item) error {
+	containers := d.containersForImage(item.Image)
+	if len(containers) == 0 {
+		return errors.New("didn't sync any files")
+	}
+
+	var hooksRunner hooks.Runner
+	if item.Artifact != nil && item.Artifact.Sync != nil {
+		var ids []string
+		for _, c := range containers {
+			ids = append(ids, c.ID)
+		}
+		opts := hooks.NewSyncEnvOpts(item.Artifact, item.Image, item.Copy, item.Delete, nil, "")
+		hooksRunner = hooks.NewDockerSyncRunner(d.client.ContainerExec, ids, item.Artifact.Sync.LifecycleHooks, opts)
+		if err := hooksRunner.RunPreHooks(ctx, out); err != nil {
+			return err
+		}
+	}
+
+	if len(item.Copy) > 0 {
+		logrus.Infoln("Copying files:", item.Copy, "to", item.Image)
+
+		for _, c := range containers {
+			if err := d.copyFiles(ctx, c.ID, item.Copy); err != nil {
+				return fmt.Errorf("copying files: %w", err)
+			}
+		}
+	}
+
+	if len(item.Delete) > 0 {
+		logrus.Infoln("Deleting files:", item.Delete, "from", item.Image)
+
+		args := []string{"rm", "-rf", "--"}
+		for _, dsts := range item.Delete {
+			args = append(args, dsts...)
+		}
+		for _, c := range containers {
+			if err := d.client.ContainerExec(ctx, c.ID, args); err != nil {
+				return fmt.Errorf("deleting files: %w", err)
+			}
+		}
+	}
+
+	if hooksRunner != nil {
+		return hooksRunner.RunPostHooks(ctx, out)
+	}
+	return nil
+}
+
+func (d *Deployer) copyFiles(ctx context.Context, id string, files map[string][]string) error {
+	reader, writer := io.Pipe()
+	defer reader.Close()
+	go func() {
+		writer.CloseWithError(util.CreateMappedTar(writer, "/", files))
+	}()
+
+	return d.client.CopyToContainer(ctx, id, "/", reader)
+}
+
+func (d *Deployer) containersForImage(image string) []types.Container {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	var containers []types.Container
+	for _, name := range sortedNames(d.containers) {
+		if c := d.containers[name]; c.Image == image {
+			containers = append(containers, c)
+		}
+	}
+	return containers
+}
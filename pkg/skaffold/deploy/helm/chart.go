@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+)
+
+const (
+	ociScheme = "oci://"
+
+	// chartLayerMediaType is the media type of the layer holding a chart pushed with `helm push`.
+	chartLayerMediaType = types.MediaType("application/vnd.cncf.helm.chart.content.v1.tar+gzip")
+	// legacyChartLayerMediaType was used by `helm chart save` before OCI support was stable.
+	legacyChartLayerMediaType = types.MediaType("application/tar+gzip")
+)
+
+// for testing
+var remoteImage = docker.RetrieveRemoteImage
+
+// isOCIChart says if a remote chart is stored in an OCI registry.
+func isOCIChart(ref string) bool {
+	return strings.HasPrefix(ref, ociScheme)
+}
+
+// loadChart loads a local chart, either unpacked or packaged with `helm package`.
+func loadChart(chartPath string) (*chart.Chart, error) {
+	c, err := loader.Load(chartPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading chart %q: %w", chartPath, err)
+	}
+	return c, nil
+}
+
+// pullChart pulls a chart stored in an OCI registry, as pushed by `helm push`.
+func pullChart(ref, version string, cfg docker.Config) (*chart.Chart, error) {
+	name := strings.TrimPrefix(ref, ociScheme)
+	if version != "" {
+		// Tags can't contain `+`: Helm replaces it with `_`.
+		name = fmt.Sprintf("%s:%s", name, strings.ReplaceAll(version, "+", "_"))
+	}
+
+	img, err := remoteImage(name, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("pulling chart %q: %w", ref, err)
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("pulling chart %q: %w", ref, err)
+	}
+
+	for _, desc := range manifest.Layers {
+		if desc.MediaType != chartLayerMediaType && desc.MediaType != legacyChartLayerMediaType {
+			continue
+		}
+
+		layer, err := img.LayerByDigest(desc.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("pulling chart %q: %w", ref, err)
+		}
+		rc, err := layer.Compressed()
+		if err != nil {
+			return nil, fmt.Errorf("pulling chart %q: %w", ref, err)
+		}
+		defer rc.Close()
+
+		c, err := loader.LoadArchive(rc)
+		if err != nil {
+			return nil, fmt.Errorf("loading chart %q: %w", ref, err)
+		}
+		return c, nil
+	}
+
+	return nil, fmt.Errorf("%q is not a Helm chart: no layer of type %q", ref, chartLayerMediaType)
+}
@@ -20,10 +20,12 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -34,6 +36,7 @@ import (
 	backoff "github.com/cenkalti/backoff/v4"
 	shell "github.com/kballard/go-shellquote"
 	"github.com/sirupsen/logrus"
+	"helm.sh/helm/v3/pkg/chart"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
@@ -42,6 +45,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/types"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
@@ -62,6 +66,9 @@ var (
 	// helm31Version represents the version cut-off for helm3.1 post-renderer behavior
 	helm31Version = semver.MustParse("3.1.0")
 
+	// helm38Version represents the version cut-off for OCI support without HELM_EXPERIMENTAL_OCI
+	helm38Version = semver.MustParse("3.8.0")
+
 	// error to throw when helm version can't be determined
 	versionErrorString = "failed to determine binary version: %w"
 
@@ -88,21 +95,31 @@ type Deployer struct {
 	isMultiConfig bool
 	// bV is the helm binary version
 	bV semver.Version
+	// binErr is set when the helm binary can't be found. Charts are then rendered in-process.
+	binErr error
+
+	// used to pull charts from OCI registries
+	dockerCfg docker.Config
 }
 
 type Config interface {
 	kubectl.Config
+	docker.Config
 	IsMultiConfig() bool
 }
 
 // NewDeployer returns a configured Deployer.  Returns an error if current version of helm is less than 3.0.0.
+// If the helm binary can't be found, the Deployer can only render charts, in-process.
 func NewDeployer(cfg Config, labels map[string]string, h *latest_v1.HelmDeploy) (*Deployer, error) {
 	hv, err := binVer()
-	if err != nil {
+	var binErr error
+	switch {
+	case errors.Is(err, exec.ErrNotFound):
+		logrus.Debugf("helm binary not found, charts will be rendered in-process: %v", err)
+		binErr = err
+	case err != nil:
 		return nil, versionGetErr(err)
-	}
-
-	if hv.LT(helm3Version) {
+	case hv.LT(helm3Version):
 		return nil, minVersionErr()
 	}
 
@@ -115,6 +132,8 @@ func NewDeployer(cfg Config, labels map[string]string, h *latest_v1.HelmDeploy)
 		configFile:    cfg.ConfigurationFile(),
 		labels:        labels,
 		bV:            hv,
+		binErr:        binErr,
+		dockerCfg:     cfg,
 		enableDebug:   cfg.Mode() == config.RunModes.Debug,
 		isMultiConfig: cfg.IsMultiConfig(),
 	}, nil
@@ -122,6 +141,9 @@ func NewDeployer(cfg Config, labels map[string]string, h *latest_v1.HelmDeploy)
 
 // Deploy deploys the build results to the Kubernetes cluster
 func (h *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Artifact) ([]string, error) {
+	if h.binErr != nil {
+		return nil, versionGetErr(h.binErr)
+	}
 	logrus.Infof("Deploying with helm v%s ...", h.bV)
 
 	var dRes []types.Artifact
@@ -229,6 +251,9 @@ func (h *Deployer) Dependencies() ([]string, error) {
 
 // Cleanup deletes what was deployed by calling Deploy.
 func (h *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
+	if h.binErr != nil {
+		return versionGetErr(h.binErr)
+	}
 	for _, r := range h.Releases {
 		releaseName, err := util.ExpandEnvTemplateOrFail(r.Name, nil)
 		if err != nil {
@@ -251,14 +276,13 @@ func (h *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 	return nil
 }
 
-// Render generates the Kubernetes manifests and writes them out
+// Render generates the Kubernetes manifests and writes them out.
+// Without the helm binary, the charts are rendered in-process.
 func (h *Deployer) Render(ctx context.Context, out io.Writer, builds []graph.Artifact, offline bool, filepath string) error {
 	renderedManifests := new(bytes.Buffer)
 
 	for _, r := range h.Releases {
-		args := []string{"template", chartSource(r)}
-
-		args = append(args[:1], append([]string{r.Name}, args[1:]...)...)
+		var valuesArgs []string
 
 		params, err := pairParamsToArtifacts(builds, r.ArtifactOverrides)
 		if err != nil {
@@ -275,10 +299,10 @@ func (h *Deployer) Render(ctx context.Context, out io.Writer, builds []graph.Art
 				return err
 			}
 
-			args = append(args, "--set-string", value)
+			valuesArgs = append(valuesArgs, "--set-string", value)
 		}
 
-		args, err = constructOverrideArgs(&r, builds, args, func(string) {})
+		valuesArgs, err = constructOverrideArgs(&r, builds, valuesArgs, func(string) {})
 		if err != nil {
 			return userErr("construct override args", err)
 		}
@@ -287,6 +311,26 @@ func (h *Deployer) Render(ctx context.Context, out io.Writer, builds []graph.Art
 		if err != nil {
 			return err
 		}
+
+		if h.binErr != nil {
+			rendered, err := h.renderInProcess(r, valuesArgs, namespace)
+			if err != nil {
+				return userErr(fmt.Sprintf("rendering chart %q", chartSource(r)), err)
+			}
+			renderedManifests.WriteString(rendered)
+			continue
+		}
+
+		if isOCIChart(r.RemoteChart) && h.bV.LT(helm38Version) {
+			return userErr("render", fmt.Errorf("OCI charts require at least Helm 3.8 (current: %v)", h.bV))
+		}
+
+		args := []string{"template", r.Name, chartSource(r)}
+		if r.RemoteChart != "" && r.Version != "" {
+			args = append(args, "--version", r.Version)
+		}
+		args = append(args, valuesArgs...)
+
 		if namespace != "" {
 			args = append(args, "--namespace", namespace)
 		}
@@ -306,6 +350,29 @@ func (h *Deployer) Render(ctx context.Context, out io.Writer, builds []graph.Art
 	return manifest.Write(renderedManifests.String(), filepath, out)
 }
 
+// renderInProcess renders a chart with the Helm SDK when the helm binary is missing.
+// Local charts and charts stored in OCI registries are supported.
+func (h *Deployer) renderInProcess(r latest_v1.HelmRelease, valuesArgs []string, namespace string) (string, error) {
+	var c *chart.Chart
+	var err error
+	switch {
+	case isOCIChart(r.RemoteChart):
+		c, err = pullChart(r.RemoteChart, r.Version, h.dockerCfg)
+	case r.RemoteChart != "":
+		err = fmt.Errorf("charts from chart repositories can only be rendered with the helm binary: %w", h.binErr)
+	default:
+		c, err = loadChart(r.ChartPath)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if namespace == "" {
+		namespace = "default"
+	}
+	return renderChart(c, valuesArgs, r.Name, namespace)
+}
+
 // deployRelease deploys a single release
 func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, releaseName string, r latest_v1.HelmRelease, builds []graph.Artifact, valuesSet map[string]bool, helmVersion semver.Version) ([]types.Artifact, error) {
 	var err error
@@ -319,6 +386,10 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, releaseName
 		repo:        r.Repo,
 	}
 
+	if isOCIChart(r.RemoteChart) && h.bV.LT(helm38Version) {
+		return nil, fmt.Errorf("OCI charts require at least Helm 3.8 (current: %v)", h.bV)
+	}
+
 	var installEnv []string
 	if h.enableDebug {
 		if h.bV.LT(helm31Version) {
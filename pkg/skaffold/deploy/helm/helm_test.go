@@ -104,6 +104,17 @@ var testDeployConfigRemoteRepo = latest_v1.HelmDeploy{
 	}},
 }
 
+var testDeployConfigOCIChart = latest_v1.HelmDeploy{
+	Releases: []latest_v1.HelmRelease{{
+		Name:        "skaffold-helm",
+		RemoteChart: "oci://registry.example.com/charts/skaffold-helm",
+		Version:     "1.2.3",
+		ArtifactOverrides: map[string]string{
+			"image": "skaffold-helm",
+		},
+	}},
+}
+
 var testDeployConfigTemplated = latest_v1.HelmDeploy{
 	Releases: []latest_v1.HelmRelease{{
 		Name:      "skaffold-helm",
@@ -415,6 +426,7 @@ var (
 	version30   = `version.BuildInfo{Version:"v3.0.0", GitCommit:"e29ce2a54e96cd02ccfce88bee4f58bb6e2a28b6", GitTreeState:"clean", GoVersion:"go1.13.4"}`
 	version31   = `version.BuildInfo{Version:"v3.1.1", GitCommit:"afe70585407b420d0097d07b21c47dc511525ac8", GitTreeState:"clean", GoVersion:"go1.13.8"}`
 	version32   = `version.BuildInfo{Version:"v3.2.0", GitCommit:"e11b7ce3b12db2941e90399e874513fbd24bcb71", GitTreeState:"clean", GoVersion:"go1.14"}`
+	version38   = `version.BuildInfo{Version:"v3.8.0", GitCommit:"d14138609b01886f544b2025f5000351c9eb092e", GitTreeState:"clean", GoVersion:"go1.17.5"}`
 )
 
 func TestBinVer(t *testing.T) {
@@ -455,6 +467,7 @@ func TestNewDeployer(t *testing.T) {
 		{"Helm 3.0", version30, false},
 		{"Helm 3.1.1", version31, false},
 		{"helm3 unparseable version", "gobbledygook", true},
+		{"Helm 3.8", version38, false},
 	}
 
 	for _, test := range tests {
@@ -1360,6 +1373,29 @@ func TestHelmRender(t *testing.T) {
 					Tag:       "skaffold-helm:tag1",
 				}},
 		},
+		{
+			description: "render with OCI chart",
+			shouldErr:   false,
+			commands: testutil.CmdRunWithOutput("helm version --client", version38).
+				AndRun("helm --kube-context kubecontext template skaffold-helm oci://registry.example.com/charts/skaffold-helm --version 1.2.3 --set-string image=skaffold-helm:tag1 --kubeconfig kubeconfig"),
+			helm: testDeployConfigOCIChart,
+			builds: []graph.Artifact{
+				{
+					ImageName: "skaffold-helm",
+					Tag:       "skaffold-helm:tag1",
+				}},
+		},
+		{
+			description: "render with OCI chart requires helm 3.8",
+			shouldErr:   true,
+			commands:    testutil.CmdRunWithOutput("helm version --client", version32),
+			helm:        testDeployConfigOCIChart,
+			builds: []graph.Artifact{
+				{
+					ImageName: "skaffold-helm",
+					Tag:       "skaffold-helm:tag1",
+				}},
+		},
 		{
 			description: "render with cli namespace",
 			shouldErr:   false,
@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/cli/values"
+	"helm.sh/helm/v3/pkg/getter"
+)
+
+// renderChart renders a chart with the Helm SDK, like `helm template` does.
+func renderChart(c *chart.Chart, valuesArgs []string, releaseName, namespace string) (string, error) {
+	if deps := c.Metadata.Dependencies; deps != nil {
+		if err := action.CheckDependencies(c, deps); err != nil {
+			return "", err
+		}
+	}
+
+	vals, err := valueOptions(valuesArgs).MergeValues(getter.All(cli.New()))
+	if err != nil {
+		return "", err
+	}
+
+	install := action.NewInstall(&action.Configuration{Log: logrus.Debugf})
+	install.DryRun = true
+	install.ClientOnly = true
+	install.Replace = true
+	install.ReleaseName = releaseName
+	install.Namespace = namespace
+
+	rel, err := install.Run(c, vals)
+	if err != nil {
+		return "", err
+	}
+
+	var manifests strings.Builder
+	fmt.Fprintln(&manifests, strings.TrimSpace(rel.Manifest))
+	for _, hook := range rel.Hooks {
+		fmt.Fprintf(&manifests, "---\n# Source: %s\n%s\n", hook.Path, hook.Manifest)
+	}
+	return manifests.String(), nil
+}
+
+// valueOptions collects the `-f`, `--set`, `--set-string` and `--set-file` flags
+// that would be passed to `helm template`.
+func valueOptions(args []string) *values.Options {
+	opts := &values.Options{}
+	for i := 0; i < len(args)-1; i++ {
+		switch args[i] {
+		case "-f", "--values":
+			opts.ValueFiles = append(opts.ValueFiles, args[i+1])
+		case "--set":
+			opts.Values = append(opts.Values, args[i+1])
+		case "--set-string":
+			opts.StringValues = append(opts.StringValues, args[i+1])
+		case "--set-file":
+			opts.FileValues = append(opts.FileValues, args[i+1])
+		default:
+			continue
+		}
+		i++
+	}
+	return opts
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"helm.sh/helm/v3/pkg/cli/values"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const renderedChart = `---
+# Source: skaffold-helm/charts/redis/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: skaffold-helm-redis
+data:
+  env: dev
+  port: "6379"
+---
+# Source: skaffold-helm/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: skaffold-helm-skaffold-helm
+spec:
+  ports:
+    - port: 80
+  selector:
+    app: skaffold-helm-skaffold-helm
+---
+# Source: skaffold-helm/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: skaffold-helm-skaffold-helm
+  namespace: %s
+  labels:
+    app: skaffold-helm-skaffold-helm
+    chart: skaffold-helm-0.1.0
+spec:
+  replicas: %d
+  selector:
+    matchLabels:
+      app: skaffold-helm-skaffold-helm
+  template:
+    metadata:
+      labels:
+        app: skaffold-helm-skaffold-helm
+    spec:
+      containers:
+        - name: skaffold-helm
+          image: "skaffold-helm:tag1"
+
+`
+
+func helmNotFound() util.Command {
+	return testutil.CmdRunOutErr("helm version --client", "", fmt.Errorf("starting command helm version --client: %w", exec.ErrNotFound))
+}
+
+func TestRenderInProcess(t *testing.T) {
+	builds := []graph.Artifact{{ImageName: "skaffold-helm", Tag: "skaffold-helm:tag1"}}
+
+	tests := []struct {
+		description string
+		release     latest_v1.HelmRelease
+		namespace   string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "local chart",
+			release: latest_v1.HelmRelease{
+				Name:              "skaffold-helm",
+				ChartPath:         "testdata/skaffold-helm",
+				ArtifactOverrides: map[string]string{"image": "skaffold-helm"},
+			},
+			expected: fmt.Sprintf(renderedChart, "default", 1),
+		},
+		{
+			description: "set values and namespace",
+			release: latest_v1.HelmRelease{
+				Name:              "skaffold-helm",
+				ChartPath:         "testdata/skaffold-helm",
+				ArtifactOverrides: map[string]string{"image": "skaffold-helm"},
+				SetValues:         map[string]string{"replicaCount": "3"},
+				Namespace:         "ns",
+			},
+			namespace: "ns",
+			expected:  fmt.Sprintf(renderedChart, "ns", 3),
+		},
+		{
+			description: "disabled subchart",
+			release: latest_v1.HelmRelease{
+				Name:              "skaffold-helm",
+				ChartPath:         "testdata/skaffold-helm",
+				ArtifactOverrides: map[string]string{"image": "skaffold-helm"},
+				SetValues:         map[string]string{"redis.enabled": "false"},
+			},
+			expected: fmt.Sprintf(renderedChart[bytes.Index([]byte(renderedChart), []byte("---\n# Source: skaffold-helm/templates/service.yaml")):], "default", 1),
+		},
+		{
+			description: "missing required value",
+			release: latest_v1.HelmRelease{
+				Name:      "skaffold-helm",
+				ChartPath: "testdata/skaffold-helm",
+				SetValues: map[string]string{"image": "null"},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "chart from a chart repository",
+			release: latest_v1.HelmRelease{
+				Name:        "skaffold-helm",
+				RemoteChart: "stable/redis",
+				Repo:        "https://charts.helm.sh/stable",
+			},
+			shouldErr: true,
+		},
+		{
+			description: "missing chart",
+			release: latest_v1.HelmRelease{
+				Name:      "skaffold-helm",
+				ChartPath: "testdata/missing",
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, helmNotFound())
+
+			deployer, err := NewDeployer(&helmConfig{}, nil, &latest_v1.HelmDeploy{Releases: []latest_v1.HelmRelease{test.release}})
+			t.RequireNoError(err)
+
+			var out bytes.Buffer
+			err = deployer.Render(context.Background(), &out, builds, true, "")
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, out.String())
+		})
+	}
+}
+
+func TestDeployWithoutHelm(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, helmNotFound())
+
+		deployer, err := NewDeployer(&helmConfig{}, nil, &testDeployConfig)
+		t.RequireNoError(err)
+
+		_, err = deployer.Deploy(context.Background(), ioutil.Discard, nil)
+		t.CheckError(true, err)
+		t.CheckError(true, deployer.Cleanup(context.Background(), ioutil.Discard))
+	})
+}
+
+func TestRenderOCIChartInProcess(t *testing.T) {
+	tests := []struct {
+		description string
+		mediaType   types.MediaType
+		version     string
+		expectedRef string
+		shouldErr   bool
+	}{
+		{
+			description: "chart pushed with helm push",
+			mediaType:   chartLayerMediaType,
+			version:     "0.1.0",
+			expectedRef: "registry.example.com/charts/skaffold-helm:0.1.0",
+		},
+		{
+			description: "build metadata in the version",
+			mediaType:   legacyChartLayerMediaType,
+			version:     "0.1.0+build",
+			expectedRef: "registry.example.com/charts/skaffold-helm:0.1.0_build",
+		},
+		{
+			description: "not a chart",
+			mediaType:   "application/vnd.oci.image.layer.v1.tar+gzip",
+			version:     "0.1.0",
+			expectedRef: "registry.example.com/charts/skaffold-helm:0.1.0",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			archive := packageChart(t, "testdata/skaffold-helm")
+			layer, err := tarball.LayerFromReader(bytes.NewReader(archive))
+			t.RequireNoError(err)
+			img, err := mutate.Append(empty.Image, mutate.Addendum{Layer: layer, MediaType: test.mediaType})
+			t.RequireNoError(err)
+
+			var pulled string
+			t.Override(&remoteImage, func(ref string, _ docker.Config) (v1.Image, error) {
+				pulled = ref
+				return img, nil
+			})
+			t.Override(&util.DefaultExecCommand, helmNotFound())
+
+			deployer, err := NewDeployer(&helmConfig{}, nil, &latest_v1.HelmDeploy{Releases: []latest_v1.HelmRelease{{
+				Name:              "skaffold-helm",
+				RemoteChart:       "oci://registry.example.com/charts/skaffold-helm",
+				Version:           test.version,
+				ArtifactOverrides: map[string]string{"image": "skaffold-helm"},
+			}}})
+			t.RequireNoError(err)
+
+			var out bytes.Buffer
+			err = deployer.Render(context.Background(), &out, []graph.Artifact{{ImageName: "skaffold-helm", Tag: "skaffold-helm:tag1"}}, true, "")
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedRef, pulled)
+			if !test.shouldErr {
+				t.CheckDeepEqual(fmt.Sprintf(renderedChart, "default", 1), out.String())
+			}
+		})
+	}
+}
+
+func TestValueOptions(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		opts := valueOptions([]string{
+			"--set-string", "image.tag=tag1",
+			"-f", "values.yaml",
+			"--set", "replicaCount=3",
+			"--set-file", "config=config.yaml",
+			"--values", "overrides.yaml",
+			"--recreate-pods",
+		})
+
+		t.CheckDeepEqual(&values.Options{
+			ValueFiles:   []string{"values.yaml", "overrides.yaml"},
+			Values:       []string{"replicaCount=3"},
+			StringValues: []string{"image.tag=tag1"},
+			FileValues:   []string{"config=config.yaml"},
+		}, opts)
+	})
+}
+
+// packageChart creates a chart archive, like `helm package` does.
+func packageChart(t *testutil.T, dir string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := tw.WriteHeader(&tar.Header{Name: filepath.ToSlash(filepath.Join(filepath.Base(dir), rel)), Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			return err
+		}
+		_, err = tw.Write(content)
+		return err
+	})
+	t.RequireNoError(err)
+	t.RequireNoError(tw.Close())
+	t.RequireNoError(gz.Close())
+	return buf.Bytes()
+}
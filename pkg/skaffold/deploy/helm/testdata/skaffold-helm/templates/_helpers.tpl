@@ -0,0 +1,8 @@
+{{- define "skaffold-helm.fullname" -}}
+{{- printf "%s-%s" .Release.Name .Chart.Name | trunc 63 | trimSuffix "-" -}}
+{{- end -}}
+
+{{- define "skaffold-helm.labels" -}}
+app: {{ include "skaffold-helm.fullname" . }}
+chart: {{ .Chart.Name }}-{{ .Chart.Version }}
+{{- end -}}
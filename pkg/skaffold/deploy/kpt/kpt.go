@@ -30,7 +30,7 @@ import (
 
 	"golang.org/x/mod/semver"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"sigs.k8s.io/kustomize/kyaml/fn/framework"
+	"sigs.k8s.io/kustomize/kyaml/kio"
 	k8syaml "sigs.k8s.io/yaml"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
@@ -318,19 +318,20 @@ func (k *Deployer) renderManifests(ctx context.Context, _ io.Writer, builds []gr
 		// Note: Here we use kpt ResourceList as the media to store the config source.
 		// Eventually, skaffold should not need to construct a kpt inner resource but only use kpt commands and
 		// the new Kptfile(v1) to establish a hydration pipeline.
-		input := bytes.NewBufferString(string(buf))
-		rl := framework.ResourceList{
-			Reader: input,
+		rw := &kio.ByteReadWriter{
+			Reader:                bytes.NewBufferString(string(buf)),
+			KeepReaderAnnotations: true,
 		}
 		// Manipulate the kustomize "Rnode"(Kustomize term) and pulls out the "Items"
 		// from ResourceLists.
-		if err := rl.Read(); err != nil {
+		items, err := rw.Read()
+		if err != nil {
 			return nil, fmt.Errorf("reading ResourceList %w", err)
 		}
 
 		var newBuf []byte
-		for i := range rl.Items {
-			item, err := rl.Items[i].String()
+		for i := range items {
+			item, err := items[i].String()
 			if err != nil {
 				return nil, fmt.Errorf("reading Item %w", err)
 			}
@@ -388,18 +389,19 @@ func (k *Deployer) renderManifests(ctx context.Context, _ io.Writer, builds []gr
 }
 
 func (k *Deployer) getKptFunc(buf []byte) ([]byte, error) {
-	input := bytes.NewBufferString(string(buf))
-	rl := framework.ResourceList{
-		Reader: input,
+	rw := &kio.ByteReadWriter{
+		Reader:                bytes.NewBufferString(string(buf)),
+		KeepReaderAnnotations: true,
 	}
 	// Manipulate the kustomize "Rnode"(Kustomize term) and pulls out the "Items"
 	// from ResourceLists.
-	if err := rl.Read(); err != nil {
+	items, err := rw.Read()
+	if err != nil {
 		return nil, fmt.Errorf("reading ResourceList %w", err)
 	}
 	var kptFn []byte
-	for i := range rl.Items {
-		item, err := rl.Items[i].String()
+	for i := range items {
+		item, err := items[i].String()
 		if err != nil {
 			return nil, fmt.Errorf("reading Item %w", err)
 		}
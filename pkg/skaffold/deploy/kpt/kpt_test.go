@@ -312,9 +312,11 @@ func TestKpt_Dependencies(t *testing.T) {
 			},
 			createFiles: map[string]string{
 				"./kpt-fn/func.yaml": "",
+				"app1.properties":    "key=value",
 			},
 			kustomizations: map[string]string{"kustomization.yaml": `configMapGenerator:
-   - files: [app1.properties]`},
+   - name: app
+     files: [app1.properties]`},
 			expected: []string{"app1.properties", "kpt-fn/func.yaml", "kustomization.yaml"},
 		},
 		{
@@ -322,8 +324,12 @@ func TestKpt_Dependencies(t *testing.T) {
 			kpt: latest_v1.KptDeploy{
 				Dir: ".",
 			},
+			createFiles: map[string]string{
+				"app1.properties": "key=value",
+			},
 			kustomizations: map[string]string{"kustomization.yaml": `configMapGenerator:
-   - files: [app1.properties]`},
+   - name: app
+     files: [app1.properties]`},
 			expected: []string{"app1.properties", "kustomization.yaml"},
 		},
 		{
@@ -331,8 +337,12 @@ func TestKpt_Dependencies(t *testing.T) {
 			kpt: latest_v1.KptDeploy{
 				Dir: ".",
 			},
+			createFiles: map[string]string{
+				"app1.properties": "key=value",
+			},
 			kustomizations: map[string]string{"kustomization.yml": `configMapGenerator:
-   - files: [app1.properties]`},
+   - name: app
+     files: [app1.properties]`},
 			expected: []string{"app1.properties", "kustomization.yml"},
 		},
 		{
@@ -340,8 +350,12 @@ func TestKpt_Dependencies(t *testing.T) {
 			kpt: latest_v1.KptDeploy{
 				Dir: ".",
 			},
+			createFiles: map[string]string{
+				"app1.properties": "key=value",
+			},
 			kustomizations: map[string]string{"Kustomization": `configMapGenerator:
-   - files: [app1.properties]`},
+   - name: app
+     files: [app1.properties]`},
 			expected: []string{"Kustomization", "app1.properties"},
 		},
 		{
@@ -38,6 +38,8 @@ import (
 type CLI struct {
 	*kubectl.CLI
 	Flags latest_v1.KubectlFlags
+	// ServerSide enables server-side apply when set.
+	ServerSide *latest_v1.KubectlServerSideApply
 
 	forceDeploy      bool
 	waitForDeletions config.WaitForDeletions
@@ -72,10 +74,19 @@ func (c *CLI) Delete(ctx context.Context, out io.Writer, manifests manifest.Mani
 	return nil
 }
 
+// DeleteLabelled runs `kubectl delete` on a single resource, only if it matches the given label selector.
+func (c *CLI) DeleteLabelled(ctx context.Context, out io.Writer, namespace, resource, name, selector string) error {
+	args := c.args(c.Flags.Delete, resource, "--selector", selector, "--field-selector", "metadata.name="+name, "--ignore-not-found=true")
+	if err := c.RunInNamespace(ctx, nil, out, "delete", namespace, args...); err != nil {
+		return userErr(fmt.Errorf("kubectl delete: %w", err))
+	}
+
+	return nil
+}
+
 // Apply runs `kubectl apply` on a list of manifests.
 func (c *CLI) Apply(ctx context.Context, out io.Writer, manifests manifest.ManifestList) error {
 	// Only redeploy modified or new manifests
-	// TODO(dgageot): should we delete a manifest that was deployed and is not anymore?
 	updated := c.previousApply.Diff(manifests)
 	logrus.Debugln(len(manifests), "manifests to deploy.", len(updated), "are updated or new")
 	c.previousApply = manifests
@@ -83,6 +94,10 @@ func (c *CLI) Apply(ctx context.Context, out io.Writer, manifests manifest.Manif
 		return nil
 	}
 
+	if c.ServerSide != nil {
+		return c.applyServerSide(ctx, out, updated)
+	}
+
 	args := []string{"-f", "-"}
 	if c.forceDeploy {
 		args = append(args, "--force", "--grace-period=0")
@@ -99,9 +114,14 @@ func (c *CLI) Apply(ctx context.Context, out io.Writer, manifests manifest.Manif
 	return nil
 }
 
-// Kustomize runs `kubectl kustomize` with the provided args
-func (c *CLI) Kustomize(ctx context.Context, args []string) ([]byte, error) {
-	return c.RunOut(ctx, "kustomize", c.args(nil, args...)...)
+// AppliedManifests returns the manifests applied by the last call to Apply.
+func (c *CLI) AppliedManifests() manifest.ManifestList {
+	return c.previousApply
+}
+
+// ForgetAppliedManifests makes the next call to Apply apply all the manifests, even the unchanged ones.
+func (c *CLI) ForgetAppliedManifests() {
+	c.previousApply = nil
 }
 
 type getResult struct {
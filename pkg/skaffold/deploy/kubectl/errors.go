@@ -18,6 +18,7 @@ package kubectl
 
 import (
 	"fmt"
+	"strings"
 
 	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
@@ -92,3 +93,31 @@ func listManifestErr(err error) error {
 func userErr(err error) error {
 	return deployerr.UserError(err, proto.StatusCode_DEPLOY_KUBECTL_USER_ERR)
 }
+
+func applyConflictErr(resource string, err error) error {
+	managers, fields := fieldConflicts(err)
+
+	suggestions := []*proto.Suggestion{
+		{
+			SuggestionCode: proto.SuggestionCode_FIX_SKAFFOLD_CONFIG_KUBECTL_FORCE_CONFLICTS,
+			Action:         "Set `deploy.kubectl.serverSide.forceConflicts` to true to take ownership of the conflicting fields",
+		},
+	}
+	if len(fields) > 0 {
+		action := fmt.Sprintf("Remove %s from the manifest of %s", strings.Join(fields, ", "), resource)
+		if len(managers) > 0 {
+			action += fmt.Sprintf(" to leave them managed by %s", strings.Join(managers, ", "))
+		}
+		suggestions = append(suggestions, &proto.Suggestion{
+			SuggestionCode: proto.SuggestionCode_REMOVE_CONFLICTING_FIELDS,
+			Action:         action,
+		})
+	}
+
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message:     fmt.Sprintf("server-side apply of %s conflicts with other field managers: %s", resource, err),
+			ErrCode:     proto.StatusCode_DEPLOY_SERVER_SIDE_APPLY_CONFLICT_ERR,
+			Suggestions: suggestions,
+		})
+}
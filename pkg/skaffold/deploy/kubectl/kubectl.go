@@ -54,6 +54,7 @@ type Deployer struct {
 	insecureRegistries map[string]bool
 	labels             map[string]string
 	skipRender         bool
+	appliedResources   []appliedResource
 }
 
 // NewDeployer returns a new Deployer for a DeployConfig filled
@@ -68,12 +69,15 @@ func NewDeployer(cfg Config, labels map[string]string, d *latest_v1.KubectlDeplo
 		}
 	}
 
+	kubectl := NewCLI(cfg, d.Flags, defaultNamespace)
+	kubectl.ServerSide = d.ServerSide
+
 	return &Deployer{
 		KubectlDeploy:      d,
 		workingDir:         cfg.GetWorkingDir(),
 		globalConfig:       cfg.GlobalConfig(),
 		defaultRepo:        cfg.DefaultRepo(),
-		kubectl:            NewCLI(cfg, d.Flags, defaultNamespace),
+		kubectl:            kubectl,
 		insecureRegistries: cfg.GetInsecureRegistries(),
 		skipRender:         cfg.SkipRender(),
 		labels:             labels,
@@ -125,6 +129,10 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Art
 		return nil, err
 	}
 
+	if err := k.prune(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
+		return nil, err
+	}
+
 	return namespaces, nil
 }
 
@@ -349,6 +357,16 @@ func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 	return nil
 }
 
+// AppliedManifests returns the manifests applied by the last deployment.
+func (k *Deployer) AppliedManifests() manifest.ManifestList {
+	return k.kubectl.AppliedManifests()
+}
+
+// ForgetAppliedManifests makes the next deployment apply all the manifests, even the unchanged ones.
+func (k *Deployer) ForgetAppliedManifests() {
+	k.kubectl.ForgetAppliedManifests()
+}
+
 // Dependencies lists all the files that describe what needs to be deployed.
 func (k *Deployer) Dependencies() ([]string, error) {
 	return k.manifestFiles(k.KubectlDeploy.Manifests)
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// PruneAnnotation is the annotation that prevents a resource from being pruned when its value is `false`.
+const PruneAnnotation = "skaffold.dev/prune"
+
+// appliedResource identifies a resource applied by the deployer.
+type appliedResource struct {
+	group     string
+	version   string
+	kind      string
+	namespace string
+	name      string
+	// keep is true for the resources that opted out of pruning.
+	keep bool
+}
+
+// key identifies a resource regardless of its API version.
+func (r appliedResource) key() string {
+	return strings.Join([]string{r.group, r.kind, r.namespace, r.name}, "/")
+}
+
+// resource is the fully qualified resource type passed to kubectl, such as `deployment.v1.apps`.
+func (r appliedResource) resource() string {
+	resource := strings.ToLower(r.kind)
+	if r.group != "" {
+		resource += "." + r.version + "." + r.group
+	}
+	return resource
+}
+
+func (r appliedResource) String() string {
+	if r.namespace == "" {
+		return fmt.Sprintf("%s/%s", strings.ToLower(r.kind), r.name)
+	}
+	return fmt.Sprintf("%s/%s in namespace %s", strings.ToLower(r.kind), r.name, r.namespace)
+}
+
+// appliedResources lists the resources of a list of manifests. Resources without namespace
+// are in the default namespace.
+func appliedResources(manifests manifest.ManifestList, defaultNamespace string) ([]appliedResource, error) {
+	var resources []appliedResource
+	for _, m := range manifests {
+		var obj struct {
+			APIVersion string `yaml:"apiVersion"`
+			Kind       string `yaml:"kind"`
+			Metadata   struct {
+				Name        string            `yaml:"name"`
+				Namespace   string            `yaml:"namespace"`
+				Annotations map[string]string `yaml:"annotations"`
+			} `yaml:"metadata"`
+		}
+		if err := yaml.Unmarshal(m, &obj); err != nil {
+			return nil, fmt.Errorf("reading applied resource: %w", err)
+		}
+		if obj.Kind == "" || obj.Metadata.Name == "" {
+			continue
+		}
+
+		group, version := "", obj.APIVersion
+		if i := strings.LastIndex(obj.APIVersion, "/"); i >= 0 {
+			group, version = obj.APIVersion[:i], obj.APIVersion[i+1:]
+		}
+		namespace := obj.Metadata.Namespace
+		if namespace == "" {
+			namespace = defaultNamespace
+		}
+
+		resources = append(resources, appliedResource{
+			group:     group,
+			version:   version,
+			kind:      obj.Kind,
+			namespace: namespace,
+			name:      obj.Metadata.Name,
+			keep:      strings.EqualFold(obj.Metadata.Annotations[PruneAnnotation], "false"),
+		})
+	}
+	return resources, nil
+}
+
+// prune deletes the resources applied by the previous deployment that are not in the manifests anymore.
+// Only the resources that are still labelled with the current run ID are deleted. On dry runs, the
+// resources that would be deleted are kept in the applied resources.
+func (k *Deployer) prune(ctx context.Context, out io.Writer, manifests manifest.ManifestList) error {
+	current, err := appliedResources(manifests, k.kubectl.Namespace)
+	if err != nil {
+		return err
+	}
+	previous := k.appliedResources
+	k.appliedResources = current
+
+	if k.Prune != nil && k.Prune.Disabled {
+		return nil
+	}
+	runID := k.labels[label.RunIDLabel]
+	if runID == "" {
+		logrus.Debugln("Not pruning resources: they are not labelled with a run ID")
+		return nil
+	}
+
+	applied := map[string]bool{}
+	for _, r := range current {
+		applied[r.key()] = true
+	}
+
+	for _, r := range previous {
+		if applied[r.key()] || r.keep {
+			continue
+		}
+
+		if k.Prune != nil && k.Prune.DryRun {
+			color.Default.Fprintf(out, "%s would be pruned (dry run)\n", r)
+			// The resource is still deployed, so it's still reported by the next deployments.
+			k.appliedResources = append(k.appliedResources, r)
+			continue
+		}
+
+		logrus.Infof("Pruning %s", r)
+		if err := k.kubectl.DeleteLabelled(ctx, out, r.namespace, r.resource(), r.name, fmt.Sprintf("%s=%s", label.RunIDLabel, runID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
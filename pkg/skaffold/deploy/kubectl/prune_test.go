@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const configMapYAML = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  key: value`
+
+const keptConfigMapYAML = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  annotations:
+    skaffold.dev/prune: "false"
+data:
+  key: value`
+
+func TestKubectlPrune(t *testing.T) {
+	tests := []struct {
+		description    string
+		firstManifest  string
+		prune          *latest_v1.KubectlPrune
+		labels         map[string]string
+		delete         string
+		expectedOutput string
+	}{
+		{
+			description:   "prune removed resource",
+			firstManifest: configMapYAML,
+			labels:        map[string]string{"skaffold.dev/run-id": "run"},
+			delete:        "kubectl --context kubecontext delete configmap --selector skaffold.dev/run-id=run --field-selector metadata.name=config --ignore-not-found=true",
+		},
+		{
+			description:    "dry run",
+			firstManifest:  configMapYAML,
+			prune:          &latest_v1.KubectlPrune{DryRun: true},
+			labels:         map[string]string{"skaffold.dev/run-id": "run"},
+			expectedOutput: " - configmap/config would be pruned (dry run)\n",
+		},
+		{
+			description:   "disabled",
+			firstManifest: configMapYAML,
+			prune:         &latest_v1.KubectlPrune{Disabled: true},
+			labels:        map[string]string{"skaffold.dev/run-id": "run"},
+		},
+		{
+			description:   "annotated resource is kept",
+			firstManifest: keptConfigMapYAML,
+			labels:        map[string]string{"skaffold.dev/run-id": "run"},
+		},
+		{
+			description:   "no run id",
+			firstManifest: configMapYAML,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().
+				Write("deployment-web.yaml", DeploymentWebYAML).
+				Write("configmap.yaml", test.firstManifest)
+
+			commands := testutil.
+				CmdRunOut("kubectl version --client -ojson", KubectlVersion112).
+				AndRunOut("kubectl --context kubecontext create --dry-run -oyaml -f "+tmpDir.Path("configmap.yaml")+" -f "+tmpDir.Path("deployment-web.yaml"), test.firstManifest+"\n---\n"+DeploymentWebYAML).
+				AndRun("kubectl --context kubecontext apply -f -").
+				AndRunOut("kubectl --context kubecontext create --dry-run -oyaml -f "+tmpDir.Path("deployment-web.yaml"), DeploymentWebYAML)
+			if test.delete != "" {
+				commands = commands.AndRun(test.delete)
+			}
+			commands = commands.AndRunOut("kubectl --context kubecontext create --dry-run -oyaml -f "+tmpDir.Path("deployment-web.yaml"), DeploymentWebYAML)
+			t.Override(&util.DefaultExecCommand, commands)
+
+			deployer, err := NewDeployer(&kubectlConfig{workingDir: "."}, test.labels, &latest_v1.KubectlDeploy{
+				Manifests: []string{tmpDir.Path("configmap.yaml"), tmpDir.Path("deployment-web.yaml")},
+				Prune:     test.prune,
+			})
+			t.RequireNoError(err)
+			builds := []graph.Artifact{{ImageName: "leeroy-web", Tag: "leeroy-web:v1"}}
+
+			_, err = deployer.Deploy(context.Background(), &bytes.Buffer{}, builds)
+			t.CheckNoError(err)
+
+			// The ConfigMap is removed from the manifests
+			deployer.Manifests = []string{tmpDir.Path("deployment-web.yaml")}
+			var out bytes.Buffer
+			_, err = deployer.Deploy(context.Background(), &out, builds)
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+
+			// A resource that is not pruned by a dry run is reported again
+			out.Reset()
+			_, err = deployer.Deploy(context.Background(), &out, builds)
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+		})
+	}
+}
+
+func TestAppliedResources(t *testing.T) {
+	manifests := manifest.ManifestList{
+		[]byte(DeploymentWebYAML),
+		[]byte(keptConfigMapYAML),
+		[]byte("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: app\n  namespace: other"),
+		[]byte("apiVersion: rbac.authorization.k8s.io/v1\nkind: ClusterRole\nmetadata:\n  name: role"),
+		[]byte("# only a comment"),
+	}
+
+	resources, err := appliedResources(manifests, "default")
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, []string{
+		"pod/leeroy-web in namespace default",
+		"configmap/config in namespace default",
+		"deployment/app in namespace other",
+		"clusterrole/role in namespace default",
+	}, describeResources(resources))
+	testutil.CheckDeepEqual(t, []string{"pod", "configmap", "deployment.v1.apps", "clusterrole.v1.rbac.authorization.k8s.io"}, resourceTypes(resources))
+	testutil.CheckDeepEqual(t, []bool{false, true, false, false}, []bool{resources[0].keep, resources[1].keep, resources[2].keep, resources[3].keep})
+}
+
+func describeResources(resources []appliedResource) []string {
+	var descriptions []string
+	for _, r := range resources {
+		descriptions = append(descriptions, r.String())
+	}
+	return descriptions
+}
+
+func resourceTypes(resources []appliedResource) []string {
+	var types []string
+	for _, r := range resources {
+		types = append(types, r.resource())
+	}
+	return types
+}
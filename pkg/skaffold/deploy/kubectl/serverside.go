@@ -0,0 +1,204 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/restmapper"
+	"sigs.k8s.io/yaml"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+)
+
+// DefaultFieldManager is the field manager used for server-side apply when none is configured.
+const DefaultFieldManager = "skaffold"
+
+// crdEstablishedTimeout is how long to wait for the kinds defined by the CRDs applied in the same deployment.
+var crdEstablishedTimeout = 10 * time.Second
+
+// applyServerSide applies a list of manifests with server-side apply, through the dynamic client.
+func (c *CLI) applyServerSide(ctx context.Context, out io.Writer, manifests manifest.ManifestList) error {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return userErr(fmt.Errorf("getting Kubernetes client: %w", err))
+	}
+	dynClient, err := kubernetesclient.DynamicClient()
+	if err != nil {
+		return userErr(fmt.Errorf("getting Kubernetes dynamic client: %w", err))
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(client.Discovery()))
+	options := c.patchOptions()
+	appliedCRD := false
+
+	for _, m := range manifests {
+		data, err := yaml.YAMLToJSON(m)
+		if err != nil {
+			return readManifestErr(fmt.Errorf("reading manifest: %w", err))
+		}
+		var obj unstructured.Unstructured
+		if err := obj.UnmarshalJSON(data); err != nil {
+			return readManifestErr(fmt.Errorf("reading manifest: %w", err))
+		}
+
+		gvk := obj.GroupVersionKind()
+		mapping, err := restMapping(mapper, gvk, appliedCRD)
+		if err != nil {
+			return userErr(fmt.Errorf("finding resource for %s: %w", gvk, err))
+		}
+		resource := strings.ToLower(gvk.GroupKind().String()) + "/" + obj.GetName()
+
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			ns := obj.GetNamespace()
+			if ns == "" {
+				if ns, err = c.defaultNamespace(); err != nil {
+					return userErr(err)
+				}
+			}
+
+			logrus.Debugln("Applying", resource, "in namespace", ns)
+			_, err = dynClient.Resource(mapping.Resource).Namespace(ns).Patch(ctx, obj.GetName(), types.ApplyPatchType, data, options)
+		} else {
+			logrus.Debugln("Applying", resource)
+			_, err = dynClient.Resource(mapping.Resource).Patch(ctx, obj.GetName(), types.ApplyPatchType, data, options)
+		}
+		if err != nil {
+			if apierrors.IsConflict(err) {
+				return applyConflictErr(resource, err)
+			}
+			return userErr(fmt.Errorf("applying %s: %w", resource, err))
+		}
+
+		fmt.Fprintln(out, resource, "serverside-applied")
+		if gvk.Group == "apiextensions.k8s.io" && gvk.Kind == "CustomResourceDefinition" {
+			appliedCRD = true
+		}
+	}
+
+	return nil
+}
+
+// patchOptions builds the options of server-side apply from the configuration and from the
+// `kubectl apply` flags that have an equivalent. Other flags are ignored, with a warning.
+func (c *CLI) patchOptions() metav1.PatchOptions {
+	fieldManager := c.ServerSide.FieldManager
+	if fieldManager == "" {
+		fieldManager = DefaultFieldManager
+	}
+	// With `--force`, skaffold takes ownership of the conflicting fields.
+	force := c.ServerSide.ForceConflicts || c.forceDeploy
+	var dryRun []string
+
+	for _, flag := range c.Flags.Apply {
+		name, value := flag, ""
+		if i := strings.Index(flag, "="); i >= 0 {
+			name, value = flag[:i], flag[i+1:]
+		}
+
+		switch {
+		case name == "--force-conflicts" && (value == "" || value == "true"):
+			force = true
+		case name == "--field-manager" && value != "":
+			fieldManager = value
+		case name == "--dry-run" && value == "server":
+			dryRun = []string{metav1.DryRunAll}
+		default:
+			logrus.Warnf("Ignoring flag %q: it has no equivalent with server-side apply", flag)
+		}
+	}
+
+	return metav1.PatchOptions{FieldManager: fieldManager, Force: &force, DryRun: dryRun}
+}
+
+// restMapping finds the resource of a kind. The discovery information is refreshed when the kind is unknown,
+// and if a CRD was applied before, it's polled until the CRD is established.
+func restMapping(mapper *restmapper.DeferredDiscoveryRESTMapper, gvk schema.GroupVersionKind, waitForCRD bool) (*meta.RESTMapping, error) {
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if !meta.IsNoMatchError(err) {
+		return mapping, err
+	}
+
+	mapper.Reset()
+	mapping, err = mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if !waitForCRD || !meta.IsNoMatchError(err) {
+		return mapping, err
+	}
+
+	logrus.Debugln("Waiting for the resource of", gvk, "to be served")
+	if pollErr := wait.Poll(time.Second, crdEstablishedTimeout, func() (bool, error) {
+		mapper.Reset()
+		mapping, err = mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		return !meta.IsNoMatchError(err), nil
+	}); pollErr != nil {
+		// Report the missing kind rather than the timeout.
+		return nil, err
+	}
+	return mapping, err
+}
+
+// defaultNamespace is the namespace of resources that don't specify one.
+func (c *CLI) defaultNamespace() (string, error) {
+	if c.Namespace != "" {
+		return c.Namespace, nil
+	}
+	cfg, err := kubectx.CurrentConfig()
+	if err != nil {
+		return "", fmt.Errorf("getting kubeconfig: %w", err)
+	}
+	if current, present := cfg.Contexts[cfg.CurrentContext]; present && current.Namespace != "" {
+		return current.Namespace, nil
+	}
+	return "default", nil
+}
+
+// fieldConflicts lists the managers and the fields that caused a server-side apply conflict.
+func fieldConflicts(err error) (managers []string, fields []string) {
+	status, ok := err.(apierrors.APIStatus)
+	if !ok || status.Status().Details == nil {
+		return nil, nil
+	}
+
+	seen := map[string]bool{}
+	for _, cause := range status.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		fields = append(fields, cause.Field)
+
+		// The message looks like: conflict with "kube-controller-manager" using apps/v1
+		if parts := strings.SplitN(cause.Message, `"`, 3); len(parts) == 3 && !seen[parts[1]] {
+			seen[parts[1]] = true
+			managers = append(managers, parts[1])
+		}
+	}
+	return managers, fields
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	fakeclient "k8s.io/client-go/kubernetes/fake"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const deploymentYAML = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 2`
+
+const clusterRoleYAML = `apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: role`
+
+const crdYAML = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com`
+
+const widgetYAML = `apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: foo`
+
+type patch struct {
+	namespace    string
+	resource     string
+	name         string
+	fieldManager string
+	force        bool
+	dryRun       []string
+}
+
+// fakeApplyClient records the patches sent to the dynamic client.
+type fakeApplyClient struct {
+	dynamic.Interface
+	patches []patch
+	err     error
+	onPatch func(resource string)
+}
+
+type fakeApplyResource struct {
+	dynamic.NamespaceableResourceInterface
+	client    *fakeApplyClient
+	resource  string
+	namespace string
+}
+
+func (c *fakeApplyClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &fakeApplyResource{client: c, resource: gvr.Resource}
+}
+
+func (r *fakeApplyResource) Namespace(ns string) dynamic.ResourceInterface {
+	return &fakeApplyResource{client: r.client, resource: r.resource, namespace: ns}
+}
+
+func (r *fakeApplyResource) Patch(_ context.Context, name string, pt types.PatchType, _ []byte, options metav1.PatchOptions, _ ...string) (*unstructured.Unstructured, error) {
+	if pt != types.ApplyPatchType {
+		return nil, errors.New("unexpected patch type")
+	}
+	r.client.patches = append(r.client.patches, patch{
+		namespace:    r.namespace,
+		resource:     r.resource,
+		name:         name,
+		fieldManager: options.FieldManager,
+		force:        *options.Force,
+		dryRun:       options.DryRun,
+	})
+	if r.client.onPatch != nil {
+		r.client.onPatch(r.resource)
+	}
+	return nil, r.client.err
+}
+
+func TestApplyServerSide(t *testing.T) {
+	tests := []struct {
+		description     string
+		serverSide      latest_v1.KubectlServerSideApply
+		flags           latest_v1.KubectlFlags
+		forceDeploy     bool
+		manifests       manifest.ManifestList
+		namespace       string
+		patchErr        error
+		expectedPatches []patch
+		expectedOutput  string
+		shouldErr       bool
+		expectedCode    proto.StatusCode
+	}{
+		{
+			description: "default field manager",
+			manifests:   manifest.ManifestList{[]byte(deploymentYAML)},
+			namespace:   "ns",
+			expectedPatches: []patch{
+				{namespace: "ns", resource: "deployments", name: "web", fieldManager: "skaffold"},
+			},
+			expectedOutput: "deployment.apps/web serverside-applied\n",
+		},
+		{
+			description: "custom field manager and force conflicts",
+			serverSide:  latest_v1.KubectlServerSideApply{FieldManager: "me", ForceConflicts: true},
+			manifests:   manifest.ManifestList{[]byte(DeploymentWebYAML), []byte(clusterRoleYAML)},
+			namespace:   "ns",
+			expectedPatches: []patch{
+				{namespace: "ns", resource: "pods", name: "leeroy-web", fieldManager: "me", force: true},
+				{resource: "clusterroles", name: "role", fieldManager: "me", force: true},
+			},
+			expectedOutput: "pod/leeroy-web serverside-applied\nclusterrole.rbac.authorization.k8s.io/role serverside-applied\n",
+		},
+		{
+			description: "force deploy",
+			forceDeploy: true,
+			manifests:   manifest.ManifestList{[]byte(deploymentYAML)},
+			namespace:   "ns",
+			expectedPatches: []patch{
+				{namespace: "ns", resource: "deployments", name: "web", fieldManager: "skaffold", force: true},
+			},
+			expectedOutput: "deployment.apps/web serverside-applied\n",
+		},
+		{
+			description: "apply flags",
+			flags:       latest_v1.KubectlFlags{Apply: []string{"--field-manager=other", "--dry-run=server", "--force-conflicts", "--prune"}},
+			manifests:   manifest.ManifestList{[]byte(deploymentYAML)},
+			namespace:   "ns",
+			expectedPatches: []patch{
+				{namespace: "ns", resource: "deployments", name: "web", fieldManager: "other", force: true, dryRun: []string{"All"}},
+			},
+			expectedOutput: "deployment.apps/web serverside-applied\n",
+		},
+		{
+			description: "crd and custom resource",
+			manifests:   manifest.ManifestList{[]byte(crdYAML), []byte(widgetYAML)},
+			namespace:   "ns",
+			expectedPatches: []patch{
+				{resource: "customresourcedefinitions", name: "widgets.example.com", fieldManager: "skaffold"},
+				{namespace: "ns", resource: "widgets", name: "foo", fieldManager: "skaffold"},
+			},
+			expectedOutput: "customresourcedefinition.apiextensions.k8s.io/widgets.example.com serverside-applied\nwidget.example.com/foo serverside-applied\n",
+		},
+		{
+			description: "field conflict",
+			manifests:   manifest.ManifestList{[]byte(deploymentYAML)},
+			namespace:   "ns",
+			patchErr: apierrors.NewApplyConflict([]metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldManagerConflict,
+				Message: `conflict with "kube-controller-manager" using apps/v1`,
+				Field:   ".spec.replicas",
+			}}, "Apply failed with 1 conflict"),
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_DEPLOY_SERVER_SIDE_APPLY_CONFLICT_ERR,
+		},
+		{
+			description:  "other error",
+			manifests:    manifest.ManifestList{[]byte(deploymentYAML)},
+			namespace:    "ns",
+			patchErr:     apierrors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "deployments"}, "web", errors.New("denied")),
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+		},
+		{
+			description:  "unknown resource",
+			manifests:    manifest.ManifestList{[]byte("apiVersion: example.com/v1\nkind: Unknown\nmetadata:\n  name: foo")},
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakeclient.NewSimpleClientset()
+			client.Resources = []*metav1.APIResourceList{
+				{GroupVersion: "v1", APIResources: []metav1.APIResource{{Kind: "Pod", Name: "pods", Namespaced: true}}},
+				{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{{Kind: "Deployment", Name: "deployments", Namespaced: true}}},
+				{GroupVersion: "rbac.authorization.k8s.io/v1", APIResources: []metav1.APIResource{{Kind: "ClusterRole", Name: "clusterroles"}}},
+				{GroupVersion: "apiextensions.k8s.io/v1", APIResources: []metav1.APIResource{{Kind: "CustomResourceDefinition", Name: "customresourcedefinitions"}}},
+			}
+			t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+			t.Override(&crdEstablishedTimeout, 10*time.Millisecond)
+
+			dynClient := &fakeApplyClient{err: test.patchErr}
+			// Applying a CRD makes its kind available.
+			dynClient.onPatch = func(resource string) {
+				if resource == "customresourcedefinitions" {
+					client.Resources = append(client.Resources, &metav1.APIResourceList{
+						GroupVersion: "example.com/v1", APIResources: []metav1.APIResource{{Kind: "Widget", Name: "widgets", Namespaced: true}},
+					})
+				}
+			}
+			t.Override(&kubernetesclient.DynamicClient, func() (dynamic.Interface, error) { return dynClient, nil })
+
+			cli := NewCLI(&kubectlConfig{force: test.forceDeploy}, test.flags, test.namespace)
+			cli.ServerSide = &test.serverSide
+			var out bytes.Buffer
+			err := cli.applyServerSide(context.Background(), &out, test.manifests)
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				t.CheckDeepEqual(test.expectedCode, err.(sErrors.ErrDef).StatusCode())
+			} else {
+				t.CheckDeepEqual(test.expectedPatches, dynClient.patches, cmp.AllowUnexported(patch{}))
+				t.CheckDeepEqual(test.expectedOutput, out.String())
+			}
+		})
+	}
+}
+
+func TestApplyConflictErr(t *testing.T) {
+	err := applyConflictErr("deployment.apps/web", apierrors.NewApplyConflict([]metav1.StatusCause{
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "hpa" using apps/v1`, Field: ".spec.replicas"},
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "hpa" using apps/v1`, Field: ".spec.template.spec.containers[name=\"web\"].resources"},
+	}, "Apply failed with 2 conflicts"))
+
+	var actions []string
+	for _, s := range err.(sErrors.ErrDef).Suggestions() {
+		actions = append(actions, s.Action)
+	}
+	testutil.CheckDeepEqual(t, []string{
+		"Set `deploy.kubectl.serverSide.forceConflicts` to true to take ownership of the conflicting fields",
+		`Remove .spec.replicas, .spec.template.spec.containers[name="web"].resources from the manifest of deployment.apps/web to leave them managed by hpa`,
+	}, actions)
+}
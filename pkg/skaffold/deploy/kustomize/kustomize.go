@@ -21,11 +21,12 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"os/exec"
 	"path/filepath"
+	"strings"
 
 	"github.com/segmentio/textio"
-	yamlv3 "gopkg.in/yaml.v3"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/types"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
@@ -36,71 +37,32 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/warnings"
 )
 
 var (
 	DefaultKustomizePath = "."
 	kustomizeFilePaths   = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
 	basePath             = "base"
-	kustomizeBinaryCheck = kustomizeBinaryExists // For testing
 )
 
-// kustomization is the content of a kustomization.yaml file.
-type kustomization struct {
-	Components            []string              `yaml:"components"`
-	Bases                 []string              `yaml:"bases"`
-	Resources             []string              `yaml:"resources"`
-	Patches               []patchWrapper        `yaml:"patches"`
-	PatchesStrategicMerge []strategicMergePatch `yaml:"patchesStrategicMerge"`
-	CRDs                  []string              `yaml:"crds"`
-	PatchesJSON6902       []patchJSON6902       `yaml:"patchesJson6902"`
-	ConfigMapGenerator    []configMapGenerator  `yaml:"configMapGenerator"`
-	SecretGenerator       []secretGenerator     `yaml:"secretGenerator"`
+// Config contains the configuration needed by the kustomize deployer.
+type Config interface {
+	kubectl.Config
+	RepoCacheDir() string
 }
 
-type patchPath struct {
-	Path  string `yaml:"path"`
-	Patch string `yaml:"patch"`
-}
-
-type patchWrapper struct {
-	*patchPath
-}
-
-type strategicMergePatch struct {
-	Path  string
-	Patch string
-}
-
-type patchJSON6902 struct {
-	Path string `yaml:"path"`
-}
-
-type configMapGenerator struct {
-	Files []string `yaml:"files"`
-	Env   string   `yaml:"env"`
-	Envs  []string `yaml:"envs"`
-}
-
-type secretGenerator struct {
-	Files []string `yaml:"files"`
-	Env   string   `yaml:"env"`
-	Envs  []string `yaml:"envs"`
-}
-
-// Deployer deploys workflows using kustomize CLI.
+// Deployer deploys workflows with the manifests built by the kustomize library.
 type Deployer struct {
 	*latest_v1.KustomizeDeploy
 
-	kubectl             kubectl.CLI
-	insecureRegistries  map[string]bool
-	labels              map[string]string
-	globalConfig        string
-	useKubectlKustomize bool
+	kubectl            kubectl.CLI
+	remotes            *remoteBases
+	insecureRegistries map[string]bool
+	labels             map[string]string
+	globalConfig       string
 }
 
-func NewDeployer(cfg kubectl.Config, labels map[string]string, d *latest_v1.KustomizeDeploy) (*Deployer, error) {
+func NewDeployer(cfg Config, labels map[string]string, d *latest_v1.KustomizeDeploy) (*Deployer, error) {
 	defaultNamespace := ""
 	if d.DefaultNamespace != nil {
 		var err error
@@ -110,37 +72,16 @@ func NewDeployer(cfg kubectl.Config, labels map[string]string, d *latest_v1.Kust
 		}
 	}
 
-	kubectl := kubectl.NewCLI(cfg, d.Flags, defaultNamespace)
-	// if user has kustomize binary, prioritize that over kubectl kustomize
-	useKubectlKustomize := !kustomizeBinaryCheck() && kubectlVersionCheck(kubectl)
-
 	return &Deployer{
-		KustomizeDeploy:     d,
-		kubectl:             kubectl,
-		insecureRegistries:  cfg.GetInsecureRegistries(),
-		globalConfig:        cfg.GlobalConfig(),
-		labels:              labels,
-		useKubectlKustomize: useKubectlKustomize,
+		KustomizeDeploy:    d,
+		kubectl:            kubectl.NewCLI(cfg, d.Flags, defaultNamespace),
+		remotes:            newRemoteBases(cfg.RepoCacheDir()),
+		insecureRegistries: cfg.GetInsecureRegistries(),
+		globalConfig:       cfg.GlobalConfig(),
+		labels:             labels,
 	}, nil
 }
 
-// Check for existence of kustomize binary in user's PATH
-func kustomizeBinaryExists() bool {
-	_, err := exec.LookPath("kustomize")
-
-	return err == nil
-}
-
-// Check that kubectl version is valid to use kubectl kustomize
-func kubectlVersionCheck(kubectl kubectl.CLI) bool {
-	gt, err := kubectl.CompareVersionTo(context.Background(), 1, 14)
-	if err != nil {
-		return false
-	}
-
-	return gt == 1
-}
-
 // Deploy runs `kubectl apply` on the manifest generated by kustomize.
 func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Artifact) ([]string, error) {
 	manifests, err := k.renderManifests(ctx, out, builds)
@@ -215,15 +156,26 @@ func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 	return nil
 }
 
+// AppliedManifests returns the manifests applied by the last deployment.
+func (k *Deployer) AppliedManifests() manifest.ManifestList {
+	return k.kubectl.AppliedManifests()
+}
+
+// ForgetAppliedManifests makes the next deployment apply all the manifests, even the unchanged ones.
+func (k *Deployer) ForgetAppliedManifests() {
+	k.kubectl.ForgetAppliedManifests()
+}
+
 // Dependencies lists all the files that describe what needs to be deployed.
 func (k *Deployer) Dependencies() ([]string, error) {
+	opts, err := buildOptions(k.BuildArgs)
+	if err != nil {
+		return nil, userErr(err)
+	}
+
 	deps := util.NewStringSet()
 	for _, kustomizePath := range k.KustomizePaths {
-		depsForKustomization, err := DependenciesForKustomization(kustomizePath)
-		if err != nil {
-			return nil, userErr(err)
-		}
-		deps.Insert(depsForKustomization...)
+		deps.Insert(dependencies(opts, k.remotes, kustomizePath)...)
 	}
 	return deps.ToList(), nil
 }
@@ -236,34 +188,6 @@ func (k *Deployer) Render(ctx context.Context, out io.Writer, builds []graph.Art
 	return manifest.Write(manifests.String(), filepath, out)
 }
 
-// Values of `patchesStrategicMerge` can be either:
-// + a file path, referenced as a plain string
-// + an inline patch referenced as a string literal
-func (p *strategicMergePatch) UnmarshalYAML(node *yamlv3.Node) error {
-	if node.Style == 0 || node.Style == yamlv3.DoubleQuotedStyle || node.Style == yamlv3.SingleQuotedStyle {
-		p.Path = node.Value
-	} else {
-		p.Patch = node.Value
-	}
-
-	return nil
-}
-
-// UnmarshalYAML implements JSON unmarshalling by reading an inline yaml fragment.
-func (p *patchWrapper) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
-	pp := &patchPath{}
-	if err := unmarshal(&pp); err != nil {
-		var oldPathString string
-		if err := unmarshal(&oldPathString); err != nil {
-			return err
-		}
-		warnings.Printf("list of file paths deprecated: see https://github.com/kubernetes-sigs/kustomize/blob/master/docs/plugins/builtins.md#patchtransformer")
-		pp.Path = oldPathString
-	}
-	p.patchPath = pp
-	return nil
-}
-
 func pathExistsLocally(filename string, workingDir string) (bool, os.FileMode) {
 	path := filename
 	if !filepath.IsAbs(filename) {
@@ -278,16 +202,7 @@ func pathExistsLocally(filename string, workingDir string) (bool, os.FileMode) {
 func (k *Deployer) readManifests(ctx context.Context) (manifest.ManifestList, error) {
 	var manifests manifest.ManifestList
 	for _, kustomizePath := range k.KustomizePaths {
-		var out []byte
-		var err error
-
-		if k.useKubectlKustomize {
-			out, err = k.kubectl.Kustomize(ctx, BuildCommandArgs(k.BuildArgs, kustomizePath))
-		} else {
-			cmd := exec.CommandContext(ctx, "kustomize", append([]string{"build"}, BuildCommandArgs(k.BuildArgs, kustomizePath)...)...)
-			out, err = util.RunCmdOut(cmd)
-		}
-
+		out, err := k.build(kustomizePath)
 		if err != nil {
 			return nil, userErr(err)
 		}
@@ -300,6 +215,70 @@ func (k *Deployer) readManifests(ctx context.Context) (manifest.ManifestList, er
 	return manifests, nil
 }
 
+// build builds a kustomization with the kustomize library, so that the manifests
+// don't depend on the version of the installed tools.
+func (k *Deployer) build(kustomizePath string) ([]byte, error) {
+	opts, err := buildOptions(k.BuildArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	out, _, err := build(opts, k.remotes, kustomizePath)
+	return out, err
+}
+
+// buildOptions translates the `buildArgs` of the kustomize deployer to the options of the kustomize library.
+func buildOptions(buildArgs []string) (*krusty.Options, error) {
+	opts := krusty.MakeDefaultOptions()
+	// Like `kustomize build`, which defaults to `--reorder legacy`.
+	opts.DoLegacyResourceSort = true
+
+	args := BuildCommandArgs(buildArgs, "")
+	for i := 0; i < len(args); i++ {
+		flag, value, hasValue := args[i], "", false
+		if eq := strings.Index(flag, "="); eq >= 0 {
+			flag, value, hasValue = flag[:eq], flag[eq+1:], true
+		}
+		nextValue := func() string {
+			if !hasValue && i+1 < len(args) {
+				i++
+				return args[i]
+			}
+			return value
+		}
+
+		switch strings.Replace(flag, "_", "-", -1) {
+		case "--load-restrictor":
+			switch v := nextValue(); v {
+			case types.LoadRestrictionsRootOnly.String():
+				opts.LoadRestrictions = types.LoadRestrictionsRootOnly
+			case types.LoadRestrictionsNone.String():
+				opts.LoadRestrictions = types.LoadRestrictionsNone
+			default:
+				return nil, fmt.Errorf("invalid load restrictor %q", v)
+			}
+		case "--reorder":
+			switch v := nextValue(); v {
+			case "legacy":
+				opts.DoLegacyResourceSort = true
+			case "none":
+				opts.DoLegacyResourceSort = false
+			default:
+				return nil, fmt.Errorf("invalid reorder option %q", v)
+			}
+		case "--enable-managedby-label":
+			opts.AddManagedbyLabel = value != "false"
+		case "--enable-alpha-plugins":
+			if value != "false" {
+				opts.PluginConfig = types.EnabledPluginConfig(types.BploUseStaticallyLinked)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported kustomize build argument %q", args[i])
+		}
+	}
+	return opts, nil
+}
+
 func IsKustomizationBase(path string) bool {
 	return filepath.Dir(path) == basePath
 }
@@ -22,11 +22,15 @@ import (
 	"errors"
 	"io/ioutil"
 	"path/filepath"
+	"sort"
 	"testing"
 	"time"
 
+	"sigs.k8s.io/kustomize/api/types"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/git"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
@@ -38,12 +42,12 @@ func TestKustomizeDeploy(t *testing.T) {
 	tests := []struct {
 		description                 string
 		kustomize                   latest_v1.KustomizeDeploy
+		files                       map[string]string
 		builds                      []graph.Artifact
 		commands                    util.Command
 		shouldErr                   bool
 		forceDeploy                 bool
 		skipSkaffoldNamespaceOption bool
-		kustomizeCmdPresent         bool
 		envs                        map[string]string
 	}{
 		{
@@ -51,27 +55,28 @@ func TestKustomizeDeploy(t *testing.T) {
 			kustomize: latest_v1.KustomizeDeploy{
 				KustomizePaths: []string{"."},
 			},
+			files: map[string]string{"kustomization.yaml": ""},
 			commands: testutil.
-				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion118).
-				AndRunOut("kustomize build .", ""),
-			kustomizeCmdPresent: true,
+				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion118),
 		},
 		{
 			description: "deploy success",
 			kustomize: latest_v1.KustomizeDeploy{
 				KustomizePaths: []string{"."},
 			},
+			files: map[string]string{
+				"kustomization.yaml": "resources: [pod.yaml]",
+				"pod.yaml":           kubectl.DeploymentWebYAML,
+			},
 			commands: testutil.
 				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion118).
-				AndRunOut("kustomize build .", kubectl.DeploymentWebYAML).
 				AndRunInputOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", kubectl.DeploymentWebYAMLv1, "").
 				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f - --force --grace-period=0"),
 			builds: []graph.Artifact{{
 				ImageName: "leeroy-web",
 				Tag:       "leeroy-web:v1",
 			}},
-			forceDeploy:         true,
-			kustomizeCmdPresent: true,
+			forceDeploy: true,
 		},
 		{
 			description: "deploy success (default namespace)",
@@ -79,9 +84,12 @@ func TestKustomizeDeploy(t *testing.T) {
 				KustomizePaths:   []string{"."},
 				DefaultNamespace: &kubectl.TestNamespace2,
 			},
+			files: map[string]string{
+				"kustomization.yaml": "resources: [pod.yaml]",
+				"pod.yaml":           kubectl.DeploymentWebYAML,
+			},
 			commands: testutil.
 				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion112).
-				AndRunOut("kustomize build .", kubectl.DeploymentWebYAML).
 				AndRunInputOut("kubectl --context kubecontext --namespace testNamespace2 get -f - --ignore-not-found -ojson", kubectl.DeploymentWebYAMLv1, "").
 				AndRun("kubectl --context kubecontext --namespace testNamespace2 apply -f - --force --grace-period=0"),
 			builds: []graph.Artifact{{
@@ -90,7 +98,6 @@ func TestKustomizeDeploy(t *testing.T) {
 			}},
 			forceDeploy:                 true,
 			skipSkaffoldNamespaceOption: true,
-			kustomizeCmdPresent:         true,
 		},
 		{
 			description: "deploy success (default namespace with env template)",
@@ -98,9 +105,12 @@ func TestKustomizeDeploy(t *testing.T) {
 				KustomizePaths:   []string{"."},
 				DefaultNamespace: &kubectl.TestNamespace2FromEnvTemplate,
 			},
+			files: map[string]string{
+				"kustomization.yaml": "resources: [pod.yaml]",
+				"pod.yaml":           kubectl.DeploymentWebYAML,
+			},
 			commands: testutil.
 				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion112).
-				AndRunOut("kustomize build .", kubectl.DeploymentWebYAML).
 				AndRunInputOut("kubectl --context kubecontext --namespace testNamespace2 get -f - --ignore-not-found -ojson", kubectl.DeploymentWebYAMLv1, "").
 				AndRun("kubectl --context kubecontext --namespace testNamespace2 apply -f - --force --grace-period=0"),
 			builds: []graph.Artifact{{
@@ -112,17 +122,20 @@ func TestKustomizeDeploy(t *testing.T) {
 			envs: map[string]string{
 				"MYENV": "Namesp",
 			},
-			kustomizeCmdPresent: true,
 		},
 		{
 			description: "deploy success with multiple kustomizations",
 			kustomize: latest_v1.KustomizeDeploy{
 				KustomizePaths: []string{"a", "b"},
 			},
+			files: map[string]string{
+				"a/kustomization.yaml": "resources: [pod.yaml]",
+				"a/pod.yaml":           kubectl.DeploymentWebYAML,
+				"b/kustomization.yaml": "resources: [pod.yaml]",
+				"b/pod.yaml":           kubectl.DeploymentAppYAML,
+			},
 			commands: testutil.
 				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion118).
-				AndRunOut("kustomize build a", kubectl.DeploymentWebYAML).
-				AndRunOut("kustomize build b", kubectl.DeploymentAppYAML).
 				AndRunInputOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", kubectl.DeploymentWebYAMLv1+"\n---\n"+kubectl.DeploymentAppYAMLv1, "").
 				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f - --force --grace-period=0"),
 			builds: []graph.Artifact{
@@ -135,40 +148,63 @@ func TestKustomizeDeploy(t *testing.T) {
 					Tag:       "leeroy-app:v1",
 				},
 			},
-			forceDeploy:         true,
-			kustomizeCmdPresent: true,
+			forceDeploy: true,
 		},
 		{
-			description: "built-in kubectl kustomize",
+			description: "deploy success with build args",
 			kustomize: latest_v1.KustomizeDeploy{
-				KustomizePaths: []string{"a", "b"},
+				KustomizePaths: []string{"a"},
+				BuildArgs:      []string{"--load-restrictor LoadRestrictionsNone"},
+			},
+			files: map[string]string{
+				"a/kustomization.yaml": "resources: [../pod.yaml]",
+				"pod.yaml":             kubectl.DeploymentWebYAML,
 			},
 			commands: testutil.
 				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion118).
-				AndRunOut("kubectl --context kubecontext --namespace testNamespace kustomize a", kubectl.DeploymentWebYAML).
-				AndRunOut("kubectl --context kubecontext --namespace testNamespace kustomize b", kubectl.DeploymentAppYAML).
-				AndRunInputOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", kubectl.DeploymentWebYAMLv1+"\n---\n"+kubectl.DeploymentAppYAMLv1, "").
+				AndRunInputOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", kubectl.DeploymentWebYAMLv1, "").
 				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f - --force --grace-period=0"),
-			builds: []graph.Artifact{
-				{
-					ImageName: "leeroy-web",
-					Tag:       "leeroy-web:v1",
-				},
-				{
-					ImageName: "leeroy-app",
-					Tag:       "leeroy-app:v1",
-				},
-			},
+			builds: []graph.Artifact{{
+				ImageName: "leeroy-web",
+				Tag:       "leeroy-web:v1",
+			}},
 			forceDeploy: true,
 		},
+		{
+			description: "files outside of the kustomization",
+			kustomize: latest_v1.KustomizeDeploy{
+				KustomizePaths: []string{"a"},
+			},
+			files: map[string]string{
+				"a/kustomization.yaml": "resources: [../pod.yaml]",
+				"pod.yaml":             kubectl.DeploymentWebYAML,
+			},
+			commands: testutil.
+				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion118),
+			shouldErr: true,
+		},
+		{
+			description: "unsupported build args",
+			kustomize: latest_v1.KustomizeDeploy{
+				KustomizePaths: []string{"."},
+				BuildArgs:      []string{"--unknown"},
+			},
+			files: map[string]string{
+				"kustomization.yaml": "resources: [pod.yaml]",
+				"pod.yaml":           kubectl.DeploymentWebYAML,
+			},
+			commands: testutil.
+				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion118),
+			shouldErr: true,
+		},
 	}
 
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.SetEnvs(test.envs)
 			t.Override(&util.DefaultExecCommand, test.commands)
-			t.Override(&kustomizeBinaryCheck, func() bool { return test.kustomizeCmdPresent })
 			t.NewTempDir().
+				WriteFiles(test.files).
 				Chdir()
 
 			skaffoldNamespaceOption := ""
@@ -197,6 +233,15 @@ func TestKustomizeDeploy(t *testing.T) {
 
 func TestKustomizeCleanup(t *testing.T) {
 	tmpDir := testutil.NewTempDir(t)
+	tmpDir.WriteFiles(map[string]string{
+		"kustomization.yaml":   "resources: [pod.yaml]",
+		"pod.yaml":             kubectl.DeploymentWebYAML,
+		"a/kustomization.yaml": "resources: [pod.yaml]",
+		"a/pod.yaml":           kubectl.DeploymentWebYAML,
+		"b/kustomization.yaml": "resources: [pod.yaml]",
+		"b/pod.yaml":           kubectl.DeploymentAppYAML,
+		"c/kustomization.yaml": "resources: [missing.yaml]",
+	})
 
 	tests := []struct {
 		description string
@@ -210,8 +255,7 @@ func TestKustomizeCleanup(t *testing.T) {
 				KustomizePaths: []string{tmpDir.Root()},
 			},
 			commands: testutil.
-				CmdRunOut("kustomize build "+tmpDir.Root(), kubectl.DeploymentWebYAML).
-				AndRun("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true -f -"),
+				CmdRun("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true -f -"),
 		},
 		{
 			description: "cleanup success with multiple kustomizations",
@@ -219,9 +263,7 @@ func TestKustomizeCleanup(t *testing.T) {
 				KustomizePaths: tmpDir.Paths("a", "b"),
 			},
 			commands: testutil.
-				CmdRunOut("kustomize build "+tmpDir.Path("a"), kubectl.DeploymentWebYAML).
-				AndRunOut("kustomize build "+tmpDir.Path("b"), kubectl.DeploymentAppYAML).
-				AndRun("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true -f -"),
+				CmdRun("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true -f -"),
 		},
 		{
 			description: "cleanup error",
@@ -229,24 +271,20 @@ func TestKustomizeCleanup(t *testing.T) {
 				KustomizePaths: []string{tmpDir.Root()},
 			},
 			commands: testutil.
-				CmdRunOut("kustomize build "+tmpDir.Root(), kubectl.DeploymentWebYAML).
-				AndRunErr("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true -f -", errors.New("BUG")),
+				CmdRunErr("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true -f -", errors.New("BUG")),
 			shouldErr: true,
 		},
 		{
 			description: "fail to read manifests",
 			kustomize: latest_v1.KustomizeDeploy{
-				KustomizePaths: []string{tmpDir.Root()},
+				KustomizePaths: []string{tmpDir.Path("c")},
 			},
-			commands: testutil.
-				CmdRunOutErr("kustomize build "+tmpDir.Root(), "", errors.New("BUG")),
 			shouldErr: true,
 		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&util.DefaultExecCommand, test.commands)
-			t.Override(&kustomizeBinaryCheck, func() bool { return true })
 
 			k, err := NewDeployer(&kustomizeConfig{
 				workingDir: tmpDir.Root(),
@@ -261,6 +299,26 @@ func TestKustomizeCleanup(t *testing.T) {
 	}
 }
 
+const (
+	deployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        image: app`
+	deploymentPatch = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  replicas: 2`
+	jsonPatch = `[{"op": "add", "path": "/spec/replicas", "value": 2}]`
+)
+
 func TestDependenciesForKustomization(t *testing.T) {
 	tests := []struct {
 		description    string
@@ -280,11 +338,16 @@ func TestDependenciesForKustomization(t *testing.T) {
 		},
 		{
 			description: "extended patches with paths",
-			kustomizations: map[string]string{"kustomization.yaml": `patches:
+			kustomizations: map[string]string{"kustomization.yaml": `resources: [app.yaml]
+patches:
 - path: patch1.yaml
   target:
     kind: Deployment`},
-			expected: []string{"kustomization.yaml", "patch1.yaml"},
+			expected: []string{"app.yaml", "kustomization.yaml", "patch1.yaml"},
+			createFiles: map[string]string{
+				"app.yaml":    deployment,
+				"patch1.yaml": deploymentPatch,
+			},
 		},
 		{
 			description: "extended patches with inline",
@@ -296,14 +359,27 @@ func TestDependenciesForKustomization(t *testing.T) {
 			expected: []string{"kustomization.yaml"},
 		},
 		{
-			description:    "patches legacy",
-			kustomizations: map[string]string{"kustomization.yaml": `patches: [patch1.yaml, path/patch2.yaml]`},
-			expected:       []string{"kustomization.yaml", "patch1.yaml", "path/patch2.yaml"},
+			description: "patches legacy",
+			kustomizations: map[string]string{"kustomization.yaml": `resources: [app.yaml]
+patches: [patch1.yaml, path/patch2.yaml]`},
+			expected: []string{"app.yaml", "kustomization.yaml", "patch1.yaml", "path/patch2.yaml"},
+			createFiles: map[string]string{
+				"app.yaml":         deployment,
+				"patch1.yaml":      deploymentPatch,
+				"path/patch2.yaml": deploymentPatch,
+			},
 		},
 		{
-			description:    "patchesStrategicMerge",
-			kustomizations: map[string]string{"kustomization.yaml": `patchesStrategicMerge: [patch1.yaml, "patch2.yaml", 'path/patch3.yaml']`},
-			expected:       []string{"kustomization.yaml", "patch1.yaml", "patch2.yaml", "path/patch3.yaml"},
+			description: "patchesStrategicMerge",
+			kustomizations: map[string]string{"kustomization.yaml": `resources: [app.yaml]
+patchesStrategicMerge: [patch1.yaml, "patch2.yaml", 'path/patch3.yaml']`},
+			expected: []string{"app.yaml", "kustomization.yaml", "patch1.yaml", "patch2.yaml", "path/patch3.yaml"},
+			createFiles: map[string]string{
+				"app.yaml":         deployment,
+				"patch1.yaml":      deploymentPatch,
+				"patch2.yaml":      deploymentPatch,
+				"path/patch3.yaml": deploymentPatch,
+			},
 		},
 		{
 			description: "inline patchesStrategicMerge",
@@ -314,20 +390,33 @@ func TestDependenciesForKustomization(t *testing.T) {
 		},
 		{
 			description:    "crds",
-			kustomizations: map[string]string{"kustomization.yaml": `patches: [crd1.yaml, path/crd2.yaml]`},
-			expected:       []string{"crd1.yaml", "kustomization.yaml", "path/crd2.yaml"},
+			kustomizations: map[string]string{"kustomization.yaml": `crds: [crd1.json, path/crd2.json]`},
+			expected:       []string{"crd1.json", "kustomization.yaml", "path/crd2.json"},
+			createFiles: map[string]string{
+				"crd1.json":      "{}",
+				"path/crd2.json": "{}",
+			},
 		},
 		{
 			description: "patches json 6902",
-			kustomizations: map[string]string{"kustomization.yaml": `patchesJson6902:
+			kustomizations: map[string]string{"kustomization.yaml": `resources: [app.yaml]
+patchesJson6902:
 - path: patch1.json
-- path: path/patch2.json`},
-			expected: []string{"kustomization.yaml", "patch1.json", "path/patch2.json"},
+  target: {group: apps, version: v1, kind: Deployment, name: app}
+- path: path/patch2.json
+  target: {group: apps, version: v1, kind: Deployment, name: app}`},
+			expected: []string{"app.yaml", "kustomization.yaml", "patch1.json", "path/patch2.json"},
+			createFiles: map[string]string{
+				"app.yaml":         deployment,
+				"patch1.json":      jsonPatch,
+				"path/patch2.json": jsonPatch,
+			},
 		},
 		{
 			description: "ignore patch without path",
 			kustomizations: map[string]string{"kustomization.yaml": `patchesJson6902:
-- patch: |-
+- target: {group: apps, version: v1, kind: Deployment, name: app}
+  patch: |-
     - op: replace
       path: /path
       value: any`},
@@ -336,20 +425,44 @@ func TestDependenciesForKustomization(t *testing.T) {
 		{
 			description: "configMapGenerator",
 			kustomizations: map[string]string{"kustomization.yaml": `configMapGenerator:
-- files: [app1.properties]
-- files: [app2.properties, app3.properties]
-- env: app1.env
-- envs: [app2.env, app3.env]`},
+- name: config1
+  files: [app1.properties]
+- name: config2
+  files: [app2.properties, app3.properties]
+- name: config3
+  env: app1.env
+- name: config4
+  envs: [app2.env, app3.env]`},
 			expected: []string{"app1.env", "app1.properties", "app2.env", "app2.properties", "app3.env", "app3.properties", "kustomization.yaml"},
+			createFiles: map[string]string{
+				"app1.properties": "key=value",
+				"app2.properties": "key=value",
+				"app3.properties": "key=value",
+				"app1.env":        "KEY1=value",
+				"app2.env":        "KEY2=value",
+				"app3.env":        "KEY3=value",
+			},
 		},
 		{
 			description: "secretGenerator",
 			kustomizations: map[string]string{"kustomization.yaml": `secretGenerator:
-- files: [secret1.file]
-- files: [secret2.file, secret3.file]
-- env: secret1.env
-- envs: [secret2.env, secret3.env]`},
+- name: secret1
+  files: [secret1.file]
+- name: secret2
+  files: [secret2.file, secret3.file]
+- name: secret3
+  env: secret1.env
+- name: secret4
+  envs: [secret2.env, secret3.env]`},
 			expected: []string{"kustomization.yaml", "secret1.env", "secret1.file", "secret2.env", "secret2.file", "secret3.env", "secret3.file"},
+			createFiles: map[string]string{
+				"secret1.file": "secret",
+				"secret2.file": "secret",
+				"secret3.file": "secret",
+				"secret1.env":  "KEY1=value",
+				"secret2.env":  "KEY2=value",
+				"secret3.env":  "KEY3=value",
+			},
 		},
 		{
 			description:    "base exists locally",
@@ -385,7 +498,7 @@ func TestDependenciesForKustomization(t *testing.T) {
 		},
 		{
 			description:    "mixed resource types",
-			kustomizations: map[string]string{"kustomization.yaml": `resources: [app.yaml, missing-or-remote-base, base]`},
+			kustomizations: map[string]string{"kustomization.yaml": `resources: [app.yaml, base, missing-or-remote-base]`},
 			expected:       []string{"app.yaml", "base/app.yaml", "base/kustomization.yaml", "kustomization.yaml"},
 			createFiles: map[string]string{
 				"app.yaml":                "",
@@ -464,6 +577,39 @@ func TestDependenciesForKustomization(t *testing.T) {
 	}
 }
 
+func TestDependenciesForRemoteKustomization(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		repoDir := t.NewTempDir().
+			Write("base1/kustomization.yaml", `resources: [app.yaml]`).
+			Write("base1/app.yaml", deployment).
+			Write("base2/kustomization.yaml", `resources: [service.yaml]`).
+			Write("base2/service.yaml", "apiVersion: v1\nkind: Service\nmetadata:\n  name: app")
+		tmpDir := t.NewTempDir().
+			Write("kustomization.yaml", `resources:
+- app.yaml
+- github.com/org/repo/base1?ref=v1
+bases:
+- https://github.com/org/repo.git/base2?ref=v1`).
+			Write("app.yaml", "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config")
+
+		var synced []latest_v1.GitInfo
+		t.Override(&git.SyncRepo, func(g latest_v1.GitInfo, _ config.SkaffoldOptions) (string, error) {
+			synced = append(synced, g)
+			return repoDir.Root(), nil
+		})
+
+		k, err := NewDeployer(&kustomizeConfig{}, nil, &latest_v1.KustomizeDeploy{KustomizePaths: []string{tmpDir.Root()}})
+		t.RequireNoError(err)
+		deps, err := k.Dependencies()
+
+		expected := append(tmpDir.Paths("app.yaml", "kustomization.yaml"), repoDir.Paths("base1/app.yaml", "base1/kustomization.yaml", "base2/kustomization.yaml", "base2/service.yaml")...)
+		sort.Strings(expected)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(expected, deps)
+		t.CheckDeepEqual([]latest_v1.GitInfo{{Repo: "https://github.com/org/repo.git", Ref: "v1"}}, synced)
+	})
+}
+
 func TestKustomizeBuildCommandArgs(t *testing.T) {
 	tests := []struct {
 		description   string
@@ -529,10 +675,67 @@ func TestKustomizeBuildCommandArgs(t *testing.T) {
 	}
 }
 
+func TestBuildOptions(t *testing.T) {
+	tests := []struct {
+		description      string
+		buildArgs        []string
+		expectedRestrict types.LoadRestrictions
+		expectedSort     bool
+		expectedLabel    bool
+		shouldErr        bool
+	}{
+		{
+			description:      "defaults",
+			expectedRestrict: types.LoadRestrictionsRootOnly,
+			expectedSort:     true,
+		},
+		{
+			description:      "load restrictor",
+			buildArgs:        []string{"--load-restrictor LoadRestrictionsNone"},
+			expectedRestrict: types.LoadRestrictionsNone,
+			expectedSort:     true,
+		},
+		{
+			description:      "deprecated load restrictor flag",
+			buildArgs:        []string{"--load_restrictor=LoadRestrictionsNone"},
+			expectedRestrict: types.LoadRestrictionsNone,
+			expectedSort:     true,
+		},
+		{
+			description:      "no reordering and managed-by label",
+			buildArgs:        []string{"--reorder", "none", "--enable-managedby-label"},
+			expectedRestrict: types.LoadRestrictionsRootOnly,
+			expectedLabel:    true,
+		},
+		{
+			description: "invalid load restrictor",
+			buildArgs:   []string{"--load-restrictor=unknown"},
+			shouldErr:   true,
+		},
+		{
+			description: "unsupported build arg",
+			buildArgs:   []string{"--enable-helm"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			opts, err := buildOptions(test.buildArgs)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expectedRestrict, opts.LoadRestrictions)
+				t.CheckDeepEqual(test.expectedSort, opts.DoLegacyResourceSort)
+				t.CheckDeepEqual(test.expectedLabel, opts.AddManagedbyLabel)
+			}
+		})
+	}
+}
+
 func TestKustomizeRender(t *testing.T) {
 	type kustomizationCall struct {
-		folder      string
-		buildResult string
+		folder    string
+		resources string
 	}
 	tests := []struct {
 		description    string
@@ -557,9 +760,10 @@ func TestKustomizeRender(t *testing.T) {
 			kustomizations: []kustomizationCall{
 				{
 					folder: ".",
-					buildResult: `apiVersion: v1
+					resources: `apiVersion: v1
 kind: Pod
 metadata:
+  name: pod
   namespace: default
 spec:
   containers:
@@ -573,6 +777,7 @@ spec:
 			expected: `apiVersion: v1
 kind: Pod
 metadata:
+  name: pod
   namespace: default
 spec:
   containers:
@@ -598,9 +803,10 @@ spec:
 			kustomizations: []kustomizationCall{
 				{
 					folder: ".",
-					buildResult: `apiVersion: v1
+					resources: `apiVersion: v1
 kind: Pod
 metadata:
+  name: pod
   namespace: default
 spec:
   containers:
@@ -616,6 +822,7 @@ kind: Pod
 metadata:
   labels:
     user/label: test
+  name: pod
   namespace: default
 spec:
   containers:
@@ -640,9 +847,10 @@ spec:
 			kustomizations: []kustomizationCall{
 				{
 					folder: "a",
-					buildResult: `apiVersion: v1
+					resources: `apiVersion: v1
 kind: Pod
 metadata:
+  name: pod
   namespace: default
 spec:
   containers:
@@ -652,9 +860,10 @@ spec:
 				},
 				{
 					folder: "b",
-					buildResult: `apiVersion: v1
+					resources: `apiVersion: v1
 kind: Pod
 metadata:
+  name: pod
   namespace: default
 spec:
   containers:
@@ -666,6 +875,7 @@ spec:
 			expected: `apiVersion: v1
 kind: Pod
 metadata:
+  name: pod
   namespace: default
 spec:
   containers:
@@ -675,6 +885,7 @@ spec:
 apiVersion: v1
 kind: Pod
 metadata:
+  name: pod
   namespace: default
 spec:
   containers:
@@ -685,15 +896,14 @@ spec:
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Chdir()
 			var kustomizationPaths []string
-			fakeCmd := testutil.
-				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion112)
 			for _, kustomizationCall := range test.kustomizations {
-				fakeCmd.AndRunOut("kustomize build "+kustomizationCall.folder, kustomizationCall.buildResult)
+				tmpDir.Write(filepath.Join(kustomizationCall.folder, "kustomization.yaml"), "resources: [pod.yaml]")
+				tmpDir.Write(filepath.Join(kustomizationCall.folder, "pod.yaml"), kustomizationCall.resources)
 				kustomizationPaths = append(kustomizationPaths, kustomizationCall.folder)
 			}
-			t.Override(&util.DefaultExecCommand, fakeCmd)
-			t.NewTempDir().Chdir()
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion112))
 
 			k, err := NewDeployer(&kustomizeConfig{
 				workingDir: ".",
@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kustomize
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/git"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+var refQuery = regexp.MustCompile(`\?(version|ref)=`)
+
+// remoteBase is a kustomization stored in a git repository, such as
+// `github.com/org/repo/path?ref=v1`.
+type remoteBase struct {
+	// repo is the url the repository is cloned from.
+	repo string
+	// path is the directory of the kustomization in the repository.
+	path string
+	ref  string
+}
+
+// parseRemoteBase parses a remote base the way kustomize does.
+// It returns false if the value doesn't reference a git repository.
+func parseRemoteBase(value string) (remoteBase, bool) {
+	if filepath.IsAbs(value) {
+		return remoteBase{}, false
+	}
+
+	if i := strings.Index(value, "_git/"); i >= 0 {
+		host := normalizeGitHost(value[:i+len("_git/")])
+		rest := value[i+len("_git/"):]
+		orgRepo := strings.Split(strings.Split(rest, "/")[0], "?")[0]
+		path, ref := peelRef(rest[len(orgRepo):])
+		return remoteBase{repo: host + orgRepo, path: strings.TrimPrefix(path, "/"), ref: ref}, orgRepo != ""
+	}
+
+	host, rest := parseGitHost(value)
+	if host == "" {
+		return remoteBase{}, false
+	}
+
+	var orgRepo, path, ref string
+	if i := strings.Index(rest, ".git"); i >= 0 {
+		orgRepo = rest[:i]
+		path, ref = peelRef(rest[i+len(".git"):])
+	} else {
+		i := strings.Index(rest, "/")
+		if i < 1 {
+			return remoteBase{}, false
+		}
+		if j := strings.Index(rest[i+1:], "/"); j >= 0 {
+			orgRepo = rest[:i+1+j]
+			path, ref = peelRef(rest[i+2+j:])
+		} else {
+			orgRepo, ref = peelRef(rest)
+		}
+	}
+	if orgRepo == "" {
+		return remoteBase{}, false
+	}
+
+	repo := host + orgRepo + ".git"
+	if strings.Contains(host, "dev.azure.com") || strings.Contains(host, "visualstudio.com") || strings.Contains(host, "amazonaws.com") {
+		repo = host + orgRepo
+	}
+	return remoteBase{repo: repo, path: strings.Trim(path, "/"), ref: ref}, true
+}
+
+// parseGitHost splits the host of a git url from the rest of the url.
+func parseGitHost(value string) (string, string) {
+	var host string
+	for _, prefix := range []string{"git::", "gh:", "ssh://", "https://", "http://", "git@", "github.com:", "github.com/"} {
+		if len(prefix) < len(value) && strings.ToLower(value[:len(prefix)]) == prefix {
+			value = value[len(prefix):]
+			host += prefix
+		}
+	}
+
+	if host == "git@" {
+		if i := strings.Index(value, "/"); i >= 0 {
+			return host + value[:i+1], value[i+1:]
+		}
+		if i := strings.Index(value, ":"); i >= 0 {
+			return host + value[:i+1], value[i+1:]
+		}
+		return host, value
+	}
+
+	for _, scheme := range []string{"ssh://", "https://", "http://"} {
+		if strings.HasSuffix(host, scheme) {
+			if i := strings.Index(value, "/"); i >= 0 {
+				host += value[:i+1]
+				value = value[i+1:]
+			}
+			break
+		}
+	}
+	return normalizeGitHost(host), value
+}
+
+func normalizeGitHost(host string) string {
+	lower := strings.ToLower(host)
+	if strings.Contains(lower, "github.com") {
+		if strings.Contains(lower, "git@") || strings.Contains(lower, "ssh:") {
+			return "git@github.com:"
+		}
+		return "https://github.com/"
+	}
+	if strings.HasPrefix(lower, "git::") {
+		return strings.TrimPrefix(lower, "git::")
+	}
+	return host
+}
+
+func peelRef(value string) (string, string) {
+	if loc := refQuery.FindStringIndex(value); loc != nil {
+		return value[:loc[0]], value[loc[1]:]
+	}
+	return value, ""
+}
+
+// remoteBases clones the remote bases into skaffold's git repository cache.
+// Each repository is synced once, and then read from the cache.
+type remoteBases struct {
+	opts config.SkaffoldOptions
+
+	mu   sync.Mutex
+	dirs map[remoteBase]string
+}
+
+func newRemoteBases(repoCacheDir string) *remoteBases {
+	return &remoteBases{
+		opts: config.SkaffoldOptions{RepoCacheDir: repoCacheDir},
+		dirs: map[remoteBase]string{},
+	}
+}
+
+// dir returns the local directory holding the kustomization of a remote base.
+// It returns false if the repository can't be synced, leaving it to kustomize
+// to load the remote base or to report an error.
+func (r *remoteBases) dir(base remoteBase) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	repo := remoteBase{repo: base.repo, ref: base.ref}
+	repoDir, found := r.dirs[repo]
+	if !found {
+		var err error
+		repoDir, err = git.SyncRepo(latest_v1.GitInfo{Repo: base.repo, Ref: base.ref}, r.opts)
+		if err != nil {
+			logrus.Debugf("unable to cache remote kustomization %s: %v", base.repo, err)
+			repoDir = ""
+		}
+		r.dirs[repo] = repoDir
+	}
+
+	if repoDir == "" {
+		return "", false
+	}
+	return filepath.Join(repoDir, filepath.FromSlash(base.path)), true
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kustomize
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/git"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestParseRemoteBase(t *testing.T) {
+	tests := []struct {
+		description string
+		value       string
+		expected    remoteBase
+		isRemote    bool
+	}{
+		{
+			description: "github",
+			value:       "github.com/org/repo/path/to/base?ref=v1",
+			expected:    remoteBase{repo: "https://github.com/org/repo.git", path: "path/to/base", ref: "v1"},
+			isRemote:    true,
+		},
+		{
+			description: "github without path",
+			value:       "github.com/org/repo?version=v1",
+			expected:    remoteBase{repo: "https://github.com/org/repo.git", ref: "v1"},
+			isRemote:    true,
+		},
+		{
+			description: "git suffix",
+			value:       "https://example.com/org/repo.git//base?ref=main",
+			expected:    remoteBase{repo: "https://example.com/org/repo.git", path: "base", ref: "main"},
+			isRemote:    true,
+		},
+		{
+			description: "ssh",
+			value:       "git@github.com:org/repo/base",
+			expected:    remoteBase{repo: "git@github.com:org/repo.git", path: "base"},
+			isRemote:    true,
+		},
+		{
+			description: "azure",
+			value:       "https://dev.azure.com/org/project/_git/repo/base?ref=v1",
+			expected:    remoteBase{repo: "https://dev.azure.com/org/project/_git/repo", path: "base", ref: "v1"},
+			isRemote:    true,
+		},
+		{
+			description: "local directory",
+			value:       "base",
+		},
+		{
+			description: "absolute directory",
+			value:       "/path/to/base",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			base, isRemote := parseRemoteBase(test.value)
+
+			t.CheckDeepEqual(test.isRemote, isRemote)
+			if test.isRemote {
+				t.CheckDeepEqual(test.expected, base, cmp.AllowUnexported(remoteBase{}))
+			}
+		})
+	}
+}
+
+func TestRemoteBasesDir(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		syncs := 0
+		t.Override(&git.SyncRepo, func(g latest_v1.GitInfo, _ config.SkaffoldOptions) (string, error) {
+			syncs++
+			if g.Repo == "https://github.com/org/missing.git" {
+				return "", errors.New("not found")
+			}
+			return "/cache/repo", nil
+		})
+
+		remotes := newRemoteBases("")
+		dir, found := remotes.dir(remoteBase{repo: "https://github.com/org/repo.git", path: "base1", ref: "v1"})
+		t.CheckTrue(found)
+		t.CheckDeepEqual(filepath.FromSlash("/cache/repo/base1"), dir)
+
+		dir, found = remotes.dir(remoteBase{repo: "https://github.com/org/repo.git", path: "base2", ref: "v1"})
+		t.CheckTrue(found)
+		t.CheckDeepEqual(filepath.FromSlash("/cache/repo/base2"), dir)
+
+		_, found = remotes.dir(remoteBase{repo: "https://github.com/org/missing.git"})
+		t.CheckFalse(found)
+		_, found = remotes.dir(remoteBase{repo: "https://github.com/org/missing.git"})
+		t.CheckFalse(found)
+
+		t.CheckDeepEqual(2, syncs)
+	})
+}
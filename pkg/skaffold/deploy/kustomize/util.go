@@ -18,100 +18,143 @@ package kustomize
 
 import (
 	"fmt"
-	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+	"github.com/sirupsen/logrus"
+	yamlv3 "gopkg.in/yaml.v3"
+	"sigs.k8s.io/kustomize/api/filesys"
+	"sigs.k8s.io/kustomize/api/krusty"
 )
 
-// DependenciesForKustomization finds common kustomize artifacts relative to the
-// provided working dir, and collects them into a list of files to be passed
-// to the file watcher.
+// defaultRemoteBases caches the remote bases of DependenciesForKustomization.
+var defaultRemoteBases = newRemoteBases("")
+
+// DependenciesForKustomization lists the files that are read when the kustomization
+// in the provided dir is built, to be passed to the file watcher.
 func DependenciesForKustomization(dir string) ([]string, error) {
-	var deps []string
+	return dependencies(krusty.MakeDefaultOptions(), defaultRemoteBases, dir), nil
+}
 
-	path, err := FindKustomizationConfig(dir)
+// dependencies builds a kustomization and lists the files that kustomize read,
+// including the remote bases cached locally.
+// If the build fails, the files read until then are listed, so that fixing
+// the kustomization is detected.
+func dependencies(opts *krusty.Options, remotes *remoteBases, dir string) []string {
+	_, read, err := build(opts, remotes, dir)
 	if err != nil {
-		// No kustomization config found so assume it's remote and stop traversing
-		return deps, nil
+		logrus.Debugf("kustomize build of %s failed, only watching the files read so far: %v", dir, err)
 	}
 
-	buf, err := ioutil.ReadFile(path)
+	root, _, err := filesys.MakeFsOnDisk().CleanedAbs(dir)
 	if err != nil {
-		return nil, err
+		return read
 	}
-
-	content := kustomization{}
-	if err := yaml.Unmarshal(buf, &content); err != nil {
-		return nil, err
+	deps := make([]string, 0, len(read))
+	for _, file := range read {
+		if rel, err := filepath.Rel(root.String(), file); err == nil {
+			file = filepath.Join(dir, rel)
+		}
+		deps = append(deps, file)
 	}
+	return deps
+}
 
-	deps = append(deps, path)
-
-	candidates := append(content.Bases, content.Resources...)
-	candidates = append(candidates, content.Components...)
-
-	for _, candidate := range candidates {
-		// If the file doesn't exist locally, we can assume it's a remote file and
-		// skip it, since we can't monitor remote files. Kustomize itself will
-		// handle invalid/missing files.
-		local, mode := pathExistsLocally(candidate, dir)
-		if !local {
-			continue
+// build builds a kustomization with the kustomize library, like `kustomize build` does,
+// and returns the files that were read.
+func build(opts *krusty.Options, remotes *remoteBases, dir string) (out []byte, read []string, err error) {
+	fs := &fileSystem{FileSystem: filesys.MakeFsOnDisk(), remotes: remotes}
+	// kustomize panics on some invalid kustomizations, such as a json patch without a target.
+	defer func() {
+		if r := recover(); r != nil {
+			out, read, err = nil, fs.read, fmt.Errorf("invalid kustomization in %s: %v", dir, r)
 		}
+	}()
 
-		if mode.IsDir() {
-			candidateDeps, err := DependenciesForKustomization(filepath.Join(dir, candidate))
-			if err != nil {
-				return nil, err
-			}
-			deps = append(deps, candidateDeps...)
-		} else {
-			deps = append(deps, filepath.Join(dir, candidate))
-		}
+	resources, err := krusty.MakeKustomizer(opts).Run(fs, dir)
+	if err != nil {
+		return nil, fs.read, err
 	}
+	out, err = resources.AsYaml()
+	return out, fs.read, err
+}
+
+// fileSystem is the file system kustomizations are built from. It records the files
+// read by kustomize, and replaces remote bases with their clone in skaffold's cache.
+type fileSystem struct {
+	filesys.FileSystem
+	remotes *remoteBases
+	read    []string
+}
 
-	for _, patch := range content.PatchesStrategicMerge {
-		if patch.Path != "" {
-			deps = append(deps, filepath.Join(dir, patch.Path))
+// ReadFile reads a file and records it.
+func (fs *fileSystem) ReadFile(path string) ([]byte, error) {
+	content, err := fs.FileSystem.ReadFile(path)
+	if err != nil {
+		// Kustomize looks for every kind of kustomization file, but other missing files
+		// are dependencies: creating them fixes the build.
+		if os.IsNotExist(err) && !IsKustomizationPath(path) {
+			fs.read = append(fs.read, path)
 		}
+		return nil, err
 	}
 
-	deps = append(deps, util.AbsolutePaths(dir, content.CRDs)...)
-
-	for _, patch := range content.Patches {
-		if patch.Path != "" {
-			deps = append(deps, filepath.Join(dir, patch.Path))
-		}
+	fs.read = append(fs.read, path)
+	if !IsKustomizationPath(path) {
+		return content, nil
 	}
+	return fs.resolveRemoteBases(filepath.Dir(path), content), nil
+}
 
-	for _, jsonPatch := range content.PatchesJSON6902 {
-		if jsonPatch.Path != "" {
-			deps = append(deps, filepath.Join(dir, jsonPatch.Path))
-		}
+// resolveRemoteBases replaces the remote resources, bases and components of a kustomization
+// with the relative path to their local clone.
+func (fs *fileSystem) resolveRemoteBases(dir string, content []byte) []byte {
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(content, &doc); err != nil || len(doc.Content) == 0 || doc.Content[0].Kind != yamlv3.MappingNode {
+		// Kustomize reports invalid kustomizations.
+		return content
 	}
 
-	for _, generator := range content.ConfigMapGenerator {
-		deps = append(deps, util.AbsolutePaths(dir, generator.Files)...)
-		envs := generator.Envs
-		if generator.Env != "" {
-			envs = append(envs, generator.Env)
+	changed := false
+	fields := doc.Content[0].Content
+	for i := 0; i+1 < len(fields); i += 2 {
+		switch fields[i].Value {
+		case "resources", "bases", "components":
+		default:
+			continue
 		}
-		deps = append(deps, util.AbsolutePaths(dir, envs)...)
-	}
 
-	for _, generator := range content.SecretGenerator {
-		deps = append(deps, util.AbsolutePaths(dir, generator.Files)...)
-		envs := generator.Envs
-		if generator.Env != "" {
-			envs = append(envs, generator.Env)
+		for _, entry := range fields[i+1].Content {
+			if entry.Kind != yamlv3.ScalarNode || fs.Exists(filepath.Join(dir, entry.Value)) {
+				continue
+			}
+			base, isRemote := parseRemoteBase(entry.Value)
+			if !isRemote {
+				continue
+			}
+			local, found := fs.remotes.dir(base)
+			if !found {
+				continue
+			}
+			// Kustomize only accepts relative paths to other kustomizations.
+			rel, err := filepath.Rel(dir, local)
+			if err != nil {
+				continue
+			}
+			entry.Value = filepath.ToSlash(rel)
+			changed = true
 		}
-		deps = append(deps, util.AbsolutePaths(dir, envs)...)
+	}
+	if !changed {
+		return content
 	}
 
-	return deps, nil
+	resolved, err := yamlv3.Marshal(&doc)
+	if err != nil {
+		return content
+	}
+	return resolved
 }
 
 // FindKustomizationConfig finds the kustomization config relative to the provided dir.
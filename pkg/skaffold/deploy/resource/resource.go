@@ -0,0 +1,479 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/diag"
+	"github.com/GoogleContainerTools/skaffold/pkg/diag/validator"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+const (
+	rollOutSuccess          = "successfully rolled out"
+	jobSuccess              = "successfully completed"
+	connectionErrMsg        = "Unable to connect to the server"
+	killedErrMsg            = "signal: killed"
+	defaultPodCheckDeadline = 30 * time.Second
+	tabHeader               = " -"
+	tab                     = "  "
+	maxLogLines             = 3
+)
+
+var (
+	// `kubectl rollout status` reports success differently for deployments, daemonsets and statefulsets.
+	rollOutSuccessMsgs = []string{rollOutSuccess, "rolling update complete", "partitioned roll out complete"}
+
+	msgKubectlKilled     = "kubectl rollout status command interrupted\n"
+	MsgKubectlConnection = "kubectl connection error\n"
+
+	nonRetryContainerErrors = map[proto.StatusCode]struct{}{
+		proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR:       {},
+		proto.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR:    {},
+		proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED: {},
+		proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING: {},
+	}
+)
+
+// Type is the kind of workload tracked by the status checker.
+type Type string
+
+// ResourceTypes lists the workload kinds a status check can be run against.
+var ResourceTypes = struct {
+	Deployment     Type
+	StatefulSet    Type
+	DaemonSet      Type
+	Job            Type
+	StandalonePods Type
+}{
+	Deployment:     "deployment",
+	StatefulSet:    "statefulset",
+	DaemonSet:      "daemonset",
+	Job:            "job",
+	StandalonePods: "pods",
+}
+
+type Resource struct {
+	name         string
+	namespace    string
+	rType        Type
+	status       Status
+	statusCode   proto.StatusCode
+	done         bool
+	deadline     time.Duration
+	pods         map[string]validator.Resource
+	podValidator diag.Diagnose
+}
+
+func (r *Resource) Deadline() time.Duration {
+	return r.deadline
+}
+
+func (r *Resource) UpdateStatus(ae proto.ActionableErr) {
+	updated := newStatus(ae)
+	if r.status.Equal(updated) {
+		r.status.changed = false
+		return
+	}
+	r.status = updated
+	r.statusCode = updated.ActionableError().ErrCode
+	r.status.changed = true
+	if ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS || isErrAndNotRetryAble(ae.ErrCode) {
+		r.done = true
+	}
+}
+
+func NewResource(name string, rType Type, ns string, deadline time.Duration) *Resource {
+	return &Resource{
+		name:         name,
+		namespace:    ns,
+		rType:        rType,
+		status:       newStatus(proto.ActionableErr{}),
+		deadline:     deadline,
+		podValidator: diag.New(nil),
+	}
+}
+
+func (r *Resource) WithValidator(pd diag.Diagnose) *Resource {
+	r.podValidator = pd
+	return r
+}
+
+func (r *Resource) Type() Type {
+	return r.rType
+}
+
+func (r *Resource) CheckStatus(ctx context.Context, cfg kubectl.Config) {
+	if r.rType == ResourceTypes.StandalonePods {
+		r.checkStandalonePodsStatus(ctx)
+		return
+	}
+
+	var ae proto.ActionableErr
+	if r.rType == ResourceTypes.Job {
+		ae = r.checkJobStatus(ctx, cfg)
+	} else {
+		ae = r.checkRolloutStatus(ctx, cfg)
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
+	if ae.ErrCode == proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED {
+		ae.Message = fmt.Sprintf("received Ctrl-C or %s could not stabilize within %v: %s", r.rType, r.deadline, ae.Message)
+	}
+
+	r.UpdateStatus(ae)
+	if err := r.fetchPods(ctx); err != nil {
+		logrus.Debugf("pod statuses could be fetched this time due to %s", err)
+	}
+}
+
+// checkRolloutStatus runs `kubectl rollout status` which understands deployments, statefulsets and daemonsets.
+func (r *Resource) checkRolloutStatus(ctx context.Context, cfg kubectl.Config) proto.ActionableErr {
+	kubeCtl := kubectl.NewCLI(cfg, "")
+
+	b, err := kubeCtl.RunOut(ctx, "rollout", "status", string(r.rType), r.name, "--namespace", r.namespace, "--watch=false")
+	if ctx.Err() != nil {
+		return proto.ActionableErr{}
+	}
+
+	details := r.cleanupStatus(string(b))
+	return parseKubectlRolloutError(details, err)
+}
+
+// checkJobStatus fetches the job and inspects its conditions, since jobs
+// are not supported by `kubectl rollout status`.
+func (r *Resource) checkJobStatus(ctx context.Context, cfg kubectl.Config) proto.ActionableErr {
+	kubeCtl := kubectl.NewCLI(cfg, "")
+
+	b, err := kubeCtl.RunOut(ctx, "get", string(r.rType), r.name, "--namespace", r.namespace, "-o", "json")
+	if ctx.Err() != nil {
+		return proto.ActionableErr{}
+	}
+	if err != nil {
+		return parseKubectlRolloutError("", err)
+	}
+
+	var job batchv1.Job
+	if err := json.Unmarshal(b, &job); err != nil {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: fmt.Sprintf("could not parse job %s: %v", r.name, err),
+		}
+	}
+	return parseJobStatus(job)
+}
+
+func parseJobStatus(job batchv1.Job) proto.ActionableErr {
+	for _, c := range job.Status.Conditions {
+		if c.Status != v1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: jobSuccess,
+			}
+		case batchv1.JobFailed:
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_JOB_FAILED,
+				Message: fmt.Sprintf("job failed: %s: %s\n", c.Reason, c.Message),
+			}
+		}
+	}
+
+	completions := int32(1)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_JOB_PENDING,
+		Message: fmt.Sprintf("waiting for job to complete: %d of %d completions succeeded\n", job.Status.Succeeded, completions),
+	}
+}
+
+// checkStandalonePodsStatus derives the status from the pods themselves, since
+// pods that are not owned by any controller have no rollout status.
+func (r *Resource) checkStandalonePodsStatus(ctx context.Context) {
+	if err := r.fetchPods(ctx); err != nil {
+		logrus.Debugf("pod statuses could be fetched this time due to %s", err)
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	podsChanged := r.status.changed
+	r.UpdateStatus(parseStandalonePodsStatus(r.pods))
+	r.status.changed = r.status.changed || podsChanged
+}
+
+func parseStandalonePodsStatus(pods map[string]validator.Resource) proto.ActionableErr {
+	pending := 0
+	for _, p := range pods {
+		ae := p.ActionableError()
+		if ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS {
+			continue
+		}
+		if _, ok := nonRetryContainerErrors[ae.ErrCode]; ok {
+			return ae
+		}
+		pending++
+	}
+	if pending > 0 {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING,
+			Message: fmt.Sprintf("pods not ready: %d/%d\n", pending, len(pods)),
+		}
+	}
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+		Message: fmt.Sprintf("pods are ready: %d/%d\n", len(pods), len(pods)),
+	}
+}
+
+func (r *Resource) String() string {
+	if r.namespace == "default" {
+		return fmt.Sprintf("%s/%s", r.rType, r.name)
+	}
+
+	return fmt.Sprintf("%s:%s/%s", r.namespace, r.rType, r.name)
+}
+
+func (r *Resource) Name() string {
+	return r.name
+}
+
+func (r *Resource) Status() Status {
+	return r.status
+}
+
+func (r *Resource) IsStatusCheckCompleteOrCancelled() bool {
+	return r.done || r.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED
+}
+
+func (r *Resource) StatusMessage() string {
+	if r.rType == ResourceTypes.Job {
+		return r.status.String()
+	}
+	for _, p := range r.pods {
+		if s := p.ActionableError(); s.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
+			return fmt.Sprintf("%s\n", s.Message)
+		}
+	}
+	return r.status.String()
+}
+
+func (r *Resource) MarkComplete() {
+	r.done = true
+}
+
+// ReportSinceLastUpdated returns a string representing resource status along with tab header
+// e.g.
+//  - testNs:deployment/leeroy-app: waiting for rollout to complete. (1/2) pending
+//      - testNs:pod/leeroy-app-xvbg : error pulling container image
+func (r *Resource) ReportSinceLastUpdated(isMuted bool) string {
+	if r.status.reported && !r.status.changed {
+		return ""
+	}
+	r.status.reported = true
+	if r.status.String() == "" {
+		return ""
+	}
+	var result strings.Builder
+	// Pod container statuses can be empty.
+	// This can happen when
+	// 1. No pods have been scheduled for the deployment
+	// 2. All containers are in running phase with no errors.
+	// In such case, avoid printing any status update for the deployment.
+	for _, p := range r.pods {
+		if s := p.ActionableError().Message; s != "" {
+			result.WriteString(fmt.Sprintf("%s %s %s: %s\n", tab, tabHeader, p, s))
+			// if logs are muted, write container logs to file and last 3 lines to
+			// result.
+			out, writeTrimLines, err := withLogFile(p.Name(), &result, p.Logs(), isMuted)
+			if err != nil {
+				logrus.Debugf("could not create log file %v", err)
+			}
+			trimLines := []string{}
+			for i, l := range p.Logs() {
+				formattedLine := fmt.Sprintf("%s %s > %s\n", tab, tab, strings.TrimSuffix(l, "\n"))
+				if isMuted && i >= len(p.Logs())-maxLogLines {
+					trimLines = append(trimLines, formattedLine)
+				}
+				out.Write([]byte(formattedLine))
+			}
+			writeTrimLines(trimLines)
+		}
+	}
+	return fmt.Sprintf("%s %s: %s%s", tabHeader, r, r.StatusMessage(), result.String())
+}
+
+func (r *Resource) cleanupStatus(msg string) string {
+	clean := msg
+	for _, kind := range kubectlKindNames(r.rType) {
+		clean = strings.ReplaceAll(clean, fmt.Sprintf(`%s "%s" `, kind, r.Name()), "")
+	}
+	if len(clean) > 0 {
+		clean = strings.ToLower(clean[0:1]) + clean[1:]
+	}
+	return clean
+}
+
+// kubectlKindNames returns the names `kubectl rollout status` uses for a resource type in its messages.
+func kubectlKindNames(t Type) []string {
+	if t == ResourceTypes.DaemonSet {
+		return []string{"daemon set", string(t)}
+	}
+	return []string{string(t)}
+}
+
+// parses out connection error
+// $kubectl logs somePod -f
+// Unable to connect to the server: dial tcp x.x.x.x:443: connect: network is unreachable
+
+// Parses out errors when kubectl was killed on client side
+// $kubectl logs testPod  -f
+// 2020/06/18 17:28:31 service is running
+// Killed: 9
+func parseKubectlRolloutError(details string, err error) proto.ActionableErr {
+	switch {
+	case err == nil && isRolledOut(details):
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: details,
+		}
+	case err == nil:
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			Message: details,
+		}
+	case strings.Contains(err.Error(), connectionErrMsg):
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
+			Message: MsgKubectlConnection,
+		}
+	case strings.Contains(err.Error(), killedErrMsg):
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED,
+			Message: msgKubectlKilled,
+		}
+	default:
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: err.Error(),
+		}
+	}
+}
+
+func isRolledOut(details string) bool {
+	for _, msg := range rollOutSuccessMsgs {
+		if strings.Contains(details, msg) {
+			return true
+		}
+	}
+	return false
+}
+
+func isErrAndNotRetryAble(statusCode proto.StatusCode) bool {
+	return statusCode != proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR &&
+		statusCode != proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING &&
+		statusCode != proto.StatusCode_STATUSCHECK_JOB_PENDING &&
+		statusCode != proto.StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING
+}
+
+// HasEncounteredUnrecoverableError goes through all pod statuses and return true
+// if any cannot be recovered
+func (r *Resource) HasEncounteredUnrecoverableError() bool {
+	// Failed job pods are retried up to the job's backoff limit, so only the job conditions decide whether it failed.
+	if r.rType == ResourceTypes.Job {
+		return false
+	}
+	for _, p := range r.pods {
+		if _, ok := nonRetryContainerErrors[p.ActionableError().ErrCode]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Resource) fetchPods(ctx context.Context) error {
+	timeoutContext, cancel := context.WithTimeout(ctx, defaultPodCheckDeadline)
+	defer cancel()
+	pods, err := r.podValidator.Run(timeoutContext)
+	if err != nil {
+		return err
+	}
+
+	newPods := map[string]validator.Resource{}
+	r.status.changed = false
+	for _, p := range pods {
+		originalPod, found := r.pods[p.String()]
+		if !found || originalPod.StatusUpdated(p) {
+			r.status.changed = true
+			switch p.ActionableError().ErrCode {
+			case proto.StatusCode_STATUSCHECK_CONTAINER_CREATING,
+				proto.StatusCode_STATUSCHECK_POD_INITIALIZING:
+				event.ResourceStatusCheckEventUpdated(p.String(), p.ActionableError())
+			default:
+				event.ResourceStatusCheckEventCompleted(p.String(), p.ActionableError())
+			}
+		}
+		newPods[p.String()] = p
+	}
+	r.pods = newPods
+	return nil
+}
+
+// StatusCode() returns the resource status code if the status check is cancelled
+// or if no pod data exists for this resource.
+// If pods are fetched, this function returns the error code a pod container encountered,
+// except for jobs whose status is decided by their conditions.
+func (r *Resource) StatusCode() proto.StatusCode {
+	// do not process pod status codes if another deployment failed
+	// or the user aborted the run.
+	if r.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED || r.rType == ResourceTypes.Job {
+		return r.statusCode
+	}
+	for _, p := range r.pods {
+		if s := p.ActionableError().ErrCode; s != proto.StatusCode_STATUSCHECK_SUCCESS {
+			return s
+		}
+	}
+	return r.statusCode
+}
+
+func (r *Resource) WithPodStatuses(scs []proto.StatusCode) *Resource {
+	r.pods = map[string]validator.Resource{}
+	for i, s := range scs {
+		name := fmt.Sprintf("%s-%d", r.name, i)
+		r.pods[name] = validator.NewResource("test", "pod", "foo", validator.Status("failed"),
+			proto.ActionableErr{Message: "pod failed", ErrCode: s}, nil)
+	}
+	return r
+}
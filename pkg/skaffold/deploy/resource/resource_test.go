@@ -101,7 +101,7 @@ func TestDeploymentCheckStatus(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&util.DefaultExecCommand, test.commands)
 
-			r := NewDeployment("graph", "test", 0)
+			r := NewResource("graph", ResourceTypes.Deployment, "test", 0)
 			r.CheckStatus(context.Background(), &statusConfig{})
 
 			if test.cancelled {
@@ -120,6 +120,169 @@ func TestDeploymentCheckStatus(t *testing.T) {
 	}
 }
 
+func TestRolloutCheckStatus(t *testing.T) {
+	tests := []struct {
+		description     string
+		rType           Type
+		commands        util.Command
+		expectedDetails string
+		complete        bool
+	}{
+		{
+			description: "statefulset rolling update complete",
+			rType:       ResourceTypes.StatefulSet,
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status statefulset graph --namespace test --watch=false",
+				"statefulset rolling update complete 3 pods at revision graph-7b8f9c...",
+			),
+			expectedDetails: "statefulset rolling update complete 3 pods at revision graph-7b8f9c...",
+			complete:        true,
+		},
+		{
+			description: "statefulset waiting for pods",
+			rType:       ResourceTypes.StatefulSet,
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status statefulset graph --namespace test --watch=false",
+				"Waiting for 1 pods to be ready...",
+			),
+			expectedDetails: "waiting for 1 pods to be ready...",
+		},
+		{
+			description: "daemonset successfully rolled out",
+			rType:       ResourceTypes.DaemonSet,
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status daemonset graph --namespace test --watch=false",
+				"daemon set \"graph\" successfully rolled out",
+			),
+			expectedDetails: "successfully rolled out",
+			complete:        true,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			r := NewResource("graph", test.rType, "test", 0)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+			t.CheckDeepEqual(test.expectedDetails, r.status.ae.Message)
+		})
+	}
+}
+
+func TestJobCheckStatus(t *testing.T) {
+	getCmd := "kubectl --context kubecontext get job migrate --namespace test -o json"
+	tests := []struct {
+		description  string
+		commands     util.Command
+		expectedCode proto.StatusCode
+		complete     bool
+	}{
+		{
+			description:  "job complete",
+			commands:     testutil.CmdRunOut(getCmd, `{"status":{"succeeded":1,"conditions":[{"type":"Complete","status":"True"}]}}`),
+			expectedCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			complete:     true,
+		},
+		{
+			description:  "job failed",
+			commands:     testutil.CmdRunOut(getCmd, `{"status":{"failed":6,"conditions":[{"type":"Failed","status":"True","reason":"BackoffLimitExceeded"}]}}`),
+			expectedCode: proto.StatusCode_STATUSCHECK_JOB_FAILED,
+			complete:     true,
+		},
+		{
+			description:  "job still running",
+			commands:     testutil.CmdRunOut(getCmd, `{"spec":{"completions":2},"status":{"active":1,"succeeded":1}}`),
+			expectedCode: proto.StatusCode_STATUSCHECK_JOB_PENDING,
+		},
+		{
+			description:  "kubectl connection error",
+			commands:     testutil.CmdRunOutErr(getCmd, "", errors.New("Unable to connect to the server")),
+			expectedCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			r := NewResource("migrate", ResourceTypes.Job, "test", 0)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+			t.CheckDeepEqual(test.expectedCode, r.Status().ActionableError().ErrCode)
+		})
+	}
+}
+
+func TestJobIgnoresPodErrors(t *testing.T) {
+	tests := []struct {
+		description  string
+		rType        Type
+		expectedCode proto.StatusCode
+		unrecovered  bool
+	}{
+		{
+			description:  "job completes after a failed pod was retried",
+			rType:        ResourceTypes.Job,
+			expectedCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+		{
+			description:  "deployment with a failed pod",
+			rType:        ResourceTypes.Deployment,
+			expectedCode: proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+			unrecovered:  true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			r := NewResource("migrate", test.rType, "test", 0).
+				WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED})
+			r.UpdateStatus(proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS, Message: jobSuccess})
+
+			t.CheckDeepEqual(test.expectedCode, r.StatusCode())
+			t.CheckDeepEqual(test.unrecovered, r.HasEncounteredUnrecoverableError())
+		})
+	}
+}
+
+func TestParseStandalonePodsStatus(t *testing.T) {
+	tests := []struct {
+		description string
+		codes       []proto.StatusCode
+		expected    proto.StatusCode
+	}{
+		{
+			description: "all pods ready",
+			codes:       []proto.StatusCode{proto.StatusCode_STATUSCHECK_SUCCESS, proto.StatusCode_STATUSCHECK_SUCCESS},
+			expected:    proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+		{
+			description: "pod still creating",
+			codes:       []proto.StatusCode{proto.StatusCode_STATUSCHECK_SUCCESS, proto.StatusCode_STATUSCHECK_CONTAINER_CREATING},
+			expected:    proto.StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING,
+		},
+		{
+			description: "pod crash looping",
+			codes:       []proto.StatusCode{proto.StatusCode_STATUSCHECK_CONTAINER_CREATING, proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING},
+			expected:    proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pods := map[string]validator.Resource{}
+			for i, c := range test.codes {
+				name := fmt.Sprintf("pod-%d", i)
+				pods[name] = validator.NewResource("test", "pod", name, "Running", proto.ActionableErr{ErrCode: c}, nil)
+			}
+			t.CheckDeepEqual(test.expected, parseStandalonePodsStatus(pods).ErrCode)
+		})
+	}
+}
+
 func TestParseKubectlError(t *testing.T) {
 	tests := []struct {
 		description string
@@ -281,7 +444,7 @@ func TestReportSinceLastUpdated(t *testing.T) {
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			dep := NewDeployment("test", "test-ns", 1)
+			dep := NewResource("test", ResourceTypes.Deployment, "test-ns", 1)
 			dep.pods = map[string]validator.Resource{
 				"foo": validator.NewResource(
 					"test",
@@ -336,7 +499,7 @@ func TestReportSinceLastUpdatedMultipleTimes(t *testing.T) {
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			dep := NewDeployment("test", "test-ns", 1)
+			dep := NewResource("test", ResourceTypes.Deployment, "test-ns", 1)
 			var actual string
 			for i, status := range test.podStatuses {
 				dep.UpdateStatus(proto.ActionableErr{
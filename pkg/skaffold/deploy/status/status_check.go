@@ -55,6 +55,7 @@ var (
 const (
 	tabHeader             = " -"
 	kubernetesMaxDeadline = 600
+	standalonePodsName    = "standalone"
 )
 
 type counter struct {
@@ -76,7 +77,7 @@ type Checker interface {
 	Check(context.Context, io.Writer) error
 }
 
-// statusChecker runs status checks for pods and deployments
+// statusChecker runs status checks for pods and the workloads that own them
 type statusChecker struct {
 	cfg             Config
 	labeller        *label.DefaultLabeller
@@ -84,7 +85,7 @@ type statusChecker struct {
 	muteLogs        bool
 }
 
-// NewStatusChecker returns a status checker which runs checks on deployments and pods.
+// NewStatusChecker returns a status checker which runs checks on deployments, statefulsets, daemonsets, jobs and pods.
 func NewStatusChecker(cfg Config, labeller *label.DefaultLabeller) Checker {
 	return statusChecker{
 		muteLogs:        cfg.Muted().MuteStatusCheck(),
@@ -94,7 +95,7 @@ func NewStatusChecker(cfg Config, labeller *label.DefaultLabeller) Checker {
 	}
 }
 
-// Run runs the status checks on deployments and pods deployed in current skaffold dev iteration.
+// Run runs the status checks on workloads and pods deployed in current skaffold dev iteration.
 func (s statusChecker) Check(ctx context.Context, out io.Writer) error {
 	event.StatusCheckEventStarted()
 	errCode, err := s.statusCheck(ctx, out)
@@ -108,50 +109,69 @@ func (s statusChecker) statusCheck(ctx context.Context, out io.Writer) (proto.St
 		return proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
 
-	deployments := make([]*resource.Deployment, 0)
+	resources := make([]*resource.Resource, 0)
 	for _, n := range s.cfg.GetNamespaces() {
-		newDeployments, err := getDeployments(ctx, client, n, s.labeller,
+		newResources, err := getResources(ctx, client, n, s.labeller,
 			getDeadline(s.deadlineSeconds))
 		if err != nil {
-			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch deployments: %w", err)
+			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch resources: %w", err)
 		}
-		deployments = append(deployments, newDeployments...)
+		resources = append(resources, newResources...)
 	}
 
 	var wg sync.WaitGroup
 
-	c := newCounter(len(deployments))
+	c := newCounter(len(resources))
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	for _, d := range deployments {
+	for _, d := range resources {
 		wg.Add(1)
-		go func(r *resource.Deployment) {
+		go func(r *resource.Resource) {
 			defer wg.Done()
 			// keep updating the resource status until it fails/succeeds/times out
-			pollDeploymentStatus(ctx, s.cfg, r)
+			pollResourceStatus(ctx, s.cfg, r)
 			rcCopy := c.markProcessed(r.Status().Error())
 			s.printStatusCheckSummary(out, r, rcCopy)
-			// if one deployment fails, cancel status checks for all deployments.
+			// if one resource fails, cancel status checks for all resources.
 			if r.Status().Error() != nil && r.StatusCode() != proto.StatusCode_STATUSCHECK_USER_CANCELLED {
 				cancel()
 			}
 		}(d)
 	}
 
-	// Retrieve pending deployments statuses
+	// Retrieve pending resource statuses
 	go func() {
-		s.printDeploymentStatus(ctx, out, deployments)
+		s.printResourceStatus(ctx, out, resources)
 	}()
 
-	// Wait for all deployment statuses to be fetched
+	// Wait for all resource statuses to be fetched
 	wg.Wait()
 	cancel()
-	return getSkaffoldDeployStatus(c, deployments)
+	return getSkaffoldDeployStatus(c, resources)
 }
 
-func getDeployments(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Deployment, error) {
+// getResources returns every workload deployed in the current run, grouped by kind.
+func getResources(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	var resources []*resource.Resource
+	for _, fetch := range []func(context.Context, kubernetes.Interface, string, *label.DefaultLabeller, time.Duration) ([]*resource.Resource, error){
+		getDeployments,
+		getStatefulSets,
+		getDaemonSets,
+		getJobs,
+		getStandalonePods,
+	} {
+		rs, err := fetch(ctx, client, ns, l, deadlineDuration)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, rs...)
+	}
+	return resources, nil
+}
+
+func getDeployments(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
 	deps, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{
 		LabelSelector: l.RunIDSelector(),
 	})
@@ -159,7 +179,7 @@ func getDeployments(ctx context.Context, client kubernetes.Interface, ns string,
 		return nil, fmt.Errorf("could not fetch deployments: %w", err)
 	}
 
-	deployments := make([]*resource.Deployment, len(deps.Items))
+	deployments := make([]*resource.Resource, len(deps.Items))
 	for i, d := range deps.Items {
 		var deadline time.Duration
 		if d.Spec.ProgressDeadlineSeconds == nil || *d.Spec.ProgressDeadlineSeconds == kubernetesMaxDeadline {
@@ -167,20 +187,100 @@ func getDeployments(ctx context.Context, client kubernetes.Interface, ns string,
 		} else {
 			deadline = time.Duration(*d.Spec.ProgressDeadlineSeconds) * time.Second
 		}
-		pd := diag.New([]string{d.Namespace}).
-			WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
-			WithValidators([]validator.Validator{validator.NewPodValidator(client)})
+		pd := newPodDiagnose(client, d.Namespace, l, d.Spec.Template.Labels)
+		deployments[i] = resource.NewResource(d.Name, resource.ResourceTypes.Deployment, d.Namespace, deadline).WithValidator(pd)
+	}
+	return deployments, nil
+}
 
-		for k, v := range d.Spec.Template.Labels {
-			pd = pd.WithLabel(k, v)
+func getStatefulSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	sets, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch statefulsets: %w", err)
+	}
+
+	statefulSets := make([]*resource.Resource, len(sets.Items))
+	for i, ss := range sets.Items {
+		pd := newPodDiagnose(client, ss.Namespace, l, ss.Spec.Template.Labels)
+		statefulSets[i] = resource.NewResource(ss.Name, resource.ResourceTypes.StatefulSet, ss.Namespace, deadlineDuration).WithValidator(pd)
+	}
+	return statefulSets, nil
+}
+
+func getDaemonSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	sets, err := client.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch daemonsets: %w", err)
+	}
+
+	daemonSets := make([]*resource.Resource, len(sets.Items))
+	for i, ds := range sets.Items {
+		pd := newPodDiagnose(client, ds.Namespace, l, ds.Spec.Template.Labels)
+		daemonSets[i] = resource.NewResource(ds.Name, resource.ResourceTypes.DaemonSet, ds.Namespace, deadlineDuration).WithValidator(pd)
+	}
+	return daemonSets, nil
+}
+
+func getJobs(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	list, err := client.BatchV1().Jobs(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch jobs: %w", err)
+	}
+
+	jobs := make([]*resource.Resource, len(list.Items))
+	for i, j := range list.Items {
+		deadline := deadlineDuration
+		if j.Spec.ActiveDeadlineSeconds != nil {
+			deadline = time.Duration(*j.Spec.ActiveDeadlineSeconds) * time.Second
+		}
+		pd := newPodDiagnose(client, j.Namespace, l, j.Spec.Template.Labels)
+		jobs[i] = resource.NewResource(j.Name, resource.ResourceTypes.Job, j.Namespace, deadline).WithValidator(pd)
+	}
+	return jobs, nil
+}
+
+// getStandalonePods returns a single resource tracking all the pods in the namespace
+// that were deployed directly rather than through a controller.
+func getStandalonePods(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch pods: %w", err)
+	}
+
+	for _, p := range pods.Items {
+		if metav1.GetControllerOf(&p) != nil {
+			continue
 		}
+		pd := diag.New([]string{ns}).
+			WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
+			WithValidators([]validator.Validator{validator.NewStandalonePodValidator(client)})
+		return []*resource.Resource{
+			resource.NewResource(standalonePodsName, resource.ResourceTypes.StandalonePods, ns, deadlineDuration).WithValidator(pd),
+		}, nil
+	}
+	return nil, nil
+}
 
-		deployments[i] = resource.NewDeployment(d.Name, d.Namespace, deadline).WithValidator(pd)
+func newPodDiagnose(client kubernetes.Interface, ns string, l *label.DefaultLabeller, podLabels map[string]string) diag.Diagnose {
+	pd := diag.New([]string{ns}).
+		WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
+		WithValidators([]validator.Validator{validator.NewPodValidator(client)})
+
+	for k, v := range podLabels {
+		pd = pd.WithLabel(k, v)
 	}
-	return deployments, nil
+	return pd
 }
 
-func pollDeploymentStatus(ctx context.Context, cfg pkgkubectl.Config, r *resource.Deployment) {
+func pollResourceStatus(ctx context.Context, cfg pkgkubectl.Config, r *resource.Resource) {
 	pollDuration := time.Duration(defaultPollPeriodInMilliseconds) * time.Millisecond
 	// Add poll duration to account for one last attempt after progressDeadlineSeconds.
 	timeoutContext, cancel := context.WithTimeout(ctx, r.Deadline()+pollDuration)
@@ -220,9 +320,9 @@ func pollDeploymentStatus(ctx context.Context, cfg pkgkubectl.Config, r *resourc
 	}
 }
 
-func getSkaffoldDeployStatus(c *counter, rs []*resource.Deployment) (proto.StatusCode, error) {
+func getSkaffoldDeployStatus(c *counter, rs []*resource.Resource) (proto.StatusCode, error) {
 	if c.failed > 0 {
-		err := fmt.Errorf("%d/%d deployment(s) failed", c.failed, c.total)
+		err := fmt.Errorf("%d/%d resource(s) failed", c.failed, c.total)
 		for _, r := range rs {
 			if r.StatusCode() != proto.StatusCode_STATUSCHECK_SUCCESS {
 				return r.StatusCode(), err
@@ -239,11 +339,11 @@ func getDeadline(d int) time.Duration {
 	return DefaultStatusCheckDeadline
 }
 
-func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Deployment, c counter) {
+func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Resource, c counter) {
 	ae := r.Status().ActionableError()
 	if r.StatusCode() == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
 		// Don't print the status summary if the user ctrl-C or
-		// another deployment failed
+		// another resource failed
 		return
 	}
 	event.ResourceStatusCheckEventCompleted(r.String(), ae)
@@ -263,15 +363,15 @@ func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Deploy
 	fmt.Fprintln(out, status)
 }
 
-// printDeploymentStatus prints resource statuses until all status check are completed or context is cancelled.
-func (s statusChecker) printDeploymentStatus(ctx context.Context, out io.Writer, deployments []*resource.Deployment) {
+// printResourceStatus prints resource statuses until all status check are completed or context is cancelled.
+func (s statusChecker) printResourceStatus(ctx context.Context, out io.Writer, resources []*resource.Resource) {
 	for {
 		var allDone bool
 		select {
 		case <-ctx.Done():
 			return
 		case <-time.After(reportStatusTime):
-			allDone = s.printStatus(deployments, out)
+			allDone = s.printStatus(resources, out)
 		}
 		if allDone {
 			return
@@ -279,9 +379,9 @@ func (s statusChecker) printDeploymentStatus(ctx context.Context, out io.Writer,
 	}
 }
 
-func (s statusChecker) printStatus(deployments []*resource.Deployment, out io.Writer) bool {
+func (s statusChecker) printStatus(resources []*resource.Resource, out io.Writer) bool {
 	allDone := true
-	for _, r := range deployments {
+	for _, r := range resources {
 		if r.IsStatusCheckCompleteOrCancelled() {
 			continue
 		}
@@ -296,7 +396,7 @@ func (s statusChecker) printStatus(deployments []*resource.Deployment, out io.Wr
 
 func getPendingMessage(pending int32, total int) string {
 	if pending > 0 {
-		return fmt.Sprintf(" [%d/%d deployment(s) still pending]", pending, total)
+		return fmt.Sprintf(" [%d/%d resource(s) still pending]", pending, total)
 	}
 	return ""
 }
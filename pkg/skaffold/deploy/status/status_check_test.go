@@ -26,6 +26,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
@@ -49,7 +51,7 @@ func TestGetDeployments(t *testing.T) {
 	tests := []struct {
 		description string
 		deps        []*appsv1.Deployment
-		expected    []*resource.Deployment
+		expected    []*resource.Resource
 		shouldErr   bool
 	}{
 		{
@@ -77,9 +79,9 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(20)},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 10*time.Second),
-				resource.NewDeployment("dep2", "test", 20*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 10*time.Second),
+				resource.NewResource("dep2", resource.ResourceTypes.Deployment, "test", 20*time.Second),
 			},
 		},
 		{
@@ -97,8 +99,8 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(300)},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 300*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 300*time.Second),
 			},
 		},
 		{
@@ -124,9 +126,9 @@ func TestGetDeployments(t *testing.T) {
 					},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 100*time.Second),
-				resource.NewDeployment("dep2", "test", 200*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 100*time.Second),
+				resource.NewResource("dep2", resource.ResourceTypes.Deployment, "test", 200*time.Second),
 			},
 		},
 		{
@@ -143,13 +145,13 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(600)},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 200*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 200*time.Second),
 			},
 		},
 		{
 			description: "no deployments",
-			expected:    []*resource.Deployment{},
+			expected:    []*resource.Resource{},
 		},
 		{
 			description: "multiple deployments in different namespaces",
@@ -175,8 +177,8 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 100*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 100*time.Second),
 			},
 		},
 		{
@@ -193,7 +195,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{},
+			expected: []*resource.Resource{},
 		},
 		{
 			description: "deployment in correct namespace deployed by skaffold but different run",
@@ -209,7 +211,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{},
+			expected: []*resource.Resource{},
 		},
 	}
 
@@ -222,7 +224,66 @@ func TestGetDeployments(t *testing.T) {
 			client := fakekubeclientset.NewSimpleClientset(objs...)
 			actual, err := getDeployments(context.Background(), client, "test", labeller, 200*time.Second)
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, &test.expected, &actual,
-				cmp.AllowUnexported(resource.Deployment{}, resource.Status{}),
+				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
+				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
+		})
+	}
+}
+
+func TestGetResources(t *testing.T) {
+	labeller := label.NewLabeller(true, nil)
+	runIDLabels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	tests := []struct {
+		description string
+		objs        []runtime.Object
+		expected    []*resource.Resource
+	}{
+		{
+			description: "all workload kinds",
+			objs: []runtime.Object{
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "dep", Namespace: "test", Labels: runIDLabels}},
+				&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test", Labels: runIDLabels}},
+				&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "test", Labels: runIDLabels}},
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "test", Labels: runIDLabels},
+					Spec:       batchv1.JobSpec{ActiveDeadlineSeconds: utilpointer.Int64Ptr(30)},
+				},
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "standalone-pod", Namespace: "test", Labels: runIDLabels}},
+			},
+			expected: []*resource.Resource{
+				resource.NewResource("dep", resource.ResourceTypes.Deployment, "test", 200*time.Second),
+				resource.NewResource("db", resource.ResourceTypes.StatefulSet, "test", 200*time.Second),
+				resource.NewResource("agent", resource.ResourceTypes.DaemonSet, "test", 200*time.Second),
+				resource.NewResource("migrate", resource.ResourceTypes.Job, "test", 30*time.Second),
+				resource.NewResource("standalone", resource.ResourceTypes.StandalonePods, "test", 200*time.Second),
+			},
+		},
+		{
+			description: "pods owned by a controller are not standalone",
+			objs: []runtime.Object{
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{
+					Name:            "dep-pod",
+					Namespace:       "test",
+					Labels:          runIDLabels,
+					OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "dep", Controller: utilpointer.BoolPtr(true)}},
+				}},
+			},
+		},
+		{
+			description: "workloads from another run are ignored",
+			objs: []runtime.Object{
+				&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test", Labels: map[string]string{label.RunIDLabel: "9876-6789"}}},
+				&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "test"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(test.objs...)
+			actual, err := getResources(context.Background(), client, "test", labeller, 200*time.Second)
+			t.CheckErrorAndDeepEqual(false, err, test.expected, actual,
+				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
 				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
 		})
 	}
@@ -232,7 +293,7 @@ func TestGetDeployStatus(t *testing.T) {
 	tests := []struct {
 		description  string
 		counter      *counter
-		deployments  []*resource.Deployment
+		deployments  []*resource.Resource
 		expected     string
 		expectedCode proto.StatusCode
 		shouldErr    bool
@@ -240,24 +301,24 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "one error",
 			counter:     &counter{total: 2, failed: 1},
-			deployments: []*resource.Deployment{
-				resource.NewDeployment("foo", "test", time.Second).
+			deployments: []*resource.Resource{
+				resource.NewResource("foo", resource.ResourceTypes.Deployment, "test", time.Second).
 					WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE}),
 			},
-			expected:     "1/2 deployment(s) failed",
+			expected:     "1/2 resource(s) failed",
 			expectedCode: proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE,
 			shouldErr:    true,
 		},
 		{
 			description: "no error",
 			counter:     &counter{total: 2},
-			deployments: []*resource.Deployment{
+			deployments: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 				withStatus(
-					resource.NewDeployment("r2", "test", 1),
+					resource.NewResource("r2", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 			},
@@ -265,9 +326,9 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "multiple errors",
 			counter:     &counter{total: 3, failed: 2},
-			expected:    "2/3 deployment(s) failed",
-			deployments: []*resource.Deployment{
-				resource.NewDeployment("foo", "test", time.Second).
+			expected:    "2/3 resource(s) failed",
+			deployments: []*resource.Resource{
+				resource.NewResource("foo", resource.ResourceTypes.Deployment, "test", time.Second).
 					WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE}),
 			},
 			expectedCode: proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE,
@@ -280,9 +341,9 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "unable to retrieve pods for deployment",
 			counter:     &counter{total: 1, failed: 1},
-			deployments: []*resource.Deployment{
+			deployments: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("deployment", "test", 1),
+					resource.NewResource("deployment", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR},
 				),
 			},
@@ -344,7 +405,7 @@ func TestPrintSummaryStatus(t *testing.T) {
 			deployment:  "dep",
 			pending:     4,
 			ae:          proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
-			expected:    " - test:deployment/dep is ready. [4/10 deployment(s) still pending]\n",
+			expected:    " - test:deployment/dep is ready. [4/10 resource(s) still pending]\n",
 		},
 		{
 			description: "more than 1 deployment left and current is in error",
@@ -371,7 +432,7 @@ func TestPrintSummaryStatus(t *testing.T) {
 			rc := newCounter(10)
 			rc.pending = test.pending
 			testEvent.InitializeState([]latest_v1.Pipeline{{}})
-			r := withStatus(resource.NewDeployment(test.deployment, test.namespace, 0), test.ae)
+			r := withStatus(resource.NewResource(test.deployment, resource.ResourceTypes.Deployment, test.namespace, 0), test.ae)
 			// report status once and set it changed to false.
 			r.ReportSinceLastUpdated(false)
 			r.UpdateStatus(test.ae)
@@ -385,15 +446,15 @@ func TestPrintStatus(t *testing.T) {
 	labeller := label.NewLabeller(true, nil)
 	tests := []struct {
 		description string
-		rs          []*resource.Deployment
+		rs          []*resource.Resource
 		expectedOut string
 		expected    bool
 	}{
 		{
 			description: "single resource successful marked complete - skip print",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 			},
@@ -401,9 +462,9 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "single resource in error marked complete -skip print",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN, Message: "error"},
 				),
 			},
@@ -411,13 +472,13 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "multiple resources 1 not complete",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 				withStatus(
-					resource.NewDeployment("r2", "test", 1).
+					resource.NewResource("r2", resource.ResourceTypes.Deployment, "test", 1).
 						WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR}),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
 						Message: "pending\n"},
@@ -429,13 +490,13 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "multiple resources 1 not complete and retry-able error",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 				withStatus(
-					resource.NewDeployment("r2", "test", 1),
+					resource.NewResource("r2", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{
 						ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
 						Message: resource.MsgKubectlConnection},
@@ -446,9 +507,9 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "skip printing if status check is cancelled",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED},
 				),
 			},
@@ -469,7 +530,7 @@ func TestPrintStatus(t *testing.T) {
 	}
 }
 
-func withStatus(d *resource.Deployment, ae proto.ActionableErr) *resource.Deployment {
+func withStatus(d *resource.Resource, ae proto.ActionableErr) *resource.Resource {
 	d.UpdateStatus(ae)
 	return d
 }
@@ -538,14 +599,14 @@ func TestPollDeployment(t *testing.T) {
 	rolloutCmd := "kubectl --context kubecontext rollout status deployment dep --namespace test --watch=false"
 	tests := []struct {
 		description string
-		dep         *resource.Deployment
+		dep         *resource.Resource
 		runs        [][]validator.Resource
 		command     util.Command
 		expected    proto.StatusCode
 	}{
 		{
-			description: "pollDeploymentStatus errors out immediately when container error can't recover",
-			dep:         resource.NewDeployment("dep", "test", time.Second),
+			description: "pollResourceStatus errors out immediately when container error can't recover",
+			dep:         resource.NewResource("dep", resource.ResourceTypes.Deployment, "test", time.Second),
 			command:     testutil.CmdRunOut(rolloutCmd, "Waiting for replicas to be available"),
 			runs: [][]validator.Resource{
 				{validator.NewResource(
@@ -559,8 +620,8 @@ func TestPollDeployment(t *testing.T) {
 			expected: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
 		},
 		{
-			description: "pollDeploymentStatus waits when a container can recover and eventually succeeds",
-			dep:         resource.NewDeployment("dep", "test", time.Second),
+			description: "pollResourceStatus waits when a container can recover and eventually succeeds",
+			dep:         resource.NewResource("dep", resource.ResourceTypes.Deployment, "test", time.Second),
 			command: testutil.CmdRunOutErr(
 				// pending due to recoverable error
 				rolloutCmd, "", errors.New("Unable to connect to the server")).
@@ -595,7 +656,7 @@ func TestPollDeployment(t *testing.T) {
 			mockVal := mockValidator{runs: test.runs}
 			dep := test.dep.WithValidator(mockVal)
 
-			pollDeploymentStatus(context.Background(), &statusConfig{}, dep)
+			pollResourceStatus(context.Background(), &statusConfig{}, dep)
 
 			t.CheckDeepEqual(test.expected, test.dep.Status().ActionableError().ErrCode)
 		})
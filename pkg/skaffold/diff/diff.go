@@ -0,0 +1,361 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/restmapper"
+	"sigs.k8s.io/yaml"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+)
+
+// Status of a rendered object compared to its live version.
+const (
+	StatusAdded     = "added"
+	StatusChanged   = "changed"
+	StatusUnchanged = "unchanged"
+)
+
+// ObjectDiff is the difference between a rendered object and its live version.
+type ObjectDiff struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	// Fields lists the paths of the fields that differ in a changed object.
+	Fields []string `json:"fields,omitempty"`
+	// Diff is a unified diff between the live and the rendered object, in yaml.
+	Diff string `json:"diff,omitempty"`
+}
+
+func (d ObjectDiff) String() string {
+	if d.Namespace == "" {
+		return fmt.Sprintf("%s/%s", strings.ToLower(d.Kind), d.Name)
+	}
+	return fmt.Sprintf("%s/%s in namespace %s", strings.ToLower(d.Kind), d.Name, d.Namespace)
+}
+
+// metadata fields that are set by the API server.
+var serverManagedFields = []string{"managedFields", "resourceVersion", "uid", "creationTimestamp", "generation", "selfLink"}
+
+// annotations and labels that change with every deployment.
+var (
+	ignoredAnnotations = []string{"kubectl.kubernetes.io/last-applied-configuration", "deployment.kubernetes.io/revision"}
+	ignoredLabels      = []string{label.RunIDLabel}
+)
+
+// HasDrift returns true if at least one object is added or changed.
+func HasDrift(diffs []ObjectDiff) bool {
+	for _, d := range diffs {
+		if d.Status != StatusUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// Compute compares rendered manifests with the live objects in the cluster.
+// Objects without a namespace are looked up in the default namespace, or
+// in the namespace of the current kube context if no default namespace is given.
+// Fields that are set by the cluster but not in the manifests, like defaults and status, are ignored.
+func Compute(ctx context.Context, manifests manifest.ManifestList, defaultNamespace string) ([]ObjectDiff, error) {
+	if defaultNamespace == "" {
+		var err error
+		if defaultNamespace, err = currentNamespace(); err != nil {
+			return nil, err
+		}
+	}
+
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return nil, fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+	dynClient, err := kubernetesclient.DynamicClient()
+	if err != nil {
+		return nil, fmt.Errorf("getting Kubernetes dynamic client: %w", err)
+	}
+	groupResources, err := restmapper.GetAPIGroupResources(client.Discovery())
+	if err != nil {
+		return nil, fmt.Errorf("getting server resources: %w", err)
+	}
+	mapper := restmapper.NewDiscoveryRESTMapper(groupResources)
+
+	var diffs []ObjectDiff
+	for _, m := range manifests {
+		data, err := yaml.YAMLToJSON(m)
+		if err != nil {
+			return nil, fmt.Errorf("reading manifest: %w", err)
+		}
+		var rendered unstructured.Unstructured
+		if err := rendered.UnmarshalJSON(data); err != nil {
+			return nil, fmt.Errorf("reading manifest: %w", err)
+		}
+
+		gvk := rendered.GroupVersionKind()
+		d := ObjectDiff{
+			APIVersion: rendered.GetAPIVersion(),
+			Kind:       rendered.GetKind(),
+			Name:       rendered.GetName(),
+		}
+
+		var live *unstructured.Unstructured
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		switch {
+		case meta.IsNoMatchError(err):
+			// The type doesn't exist yet, for example a custom resource whose definition is in the manifests.
+			logrus.Debugf("Unknown resource type %s", gvk)
+		case err != nil:
+			return nil, fmt.Errorf("finding resource for %s: %w", gvk, err)
+		case mapping.Scope.Name() == meta.RESTScopeNameNamespace:
+			d.Namespace = rendered.GetNamespace()
+			if d.Namespace == "" {
+				d.Namespace = defaultNamespace
+			}
+			live, err = dynClient.Resource(mapping.Resource).Namespace(d.Namespace).Get(ctx, d.Name, metav1.GetOptions{})
+		default:
+			live, err = dynClient.Resource(mapping.Resource).Get(ctx, d.Name, metav1.GetOptions{})
+		}
+		if err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return nil, fmt.Errorf("getting %s: %w", d, err)
+		}
+
+		desired, err := normalize(rendered.Object)
+		if err != nil {
+			return nil, err
+		}
+		if live == nil {
+			d.Status = StatusAdded
+			d.Diff, err = unifiedDiff(nil, desired)
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, d)
+			continue
+		}
+
+		current, err := normalize(live.Object)
+		if err != nil {
+			return nil, err
+		}
+		current = restrictTo(current, desired).(map[string]interface{})
+		d.Diff, err = unifiedDiff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+		d.Fields = changedFields(current, desired, "")
+		d.Status = StatusChanged
+		if d.Diff == "" {
+			d.Status = StatusUnchanged
+		}
+		diffs = append(diffs, d)
+	}
+
+	return diffs, nil
+}
+
+// currentNamespace is the namespace of the current kube context.
+func currentNamespace() (string, error) {
+	cfg, err := kubectx.CurrentConfig()
+	if err != nil {
+		return "", fmt.Errorf("getting kubeconfig: %w", err)
+	}
+	if current, present := cfg.Contexts[cfg.CurrentContext]; present && current.Namespace != "" {
+		return current.Namespace, nil
+	}
+	return "default", nil
+}
+
+// normalize removes the fields that are managed by the server or that change with every deployment.
+// It also round-trips the object through json so that numbers have the same type in live and rendered objects.
+func normalize(obj map[string]interface{}) (map[string]interface{}, error) {
+	buf, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("normalizing object: %w", err)
+	}
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(buf, &normalized); err != nil {
+		return nil, fmt.Errorf("normalizing object: %w", err)
+	}
+
+	delete(normalized, "status")
+	metadata, ok := normalized["metadata"].(map[string]interface{})
+	if !ok {
+		return normalized, nil
+	}
+	for _, field := range serverManagedFields {
+		delete(metadata, field)
+	}
+	removeKeys(metadata, "annotations", ignoredAnnotations)
+	removeKeys(metadata, "labels", ignoredLabels)
+
+	return normalized, nil
+}
+
+// removeKeys removes keys from a nested map, and the nested map if it becomes empty.
+func removeKeys(obj map[string]interface{}, field string, keys []string) {
+	values, ok := obj[field].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, key := range keys {
+		delete(values, key)
+	}
+	if len(values) == 0 {
+		delete(obj, field)
+	}
+}
+
+// restrictTo keeps only the fields of a live object that are set in the rendered object.
+// This hides the defaults set by the API server.
+func restrictTo(live, rendered interface{}) interface{} {
+	switch r := rendered.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return live
+		}
+		restricted := map[string]interface{}{}
+		for k, v := range r {
+			if lv, found := l[k]; found {
+				restricted[k] = restrictTo(lv, v)
+			}
+		}
+		return restricted
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(r) {
+			return live
+		}
+		restricted := make([]interface{}, len(l))
+		for i := range l {
+			restricted[i] = restrictTo(l[i], r[i])
+		}
+		return restricted
+	default:
+		return live
+	}
+}
+
+// changedFields lists the paths of the fields that differ between two objects,
+// for example `spec.template.spec.containers[0].image`.
+func changedFields(live, rendered interface{}, path string) []string {
+	switch r := rendered.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return []string{path}
+		}
+		keys := map[string]bool{}
+		for k := range r {
+			keys[k] = true
+		}
+		for k := range l {
+			keys[k] = true
+		}
+		var sorted []string
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+
+		var fields []string
+		for _, k := range sorted {
+			field := k
+			if path != "" {
+				field = path + "." + k
+			}
+			lv, inLive := l[k]
+			rv, inRendered := r[k]
+			if !inLive || !inRendered {
+				fields = append(fields, field)
+				continue
+			}
+			fields = append(fields, changedFields(lv, rv, field)...)
+		}
+		return fields
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(r) {
+			return []string{path}
+		}
+		var fields []string
+		for i := range r {
+			fields = append(fields, changedFields(l[i], r[i], fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return fields
+	default:
+		if !reflect.DeepEqual(live, rendered) {
+			return []string{path}
+		}
+		return nil
+	}
+}
+
+// unifiedDiff returns the diff between the yaml representations of two objects,
+// or an empty string if they are identical.
+func unifiedDiff(live, rendered interface{}) (string, error) {
+	var from []string
+	if live != nil {
+		buf, err := yaml.Marshal(live)
+		if err != nil {
+			return "", err
+		}
+		from = splitLines(buf)
+	}
+	buf, err := yaml.Marshal(rendered)
+	if err != nil {
+		return "", err
+	}
+	to := splitLines(buf)
+
+	if strings.Join(from, "") == strings.Join(to, "") {
+		return "", nil
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        from,
+		B:        to,
+		FromFile: "live",
+		ToFile:   "rendered",
+		Context:  3,
+	})
+}
+
+// splitLines splits yaml into lines, keeping the line endings.
+func splitLines(buf []byte) []string {
+	lines := strings.SplitAfter(string(buf), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	fakedynclient "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	fakeclient "k8s.io/client-go/kubernetes/fake"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const renderedDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  labels:
+    app: web
+    skaffold.dev/run-id: new-run
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: web
+        image: web:v2`
+
+func liveDeployment(replicas int64, image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":              "web",
+			"namespace":         "ns",
+			"uid":               "1234",
+			"resourceVersion":   "42",
+			"generation":        int64(3),
+			"creationTimestamp": "2021-01-01T00:00:00Z",
+			"labels": map[string]interface{}{
+				"app":                 "web",
+				"skaffold.dev/run-id": "old-run",
+			},
+			"annotations": map[string]interface{}{
+				"deployment.kubernetes.io/revision": "3",
+			},
+		},
+		"spec": map[string]interface{}{
+			"replicas":             replicas,
+			"revisionHistoryLimit": int64(10),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name":                     "web",
+							"image":                    image,
+							"imagePullPolicy":          "IfNotPresent",
+							"terminationMessagePath":   "/dev/termination-log",
+							"terminationMessagePolicy": "File",
+						},
+					},
+					"restartPolicy": "Always",
+				},
+			},
+		},
+		"status": map[string]interface{}{
+			"replicas": replicas,
+		},
+	}}
+}
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		description string
+		manifests   manifest.ManifestList
+		live        []runtime.Object
+		expected    []ObjectDiff
+	}{
+		{
+			description: "unchanged, ignoring defaults and server managed fields",
+			manifests:   manifest.ManifestList{[]byte(renderedDeployment)},
+			live:        []runtime.Object{liveDeployment(2, "web:v2")},
+			expected: []ObjectDiff{
+				{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "ns", Name: "web", Status: StatusUnchanged},
+			},
+		},
+		{
+			description: "changed",
+			manifests:   manifest.ManifestList{[]byte(renderedDeployment)},
+			live:        []runtime.Object{liveDeployment(1, "web:v1")},
+			expected: []ObjectDiff{
+				{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "ns", Name: "web", Status: StatusChanged, Fields: []string{"spec.replicas", "spec.template.spec.containers[0].image"}, Diff: `--- live
++++ rendered
+@@ -5,9 +5,9 @@
+     app: web
+   name: web
+ spec:
+-  replicas: 1
++  replicas: 2
+   template:
+     spec:
+       containers:
+-      - image: web:v1
++      - image: web:v2
+         name: web
+`},
+			},
+		},
+		{
+			description: "added",
+			manifests:   manifest.ManifestList{[]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n  namespace: other\ndata:\n  key: value")},
+			expected: []ObjectDiff{
+				{APIVersion: "v1", Kind: "ConfigMap", Namespace: "other", Name: "config", Status: StatusAdded, Diff: `--- live
++++ rendered
+@@ -0,0 +1,7 @@
++apiVersion: v1
++data:
++  key: value
++kind: ConfigMap
++metadata:
++  name: config
++  namespace: other
+`},
+			},
+		},
+		{
+			description: "unknown type",
+			manifests:   manifest.ManifestList{[]byte("apiVersion: example.com/v1\nkind: Custom\nmetadata:\n  name: foo")},
+			expected: []ObjectDiff{
+				{APIVersion: "example.com/v1", Kind: "Custom", Name: "foo", Status: StatusAdded, Diff: `--- live
++++ rendered
+@@ -0,0 +1,4 @@
++apiVersion: example.com/v1
++kind: Custom
++metadata:
++  name: foo
+`},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakeclient.NewSimpleClientset()
+			client.Resources = []*metav1.APIResourceList{
+				{GroupVersion: "v1", APIResources: []metav1.APIResource{{Kind: "ConfigMap", Name: "configmaps", Namespaced: true}}},
+				{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{{Kind: "Deployment", Name: "deployments", Namespaced: true}}},
+			}
+			t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+			dynClient := fakedynclient.NewSimpleDynamicClient(runtime.NewScheme(), test.live...)
+			t.Override(&kubernetesclient.DynamicClient, func() (dynamic.Interface, error) { return dynClient, nil })
+
+			diffs, err := Compute(context.Background(), test.manifests, "ns")
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, diffs)
+			t.CheckDeepEqual(test.expected[0].Status != StatusUnchanged, HasDrift(diffs))
+		})
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/docker/go-connections/nat"
+	"github.com/sirupsen/logrus"
+)
+
+// NetworkCreate creates a network with the given name, unless it already exists.
+// Returns true if the network was created.
+func (l *localDaemon) NetworkCreate(ctx context.Context, name string, labels map[string]string) (bool, error) {
+	networks, err := l.apiClient.NetworkList(ctx, types.NetworkListOptions{
+		Filters: filters.NewArgs(filters.Arg("name", name)),
+	})
+	if err != nil {
+		return false, fmt.Errorf("listing networks: %w", err)
+	}
+	for _, n := range networks {
+		// The name filter also matches on substrings.
+		if n.Name == name {
+			return false, nil
+		}
+	}
+
+	if _, err := l.apiClient.NetworkCreate(ctx, name, types.NetworkCreate{
+		CheckDuplicate: true,
+		Labels:         labels,
+	}); err != nil {
+		return false, fmt.Errorf("creating network %q: %w", name, err)
+	}
+	return true, nil
+}
+
+// NetworkRemove removes a network, unless it doesn't exist or containers are still connected to it.
+func (l *localDaemon) NetworkRemove(ctx context.Context, name string) error {
+	inspect, err := l.apiClient.NetworkInspect(ctx, name, types.NetworkInspectOptions{})
+	if client.IsErrNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("inspecting network %q: %w", name, err)
+	}
+	if len(inspect.Containers) > 0 {
+		logrus.Debugf("Not removing network %q: %d containers are still connected to it", name, len(inspect.Containers))
+		return nil
+	}
+
+	if err := l.apiClient.NetworkRemove(ctx, name); err != nil && !client.IsErrNotFound(err) {
+		return fmt.Errorf("removing network %q: %w", name, err)
+	}
+	return nil
+}
+
+// Run creates and starts a container, pulling its image if it's not available locally.
+// Returns the id of the container.
+func (l *localDaemon) Run(ctx context.Context, out io.Writer, opts ContainerRun) (string, error) {
+	if !l.ImageExists(ctx, opts.Image) {
+		if err := l.Pull(ctx, out, opts.Image); err != nil {
+			return "", err
+		}
+	}
+
+	exposedPorts := nat.PortSet{}
+	for port := range opts.Ports {
+		exposedPorts[port] = struct{}{}
+	}
+
+	var networkingConfig *network.NetworkingConfig
+	if opts.Network != "" {
+		networkingConfig = &network.NetworkingConfig{
+			EndpointsConfig: map[string]*network.EndpointSettings{
+				opts.Network: {Aliases: opts.NetworkAliases},
+			},
+		}
+	}
+
+	created, err := l.apiClient.ContainerCreate(ctx, &container.Config{
+		Image:        opts.Image,
+		User:         opts.User,
+		Entrypoint:   opts.Entrypoint,
+		Cmd:          opts.Command,
+		Env:          opts.Env,
+		Labels:       opts.Labels,
+		ExposedPorts: exposedPorts,
+	}, &container.HostConfig{
+		Mounts:       opts.Mounts,
+		PortBindings: opts.Ports,
+	}, networkingConfig, nil, opts.Name)
+	if err != nil {
+		return "", fmt.Errorf("creating container %q: %w", opts.Name, err)
+	}
+
+	if opts.BeforeStart != nil {
+		if err := opts.BeforeStart(ctx, created.ID); err != nil {
+			return "", err
+		}
+	}
+
+	if err := l.apiClient.ContainerStart(ctx, created.ID, types.ContainerStartOptions{}); err != nil {
+		return "", fmt.Errorf("starting container %q: %w", opts.Name, err)
+	}
+	return created.ID, nil
+}
+
+// ContainerList lists all the containers, running or not, that have the given labels.
+// A label with an empty value matches the containers that have this label, whatever its value.
+func (l *localDaemon) ContainerList(ctx context.Context, labels map[string]string) ([]types.Container, error) {
+	args := filters.NewArgs()
+	for k, v := range labels {
+		if v == "" {
+			args.Add("label", k)
+			continue
+		}
+		args.Add("label", fmt.Sprintf("%s=%s", k, v))
+	}
+
+	return l.apiClient.ContainerList(ctx, types.ContainerListOptions{
+		All:     true,
+		Filters: args,
+	})
+}
+
+// ContainerLogs streams the logs of a container to w, until the container stops.
+func (l *localDaemon) ContainerLogs(ctx context.Context, w io.Writer, id string) error {
+	rc, err := l.apiClient.ContainerLogs(ctx, id, types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+	})
+	if err != nil {
+		return fmt.Errorf("getting logs of container %q: %w", id, err)
+	}
+	defer rc.Close()
+
+	_, err = stdcopy.StdCopy(w, w, rc)
+	return err
+}
+
+// ContainerRemove stops and removes a container.
+func (l *localDaemon) ContainerRemove(ctx context.Context, id string) error {
+	if err := l.apiClient.ContainerRemove(ctx, id, types.ContainerRemoveOptions{Force: true}); err != nil && !client.IsErrNotFound(err) {
+		return fmt.Errorf("removing container %q: %w", id, err)
+	}
+	return nil
+}
+
+// CopyToContainer extracts a tar archive into a directory of a container.
+func (l *localDaemon) CopyToContainer(ctx context.Context, id string, dir string, content io.Reader) error {
+	return l.apiClient.CopyToContainer(ctx, id, dir, content, types.CopyToContainerOptions{})
+}
+
+// ContainerExec runs a command in a running container and waits for it to complete.
+func (l *localDaemon) ContainerExec(ctx context.Context, id string, cmd []string) error {
+	exec, err := l.apiClient.ContainerExecCreate(ctx, id, types.ExecConfig{
+		Cmd:          cmd,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return fmt.Errorf("creating exec in container %q: %w", id, err)
+	}
+
+	resp, err := l.apiClient.ContainerExecAttach(ctx, exec.ID, types.ExecStartCheck{})
+	if err != nil {
+		return fmt.Errorf("running %v in container %q: %w", cmd, id, err)
+	}
+	defer resp.Close()
+
+	// Wait for the command to complete.
+	if _, err := io.Copy(ioutil.Discard, resp.Reader); err != nil {
+		return err
+	}
+
+	inspect, err := l.apiClient.ContainerExecInspect(ctx, exec.ID)
+	if err != nil {
+		return err
+	}
+	if inspect.ExitCode != 0 {
+		return fmt.Errorf("running %v in container %q: exit code %d", cmd, id, inspect.ExitCode)
+	}
+	return nil
+}
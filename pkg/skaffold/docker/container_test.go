@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type fakeNetworkClient struct {
+	client.CommonAPIClient
+
+	networks   map[string]types.NetworkResource
+	created    []string
+	removed    []string
+	listFilter filters.Args
+}
+
+func (f *fakeNetworkClient) NetworkList(_ context.Context, opts types.NetworkListOptions) ([]types.NetworkResource, error) {
+	var networks []types.NetworkResource
+	for _, n := range f.networks {
+		networks = append(networks, n)
+	}
+	return networks, nil
+}
+
+func (f *fakeNetworkClient) NetworkCreate(_ context.Context, name string, _ types.NetworkCreate) (types.NetworkCreateResponse, error) {
+	f.created = append(f.created, name)
+	return types.NetworkCreateResponse{}, nil
+}
+
+func (f *fakeNetworkClient) NetworkInspect(_ context.Context, name string, _ types.NetworkInspectOptions) (types.NetworkResource, error) {
+	if n, found := f.networks[name]; found {
+		return n, nil
+	}
+	return types.NetworkResource{}, errNotFound{}
+}
+
+func (f *fakeNetworkClient) NetworkRemove(_ context.Context, name string) error {
+	f.removed = append(f.removed, name)
+	return nil
+}
+
+func (f *fakeNetworkClient) ContainerList(_ context.Context, opts types.ContainerListOptions) ([]types.Container, error) {
+	f.listFilter = opts.Filters
+	return nil, nil
+}
+
+type errNotFound struct{}
+
+func (errNotFound) Error() string { return "not found" }
+func (errNotFound) NotFound()     {}
+
+func TestNetworkCreate(t *testing.T) {
+	tests := []struct {
+		description     string
+		networks        map[string]types.NetworkResource
+		expectedCreated bool
+	}{
+		{
+			description:     "create missing network",
+			networks:        map[string]types.NetworkResource{"skaffold-network-2": {Name: "skaffold-network-2"}},
+			expectedCreated: true,
+		},
+		{
+			description: "existing network",
+			networks:    map[string]types.NetworkResource{"skaffold-network": {Name: "skaffold-network"}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			fake := &fakeNetworkClient{networks: test.networks}
+			localDocker := NewLocalDaemon(fake, nil, false, nil)
+
+			created, err := localDocker.NetworkCreate(context.Background(), "skaffold-network", nil)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedCreated, created)
+			t.CheckDeepEqual(test.expectedCreated, len(fake.created) == 1)
+		})
+	}
+}
+
+func TestNetworkRemove(t *testing.T) {
+	tests := []struct {
+		description     string
+		networks        map[string]types.NetworkResource
+		expectedRemoved []string
+	}{
+		{
+			description:     "unused network",
+			networks:        map[string]types.NetworkResource{"skaffold-network": {Name: "skaffold-network"}},
+			expectedRemoved: []string{"skaffold-network"},
+		},
+		{
+			description: "network in use",
+			networks: map[string]types.NetworkResource{"skaffold-network": {
+				Name:       "skaffold-network",
+				Containers: map[string]types.EndpointResource{"id": {Name: "app"}},
+			}},
+		},
+		{
+			description: "missing network",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			fake := &fakeNetworkClient{networks: test.networks}
+			localDocker := NewLocalDaemon(fake, nil, false, nil)
+
+			err := localDocker.NetworkRemove(context.Background(), "skaffold-network")
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedRemoved, fake.removed)
+		})
+	}
+}
+
+func TestContainerListFilters(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		fake := &fakeNetworkClient{}
+		localDocker := NewLocalDaemon(fake, nil, false, nil)
+
+		_, err := localDocker.ContainerList(context.Background(), map[string]string{"owner": "", "key": "value"})
+
+		t.CheckNoError(err)
+		labels := fake.listFilter.Get("label")
+		sort.Strings(labels)
+		t.CheckDeepEqual([]string{"key=value", "owner"}, labels)
+	})
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/docker/docker/pkg/jsonmessage"
 	"github.com/docker/docker/pkg/progress"
 	"github.com/docker/docker/pkg/streamformatter"
+	"github.com/docker/go-connections/nat"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/sirupsen/logrus"
 
@@ -50,12 +51,18 @@ const (
 )
 
 type ContainerRun struct {
-	Image       string
-	User        string
-	Command     []string
-	Mounts      []mount.Mount
-	Env         []string
-	BeforeStart func(context.Context, string) error
+	Name           string
+	Image          string
+	User           string
+	Entrypoint     []string
+	Command        []string
+	Mounts         []mount.Mount
+	Env            []string
+	Labels         map[string]string
+	Network        string
+	NetworkAliases []string
+	Ports          nat.PortMap
+	BeforeStart    func(context.Context, string) error
 }
 
 // LocalDaemon talks to a local Docker API.
@@ -77,6 +84,14 @@ type LocalDaemon interface {
 	ImageList(ctx context.Context, ref string) ([]types.ImageSummary, error)
 	Prune(ctx context.Context, images []string, pruneChildren bool) ([]string, error)
 	DiskUsage(ctx context.Context) (uint64, error)
+	NetworkCreate(ctx context.Context, name string, labels map[string]string) (bool, error)
+	NetworkRemove(ctx context.Context, name string) error
+	Run(ctx context.Context, out io.Writer, opts ContainerRun) (string, error)
+	ContainerList(ctx context.Context, labels map[string]string) ([]types.Container, error)
+	ContainerLogs(ctx context.Context, w io.Writer, id string) error
+	ContainerRemove(ctx context.Context, id string) error
+	CopyToContainer(ctx context.Context, id string, dir string, content io.Reader) error
+	ContainerExec(ctx context.Context, id string, cmd []string) error
 	RawClient() client.CommonAPIClient
 }
 
@@ -85,6 +100,7 @@ type BuildOptions struct {
 	Tag            string
 	Mode           config.RunMode
 	ExtraBuildArgs map[string]*string
+	Platform       string
 }
 
 type localDaemon struct {
@@ -214,6 +230,7 @@ func (l *localDaemon) Build(ctx context.Context, out io.Writer, workspace string
 		NetworkMode: strings.ToLower(a.NetworkMode),
 		ExtraHosts:  a.AddHost,
 		NoCache:     a.NoCache,
+		Platform:    opts.Platform,
 	})
 	if err != nil {
 		return "", fmt.Errorf("docker build: %w", err)
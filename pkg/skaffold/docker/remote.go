@@ -21,8 +21,11 @@ import (
 
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/sirupsen/logrus"
 
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
@@ -33,6 +36,9 @@ var (
 	RemoteDigest = getRemoteDigest
 	remoteImage  = remote.Image
 	remoteIndex  = remote.Index
+	remoteGet    = remote.Get
+
+	remoteWriteIndex = remote.WriteIndex
 )
 
 func AddRemoteTag(src, target string, cfg Config) error {
@@ -74,6 +80,148 @@ func RetrieveRemoteConfig(identifier string, cfg Config) (*v1.ConfigFile, error)
 	return img.ConfigFile()
 }
 
+// RetrieveRemoteImage retrieves an image from its registry
+func RetrieveRemoteImage(identifier string, cfg Config) (v1.Image, error) {
+	return getRemoteImage(identifier, cfg)
+}
+
+// RetrieveRemoteImageForPlatform retrieves an image from its registry.
+// If the reference is a manifest list, the image built for the given platform is retrieved.
+func RetrieveRemoteImageForPlatform(identifier string, platform v1.Platform, cfg Config) (v1.Image, error) {
+	ref, err := parseReference(identifier, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return remoteImage(ref, remote.WithAuthFromKeychain(primaryKeychain), remote.WithPlatform(platform))
+}
+
+// RetrieveRemoteManifest retrieves the raw manifest, or index, of a remote reference
+func RetrieveRemoteManifest(identifier string, cfg Config) ([]byte, error) {
+	ref, err := parseReference(identifier, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	desc, err := remoteGet(ref, remote.WithAuthFromKeychain(primaryKeychain))
+	if err != nil {
+		return nil, err
+	}
+	return desc.Manifest, nil
+}
+
+// WriteRemoteManifest pushes a raw manifest, or index, to the given tag.
+// The blobs and manifests it references must already exist in the registry.
+func WriteRemoteManifest(manifest []byte, mediaType types.MediaType, tag string, cfg Config) error {
+	ref, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return err
+	}
+	t, ok := ref.(name.Tag)
+	if !ok {
+		return fmt.Errorf("%q is not a tag", tag)
+	}
+
+	return remote.Tag(t, &rawManifest{manifest: manifest, mediaType: mediaType}, remote.WithAuthFromKeychain(primaryKeychain))
+}
+
+// RetagRemote adds a tag to an image, or index, that's already in the registry.
+// Unlike AddRemoteTag, only the manifest is uploaded.
+func RetagRemote(src, target string, cfg Config) error {
+	logrus.Debugf("attempting to add tag %s to src %s", target, src)
+	ref, err := parseReference(src, cfg)
+	if err != nil {
+		return err
+	}
+
+	desc, err := remoteGet(ref, remote.WithAuthFromKeychain(primaryKeychain))
+	if err != nil {
+		return fmt.Errorf("getting manifest of %q: %w", src, err)
+	}
+	return WriteRemoteManifest(desc.Manifest, desc.MediaType, target, cfg)
+}
+
+// CreateManifestList pushes, to the given tag, a manifest list of images that are already in the registry.
+// Each image is listed with the platform it's built for. It returns the digest of the manifest list.
+func CreateManifestList(images []string, platforms []v1.Platform, tag string, cfg Config) (string, error) {
+	var imgs []v1.Image
+	for _, image := range images {
+		img, err := getRemoteImage(image, cfg)
+		if err != nil {
+			return "", fmt.Errorf("getting image %q: %w", image, err)
+		}
+		imgs = append(imgs, img)
+	}
+
+	idx, err := NewManifestList(imgs, platforms)
+	if err != nil {
+		return "", err
+	}
+	if err := WriteRemoteIndex(idx, tag, cfg); err != nil {
+		return "", err
+	}
+
+	return digest(idx)
+}
+
+// NewManifestList creates an in-memory manifest list of images, each listed with the platform it's built for.
+// The manifest list is a Docker manifest list if any image has a Docker manifest, and an OCI index otherwise.
+func NewManifestList(images []v1.Image, platforms []v1.Platform) (v1.ImageIndex, error) {
+	if len(images) != len(platforms) {
+		return nil, fmt.Errorf("found %d platforms for %d images", len(platforms), len(images))
+	}
+
+	var adds []mutate.IndexAddendum
+	mediaType := types.OCIImageIndex
+	for i, img := range images {
+		mt, err := img.MediaType()
+		if err != nil {
+			return nil, fmt.Errorf("getting media type of image: %w", err)
+		}
+		if mt == types.DockerManifestSchema2 {
+			mediaType = types.DockerManifestList
+		}
+
+		platform := platforms[i]
+		adds = append(adds, mutate.IndexAddendum{
+			Add:        img,
+			Descriptor: v1.Descriptor{Platform: &platform},
+		})
+	}
+	return mutate.IndexMediaType(mutate.AppendManifests(empty.Index, adds...), mediaType), nil
+}
+
+// WriteRemoteIndex pushes an in-memory index, and the images it references, to the given tag
+func WriteRemoteIndex(idx v1.ImageIndex, tag string, cfg Config) error {
+	ref, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return err
+	}
+	if err := remoteWriteIndex(ref, idx, remote.WithAuthFromKeychain(primaryKeychain)); err != nil {
+		return fmt.Errorf("%s %q: %w", sErrors.PushImageErr, tag, err)
+	}
+	return nil
+}
+
+// rawManifest is a remote.Taggable for manifests that are already serialized.
+type rawManifest struct {
+	manifest  []byte
+	mediaType types.MediaType
+}
+
+func (m *rawManifest) RawManifest() ([]byte, error)        { return m.manifest, nil }
+func (m *rawManifest) MediaType() (types.MediaType, error) { return m.mediaType, nil }
+
+// WriteRemoteImage pushes an in-memory image to the given tag
+func WriteRemoteImage(img v1.Image, tag string, cfg Config) error {
+	ref, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return err
+	}
+
+	return remote.Write(ref, img, remote.WithAuthFromKeychain(primaryKeychain))
+}
+
 // Push pushes the tarball image
 func Push(tarPath, tag string, cfg Config) (string, error) {
 	t, err := name.NewTag(tag, name.WeakValidation)
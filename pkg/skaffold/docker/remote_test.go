@@ -22,6 +22,7 @@ import (
 
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/random"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/types"
 
@@ -152,6 +153,56 @@ func TestRemoteDigest(t *testing.T) {
 	}
 }
 
+func TestCreateManifestList(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		amd64, _ := random.Image(1024, 1)
+		arm64, _ := random.Image(1024, 1)
+		images := map[string]v1.Image{
+			"index.docker.io/library/image:tag_linux_amd64": amd64,
+			"index.docker.io/library/image:tag_linux_arm64": arm64,
+		}
+		t.Override(&remoteImage, func(ref name.Reference, options ...remote.Option) (v1.Image, error) {
+			if img, found := images[ref.Name()]; found {
+				return img, nil
+			}
+			return nil, fmt.Errorf("not found: %s", ref.Name())
+		})
+		var written v1.ImageIndex
+		t.Override(&remoteWriteIndex, func(ref name.Reference, idx v1.ImageIndex, options ...remote.Option) error {
+			t.CheckDeepEqual("index.docker.io/library/image:tag", ref.Name())
+			written = idx
+			return nil
+		})
+
+		platforms := []v1.Platform{{OS: "linux", Architecture: "amd64"}, {OS: "linux", Architecture: "arm64"}}
+		digest, err := CreateManifestList([]string{"image:tag_linux_amd64", "image:tag_linux_arm64"}, platforms, "image:tag", &mockConfig{})
+		t.CheckNoError(err)
+
+		expectedDigest, _ := written.Digest()
+		t.CheckDeepEqual(expectedDigest.String(), digest)
+		manifest, _ := written.IndexManifest()
+		t.CheckDeepEqual(types.DockerManifestList, manifest.MediaType)
+		t.CheckDeepEqual(2, len(manifest.Manifests))
+		for i, img := range []v1.Image{amd64, arm64} {
+			imgDigest, _ := img.Digest()
+			t.CheckDeepEqual(imgDigest, manifest.Manifests[i].Digest)
+			t.CheckDeepEqual(&platforms[i], manifest.Manifests[i].Platform)
+		}
+	})
+}
+
+func TestCreateManifestListNotFound(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&remoteImage, func(ref name.Reference, options ...remote.Option) (v1.Image, error) {
+			return nil, fmt.Errorf("not found: %s", ref.Name())
+		})
+
+		_, err := CreateManifestList([]string{"image:tag_linux_amd64"}, []v1.Platform{{OS: "linux", Architecture: "amd64"}}, "image:tag", &mockConfig{})
+
+		t.CheckError(true, err)
+	})
+}
+
 type fakeImage struct {
 	v1.Image
 	Reference name.Reference
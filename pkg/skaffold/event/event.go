@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
 
 	//nolint:golint,staticcheck
@@ -46,6 +47,8 @@ const (
 	Succeeded  = "Succeeded"
 	Terminated = "Terminated"
 	Canceled   = "Canceled"
+	Changed    = "Changed"
+	Deleted    = "Deleted"
 )
 
 var handler = newHandler()
@@ -426,6 +429,21 @@ func FileSyncSucceeded(fileCount int, image string) {
 	handler.handleFileSyncEvent(&proto.FileSyncEvent{FileCount: int32(fileCount), Image: image, Status: Succeeded})
 }
 
+// DriftDetected notifies that a deployed resource no longer matches the applied manifests.
+func DriftDetected(resource, namespace, status string, fields []string, err error) {
+	handler.handle(&proto.Event{
+		EventType: &proto.Event_DriftEvent{
+			DriftEvent: &proto.DriftEvent{
+				Resource:      resource,
+				Namespace:     namespace,
+				Status:        status,
+				Fields:        fields,
+				ActionableErr: sErrors.ActionableErr(handler.cfg, constants.Deploy, err),
+			},
+		},
+	})
+}
+
 // PortForwarded notifies that a remote port has been forwarded locally.
 func PortForwarded(localPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address string) {
 	event := proto.PortEvent{
@@ -711,6 +729,14 @@ func (ev *eventHandler) handleExec(f firedEvent) {
 		case Terminated:
 			logEntry.Entry = fmt.Sprintf("Debuggable container terminated pod/%s:%s (%s)", de.PodName, de.ContainerName, de.Namespace)
 		}
+	case *proto.Event_DriftEvent:
+		de := e.DriftEvent
+		switch de.Status {
+		case Changed:
+			logEntry.Entry = fmt.Sprintf("Resource %s changed in the cluster: %s", de.Resource, strings.Join(de.Fields, ", "))
+		case Deleted:
+			logEntry.Entry = fmt.Sprintf("Resource %s deleted from the cluster", de.Resource)
+		}
 	case *proto.Event_DevLoopEvent:
 		de := e.DevLoopEvent
 		switch de.Status {
@@ -45,12 +45,15 @@ const (
 	Succeeded  = "Succeeded"
 	Terminated = "Terminated"
 	Canceled   = "Canceled"
+	Changed    = "Changed"
+	Deleted    = "Deleted"
 )
 
 var handler = newHandler()
 
 func newHandler() *eventHandler {
 	h := &eventHandler{
+		eventLog:  newEventRing(maxEventLogSize),
 		eventChan: make(chan *proto.Event),
 	}
 	go func() {
@@ -66,7 +69,8 @@ func newHandler() *eventHandler {
 }
 
 type eventHandler struct {
-	eventLog []proto.Event
+	eventLog *eventRing
+	lastID   int64
 	logLock  sync.Mutex
 	cfg      event.Config
 
@@ -79,8 +83,14 @@ type eventHandler struct {
 
 type listener struct {
 	callback func(*proto.Event) error
+	filter   *eventFilter
 	errors   chan error
 	closed   bool
+
+	// replaying is true while the buffered events are being sent to the listener.
+	// New events are queued until then to preserve the order.
+	replaying bool
+	queue     []proto.Event
 }
 
 func GetIteration() int {
@@ -96,6 +106,22 @@ func ForEachEvent(callback func(*proto.Event) error) error {
 	return handler.forEachEvent(callback)
 }
 
+// ValidateEventsRequest checks that the criteria of an events request are valid.
+func ValidateEventsRequest(req *proto.EventsRequest) error {
+	_, err := newEventFilter(req)
+	return err
+}
+
+// ForEachFilteredEvent calls the callback with the buffered events and then the new events
+// that match the request, starting from the event id given in the request.
+func ForEachFilteredEvent(req *proto.EventsRequest, callback func(*proto.Event) error) error {
+	filter, err := newEventFilter(req)
+	if err != nil {
+		return err
+	}
+	return handler.forEachFilteredEvent(filter, req.GetFromId(), callback)
+}
+
 func Handle(event *proto.Event) error {
 	if event != nil {
 		handler.handle(event)
@@ -118,8 +144,15 @@ func (ev *eventHandler) getState() proto.State {
 func (ev *eventHandler) logEvent(event *proto.Event) {
 	ev.logLock.Lock()
 
+	ev.lastID++
+	event.Id = ev.lastID
+
 	for _, listener := range ev.listeners {
-		if listener.closed {
+		if listener.closed || !listener.filter.matches(event) {
+			continue
+		}
+		if listener.replaying {
+			listener.queue = append(listener.queue, *event)
 			continue
 		}
 
@@ -128,29 +161,55 @@ func (ev *eventHandler) logEvent(event *proto.Event) {
 			listener.closed = true
 		}
 	}
-	ev.eventLog = append(ev.eventLog, *event)
+	ev.eventLog.add(*event)
 
 	ev.logLock.Unlock()
 }
 
 func (ev *eventHandler) forEachEvent(callback func(*proto.Event) error) error {
+	return ev.forEachFilteredEvent(&eventFilter{}, 0, callback)
+}
+
+func (ev *eventHandler) forEachFilteredEvent(filter *eventFilter, fromID int64, callback func(*proto.Event) error) error {
 	listener := &listener{
-		callback: callback,
-		errors:   make(chan error),
+		callback:  callback,
+		filter:    filter,
+		errors:    make(chan error),
+		replaying: true,
 	}
 
+	// The buffered events are copied under the lock but sent after releasing it,
+	// so that a slow subscriber doesn't block the other ones.
 	ev.logLock.Lock()
-
-	oldEvents := make([]proto.Event, len(ev.eventLog))
-	copy(oldEvents, ev.eventLog)
+	pending, err := ev.eventLog.since(fromID)
+	if err != nil {
+		ev.logLock.Unlock()
+		return err
+	}
 	ev.listeners = append(ev.listeners, listener)
-
 	ev.logLock.Unlock()
 
-	for i := range oldEvents {
-		if err := callback(&oldEvents[i]); err != nil {
-			// listener should maybe be closed
-			return err
+	for {
+		for i := range pending {
+			if !filter.matches(&pending[i]) {
+				continue
+			}
+			if err := callback(&pending[i]); err != nil {
+				ev.logLock.Lock()
+				listener.closed = true
+				ev.logLock.Unlock()
+				return err
+			}
+		}
+
+		// Send the events that were logged during the replay, until there are none left.
+		ev.logLock.Lock()
+		pending, listener.queue = listener.queue, nil
+		listener.replaying = len(pending) > 0
+		ev.logLock.Unlock()
+
+		if len(pending) == 0 {
+			break
 		}
 	}
 
@@ -329,6 +388,60 @@ func BuildSucceeded(id int, artifact string) {
 	})
 }
 
+func CacheMiss(artifact, hash, previousHash, reason string, diffs []*proto.CacheInputDiff) {
+	handler.handle(&proto.Event{
+		EventType: &proto.Event_CacheMissEvent{
+			CacheMissEvent: &proto.CacheMissEvent{
+				TaskId:       fmt.Sprintf("%s-%d", constants.Build, handler.iteration),
+				Artifact:     artifact,
+				Hash:         hash,
+				PreviousHash: previousHash,
+				Reason:       reason,
+				Diffs:        diffs,
+			},
+		},
+	})
+}
+
+// DriftDetected notifies that a deployed resource no longer matches the applied manifests.
+func DriftDetected(resource, namespace, status string, fields []string, err error) {
+	handler.handle(&proto.Event{
+		EventType: &proto.Event_DriftEvent{
+			DriftEvent: &proto.DriftEvent{
+				TaskId:        fmt.Sprintf("%s-%d", constants.DevLoop, handler.iteration),
+				Resource:      resource,
+				Namespace:     namespace,
+				Status:        status,
+				Fields:        fields,
+				ActionableErr: sErrors.ActionableErrV2(handler.cfg, constants.Deploy, err),
+			},
+		},
+	})
+}
+
+// PortForwarded notifies that a port forward is established, with the bytes it has transferred so far.
+func PortForwarded(pe *proto.PortForwardEvent) {
+	pe.TaskId = fmt.Sprintf("%s-%d", constants.PortForward, handler.iteration)
+	pe.Status = Succeeded
+	handler.handle(&proto.Event{
+		EventType: &proto.Event_PortEvent{
+			PortEvent: pe,
+		},
+	})
+}
+
+// PortForwardFailed notifies that a port forward couldn't connect to its target, or that one of its connections failed.
+func PortForwardFailed(pe *proto.PortForwardEvent, err error) {
+	pe.TaskId = fmt.Sprintf("%s-%d", constants.PortForward, handler.iteration)
+	pe.Status = Failed
+	pe.ActionableErr = sErrors.ActionableErrV2(handler.cfg, constants.PortForward, err)
+	handler.handle(&proto.Event{
+		EventType: &proto.Event_PortEvent{
+			PortEvent: pe,
+		},
+	})
+}
+
 func (ev *eventHandler) setState(state proto.State) {
 	ev.stateLock.Lock()
 	ev.state = state
@@ -378,6 +491,9 @@ func (ev *eventHandler) handleExec(event *proto.Event) {
 	case *proto.Event_PortEvent:
 		pe := e.PortEvent
 		ev.stateLock.Lock()
+		if ev.state.ForwardedPorts == nil {
+			ev.state.ForwardedPorts = map[int32]*proto.PortForwardEvent{}
+		}
 		ev.state.ForwardedPorts[pe.LocalPort] = pe
 		ev.stateLock.Unlock()
 	case *proto.Event_StatusCheckSubtaskEvent:
@@ -411,7 +527,7 @@ func (ev *eventHandler) handleExec(event *proto.Event) {
 	ev.logEvent(event)
 }
 
-// SaveEventsToFile saves the current event log to the filepath provided
+// SaveEventsToFile saves the buffered events to the filepath provided
 func SaveEventsToFile(fp string) error {
 	handler.logLock.Lock()
 	f, err := os.OpenFile(fp, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0600)
@@ -420,9 +536,9 @@ func SaveEventsToFile(fp string) error {
 	}
 	defer f.Close()
 	marshaller := jsonpb.Marshaler{}
-	for _, ev := range handler.eventLog {
+	for i := 0; i < handler.eventLog.len(); i++ {
 		contents := bytes.NewBuffer([]byte{})
-		if err := marshaller.Marshal(contents, &ev); err != nil {
+		if err := marshaller.Marshal(contents, handler.eventLog.at(i)); err != nil {
 			return fmt.Errorf("marshalling event: %w", err)
 		}
 		if _, err := f.WriteString(contents.String() + "\n"); err != nil {
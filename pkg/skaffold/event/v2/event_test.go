@@ -75,6 +75,61 @@ func TestGetLogEvents(t *testing.T) {
 	}
 }
 
+func TestForEachFilteredEvent(t *testing.T) {
+	ev := newHandler()
+	for _, id := range []string{"Build-1", "Deploy-1", "Build-2"} {
+		ev.logEvent(&proto.Event{
+			EventType: &proto.Event_TaskEvent{
+				TaskEvent: &proto.TaskEvent{Id: id},
+			},
+		})
+	}
+	go func() {
+		ev.logEvent(&proto.Event{
+			EventType: &proto.Event_TaskEvent{
+				TaskEvent: &proto.TaskEvent{Id: "Deploy-2"},
+			},
+		})
+		ev.logEvent(&proto.Event{
+			EventType: &proto.Event_TaskEvent{
+				TaskEvent: &proto.TaskEvent{Id: "Build-3"},
+			},
+		})
+	}()
+
+	filter, err := newEventFilter(&proto.EventsRequest{TaskIds: []string{"Build-1", "Build-2", "Build-3"}})
+	testutil.CheckError(t, false, err)
+
+	var received []int64
+	ev.forEachFilteredEvent(filter, 2, func(e *proto.Event) error {
+		received = append(received, e.Id)
+		if e.GetTaskEvent().Id == "Build-3" {
+			return errors.New("Done")
+		}
+		return nil
+	})
+
+	testutil.CheckDeepEqual(t, []int64{3, 5}, received)
+}
+
+func TestForEachFilteredEventDropped(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&maxEventLogSize, 2)
+
+		ev := newHandler()
+		for i := 0; i < 3; i++ {
+			ev.logEvent(&proto.Event{})
+		}
+
+		err := ev.forEachFilteredEvent(&eventFilter{}, 1, func(*proto.Event) error {
+			return nil
+		})
+
+		t.CheckErrorContains(ErrEventsDropped.Error(), err)
+		t.CheckDeepEqual(0, len(ev.listeners))
+	})
+}
+
 func TestGetState(t *testing.T) {
 	ev := newHandler()
 	ev.state = emptyState(mockCfg([]latest_v1.Pipeline{{}}, "test"))
@@ -250,7 +305,7 @@ func TestTaskFailed(t *testing.T) {
 			phase:       constants.Build,
 			waitFn: func() bool {
 				handler.logLock.Lock()
-				logEntry := handler.eventLog[len(handler.eventLog)-1]
+				logEntry := handler.eventLog.last()
 				handler.logLock.Unlock()
 				te := logEntry.GetTaskEvent()
 				return te != nil && te.Status == Failed && te.Id == "Build-0"
@@ -261,7 +316,7 @@ func TestTaskFailed(t *testing.T) {
 			phase:       constants.Deploy,
 			waitFn: func() bool {
 				handler.logLock.Lock()
-				logEntry := handler.eventLog[len(handler.eventLog)-1]
+				logEntry := handler.eventLog.last()
 				handler.logLock.Unlock()
 				te := logEntry.GetTaskEvent()
 				return te != nil && te.Status == Failed && te.Id == "Deploy-0"
@@ -272,7 +327,7 @@ func TestTaskFailed(t *testing.T) {
 			phase:       constants.StatusCheck,
 			waitFn: func() bool {
 				handler.logLock.Lock()
-				logEntry := handler.eventLog[len(handler.eventLog)-1]
+				logEntry := handler.eventLog.last()
 				handler.logLock.Unlock()
 				te := logEntry.GetTaskEvent()
 				return te != nil && te.Status == Failed && te.Id == "StatusCheck-0"
@@ -287,6 +342,24 @@ func TestTaskFailed(t *testing.T) {
 	}
 }
 
+func TestPortForwarded(t *testing.T) {
+	defer func() { handler = newHandler() }()
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latest_v1.Pipeline{{}}, "test"))
+
+	PortForwarded(&proto.PortForwardEvent{LocalPort: 8080, PodName: "pod", BytesSent: 10, BytesReceived: 20})
+	wait(t, func() bool {
+		pe := handler.getState().ForwardedPorts[8080]
+		return pe != nil && pe.Status == Succeeded && pe.TaskId == "PortForward-0" && pe.BytesReceived == 20
+	})
+
+	PortForwardFailed(&proto.PortForwardEvent{LocalPort: 8080, PodName: "pod"}, errors.New("connection refused"))
+	wait(t, func() bool {
+		pe := handler.getState().ForwardedPorts[8080]
+		return pe != nil && pe.Status == Failed && strings.Contains(pe.ActionableErr.Message, "connection refused")
+	})
+}
+
 func TestAutoTriggerDiff(t *testing.T) {
 	tests := []struct {
 		description  string
@@ -359,13 +432,13 @@ func TestSaveEventsToFile(t *testing.T) {
 	}
 
 	// add some events to the event log
-	handler.eventLog = []proto.Event{
-		{
-			EventType: &proto.Event_BuildSubtaskEvent{},
-		}, {
-			EventType: &proto.Event_TaskEvent{},
-		},
-	}
+	handler.eventLog = newEventRing(maxEventLogSize)
+	handler.eventLog.add(proto.Event{
+		EventType: &proto.Event_BuildSubtaskEvent{},
+	})
+	handler.eventLog.add(proto.Event{
+		EventType: &proto.Event_TaskEvent{},
+	})
 
 	// save events to file
 	if err := SaveEventsToFile(f.Name()); err != nil {
@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"fmt"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+)
+
+// eventTypes are the names of the event types, as they appear in the `Event` message.
+var eventTypes = []string{
+	"metaEvent",
+	"skaffoldLogEvent",
+	"applicationLogEvent",
+	"taskEvent",
+	"buildSubtaskEvent",
+	"deploySubtaskEvent",
+	"portEvent",
+	"statusCheckSubtaskEvent",
+	"fileSyncEvent",
+	"debuggingContainerEvent",
+	"terminationEvent",
+	"testEvent",
+	"cacheMissEvent",
+	"driftEvent",
+}
+
+// eventFilter selects the events sent to a subscriber.
+type eventFilter struct {
+	types       []string
+	artifacts   []string
+	taskIDs     []string
+	minSeverity proto.LogLevel
+}
+
+func newEventFilter(req *proto.EventsRequest) (*eventFilter, error) {
+	for _, t := range req.GetTypes() {
+		if !util.StrSliceContains(eventTypes, t) {
+			return nil, fmt.Errorf("unknown event type %q", t)
+		}
+	}
+
+	return &eventFilter{
+		types:       req.GetTypes(),
+		artifacts:   req.GetArtifacts(),
+		taskIDs:     req.GetTaskIds(),
+		minSeverity: req.GetMinSeverity(),
+	}, nil
+}
+
+// matches returns true if an event matches all the criteria of the filter.
+func (f *eventFilter) matches(event *proto.Event) bool {
+	eventType, payload := eventPayload(event)
+
+	if len(f.types) > 0 && !util.StrSliceContains(f.types, eventType) {
+		return false
+	}
+	if len(f.artifacts) > 0 {
+		a, ok := payload.(interface{ GetArtifact() string })
+		if !ok || !util.StrSliceContains(f.artifacts, a.GetArtifact()) {
+			return false
+		}
+	}
+	if len(f.taskIDs) > 0 && !util.StrSliceContains(f.taskIDs, taskID(payload)) {
+		return false
+	}
+	return severity(event) >= f.minSeverity
+}
+
+// eventPayload returns the type and the content of an event.
+func eventPayload(event *proto.Event) (string, interface{}) {
+	switch e := event.GetEventType().(type) {
+	case *proto.Event_MetaEvent:
+		return "metaEvent", e.MetaEvent
+	case *proto.Event_SkaffoldLogEvent:
+		return "skaffoldLogEvent", e.SkaffoldLogEvent
+	case *proto.Event_ApplicationLogEvent:
+		return "applicationLogEvent", e.ApplicationLogEvent
+	case *proto.Event_TaskEvent:
+		return "taskEvent", e.TaskEvent
+	case *proto.Event_BuildSubtaskEvent:
+		return "buildSubtaskEvent", e.BuildSubtaskEvent
+	case *proto.Event_DeploySubtaskEvent:
+		return "deploySubtaskEvent", e.DeploySubtaskEvent
+	case *proto.Event_PortEvent:
+		return "portEvent", e.PortEvent
+	case *proto.Event_StatusCheckSubtaskEvent:
+		return "statusCheckSubtaskEvent", e.StatusCheckSubtaskEvent
+	case *proto.Event_FileSyncEvent:
+		return "fileSyncEvent", e.FileSyncEvent
+	case *proto.Event_DebuggingContainerEvent:
+		return "debuggingContainerEvent", e.DebuggingContainerEvent
+	case *proto.Event_TerminationEvent:
+		return "terminationEvent", e.TerminationEvent
+	case *proto.Event_TestEvent:
+		return "testEvent", e.TestEvent
+	case *proto.Event_CacheMissEvent:
+		return "cacheMissEvent", e.CacheMissEvent
+	case *proto.Event_DriftEvent:
+		return "driftEvent", e.DriftEvent
+	default:
+		return "", nil
+	}
+}
+
+// taskID returns the id of the task an event belongs to.
+func taskID(payload interface{}) string {
+	switch e := payload.(type) {
+	case *proto.TaskEvent:
+		return e.GetId()
+	case interface{ GetTaskId() string }:
+		return e.GetTaskId()
+	default:
+		return ""
+	}
+}
+
+// severity returns the log level of an event. Failures are errors, drifts are warnings
+// and the other events are informational.
+func severity(event *proto.Event) proto.LogLevel {
+	_, payload := eventPayload(event)
+
+	switch e := payload.(type) {
+	case *proto.SkaffoldLogEvent:
+		return e.GetLevel()
+	case *proto.TerminationEvent:
+		if e.GetErr() != nil || e.GetStatus() == Failed {
+			return proto.LogLevel_ERROR
+		}
+	case *proto.DriftEvent:
+		return proto.LogLevel_WARN
+	}
+
+	if s, ok := payload.(interface{ GetStatus() string }); ok && s.GetStatus() == Failed {
+		return proto.LogLevel_ERROR
+	}
+	if a, ok := payload.(interface{ GetActionableErr() *proto.ActionableErr }); ok && a.GetActionableErr() != nil {
+		return proto.LogLevel_ERROR
+	}
+	return proto.LogLevel_INFO
+}
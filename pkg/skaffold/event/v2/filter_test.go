@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"testing"
+
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestNewEventFilter(t *testing.T) {
+	tests := []struct {
+		description string
+		request     *proto.EventsRequest
+		shouldErr   bool
+	}{
+		{
+			description: "empty request",
+			request:     &proto.EventsRequest{},
+		},
+		{
+			description: "known types",
+			request:     &proto.EventsRequest{Types: []string{"taskEvent", "driftEvent"}},
+		},
+		{
+			description: "unknown type",
+			request:     &proto.EventsRequest{Types: []string{"taskEvent", "TaskEvent"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			_, err := newEventFilter(test.request)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestEventFilterMatches(t *testing.T) {
+	buildFailed := &proto.Event{EventType: &proto.Event_BuildSubtaskEvent{BuildSubtaskEvent: &proto.BuildSubtaskEvent{TaskId: "Build-1", Artifact: "leeroy-web", Status: Failed}}}
+	cacheMiss := &proto.Event{EventType: &proto.Event_CacheMissEvent{CacheMissEvent: &proto.CacheMissEvent{TaskId: "Build-1", Artifact: "leeroy-app"}}}
+	taskStarted := &proto.Event{EventType: &proto.Event_TaskEvent{TaskEvent: &proto.TaskEvent{Id: "Deploy-1", Status: InProgress}}}
+	drift := &proto.Event{EventType: &proto.Event_DriftEvent{DriftEvent: &proto.DriftEvent{TaskId: "DevLoop-1", ActionableErr: &proto.ActionableErr{}}}}
+	warning := &proto.Event{EventType: &proto.Event_SkaffoldLogEvent{SkaffoldLogEvent: &proto.SkaffoldLogEvent{TaskId: "Deploy-1", Level: proto.LogLevel_WARN}}}
+	appLog := &proto.Event{EventType: &proto.Event_ApplicationLogEvent{ApplicationLogEvent: &proto.ApplicationLogEvent{Message: "hello"}}}
+	events := []*proto.Event{buildFailed, cacheMiss, taskStarted, drift, warning, appLog}
+
+	tests := []struct {
+		description string
+		request     *proto.EventsRequest
+		expected    []*proto.Event
+	}{
+		{
+			description: "no criteria",
+			request:     &proto.EventsRequest{},
+			expected:    events,
+		},
+		{
+			description: "by type",
+			request:     &proto.EventsRequest{Types: []string{"taskEvent", "applicationLogEvent"}},
+			expected:    []*proto.Event{taskStarted, appLog},
+		},
+		{
+			description: "by artifact",
+			request:     &proto.EventsRequest{Artifacts: []string{"leeroy-app"}},
+			expected:    []*proto.Event{cacheMiss},
+		},
+		{
+			description: "by task id",
+			request:     &proto.EventsRequest{TaskIds: []string{"Deploy-1"}},
+			expected:    []*proto.Event{taskStarted, warning},
+		},
+		{
+			description: "by severity",
+			request:     &proto.EventsRequest{MinSeverity: proto.LogLevel_WARN},
+			expected:    []*proto.Event{buildFailed, drift, warning},
+		},
+		{
+			description: "all criteria",
+			request:     &proto.EventsRequest{Types: []string{"buildSubtaskEvent", "cacheMissEvent"}, TaskIds: []string{"Build-1"}, MinSeverity: proto.LogLevel_ERROR},
+			expected:    []*proto.Event{buildFailed},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			filter, err := newEventFilter(test.request)
+			t.CheckNoError(err)
+
+			var matched []*proto.Event
+			for _, e := range events {
+				if filter.matches(e) {
+					matched = append(matched, e)
+				}
+			}
+			t.CheckDeepEqual(test.expected, matched)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"errors"
+
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+)
+
+// maxEventLogSize is the number of events kept in memory to be replayed to new subscribers.
+var maxEventLogSize = 10000
+
+// ErrEventsDropped is returned when replaying from an event id that is no longer buffered.
+var ErrEventsDropped = errors.New("events were dropped from the event log, replay from the current state instead")
+
+// eventRing is a bounded buffer that keeps the most recent events, ordered by id.
+type eventRing struct {
+	events   []proto.Event
+	start    int
+	capacity int
+}
+
+func newEventRing(capacity int) *eventRing {
+	return &eventRing{capacity: capacity}
+}
+
+// add appends an event, dropping the oldest one when the buffer is full.
+func (r *eventRing) add(event proto.Event) {
+	if len(r.events) < r.capacity {
+		r.events = append(r.events, event)
+		return
+	}
+
+	r.events[r.start] = event
+	r.start = (r.start + 1) % r.capacity
+}
+
+// len returns the number of buffered events.
+func (r *eventRing) len() int {
+	return len(r.events)
+}
+
+// at returns the i-th oldest buffered event.
+func (r *eventRing) at(i int) *proto.Event {
+	return &r.events[(r.start+i)%len(r.events)]
+}
+
+// last returns the most recent event.
+func (r *eventRing) last() *proto.Event {
+	return r.at(r.len() - 1)
+}
+
+// since returns a copy of the buffered events whose id is greater than or equal to the given one.
+// It fails if some of these events were already dropped.
+func (r *eventRing) since(id int64) ([]proto.Event, error) {
+	if r.len() > 0 && id > 0 && id < r.at(0).Id {
+		return nil, ErrEventsDropped
+	}
+
+	var events []proto.Event
+	for i := 0; i < r.len(); i++ {
+		if e := r.at(i); e.Id >= id {
+			events = append(events, *e)
+		}
+	}
+	return events, nil
+}
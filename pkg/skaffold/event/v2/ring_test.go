@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"testing"
+
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestEventRing(t *testing.T) {
+	tests := []struct {
+		description string
+		added       int
+		since       int64
+		expected    []int64
+		shouldErr   bool
+	}{
+		{
+			description: "empty",
+		},
+		{
+			description: "not full",
+			added:       2,
+			expected:    []int64{1, 2},
+		},
+		{
+			description: "oldest events are dropped",
+			added:       5,
+			expected:    []int64{3, 4, 5},
+		},
+		{
+			description: "since an id",
+			added:       5,
+			since:       4,
+			expected:    []int64{4, 5},
+		},
+		{
+			description: "since a dropped id",
+			added:       5,
+			since:       2,
+			shouldErr:   true,
+		},
+		{
+			description: "since a future id",
+			added:       5,
+			since:       6,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			ring := newEventRing(3)
+			for id := 1; id <= test.added; id++ {
+				ring.add(proto.Event{Id: int64(id)})
+			}
+
+			events, err := ring.since(test.since)
+			t.CheckError(test.shouldErr, err)
+
+			var ids []int64
+			for _, e := range events {
+				ids = append(ids, e.Id)
+			}
+			t.CheckDeepEqual(test.expected, ids)
+		})
+	}
+}
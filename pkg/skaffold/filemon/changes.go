@@ -19,10 +19,12 @@ package filemon
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/bmatcuk/doublestar"
 	"github.com/sirupsen/logrus"
 )
 
@@ -78,6 +80,13 @@ func (e *Events) String() string {
 }
 
 func events(prev, curr FileMap) Events {
+	e := diff(prev, curr)
+	logEvents(e)
+	return e
+}
+
+// diff lists the files that were added, modified or deleted between two states.
+func diff(prev, curr FileMap) Events {
 	e := Events{}
 	for f, t := range prev {
 		modtime, ok := curr[f]
@@ -105,10 +114,41 @@ func events(prev, curr FileMap) Events {
 	}
 
 	sortEvents(e)
-	logEvents(e)
 	return e
 }
 
+// filter removes the files that match any of the ignore patterns.
+// Patterns are matched against paths relative to root.
+func filter(state FileMap, root string, ignore []string) (FileMap, error) {
+	if len(ignore) == 0 {
+		return state, nil
+	}
+
+	filtered := FileMap{}
+	for path, modTime := range state {
+		relPath := path
+		if rel, err := filepath.Rel(root, path); err == nil {
+			relPath = rel
+		}
+
+		ignored := false
+		for _, pattern := range ignore {
+			matches, err := doublestar.PathMatch(filepath.FromSlash(pattern), relPath)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ignore pattern %q: %w", pattern, err)
+			}
+			if matches {
+				ignored = true
+				break
+			}
+		}
+		if !ignored {
+			filtered[path] = modTime
+		}
+	}
+	return filtered, nil
+}
+
 func sortEvents(e Events) {
 	sort.Strings(e.Added)
 	sort.Strings(e.Modified)
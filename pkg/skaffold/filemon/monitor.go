@@ -16,13 +16,36 @@ limitations under the License.
 
 package filemon
 
+import "time"
+
+// for testing
+var timeNow = time.Now
+
 // Monitor monitors files changes for multiples components.
 type Monitor interface {
 	Register(deps func() ([]string, error), onChange func(Events)) error
+	RegisterWithOptions(deps func() ([]string, error), onChange func(Events), opts WatchOptions) error
 	Run(debounce bool) error
+	Pending() (time.Duration, bool)
 	Reset()
 }
 
+// WatchOptions configures how the changes of a component are detected and batched.
+type WatchOptions struct {
+	// QuietPeriod is how long no change must be detected before the
+	// accumulated changes are reported.
+	QuietPeriod time.Duration
+
+	// MaxBatchWindow caps how long changes are accumulated, even if files keep changing.
+	MaxBatchWindow time.Duration
+
+	// Ignore lists glob patterns, relative to Root, of files whose changes are ignored.
+	Ignore []string
+
+	// Root is the directory the Ignore patterns are relative to.
+	Root string
+}
+
 type watchList struct {
 	changedComponents map[int]bool
 	components        []*component
@@ -38,58 +61,145 @@ func NewMonitor() Monitor {
 type component struct {
 	deps     func() ([]string, error)
 	onChange func(Events)
+	opts     WatchOptions
 	state    FileMap
 	events   Events
+
+	// base is the state before the first change of the current batch.
+	base        FileMap
+	firstChange time.Time
+	lastChange  time.Time
+	notified    bool
 }
 
 // Register adds a new component to the watch list.
 func (w *watchList) Register(deps func() ([]string, error), onChange func(Events)) error {
-	state, err := Stat(deps)
+	return w.RegisterWithOptions(deps, onChange, WatchOptions{})
+}
+
+// RegisterWithOptions adds a new component to the watch list, with custom watch options.
+func (w *watchList) RegisterWithOptions(deps func() ([]string, error), onChange func(Events), opts WatchOptions) error {
+	c := &component{
+		deps:     deps,
+		onChange: onChange,
+		opts:     opts,
+	}
+
+	state, err := c.stat()
 	if err != nil {
 		return err
 	}
+	c.state = state
 
-	w.components = append(w.components, &component{
-		deps:     deps,
-		onChange: onChange,
-		state:    state,
-	})
+	w.components = append(w.components, c)
 	return nil
 }
 
+// Reset forgets the changes that were reported. Changes still held by a quiet period
+// are kept, so that they are reported once the quiet period ends.
 func (w *watchList) Reset() {
-	w.changedComponents = map[int]bool{}
+	for i := range w.changedComponents {
+		if w.components[i].notified {
+			delete(w.changedComponents, i)
+		}
+	}
 }
 
 // Run watches files until the context is cancelled or an error occurs.
 func (w *watchList) Run(debounce bool) error {
+	now := timeNow()
+
 	changed := 0
 	for i, component := range w.components {
-		state, err := Stat(component.deps)
+		state, err := component.stat()
 		if err != nil {
 			return err
 		}
 		e := events(component.state, state)
 
 		if e.HasChanged() {
+			if !w.changedComponents[i] {
+				component.base = component.state
+				component.firstChange = now
+			}
 			w.changedComponents[i] = true
 			component.state = state
-			component.events = e
+			component.events = diff(component.base, state)
+			component.lastChange = now
+			component.notified = false
 			changed++
 		}
 	}
 
+	for i, component := range w.components {
+		if !w.changedComponents[i] || component.notified {
+			continue
+		}
+		if !component.ready(now, debounce, changed > 0) {
+			continue
+		}
+
+		component.notified = true
+		if !component.events.HasChanged() {
+			// Files changed back to their original state during the batch.
+			delete(w.changedComponents, i)
+			continue
+		}
+		component.onChange(component.events)
+	}
+	return nil
+}
+
+// Pending returns how long to wait before running the monitor again, to report changes
+// that are held by a quiet period.
+func (w *watchList) Pending() (time.Duration, bool) {
+	now := timeNow()
+
+	var next time.Duration
+	pending := false
+	for i, component := range w.components {
+		if !w.changedComponents[i] || component.notified || component.opts.QuietPeriod == 0 {
+			continue
+		}
+
+		wait := component.opts.QuietPeriod - now.Sub(component.lastChange)
+		if maxBatch := component.opts.MaxBatchWindow; maxBatch > 0 {
+			if untilMax := maxBatch - now.Sub(component.firstChange); untilMax < wait {
+				wait = untilMax
+			}
+		}
+		if wait < 0 {
+			wait = 0
+		}
+		if !pending || wait < next {
+			next = wait
+		}
+		pending = true
+	}
+	return next, pending
+}
+
+func (c *component) stat() (FileMap, error) {
+	state, err := Stat(c.deps)
+	if err != nil {
+		return nil, err
+	}
+	return filter(state, c.opts.Root, c.opts.Ignore)
+}
+
+// ready tells whether the accumulated changes of a component should be reported.
+func (c *component) ready(now time.Time, debounce bool, changed bool) bool {
+	if c.opts.MaxBatchWindow > 0 && now.Sub(c.firstChange) >= c.opts.MaxBatchWindow {
+		return true
+	}
+	if c.opts.QuietPeriod > 0 {
+		return now.Sub(c.lastChange) >= c.opts.QuietPeriod
+	}
+
 	// Rapid file changes that are more frequent than the poll interval would trigger
 	// multiple rebuilds.
 	// To prevent that, we debounce changes that happen too quickly
 	// by waiting for a full turn where nothing happens and trigger a rebuild for
 	// the accumulated changes.
-	if (!debounce && changed > 0) || (debounce && changed == 0 && len(w.changedComponents) > 0) {
-		for i, component := range w.components {
-			if w.changedComponents[i] {
-				component.onChange(component.events)
-			}
-		}
-	}
-	return nil
+	return !debounce || !changed
 }
@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/walk"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -74,6 +75,188 @@ func TestFileMonitor(t *testing.T) {
 	}
 }
 
+func TestFileMonitorDebounce(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("file")
+
+		monitor := NewMonitor()
+		changed := callback{}
+		err := monitor.Register(files(tmpDir), changed.call)
+		t.CheckNoError(err)
+
+		// Changes are reported after a full turn where nothing changes.
+		tmpDir.Touch("new")
+		t.CheckNoError(monitor.Run(true))
+		t.CheckDeepEqual(0, changed.calls())
+
+		tmpDir.Chtimes("file", time.Now().Add(2*time.Second))
+		t.CheckNoError(monitor.Run(true))
+		t.CheckDeepEqual(0, changed.calls())
+
+		t.CheckNoError(monitor.Run(true))
+		t.CheckDeepEqual(1, changed.calls())
+		t.CheckDeepEqual(Events{Added: []string{tmpDir.Path("new")}, Modified: []string{tmpDir.Path("file")}}, changed.events[0])
+
+		_, pending := monitor.Pending()
+		t.CheckFalse(pending)
+	})
+}
+
+func TestFileMonitorWithOptions(t *testing.T) {
+	tests := []struct {
+		description   string
+		opts          WatchOptions
+		changes       []func(folder *testutil.TempDir)
+		expectedCalls []int
+		expectedFiles []string
+	}{
+		{
+			description: "quiet period",
+			opts:        WatchOptions{QuietPeriod: 3 * time.Second},
+			changes: []func(folder *testutil.TempDir){
+				func(folder *testutil.TempDir) { folder.Touch("a") },
+				func(folder *testutil.TempDir) { folder.Touch("b") },
+				nil,
+				nil,
+				nil,
+			},
+			expectedCalls: []int{0, 0, 0, 0, 1},
+			expectedFiles: []string{"a", "b"},
+		},
+		{
+			description: "max batch window",
+			opts:        WatchOptions{QuietPeriod: 3 * time.Second, MaxBatchWindow: 2 * time.Second},
+			changes: []func(folder *testutil.TempDir){
+				func(folder *testutil.TempDir) { folder.Touch("a") },
+				func(folder *testutil.TempDir) { folder.Touch("b") },
+				func(folder *testutil.TempDir) { folder.Touch("c") },
+			},
+			expectedCalls: []int{0, 0, 1},
+			expectedFiles: []string{"a", "b", "c"},
+		},
+		{
+			description: "ignored files",
+			opts:        WatchOptions{Ignore: []string{"**/*.swp", "tmp/**"}},
+			changes: []func(folder *testutil.TempDir){
+				func(folder *testutil.TempDir) { folder.Touch("a.swp", "tmp/b", "dir/c.swp") },
+				func(folder *testutil.TempDir) { folder.Touch("d") },
+			},
+			expectedCalls: []int{0, 1},
+			expectedFiles: []string{"d"},
+		},
+		{
+			description: "files changed back",
+			opts:        WatchOptions{QuietPeriod: 2 * time.Second},
+			changes: []func(folder *testutil.TempDir){
+				func(folder *testutil.TempDir) { folder.Touch("a") },
+				func(folder *testutil.TempDir) { folder.Remove("a") },
+				nil,
+				nil,
+			},
+			expectedCalls: []int{0, 0, 0, 0},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			now := time.Now()
+			t.Override(&timeNow, func() time.Time { return now })
+
+			tmpDir := t.NewTempDir().Touch("file")
+			test.opts.Root = tmpDir.Root()
+
+			monitor := NewMonitor()
+			changed := callback{}
+			err := monitor.RegisterWithOptions(files(tmpDir), changed.call, test.opts)
+			t.CheckNoError(err)
+
+			for i, change := range test.changes {
+				if change != nil {
+					change(tmpDir)
+				}
+				t.CheckNoError(monitor.Run(false))
+				t.CheckDeepEqual(test.expectedCalls[i], changed.calls())
+
+				now = now.Add(time.Second)
+			}
+
+			if len(test.expectedFiles) > 0 {
+				t.CheckDeepEqual(tmpDir.Paths(test.expectedFiles...), changed.events[0].Added)
+			}
+			_, pending := monitor.Pending()
+			t.CheckFalse(pending)
+		})
+	}
+}
+
+func TestPending(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		now := time.Now()
+		t.Override(&timeNow, func() time.Time { return now })
+		tmpDir := t.NewTempDir().Touch("file")
+
+		monitor := NewMonitor()
+		err := monitor.RegisterWithOptions(files(tmpDir), func(Events) {}, WatchOptions{QuietPeriod: 5 * time.Second, MaxBatchWindow: 6 * time.Second})
+		t.CheckNoError(err)
+
+		_, pending := monitor.Pending()
+		t.CheckFalse(pending)
+
+		tmpDir.Touch("a")
+		t.CheckNoError(monitor.Run(false))
+		now = now.Add(2 * time.Second)
+		wait, pending := monitor.Pending()
+		t.CheckTrue(pending)
+		t.CheckDeepEqual(3*time.Second, wait)
+
+		tmpDir.Touch("b")
+		t.CheckNoError(monitor.Run(false))
+		now = now.Add(3 * time.Second)
+		wait, pending = monitor.Pending()
+		t.CheckTrue(pending)
+		t.CheckDeepEqual(time.Second, wait)
+	})
+}
+
+func TestResetKeepsPendingChanges(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		now := time.Now()
+		t.Override(&timeNow, func() time.Time { return now })
+		quietDir := t.NewTempDir().Touch("file")
+		eagerDir := t.NewTempDir().Touch("file")
+
+		monitor := NewMonitor()
+		quiet := callback{}
+		eager := callback{}
+		t.CheckNoError(monitor.RegisterWithOptions(files(quietDir), quiet.call, WatchOptions{QuietPeriod: 3 * time.Second}))
+		t.CheckNoError(monitor.Register(files(eagerDir), eager.call))
+
+		quietDir.Touch("a")
+		eagerDir.Touch("b")
+		t.CheckNoError(monitor.Run(false))
+		t.CheckDeepEqual(0, quiet.calls())
+		t.CheckDeepEqual(1, eager.calls())
+
+		// The dev loop handles the reported changes.
+		monitor.Reset()
+
+		now = now.Add(time.Second)
+		quietDir.Touch("c")
+		t.CheckNoError(monitor.Run(false))
+		_, pending := monitor.Pending()
+		t.CheckTrue(pending)
+
+		now = now.Add(3 * time.Second)
+		t.CheckNoError(monitor.Run(false))
+		t.CheckDeepEqual(1, quiet.calls())
+		t.CheckDeepEqual(quietDir.Paths("a", "c"), quiet.events[0].Added)
+		t.CheckDeepEqual(1, eager.calls())
+
+		monitor.Reset()
+		_, pending = monitor.Pending()
+		t.CheckFalse(pending)
+	})
+}
+
 type callback struct {
 	events []Events
 }
@@ -85,3 +268,10 @@ func (c *callback) call(e Events) {
 func (c *callback) calls() int {
 	return len(c.events)
 }
+
+// files lists the regular files in a temp directory.
+func files(folder *testutil.TempDir) func() ([]string, error) {
+	return func() ([]string, error) {
+		return walk.From(folder.Root()).WhenIsFile().CollectPaths()
+	}
+}
@@ -22,6 +22,8 @@ import latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/l
 type Artifact struct {
 	ImageName string `json:"imageName"`
 	Tag       string `json:"tag"`
+	// AdditionalTags are the other tags the image was pushed with.
+	AdditionalTags []string `json:"additionalTags,omitempty"`
 }
 
 // ArtifactGraph is a map of [artifact image : artifact definition]
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/segmentio/textio"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// containerSelector decides whether a hook should run in the given container.
+type containerSelector func(v1.Pod, v1.Container) bool
+
+// containerHook represents a lifecycle hook to be executed inside running containers
+type containerHook struct {
+	cfg         latest_v1.ContainerHook
+	cli         *kubectl.CLI
+	namespaces  []string
+	runID       string // if set, only the pods deployed by this run are considered
+	selector    containerSelector
+	description string // describes the selected containers in error messages
+	required    bool   // if set, it is an error for no container to match the selector
+}
+
+// run executes the lifecycle hook in every running container matching the selector
+func (h containerHook) run(ctx context.Context, p phase, out io.Writer) error {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	var listOptions metav1.ListOptions
+	if h.runID != "" {
+		listOptions.LabelSelector = fmt.Sprintf("%s=%s", label.RunIDLabel, h.runID)
+	}
+
+	matched := 0
+	for _, ns := range h.namespaces {
+		pods, err := client.CoreV1().Pods(ns).List(ctx, listOptions)
+		if err != nil {
+			return fmt.Errorf("getting pods for namespace %q: %w", ns, err)
+		}
+
+		for _, pod := range pods.Items {
+			if pod.Status.Phase != v1.PodRunning {
+				continue
+			}
+			for _, c := range pod.Spec.Containers {
+				if !h.selector(pod, c) {
+					continue
+				}
+				matched++
+
+				args := append([]string{pod.Name, "--namespace", pod.Namespace, "-c", c.Name, "--"}, h.cfg.Command...)
+				cmd := h.cli.Command(ctx, "exec", args...)
+				w := textio.NewPrefixWriter(out, fmt.Sprintf("[%s %s] ", pod.Name, c.Name))
+				cmd.Stdout = w
+				cmd.Stderr = w
+
+				err := util.RunCmd(cmd)
+				w.Flush()
+				if err != nil {
+					return hookErr(ctx, p, h.cfg.Command, err)
+				}
+			}
+		}
+	}
+
+	if matched == 0 {
+		if h.required {
+			return containerNotFoundErr(p, h.cfg.Command, h.description)
+		}
+		logrus.Infof("Skipping %s hook %q: no running container matching %s", p, h.cfg.Command, h.description)
+	}
+	return nil
+}
+
+// ContainerExec runs a command in the Docker container with the given id.
+type ContainerExec func(ctx context.Context, id string, cmd []string) error
+
+// dockerContainerHook represents a lifecycle hook to be executed inside containers run with the local Docker daemon
+type dockerContainerHook struct {
+	cfg         latest_v1.ContainerHook
+	exec        ContainerExec
+	ids         []string
+	description string // describes the selected containers in error messages
+}
+
+// run executes the lifecycle hook in every selected container
+func (h dockerContainerHook) run(ctx context.Context, p phase, _ io.Writer) error {
+	if len(h.ids) == 0 {
+		return containerNotFoundErr(p, h.cfg.Command, h.description)
+	}
+	for _, id := range h.ids {
+		if err := h.exec(ctx, id, h.cfg.Command); err != nil {
+			return hookErr(ctx, p, h.cfg.Command, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// BuildEnvOpts contains the values exposed to build hooks as environment variables.
+type BuildEnvOpts struct {
+	Image        string
+	ImageRepo    string
+	ImageTag     string
+	BuildContext string
+}
+
+// SyncEnvOpts contains the values exposed to sync hooks as environment variables.
+type SyncEnvOpts struct {
+	Image                string
+	BuildContext         string
+	FilesAddedOrModified []string
+	FilesDeleted         []string
+	KubeContext          string
+	Namespaces           []string
+}
+
+// DeployEnvOpts contains the values exposed to deploy hooks as environment variables.
+type DeployEnvOpts struct {
+	RunID       string
+	KubeContext string
+	Namespaces  []string
+}
+
+// NewBuildEnvOpts returns the build hooks environment for artifact `a` being built as `image`.
+func NewBuildEnvOpts(a *latest_v1.Artifact, image string) (BuildEnvOpts, error) {
+	ref, err := docker.ParseReference(image)
+	if err != nil {
+		return BuildEnvOpts{}, err
+	}
+	return BuildEnvOpts{
+		Image:        image,
+		ImageRepo:    ref.Repo,
+		ImageTag:     ref.Tag,
+		BuildContext: a.Workspace,
+	}, nil
+}
+
+// NewSyncEnvOpts returns the sync hooks environment for artifact `a` being synced to `image`.
+func NewSyncEnvOpts(a *latest_v1.Artifact, image string, addOrModified, deleted map[string][]string, namespaces []string, kubeContext string) SyncEnvOpts {
+	return SyncEnvOpts{
+		Image:                image,
+		BuildContext:         a.Workspace,
+		FilesAddedOrModified: sortedKeys(addOrModified),
+		FilesDeleted:         sortedKeys(deleted),
+		KubeContext:          kubeContext,
+		Namespaces:           namespaces,
+	}
+}
+
+func sortedKeys(m map[string][]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (o BuildEnvOpts) env() []string {
+	return []string{
+		"SKAFFOLD_IMAGE=" + o.Image,
+		"SKAFFOLD_IMAGE_REPO=" + o.ImageRepo,
+		"SKAFFOLD_IMAGE_TAG=" + o.ImageTag,
+		"SKAFFOLD_BUILD_CONTEXT=" + o.BuildContext,
+	}
+}
+
+func (o SyncEnvOpts) env() []string {
+	return []string{
+		"SKAFFOLD_IMAGE=" + o.Image,
+		"SKAFFOLD_BUILD_CONTEXT=" + o.BuildContext,
+		"SKAFFOLD_FILES_ADDED_OR_MODIFIED=" + strings.Join(o.FilesAddedOrModified, ","),
+		"SKAFFOLD_FILES_DELETED=" + strings.Join(o.FilesDeleted, ","),
+		"SKAFFOLD_KUBE_CONTEXT=" + o.KubeContext,
+		"SKAFFOLD_NAMESPACES=" + strings.Join(o.Namespaces, ","),
+	}
+}
+
+func (o DeployEnvOpts) env() []string {
+	return []string{
+		"SKAFFOLD_RUN_ID=" + o.RunID,
+		"SKAFFOLD_KUBE_CONTEXT=" + o.KubeContext,
+		"SKAFFOLD_NAMESPACES=" + strings.Join(o.Namespaces, ","),
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+func hookErr(ctx context.Context, p phase, command []string, err error) error {
+	if ctx.Err() != nil {
+		return sErrors.NewError(err,
+			proto.ActionableErr{
+				Message: fmt.Sprintf("%s hook %q cancelled", p, command),
+				ErrCode: proto.StatusCode_HOOK_CANCELLED,
+			})
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return sErrors.NewError(err,
+			proto.ActionableErr{
+				Message: fmt.Sprintf("%s hook %q exited with non-zero exit code %d", p, command, exitErr.ExitCode()),
+				ErrCode: proto.StatusCode_HOOK_NON_ZERO_EXIT_ERR,
+				Suggestions: []*proto.Suggestion{
+					{
+						SuggestionCode: proto.SuggestionCode_CHECK_HOOK_COMMAND,
+						Action:         "Check the hook command and its output above",
+					},
+				},
+			})
+	}
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("running %s hook %q: %s", p, command, err),
+			ErrCode: proto.StatusCode_HOOK_RUN_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CHECK_HOOK_COMMAND,
+					Action:         "Check that the hook command exists and is executable",
+				},
+			},
+		})
+}
+
+func containerNotFoundErr(p phase, command []string, selector string) error {
+	return sErrors.NewErrorWithStatusCode(
+		proto.ActionableErr{
+			Message: fmt.Sprintf("no running container found matching %s for %s hook %q", selector, p, command),
+			ErrCode: proto.StatusCode_HOOK_CONTAINER_NOT_FOUND_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CHECK_HOOK_CONTAINER,
+					Action:         "Check that the hook's pod and container names match a running container",
+				},
+			},
+		})
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"runtime"
+
+	"github.com/sirupsen/logrus"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// hostHook represents a lifecycle hook to be executed on the host machine
+type hostHook struct {
+	cfg latest_v1.HostHook
+	env []string // environment variables to set in the hook process
+}
+
+// run executes the lifecycle hook on the host machine
+func (h hostHook) run(ctx context.Context, p phase, out io.Writer) error {
+	if len(h.cfg.OS) > 0 && !util.StrSliceContains(h.cfg.OS, runtime.GOOS) {
+		logrus.Infof("Skipping %s hook %q on OS %q", p, h.cfg.Command, runtime.GOOS)
+		return nil
+	}
+
+	cmd := exec.CommandContext(ctx, h.cfg.Command[0], h.cfg.Command[1:]...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	cmd.Env = append(util.OSEnviron(), h.env...)
+
+	if err := util.RunCmd(cmd); err != nil {
+		return hookErr(ctx, p, h.cfg.Command, err)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/segmentio/textio"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// hook is a single lifecycle hook, either on the host or in containers.
+type hook interface {
+	run(ctx context.Context, p phase, out io.Writer) error
+}
+
+// runner executes the pre and post hooks of a single phase.
+type runner struct {
+	preHooks  []hook
+	postHooks []hook
+	prePhase  phase
+	postPhase phase
+}
+
+// NewBuildRunner returns a Runner for the build hooks of an artifact.
+func NewBuildRunner(cfg latest_v1.BuildHooks, opts BuildEnvOpts) Runner {
+	env := opts.env()
+	r := runner{prePhase: phases.PreBuild, postPhase: phases.PostBuild}
+	for _, h := range cfg.PreHooks {
+		r.preHooks = append(r.preHooks, hostHook{cfg: h, env: env})
+	}
+	for _, h := range cfg.PostHooks {
+		r.postHooks = append(r.postHooks, hostHook{cfg: h, env: env})
+	}
+	return r
+}
+
+// NewSyncRunner returns a Runner for the sync hooks of an artifact.
+// Container hooks run in every running container of `opts.Image` in the pods deployed by run `runID`.
+func NewSyncRunner(cli *kubectl.CLI, cfg latest_v1.SyncHooks, opts SyncEnvOpts, runID string) Runner {
+	env := opts.env()
+	selector := func(_ v1.Pod, c v1.Container) bool { return c.Image == opts.Image }
+	newHook := func(h latest_v1.SyncHookItem) hook {
+		if h.HostHook != nil {
+			return hostHook{cfg: *h.HostHook, env: env}
+		}
+		return containerHook{
+			cfg:         *h.ContainerHook,
+			cli:         cli,
+			namespaces:  opts.Namespaces,
+			runID:       runID,
+			selector:    selector,
+			description: fmt.Sprintf("image %q", opts.Image),
+			required:    true,
+		}
+	}
+
+	r := runner{prePhase: phases.PreSync, postPhase: phases.PostSync}
+	for _, h := range cfg.PreHooks {
+		r.preHooks = append(r.preHooks, newHook(h))
+	}
+	for _, h := range cfg.PostHooks {
+		r.postHooks = append(r.postHooks, newHook(h))
+	}
+	return r
+}
+
+// NewDockerSyncRunner returns a Runner for the sync hooks of an artifact run with the local Docker daemon.
+// Container hooks run in the containers with the given ids.
+func NewDockerSyncRunner(exec ContainerExec, ids []string, cfg latest_v1.SyncHooks, opts SyncEnvOpts) Runner {
+	env := opts.env()
+	newHook := func(h latest_v1.SyncHookItem) hook {
+		if h.HostHook != nil {
+			return hostHook{cfg: *h.HostHook, env: env}
+		}
+		return dockerContainerHook{
+			cfg:         *h.ContainerHook,
+			exec:        exec,
+			ids:         ids,
+			description: fmt.Sprintf("image %q", opts.Image),
+		}
+	}
+
+	r := runner{prePhase: phases.PreSync, postPhase: phases.PostSync}
+	for _, h := range cfg.PreHooks {
+		r.preHooks = append(r.preHooks, newHook(h))
+	}
+	for _, h := range cfg.PostHooks {
+		r.postHooks = append(r.postHooks, newHook(h))
+	}
+	return r
+}
+
+// NewDeployRunner returns a Runner for the given deploy hooks.
+// Container hooks run in every running container matching their pod and container names, in the pods deployed by `opts.RunID`.
+// Before a deploy, such containers may not exist yet, in which case the hook is skipped.
+func NewDeployRunner(cli *kubectl.CLI, cfg latest_v1.DeployHooks, opts DeployEnvOpts) Runner {
+	env := opts.env()
+	newHook := func(h latest_v1.DeployHookItem, required bool) hook {
+		if h.HostHook != nil {
+			return hostHook{cfg: *h.HostHook, env: env}
+		}
+		c := h.ContainerHook
+		return containerHook{
+			cfg:         c.ContainerHook,
+			cli:         cli,
+			namespaces:  opts.Namespaces,
+			runID:       opts.RunID,
+			selector:    namedContainerSelector(c.PodName, c.ContainerName),
+			description: fmt.Sprintf("pod %q and container %q", c.PodName, c.ContainerName),
+			required:    required,
+		}
+	}
+
+	r := runner{prePhase: phases.PreDeploy, postPhase: phases.PostDeploy}
+	for _, h := range cfg.PreHooks {
+		r.preHooks = append(r.preHooks, newHook(h, false))
+	}
+	for _, h := range cfg.PostHooks {
+		r.postHooks = append(r.postHooks, newHook(h, true))
+	}
+	return r
+}
+
+// namedContainerSelector matches containers by pod and container name glob patterns.
+// An empty container name pattern matches all containers.
+func namedContainerSelector(podName, containerName string) containerSelector {
+	return func(p v1.Pod, c v1.Container) bool {
+		if matched, _ := path.Match(podName, p.Name); !matched {
+			return false
+		}
+		if containerName == "" {
+			return true
+		}
+		matched, _ := path.Match(containerName, c.Name)
+		return matched
+	}
+}
+
+func (r runner) RunPreHooks(ctx context.Context, out io.Writer) error {
+	return run(ctx, out, r.prePhase, r.preHooks)
+}
+
+func (r runner) RunPostHooks(ctx context.Context, out io.Writer) error {
+	return run(ctx, out, r.postPhase, r.postHooks)
+}
+
+func run(ctx context.Context, out io.Writer, p phase, hooks []hook) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	color.Default.Fprintf(out, "Starting %s hooks...\n", p)
+	w := textio.NewPrefixWriter(out, " - ")
+	defer w.Flush()
+	for _, h := range hooks {
+		if err := h.run(ctx, p, w); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	color.Default.Fprintf(out, "Completed %s hooks\n", p)
+	return nil
+}
@@ -0,0 +1,381 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuildHooks(t *testing.T) {
+	opts := BuildEnvOpts{Image: "gcr.io/foo/img:tag", ImageRepo: "gcr.io/foo/img", ImageTag: "tag", BuildContext: "app"}
+	env := []string{"SKAFFOLD_IMAGE=gcr.io/foo/img:tag", "SKAFFOLD_IMAGE_REPO=gcr.io/foo/img", "SKAFFOLD_IMAGE_TAG=tag", "SKAFFOLD_BUILD_CONTEXT=app"}
+
+	tests := []struct {
+		description string
+		hooks       latest_v1.BuildHooks
+		commands    *testutil.FakeCmd
+		pre         bool
+		expectedOut string
+		shouldErr   bool
+		errCode     proto.StatusCode
+	}{
+		{
+			description: "no hooks",
+			pre:         true,
+		},
+		{
+			description: "pre hook",
+			hooks: latest_v1.BuildHooks{
+				PreHooks: []latest_v1.HostHook{{Command: []string{"sh", "-c", "echo pre"}}},
+			},
+			commands:    testutil.CmdRunEnv("sh -c echo pre", env),
+			pre:         true,
+			expectedOut: "Starting pre-build hooks...\nCompleted pre-build hooks\n",
+		},
+		{
+			description: "post hooks run in order",
+			hooks: latest_v1.BuildHooks{
+				PostHooks: []latest_v1.HostHook{{Command: []string{"first"}}, {Command: []string{"second"}}},
+			},
+			commands:    testutil.CmdRun("first").AndRun("second"),
+			expectedOut: "Starting post-build hooks...\nCompleted post-build hooks\n",
+		},
+		{
+			description: "hook output is prefixed",
+			hooks: latest_v1.BuildHooks{
+				PreHooks: []latest_v1.HostHook{{Command: []string{"generate"}}},
+			},
+			commands:    testutil.CmdRunWithOutput("generate", "line 1\nline 2\n"),
+			pre:         true,
+			expectedOut: "Starting pre-build hooks...\n - line 1\n - line 2\nCompleted pre-build hooks\n",
+		},
+		{
+			description: "hook skipped on other OS",
+			hooks: latest_v1.BuildHooks{
+				PreHooks: []latest_v1.HostHook{{Command: []string{"generate"}, OS: []string{"not-" + runtime.GOOS}}},
+			},
+			pre:         true,
+			expectedOut: "Starting pre-build hooks...\nCompleted pre-build hooks\n",
+		},
+		{
+			description: "hook fails to run",
+			hooks: latest_v1.BuildHooks{
+				PreHooks: []latest_v1.HostHook{{Command: []string{"generate"}}, {Command: []string{"never"}}},
+			},
+			commands:  testutil.CmdRunErr("generate", errors.New("not found")),
+			pre:       true,
+			shouldErr: true,
+			errCode:   proto.StatusCode_HOOK_RUN_ERR,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
+			}
+
+			var out bytes.Buffer
+			r := NewBuildRunner(test.hooks, opts)
+			var err error
+			if test.pre {
+				err = r.RunPreHooks(context.Background(), &out)
+			} else {
+				err = r.RunPostHooks(context.Background(), &out)
+			}
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				t.CheckDeepEqual(test.errCode, err.(sErrors.Error).StatusCode())
+			} else {
+				t.CheckDeepEqual(test.expectedOut, out.String())
+			}
+		})
+	}
+}
+
+func TestSyncHooks(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "podname", Namespace: "ns", Labels: map[string]string{"skaffold.dev/run-id": "run-id"}},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+		Spec: v1.PodSpec{Containers: []v1.Container{
+			{Name: "app", Image: "img:123"},
+			{Name: "sidecar", Image: "other"},
+		}},
+	}
+	// not deployed by this run
+	otherPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ns", Labels: map[string]string{"skaffold.dev/run-id": "other-run"}},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app", Image: "img:123"}}},
+	}
+
+	tests := []struct {
+		description string
+		hooks       latest_v1.SyncHooks
+		image       string
+		commands    *testutil.FakeCmd
+		expectedOut string
+		shouldErr   bool
+		errCode     proto.StatusCode
+	}{
+		{
+			description: "host and container hooks",
+			hooks: latest_v1.SyncHooks{
+				PreHooks: []latest_v1.SyncHookItem{
+					{HostHook: &latest_v1.HostHook{Command: []string{"echo", "host"}}},
+					{ContainerHook: &latest_v1.ContainerHook{Command: []string{"kill", "-HUP", "1"}}},
+				},
+			},
+			image: "img:123",
+			commands: testutil.CmdRunEnv("echo host", []string{"SKAFFOLD_IMAGE=img:123", "SKAFFOLD_FILES_ADDED_OR_MODIFIED=a.txt,b.txt", "SKAFFOLD_NAMESPACES=ns"}).
+				AndRunWithOutput("kubectl --context kubecontext exec podname --namespace ns -c app -- kill -HUP 1", "reloaded\n"),
+			expectedOut: "Starting pre-sync hooks...\n - [podname app] reloaded\nCompleted pre-sync hooks\n",
+		},
+		{
+			description: "no matching container",
+			hooks: latest_v1.SyncHooks{
+				PreHooks: []latest_v1.SyncHookItem{
+					{ContainerHook: &latest_v1.ContainerHook{Command: []string{"kill", "-HUP", "1"}}},
+				},
+			},
+			image:     "img:456",
+			shouldErr: true,
+			errCode:   proto.StatusCode_HOOK_CONTAINER_NOT_FOUND_ERR,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return fake.NewSimpleClientset(pod, otherPod), nil })
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
+			}
+
+			cli := &kubectl.CLI{KubeContext: "kubecontext"}
+			a := &latest_v1.Artifact{ImageName: "img", Workspace: "."}
+			opts := NewSyncEnvOpts(a, test.image, map[string][]string{"b.txt": {"/b.txt"}, "a.txt": {"/a.txt"}}, nil, []string{"ns"}, "kubecontext")
+			var out bytes.Buffer
+			err := NewSyncRunner(cli, test.hooks, opts, "run-id").RunPreHooks(context.Background(), &out)
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				t.CheckDeepEqual(test.errCode, err.(sErrors.Error).StatusCode())
+			} else {
+				t.CheckDeepEqual(test.expectedOut, out.String())
+			}
+		})
+	}
+}
+
+func TestDockerSyncHooks(t *testing.T) {
+	tests := []struct {
+		description   string
+		ids           []string
+		execErr       error
+		expectedExecs []string
+		shouldErr     bool
+		errCode       proto.StatusCode
+	}{
+		{
+			description:   "run in every container",
+			ids:           []string{"id1", "id2"},
+			expectedExecs: []string{"id1: kill -HUP 1", "id2: kill -HUP 1"},
+		},
+		{
+			description: "no container",
+			shouldErr:   true,
+			errCode:     proto.StatusCode_HOOK_CONTAINER_NOT_FOUND_ERR,
+		},
+		{
+			description:   "failing command",
+			ids:           []string{"id1", "id2"},
+			execErr:       errors.New("exit code 1"),
+			expectedExecs: []string{"id1: kill -HUP 1"},
+			shouldErr:     true,
+			errCode:       proto.StatusCode_HOOK_RUN_ERR,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var execs []string
+			containerExec := func(_ context.Context, id string, cmd []string) error {
+				execs = append(execs, fmt.Sprintf("%s: %s", id, strings.Join(cmd, " ")))
+				return test.execErr
+			}
+			hooks := latest_v1.SyncHooks{
+				PostHooks: []latest_v1.SyncHookItem{
+					{ContainerHook: &latest_v1.ContainerHook{Command: []string{"kill", "-HUP", "1"}}},
+				},
+			}
+			opts := NewSyncEnvOpts(&latest_v1.Artifact{ImageName: "img"}, "img:123", nil, nil, nil, "")
+			var out bytes.Buffer
+			err := NewDockerSyncRunner(containerExec, test.ids, hooks, opts).RunPostHooks(context.Background(), &out)
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				t.CheckDeepEqual(test.errCode, err.(sErrors.Error).StatusCode())
+			} else {
+				t.CheckDeepEqual("Starting post-sync hooks...\nCompleted post-sync hooks\n", out.String())
+			}
+			t.CheckDeepEqual(test.expectedExecs, execs)
+		})
+	}
+}
+
+func TestDeployHooks(t *testing.T) {
+	pods := []*v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "backend-1", Namespace: "ns", Labels: map[string]string{"skaffold.dev/run-id": "run-id"}},
+			Status:     v1.PodStatus{Phase: v1.PodRunning},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app"}, {Name: "sidecar"}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "backend-2", Namespace: "ns", Labels: map[string]string{"skaffold.dev/run-id": "run-id"}},
+			Status:     v1.PodStatus{Phase: v1.PodPending},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app"}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "ns", Labels: map[string]string{"skaffold.dev/run-id": "run-id"}},
+			Status:     v1.PodStatus{Phase: v1.PodRunning},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app"}}},
+		},
+		{
+			// not deployed by skaffold
+			ObjectMeta: metav1.ObjectMeta{Name: "backend-3", Namespace: "ns"},
+			Status:     v1.PodStatus{Phase: v1.PodRunning},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app"}}},
+		},
+	}
+	containerHook := func(podName, containerName string) latest_v1.DeployHookItem {
+		return latest_v1.DeployHookItem{ContainerHook: &latest_v1.NamedContainerHook{
+			ContainerHook: latest_v1.ContainerHook{Command: []string{"migrate"}},
+			PodName:       podName,
+			ContainerName: containerName,
+		}}
+	}
+
+	tests := []struct {
+		description string
+		hooks       latest_v1.DeployHooks
+		pre         bool
+		commands    *testutil.FakeCmd
+		shouldErr   bool
+		errCode     proto.StatusCode
+	}{
+		{
+			description: "host hook",
+			hooks: latest_v1.DeployHooks{
+				PostHooks: []latest_v1.DeployHookItem{{HostHook: &latest_v1.HostHook{Command: []string{"notify"}}}},
+			},
+			commands: testutil.CmdRunEnv("notify", []string{"SKAFFOLD_RUN_ID=run-id", "SKAFFOLD_KUBE_CONTEXT=kubecontext", "SKAFFOLD_NAMESPACES=ns"}),
+		},
+		{
+			description: "container hook matches pod name glob",
+			hooks: latest_v1.DeployHooks{
+				PostHooks: []latest_v1.DeployHookItem{containerHook("backend-*", "app")},
+			},
+			commands: testutil.CmdRun("kubectl --context kubecontext exec backend-1 --namespace ns -c app -- migrate"),
+		},
+		{
+			description: "container hook runs in all containers of the pod",
+			hooks: latest_v1.DeployHooks{
+				PostHooks: []latest_v1.DeployHookItem{containerHook("backend-1", "")},
+			},
+			commands: testutil.CmdRun("kubectl --context kubecontext exec backend-1 --namespace ns -c app -- migrate").
+				AndRun("kubectl --context kubecontext exec backend-1 --namespace ns -c sidecar -- migrate"),
+		},
+		{
+			description: "pre-deploy container hook is skipped without matching container",
+			hooks: latest_v1.DeployHooks{
+				PreHooks: []latest_v1.DeployHookItem{containerHook("db-*", "")},
+			},
+			pre: true,
+		},
+		{
+			description: "post-deploy container hook fails without matching container",
+			hooks: latest_v1.DeployHooks{
+				PostHooks: []latest_v1.DeployHookItem{containerHook("db-*", "")},
+			},
+			shouldErr: true,
+			errCode:   proto.StatusCode_HOOK_CONTAINER_NOT_FOUND_ERR,
+		},
+		{
+			description: "container hook exits with non-zero code",
+			hooks: latest_v1.DeployHooks{
+				PostHooks: []latest_v1.DeployHookItem{containerHook("frontend", "app")},
+			},
+			commands:  testutil.CmdRunErr("kubectl --context kubecontext exec frontend --namespace ns -c app -- migrate", &exec.ExitError{ProcessState: &os.ProcessState{}}),
+			shouldErr: true,
+			errCode:   proto.StatusCode_HOOK_NON_ZERO_EXIT_ERR,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) {
+				return fake.NewSimpleClientset(pods[0], pods[1], pods[2], pods[3]), nil
+			})
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
+			}
+
+			cli := &kubectl.CLI{KubeContext: "kubecontext"}
+			opts := DeployEnvOpts{RunID: "run-id", KubeContext: "kubecontext", Namespaces: []string{"ns"}}
+			r := NewDeployRunner(cli, test.hooks, opts)
+			var err error
+			if test.pre {
+				err = r.RunPreHooks(context.Background(), ioutil.Discard)
+			} else {
+				err = r.RunPostHooks(context.Background(), ioutil.Discard)
+			}
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				t.CheckDeepEqual(test.errCode, err.(sErrors.Error).StatusCode())
+			}
+		})
+	}
+}
+
+func TestNewBuildEnvOpts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		opts, err := NewBuildEnvOpts(&latest_v1.Artifact{ImageName: "img", Workspace: "app"}, "gcr.io/foo/img:v1")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(BuildEnvOpts{Image: "gcr.io/foo/img:v1", ImageRepo: "gcr.io/foo", ImageTag: "v1", BuildContext: "app"}, opts)
+	})
+}
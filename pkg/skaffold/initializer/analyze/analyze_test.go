@@ -144,6 +144,26 @@ func TestAnalyze(t *testing.T) {
 			},
 			shouldErr: false,
 		},
+		{
+			description: "ko builder",
+			filesWithContents: map[string]string{
+				"k8pod.yml":          validK8sManifest,
+				"go.mod":             "module example.com/app",
+				"cmd/server/main.go": "package main\n\nfunc main() {}\n",
+				"pkg/lib/lib.go":     "package lib\n",
+			},
+			config: initconfig.Config{
+				Force:        false,
+				EnableKoInit: true,
+			},
+			expectedConfigs: []string{
+				"k8pod.yml",
+			},
+			expectedBuilders: []builder{
+				{name: "Ko", path: "cmd/server/main.go"},
+			},
+			shouldErr: false,
+		},
 		{
 			description: "skip validating nested jib configs",
 			filesWithContents: map[string]string{
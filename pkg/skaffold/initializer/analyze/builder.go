@@ -22,6 +22,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/ko"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/initializer/build"
 )
@@ -31,6 +32,7 @@ type builderAnalyzer struct {
 	enableJibInit        bool
 	enableJibGradleInit  bool
 	enableBuildpacksInit bool
+	enableKoInit         bool
 	findBuilders         bool
 	buildpacksBuilder    string
 	foundBuilders        []build.InitBuilder
@@ -96,5 +98,14 @@ func (a *builderAnalyzer) detectBuilders(path string, detectJib bool) ([]build.I
 		}
 	}
 
+	if a.enableKoInit {
+		// Check for Go main packages
+		if ko.Validate(path) {
+			results = append(results, ko.ArtifactConfig{
+				File: path,
+			})
+		}
+	}
+
 	return results, searchSubDirectories
 }
@@ -89,9 +89,11 @@ func builderRank(builder InitBuilder) int {
 		return 3
 	case a.BuildpackArtifact != nil:
 		return 4
+	case a.KoArtifact != nil:
+		return 5
 	}
 
-	return 5
+	return 6
 }
 
 func (d *defaultBuildInitializer) resolveBuilderImagesInteractively() error {
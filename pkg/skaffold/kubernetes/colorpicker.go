@@ -43,6 +43,7 @@ var colorCodes = []color.Color{
 // from each pod.
 type ColorPicker interface {
 	Pick(pod *v1.Pod) color.Color
+	PickForImage(image string) color.Color
 }
 
 type colorPicker struct {
@@ -70,7 +71,7 @@ func NewColorPicker(imageNames []string) ColorPicker {
 // write with no formatting.
 func (p *colorPicker) Pick(pod *v1.Pod) color.Color {
 	for _, container := range pod.Spec.Containers {
-		if c, present := p.imageColors[stripTag(container.Image)]; present {
+		if c := p.PickForImage(container.Image); c != color.None {
 			return c
 		}
 	}
@@ -79,6 +80,14 @@ func (p *colorPicker) Pick(pod *v1.Pod) color.Color {
 	return color.None
 }
 
+// PickForImage will return the color that was associated with the image when `NewColorPicker` was called.
+func (p *colorPicker) PickForImage(image string) color.Color {
+	if c, present := p.imageColors[stripTag(image)]; present {
+		return c
+	}
+	return color.None
+}
+
 func stripTag(image string) string {
 	if !strings.Contains(image, ":") {
 		return image
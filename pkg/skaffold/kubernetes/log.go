@@ -30,6 +30,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/logfile"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 )
 
@@ -46,6 +47,9 @@ type LogAggregator struct {
 	events            chan PodEvent
 	trackedContainers trackedContainers
 	outputLock        sync.Mutex
+	filterLock        sync.RWMutex
+	filter            *LogFilter
+	store             *logfile.Store
 }
 
 type Config interface {
@@ -74,6 +78,27 @@ func (a *LogAggregator) SetSince(t time.Time) {
 	a.sinceTime = t
 }
 
+// PersistTo persists all the logs to the given store, even when the logs are muted.
+// The store outlives the LogAggregator.
+func (a *LogAggregator) PersistTo(store *logfile.Store) {
+	if a == nil {
+		// Logs are not activated.
+		return
+	}
+
+	a.store = store
+}
+
+// SetIteration sets the dev iteration of the logs persisted next.
+func (a *LogAggregator) SetIteration(iteration int) {
+	if a == nil {
+		// Logs are not activated.
+		return
+	}
+
+	a.store.SetIteration(iteration)
+}
+
 // Start starts a logger that listens to pods and tail their logs
 // if they are matched by the `podSelector`.
 func (a *LogAggregator) Start(ctx context.Context, namespaces []string) error {
@@ -163,33 +188,68 @@ func (a *LogAggregator) streamContainerLogs(ctx context.Context, pod *v1.Pod, co
 
 	headerColor := a.colorPicker.Pick(pod)
 	prefix := a.prefix(pod, container)
-	if err := a.streamRequest(ctx, headerColor, prefix, tr); err != nil {
+	format := a.logFormat(pod)
+	if err := a.streamRequest(ctx, pod.Name, container.Name, headerColor, prefix, format, tr); err != nil {
 		logrus.Errorf("streaming request %s", err)
 	}
 }
 
-func (a *LogAggregator) printLogLine(headerColor color.Color, prefix, text string) {
+func (a *LogAggregator) printLogLine(headerColor color.Color, prefix string, line logLine, jsonParse *latest_v1.JSONParseConfig) {
 	if !a.IsMuted() {
 		a.outputLock.Lock()
 
-		headerColor.Fprintf(a.output, "%s ", prefix)
-		fmt.Fprint(a.output, text)
+		printLogLine(a.output, headerColor, prefix, line, jsonParse)
 
 		a.outputLock.Unlock()
 	}
 }
 
-func (a *LogAggregator) prefix(pod *v1.Pod, container v1.ContainerStatus) string {
-	var c latest_v1.Pipeline
-	var present bool
+func printLogLine(out io.Writer, headerColor color.Color, prefix string, line logLine, jsonParse *latest_v1.JSONParseConfig) {
+	if prefix != "" {
+		headerColor.Fprintf(out, "%s ", prefix)
+	}
+	line.render(out, jsonParse)
+}
+
+// LogFormatter prints the log lines of a container that isn't run by Kubernetes, such as
+// the containers run by the Docker deployer, the same way the LogAggregator prints the
+// logs of pods: with a colored prefix, and parsed and filtered following the
+// `deploy.logs` configuration of the container's pipeline.
+type LogFormatter struct {
+	headerColor color.Color
+	prefix      string
+	format      logFormat
+}
+
+// NewLogFormatter creates the LogFormatter of a container. Its log lines are prefixed
+// with the container name, unless prefixes are disabled.
+func NewLogFormatter(headerColor color.Color, containerName string, logs latest_v1.LogsConfig) LogFormatter {
+	prefix := fmt.Sprintf("[%s]", containerName)
+	if logs.Prefix == "none" {
+		prefix = ""
+	}
+	return LogFormatter{headerColor: headerColor, prefix: prefix, format: newLogFormat(logs)}
+}
+
+// PrintLine prints a log line to out, unless it's filtered out.
+func (f LogFormatter) PrintLine(out io.Writer, text string) {
+	if line, ok := f.format.parse(text, nil); ok {
+		printLogLine(out, f.headerColor, f.prefix, line, f.format.jsonParse)
+	}
+}
+
+// pipeline returns the pipeline that deployed a pod.
+func (a *LogAggregator) pipeline(pod *v1.Pod) latest_v1.Pipeline {
 	for _, container := range pod.Spec.Containers {
-		if c, present = a.config.PipelineForImage(stripTag(container.Image)); present {
-			break
+		if c, present := a.config.PipelineForImage(stripTag(container.Image)); present {
+			return c
 		}
 	}
-	if !present {
-		c = a.config.DefaultPipeline()
-	}
+	return a.config.DefaultPipeline()
+}
+
+func (a *LogAggregator) prefix(pod *v1.Pod, container v1.ContainerStatus) string {
+	c := a.pipeline(pod)
 	switch c.Deploy.Logs.Prefix {
 	case "auto":
 		if pod.Name != container.Name {
@@ -207,6 +267,30 @@ func (a *LogAggregator) prefix(pod *v1.Pod, container v1.ContainerStatus) string
 	}
 }
 
+// logFormat returns how the log lines of a pod are parsed and filtered.
+func (a *LogAggregator) logFormat(pod *v1.Pod) logFormat {
+	return newLogFormat(a.pipeline(pod).Deploy.Logs)
+}
+
+// SetFilter replaces the configured log filters of all the containers.
+// A nil filter restores the configured ones.
+func (a *LogAggregator) SetFilter(f *LogFilter) {
+	if a == nil {
+		// Logs are not activated.
+		return
+	}
+
+	a.filterLock.Lock()
+	a.filter = f
+	a.filterLock.Unlock()
+}
+
+func (a *LogAggregator) filterOverride() *LogFilter {
+	a.filterLock.RLock()
+	defer a.filterLock.RUnlock()
+	return a.filter
+}
+
 func autoPrefix(pod *v1.Pod, container v1.ContainerStatus) string {
 	if pod.Name != container.Name {
 		return fmt.Sprintf("[%s %s]", pod.Name, container.Name)
@@ -222,7 +306,7 @@ func podAndContainerPrefix(pod *v1.Pod, container v1.ContainerStatus) string {
 	return fmt.Sprintf("[%s %s]", pod.Name, container.Name)
 }
 
-func (a *LogAggregator) streamRequest(ctx context.Context, headerColor color.Color, prefix string, rc io.Reader) error {
+func (a *LogAggregator) streamRequest(ctx context.Context, podName, containerName string, headerColor color.Color, prefix string, format logFormat, rc io.Reader) error {
 	r := bufio.NewReader(rc)
 	for {
 		select {
@@ -239,7 +323,13 @@ func (a *LogAggregator) streamRequest(ctx context.Context, headerColor color.Col
 				return fmt.Errorf("reading bytes from log stream: %w", err)
 			}
 
-			a.printLogLine(headerColor, prefix, line)
+			if err := a.store.Write(podName, containerName, time.Now(), line); err != nil {
+				logrus.Debugf("persisting logs of %s: %v", prefix, err)
+			}
+
+			if parsed, ok := format.parse(line, a.filterOverride()); ok {
+				a.printLogLine(headerColor, prefix, parsed, format.jsonParse)
+			}
 		}
 	}
 }
@@ -0,0 +1,279 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+var (
+	defaultLevelFields   = []string{"level", "severity", "lvl"}
+	defaultMessageFields = []string{"message", "msg", "text"}
+
+	// logLevels ranks the log levels, including the common aliases.
+	logLevels = map[string]int{
+		"trace":    0,
+		"debug":    1,
+		"info":     2,
+		"notice":   2,
+		"warn":     3,
+		"warning":  3,
+		"error":    4,
+		"err":      4,
+		"fatal":    5,
+		"panic":    5,
+		"critical": 5,
+		"crit":     5,
+	}
+
+	levelColors = map[int]color.Color{
+		0: color.White,
+		1: color.White,
+		2: color.Blue,
+		3: color.Yellow,
+		4: color.Red,
+		5: color.LightRed,
+	}
+)
+
+// logLine is a single log line, with its fields if it's a JSON object.
+type logLine struct {
+	raw     string
+	fields  map[string]interface{}
+	level   string
+	message string
+}
+
+// parseLogLine parses a log line as a JSON object.
+// If parsing is disabled or fails, the line is kept unstructured.
+func parseLogLine(cfg *latest_v1.JSONParseConfig, text string) logLine {
+	line := logLine{raw: text}
+	if cfg == nil {
+		return line
+	}
+
+	trimmed := strings.TrimSpace(text)
+	if !strings.HasPrefix(trimmed, "{") {
+		return line
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(trimmed))
+	decoder.UseNumber()
+	var fields map[string]interface{}
+	if err := decoder.Decode(&fields); err != nil {
+		return line
+	}
+
+	line.fields = fields
+	line.level = line.lookup(cfg.LevelField, defaultLevelFields)
+	line.message = line.lookup(cfg.MessageField, defaultMessageFields)
+	return line
+}
+
+// lookup returns the value of the given field, or of the first default field found.
+func (l logLine) lookup(field string, defaults []string) string {
+	if field != "" {
+		return l.field(field)
+	}
+	for _, name := range defaults {
+		if _, found := l.fields[name]; found {
+			return l.field(name)
+		}
+	}
+	return ""
+}
+
+// field returns the value of a field, formatted as a string.
+// Nested fields can be accessed with a dotted path such as `http.status`.
+func (l logLine) field(name string) string {
+	var value interface{} = l.fields
+	for _, key := range strings.Split(name, ".") {
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		if value, ok = obj[key]; !ok {
+			return ""
+		}
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		buf, _ := json.Marshal(v)
+		return string(buf)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (l logLine) structured() bool {
+	return l.fields != nil
+}
+
+// render writes a JSON log line as `LEVEL message key=value...`, with the level colored.
+// Unstructured lines are written as is.
+func (l logLine) render(out io.Writer, cfg *latest_v1.JSONParseConfig) {
+	if !l.structured() {
+		fmt.Fprint(out, l.raw)
+		return
+	}
+
+	var parts []string
+	if l.message != "" {
+		parts = append(parts, l.message)
+	}
+	for _, name := range cfg.Fields {
+		if value := l.field(name); value != "" {
+			parts = append(parts, fmt.Sprintf("%s=%s", name, value))
+		}
+	}
+	if l.level == "" && len(parts) == 0 {
+		// Nothing to render: keep the original line.
+		fmt.Fprint(out, l.raw)
+		return
+	}
+
+	if l.level != "" {
+		c := color.None
+		if rank, found := logLevels[strings.ToLower(l.level)]; found {
+			c = levelColors[rank]
+		}
+		c.Fprintf(out, "%-5s", strings.ToUpper(l.level))
+		if len(parts) > 0 {
+			fmt.Fprint(out, " ")
+		}
+	}
+	fmt.Fprintln(out, strings.Join(parts, " "))
+}
+
+// LogFilter selects the log lines to print.
+type LogFilter struct {
+	minLevel int
+	fields   map[string]string
+	regex    *regexp.Regexp
+}
+
+// ValidLogLevels returns the log levels a LogFilter accepts, including the common aliases,
+// sorted from the least to the most severe.
+func ValidLogLevels() []string {
+	var levels []string
+	for level := range logLevels {
+		levels = append(levels, level)
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		if logLevels[levels[i]] != logLevels[levels[j]] {
+			return logLevels[levels[i]] < logLevels[levels[j]]
+		}
+		return levels[i] < levels[j]
+	})
+	return levels
+}
+
+// NewLogFilter creates a LogFilter from its configuration.
+// It returns nil if there's no configuration.
+func NewLogFilter(cfg *latest_v1.LogFilter) (*LogFilter, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	f := &LogFilter{fields: cfg.Fields}
+
+	if cfg.MinLevel != "" {
+		rank, found := logLevels[strings.ToLower(cfg.MinLevel)]
+		if !found {
+			return nil, fmt.Errorf("invalid log level %q", cfg.MinLevel)
+		}
+		f.minLevel = rank
+	}
+
+	if cfg.Regex != "" {
+		re, err := regexp.Compile(cfg.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log filter regex %q: %w", cfg.Regex, err)
+		}
+		f.regex = re
+	}
+
+	return f, nil
+}
+
+// matches says if a log line should be printed.
+// A nil filter matches all the lines.
+func (f *LogFilter) matches(l logLine) bool {
+	if f == nil {
+		return true
+	}
+
+	if f.minLevel > 0 && l.level != "" {
+		if rank, found := logLevels[strings.ToLower(l.level)]; found && rank < f.minLevel {
+			return false
+		}
+	}
+
+	for name, value := range f.fields {
+		if !l.structured() || l.field(name) != value {
+			return false
+		}
+	}
+
+	if f.regex != nil && !f.regex.MatchString(l.raw) {
+		return false
+	}
+
+	return true
+}
+
+// logFormat parses, filters and renders the log lines of a container.
+type logFormat struct {
+	jsonParse *latest_v1.JSONParseConfig
+	filter    *LogFilter
+}
+
+// newLogFormat returns how log lines are parsed and filtered, following the `deploy.logs` configuration.
+func newLogFormat(logs latest_v1.LogsConfig) logFormat {
+	filter, err := NewLogFilter(logs.Filter)
+	if err != nil {
+		logrus.Warnf("ignoring log filter: %v", err)
+	}
+	return logFormat{jsonParse: logs.JSONParse, filter: filter}
+}
+
+// parse parses a log line and says if it should be printed.
+// The given filter, if any, replaces the configured one.
+func (f logFormat) parse(text string, override *LogFilter) (logLine, bool) {
+	line := parseLogLine(f.jsonParse, text)
+
+	filter := f.filter
+	if override != nil {
+		filter = override
+	}
+	return line, filter.matches(line)
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"bytes"
+	"testing"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestRenderLogLine(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         *latest_v1.JSONParseConfig
+		line        string
+		expected    string
+	}{
+		{
+			description: "parsing disabled",
+			line:        `{"level":"info","msg":"hello"}` + "\n",
+			expected:    `{"level":"info","msg":"hello"}` + "\n",
+		},
+		{
+			description: "not json",
+			cfg:         &latest_v1.JSONParseConfig{},
+			line:        "hello world\n",
+			expected:    "hello world\n",
+		},
+		{
+			description: "invalid json",
+			cfg:         &latest_v1.JSONParseConfig{},
+			line:        "{hello\n",
+			expected:    "{hello\n",
+		},
+		{
+			description: "level and message",
+			cfg:         &latest_v1.JSONParseConfig{},
+			line:        `{"level":"info","msg":"hello","other":1}` + "\n",
+			expected:    "INFO  hello\n",
+		},
+		{
+			description: "alternative field names",
+			cfg:         &latest_v1.JSONParseConfig{},
+			line:        `{"severity":"WARNING","message":"careful"}`,
+			expected:    "WARNING careful\n",
+		},
+		{
+			description: "selected fields",
+			cfg:         &latest_v1.JSONParseConfig{Fields: []string{"trace_id", "http.status", "missing"}},
+			line:        `{"level":"error","msg":"failed","trace_id":"abc","http":{"status":500}}`,
+			expected:    "ERROR failed trace_id=abc http.status=500\n",
+		},
+		{
+			description: "custom level and message fields",
+			cfg:         &latest_v1.JSONParseConfig{LevelField: "l", MessageField: "m"},
+			line:        `{"l":"debug","m":"custom","msg":"ignored"}`,
+			expected:    "DEBUG custom\n",
+		},
+		{
+			description: "nothing to render",
+			cfg:         &latest_v1.JSONParseConfig{},
+			line:        `{"other":1}` + "\n",
+			expected:    `{"other":1}` + "\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var buf bytes.Buffer
+			parseLogLine(test.cfg, test.line).render(&buf, test.cfg)
+
+			t.CheckDeepEqual(test.expected, buf.String())
+		})
+	}
+}
+
+func TestLogFilter(t *testing.T) {
+	tests := []struct {
+		description string
+		filter      *latest_v1.LogFilter
+		line        string
+		expected    bool
+	}{
+		{
+			description: "no filter",
+			line:        `{"level":"debug"}`,
+			expected:    true,
+		},
+		{
+			description: "level above minimum",
+			filter:      &latest_v1.LogFilter{MinLevel: "warn"},
+			line:        `{"level":"error"}`,
+			expected:    true,
+		},
+		{
+			description: "level below minimum",
+			filter:      &latest_v1.LogFilter{MinLevel: "WARN"},
+			line:        `{"level":"info"}`,
+		},
+		{
+			description: "line without level",
+			filter:      &latest_v1.LogFilter{MinLevel: "warn"},
+			line:        "plain text",
+			expected:    true,
+		},
+		{
+			description: "matching field",
+			filter:      &latest_v1.LogFilter{Fields: map[string]string{"trace_id": "abc", "http.status": "500"}},
+			line:        `{"trace_id":"abc","http":{"status":500}}`,
+			expected:    true,
+		},
+		{
+			description: "other field value",
+			filter:      &latest_v1.LogFilter{Fields: map[string]string{"trace_id": "abc"}},
+			line:        `{"trace_id":"def"}`,
+		},
+		{
+			description: "field on plain text",
+			filter:      &latest_v1.LogFilter{Fields: map[string]string{"trace_id": "abc"}},
+			line:        "trace_id=abc",
+		},
+		{
+			description: "matching regex",
+			filter:      &latest_v1.LogFilter{Regex: "GET /api"},
+			line:        "GET /api/users",
+			expected:    true,
+		},
+		{
+			description: "other regex",
+			filter:      &latest_v1.LogFilter{Regex: "^POST"},
+			line:        "GET /api/users",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			filter, err := NewLogFilter(test.filter)
+			t.CheckNoError(err)
+
+			matches := filter.matches(parseLogLine(&latest_v1.JSONParseConfig{}, test.line))
+
+			t.CheckDeepEqual(test.expected, matches)
+		})
+	}
+}
+
+func TestNewLogFilterErrors(t *testing.T) {
+	_, err := NewLogFilter(&latest_v1.LogFilter{MinLevel: "verbose"})
+	testutil.CheckError(t, true, err)
+
+	_, err = NewLogFilter(&latest_v1.LogFilter{Regex: "("})
+	testutil.CheckError(t, true, err)
+}
@@ -24,10 +24,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp/cmpopts"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/logfile"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -110,7 +112,7 @@ func TestPrintLogLine(t *testing.T) {
 
 			go func() {
 				for i := 0; i < 100; i++ {
-					logger.printLogLine(color.Default, "PREFIX", "TEXT\n")
+					logger.printLogLine(color.Default, "PREFIX", logLine{raw: "TEXT\n"}, nil)
 				}
 				wg.Done()
 			}()
@@ -131,9 +133,96 @@ func TestLogAggregatorZeroValue(t *testing.T) {
 	m.Start(context.Background(), []string{})
 	m.Mute()
 	m.Unmute()
+	m.SetFilter(nil)
+	m.PersistTo(nil)
+	m.SetIteration(1)
 	m.Stop()
 }
 
+func TestStreamRequest(t *testing.T) {
+	tests := []struct {
+		description string
+		logs        latest_v1.LogsConfig
+		override    *latest_v1.LogFilter
+		expected    string
+	}{
+		{
+			description: "raw lines",
+			expected:    "[c] {\"level\":\"debug\",\"msg\":\"starting\"}\n[c] plain text\n[c] {\"level\":\"error\",\"msg\":\"failed\"}\n",
+		},
+		{
+			description: "parse json",
+			logs:        latest_v1.LogsConfig{JSONParse: &latest_v1.JSONParseConfig{}},
+			expected:    "[c] DEBUG starting\n[c] plain text\n[c] ERROR failed\n",
+		},
+		{
+			description: "configured filter",
+			logs:        latest_v1.LogsConfig{JSONParse: &latest_v1.JSONParseConfig{}, Filter: &latest_v1.LogFilter{MinLevel: "info"}},
+			expected:    "[c] plain text\n[c] ERROR failed\n",
+		},
+		{
+			description: "overridden filter",
+			logs:        latest_v1.LogsConfig{JSONParse: &latest_v1.JSONParseConfig{}, Filter: &latest_v1.LogFilter{MinLevel: "info"}},
+			override:    &latest_v1.LogFilter{Regex: "start"},
+			expected:    "[c] DEBUG starting\n",
+		},
+		{
+			description: "cleared filter",
+			logs:        latest_v1.LogsConfig{JSONParse: &latest_v1.JSONParseConfig{}, Filter: &latest_v1.LogFilter{MinLevel: "info"}},
+			override:    &latest_v1.LogFilter{},
+			expected:    "[c] DEBUG starting\n[c] plain text\n[c] ERROR failed\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var buf bytes.Buffer
+			logger := &LogAggregator{
+				output: &buf,
+				config: &mockConfig{log: test.logs},
+			}
+			override, err := NewLogFilter(test.override)
+			t.CheckNoError(err)
+			logger.SetFilter(override)
+
+			pod := podWithName("pod")
+			format := logger.logFormat(&pod)
+			logs := strings.NewReader("{\"level\":\"debug\",\"msg\":\"starting\"}\nplain text\n{\"level\":\"error\",\"msg\":\"failed\"}\n")
+			err = logger.streamRequest(context.Background(), "pod", "c", color.None, "[c]", format, logs)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, buf.String())
+		})
+	}
+}
+
+func TestPersistLogs(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		dir := t.NewTempDir()
+		store, err := logfile.NewStore(dir.Root())
+		t.CheckNoError(err)
+
+		var buf bytes.Buffer
+		logger := &LogAggregator{
+			output: &buf,
+			config: &mockConfig{log: latest_v1.LogsConfig{Filter: &latest_v1.LogFilter{Regex: "shown"}}},
+		}
+		logger.PersistTo(store)
+		logger.SetIteration(2)
+		logger.Mute()
+
+		pod := podWithName("pod")
+		err = logger.streamRequest(context.Background(), "pod", "c", color.None, "[c]", logger.logFormat(&pod), strings.NewReader("shown\nfiltered\n"))
+		t.CheckNoError(err)
+
+		entries, err := logfile.Read(dir.Root(), logfile.Query{Iteration: -1})
+		t.CheckNoError(err)
+		t.CheckDeepEqual(2, len(entries))
+		t.CheckDeepEqual(logfile.Entry{Iteration: 2, Pod: "pod", Container: "c", Text: "shown\n"}, entries[0], cmpopts.IgnoreFields(logfile.Entry{}, "Time"))
+		t.CheckDeepEqual(logfile.Entry{Iteration: 2, Pod: "pod", Container: "c", Text: "filtered\n"}, entries[1], cmpopts.IgnoreFields(logfile.Entry{}, "Time"))
+		t.CheckEmpty(buf.String())
+	})
+}
+
 func TestPrefix(t *testing.T) {
 	tests := []struct {
 		description    string
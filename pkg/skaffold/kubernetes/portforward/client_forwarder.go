@@ -0,0 +1,514 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	protoV2 "github.com/GoogleContainerTools/skaffold/proto/v2"
+)
+
+// For testing
+var (
+	newPodDialer      = podDialer
+	waitReconnect     = 1 * time.Second
+	portForwarded     = eventV2.PortForwarded
+	portForwardFailed = eventV2.PortForwardFailed
+)
+
+// ClientForwarder port-forwards with the portforward subresource of pods, like `kubectl port-forward`,
+// but in-process. When the forwarded pod goes away, it reconnects to a new pod of the same resource.
+type ClientForwarder struct {
+	out io.Writer
+}
+
+// NewClientForwarder returns a new ClientForwarder
+func NewClientForwarder(out io.Writer) *ClientForwarder {
+	return &ClientForwarder{
+		out: out,
+	}
+}
+
+// Forward listens on the local port of a port forward entry and forwards
+// its connections to the target pod in the background.
+// It returns an error if the target pod can't be reached, but keeps
+// trying to connect until the entry is terminated.
+func (f *ClientForwarder) Forward(parentCtx context.Context, pfe *portForwardEntry) error {
+	pfe.terminationLock.Lock()
+	if pfe.terminated {
+		logrus.Debugf("port forwarding %v was cancelled...", pfe)
+		pfe.terminationLock.Unlock()
+		return nil
+	}
+	ctx, cancel := context.WithCancel(parentCtx)
+	pfe.cancel = cancel
+	pfe.terminationLock.Unlock()
+
+	address := pfe.resource.Address
+	if address == "" {
+		address = util.Loopback
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort(address, strconv.Itoa(pfe.localPort)))
+	if err != nil {
+		cancel()
+		return fmt.Errorf("port forwarding %v: %w", pfe, err)
+	}
+
+	t := &tunnel{out: f.out, entry: pfe}
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+		t.close()
+	}()
+
+	// The outcome of the first connection is reported by the caller.
+	_, _, err = t.connection(ctx)
+	t.failing = err != nil
+	go t.serve(ctx, listener)
+	go t.maintain(ctx, err != nil)
+	if err != nil {
+		return fmt.Errorf("port forwarding %v: %w", pfe, err)
+	}
+	return nil
+}
+
+// Terminate stops forwarding the port of an entry and closes its connections.
+func (*ClientForwarder) Terminate(p *portForwardEntry) {
+	logrus.Debugf("Terminating port-forward %v", p)
+
+	p.terminationLock.Lock()
+	defer p.terminationLock.Unlock()
+
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.terminated = true
+}
+
+// tunnel forwards the connections of a local port to the current target pod of a port forward entry.
+type tunnel struct {
+	out   io.Writer
+	entry *portForwardEntry
+
+	lock       sync.Mutex
+	conn       httpstream.Connection
+	podName    string
+	remotePort int
+	requestID  int
+	// failing is set once a failure is reported, so that
+	// events are only sent when the port forward breaks or recovers.
+	failing bool
+
+	bytesSent     int64
+	bytesReceived int64
+}
+
+// connection returns the connection to the target pod, and connects to
+// the newest pod of the forwarded resource if there's none.
+func (t *tunnel) connection(ctx context.Context) (httpstream.Connection, int, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.conn != nil {
+		select {
+		case <-t.conn.CloseChan():
+			t.conn = nil
+		default:
+			return t.conn, t.remotePort, nil
+		}
+	}
+	if ctx.Err() != nil {
+		return nil, 0, ctx.Err()
+	}
+
+	podName, remotePort, err := findTargetPod(ctx, t.entry)
+	if err != nil {
+		return nil, 0, err
+	}
+	dialer, err := newPodDialer(t.entry.resource.Namespace, podName)
+	if err != nil {
+		return nil, 0, err
+	}
+	conn, _, err := dialer.Dial(portforward.PortForwardProtocolV1Name)
+	if err != nil {
+		return nil, 0, fmt.Errorf("connecting to pod %s/%s: %w", t.entry.resource.Namespace, podName, err)
+	}
+
+	logrus.Debugf("port forwarding %v connected to pod %s, port %d", t.entry, podName, remotePort)
+	t.conn, t.podName, t.remotePort = conn, podName, remotePort
+	return conn, remotePort, nil
+}
+
+// maintain reconnects when the connection to the target pod is lost, usually because the pod was replaced.
+func (t *tunnel) maintain(ctx context.Context, disconnected bool) {
+	for {
+		if disconnected {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(waitReconnect):
+			}
+		}
+
+		conn, _, err := t.connection(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logrus.Debugf("port forwarding %v can't connect: %v, retrying...", t.entry, err)
+			t.failed(err)
+			disconnected = true
+			continue
+		}
+		if disconnected {
+			color.Green.Fprintf(t.out, "port forwarding %v reconnected to pod %s\n", t.entry, t.currentPod())
+			t.reconnected()
+			disconnected = false
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-conn.CloseChan():
+			logrus.Debugf("port forwarding %v lost its connection to pod %s, reconnecting...", t.entry, t.currentPod())
+			disconnected = true
+		}
+	}
+}
+
+// serve accepts local connections until the listener is closed.
+func (t *tunnel) serve(ctx context.Context, listener net.Listener) {
+	for {
+		local, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() == nil {
+				logrus.Debugf("port forwarding %v stopped accepting connections: %v", t.entry, err)
+			}
+			return
+		}
+		go t.handle(ctx, local)
+	}
+}
+
+// handle copies data between a local connection and a new stream to the target pod.
+func (t *tunnel) handle(ctx context.Context, local net.Conn) {
+	defer local.Close()
+
+	if err := t.forward(ctx, local); err != nil {
+		logrus.Debugf("port forwarding %v: %v", t.entry, err)
+		t.failed(err)
+		return
+	}
+	t.recovered()
+}
+
+// failed reports a failure, unless one was already reported since the port forward last worked.
+func (t *tunnel) failed(err error) {
+	t.lock.Lock()
+	alreadyFailing := t.failing
+	t.failing = true
+	t.lock.Unlock()
+
+	if !alreadyFailing {
+		portForwardFailed(t.event(), err)
+	}
+}
+
+// recovered reports that the port forward works again after a failure.
+func (t *tunnel) recovered() {
+	t.lock.Lock()
+	wasFailing := t.failing
+	t.failing = false
+	t.lock.Unlock()
+
+	if wasFailing {
+		portForwarded(t.event())
+	}
+}
+
+// reconnected reports that the port forward is connected to a new pod.
+func (t *tunnel) reconnected() {
+	t.lock.Lock()
+	t.failing = false
+	t.lock.Unlock()
+
+	portForwarded(t.event())
+}
+
+func (t *tunnel) forward(ctx context.Context, local net.Conn) error {
+	conn, remotePort, err := t.connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	headers := http.Header{}
+	headers.Set(corev1.StreamType, corev1.StreamTypeError)
+	headers.Set(corev1.PortHeader, strconv.Itoa(remotePort))
+	headers.Set(corev1.PortForwardRequestIDHeader, strconv.Itoa(t.nextRequestID()))
+	errorStream, err := conn.CreateStream(headers)
+	if err != nil {
+		return fmt.Errorf("creating error stream: %w", err)
+	}
+	// we're not writing to this stream
+	errorStream.Close()
+
+	errChan := make(chan error, 1)
+	go func() {
+		message, err := ioutil.ReadAll(errorStream)
+		switch {
+		case err != nil:
+			errChan <- fmt.Errorf("reading error stream: %w", err)
+		case len(message) > 0:
+			errChan <- errors.New(string(message))
+		default:
+			errChan <- nil
+		}
+	}()
+
+	headers.Set(corev1.StreamType, corev1.StreamTypeData)
+	dataStream, err := conn.CreateStream(headers)
+	if err != nil {
+		return fmt.Errorf("creating data stream: %w", err)
+	}
+
+	remoteDone := make(chan struct{})
+	localErr := make(chan error, 1)
+	go func() {
+		n, _ := io.Copy(local, dataStream)
+		atomic.AddInt64(&t.bytesReceived, n)
+		close(remoteDone)
+	}()
+	go func() {
+		// tell the pod that we're done sending data
+		defer dataStream.Close()
+
+		n, err := io.Copy(dataStream, local)
+		atomic.AddInt64(&t.bytesSent, n)
+		if err != nil && !strings.Contains(err.Error(), "use of closed network connection") {
+			localErr <- fmt.Errorf("copying to pod %s: %w", t.currentPod(), err)
+		}
+	}()
+
+	select {
+	case <-remoteDone:
+	case err := <-localErr:
+		return err
+	}
+
+	if err := <-errChan; err != nil {
+		return fmt.Errorf("forwarding to pod %s: %w", t.currentPod(), err)
+	}
+	return nil
+}
+
+func (t *tunnel) nextRequestID() int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	id := t.requestID
+	t.requestID++
+	return id
+}
+
+func (t *tunnel) currentPod() string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.podName
+}
+
+func (t *tunnel) close() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.conn != nil {
+		t.conn.Close()
+		t.conn = nil
+	}
+}
+
+// event describes the port forward, its current pod and the traffic it has carried.
+func (t *tunnel) event() *protoV2.PortForwardEvent {
+	pe := t.entry.v2Event()
+	if pod := t.currentPod(); pod != "" {
+		pe.PodName = pod
+	}
+	pe.BytesSent = atomic.LoadInt64(&t.bytesSent)
+	pe.BytesReceived = atomic.LoadInt64(&t.bytesReceived)
+	return pe
+}
+
+// podDialer returns a dialer to the portforward subresource of a pod.
+func podDialer(namespace, pod string) (httpstream.Dialer, error) {
+	config, err := kubectx.GetRestClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("getting client config for Kubernetes client: %w", err)
+	}
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return nil, err
+	}
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return nil, fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	url := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("portforward").
+		URL()
+	return spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url), nil
+}
+
+// findTargetPod finds the pod to forward to, and its port.
+// Controllers are resolved to their newest pod, like services.
+func findTargetPod(ctx context.Context, pfe *portForwardEntry) (string, int, error) {
+	ns, name, port := pfe.resource.Namespace, pfe.resource.Name, pfe.resource.Port
+
+	resourceType := strings.ToLower(string(pfe.resource.Type))
+	if resourceType == "service" {
+		return findNewestPodForSvc(ctx, ns, name, port)
+	}
+
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return "", -1, fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	var pods []corev1.Pod
+	if resourceType == "pod" {
+		pod, err := client.CoreV1().Pods(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", -1, fmt.Errorf("getting pod %s/%s: %w", ns, name, err)
+		}
+		pods = []corev1.Pod{*pod}
+	} else {
+		selector, err := podSelectorOf(ctx, client, resourceType, ns, name)
+		if err != nil {
+			return "", -1, err
+		}
+		podsList, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return "", -1, fmt.Errorf("listing pods: %w", err)
+		}
+		for _, pod := range podsList.Items {
+			if pod.Status.Phase == corev1.PodPending || pod.Status.Phase == corev1.PodRunning {
+				pods = append(pods, pod)
+			}
+		}
+		sort.Slice(pods, newestPodsFirst(pods))
+	}
+
+	for _, p := range pods {
+		if containerPort := findContainerPort(port, p); containerPort > 0 {
+			return p.Name, containerPort, nil
+		}
+	}
+	return "", -1, fmt.Errorf("no pods of %s/%s expose port %s", pfe.resource.Type, name, port.String())
+}
+
+// podSelectorOf returns the selector of the pods of a controller.
+func podSelectorOf(ctx context.Context, client kubernetes.Interface, resourceType, ns, name string) (labels.Selector, error) {
+	var selector *metav1.LabelSelector
+	var err error
+
+	switch resourceType {
+	case "deployment":
+		var d *appsv1.Deployment
+		if d, err = client.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			selector = d.Spec.Selector
+		}
+	case "replicaset":
+		var rs *appsv1.ReplicaSet
+		if rs, err = client.AppsV1().ReplicaSets(ns).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			selector = rs.Spec.Selector
+		}
+	case "statefulset":
+		var ss *appsv1.StatefulSet
+		if ss, err = client.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			selector = ss.Spec.Selector
+		}
+	case "daemonset":
+		var ds *appsv1.DaemonSet
+		if ds, err = client.AppsV1().DaemonSets(ns).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			selector = ds.Spec.Selector
+		}
+	case "job":
+		var job *batchv1.Job
+		if job, err = client.BatchV1().Jobs(ns).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			selector = job.Spec.Selector
+		}
+	case "replicationcontroller":
+		var rc *corev1.ReplicationController
+		if rc, err = client.CoreV1().ReplicationControllers(ns).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			return labels.SelectorFromSet(rc.Spec.Selector), nil
+		}
+	default:
+		return nil, fmt.Errorf("resource type %q can't be port forwarded natively", resourceType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting %s %s/%s: %w", resourceType, ns, name, err)
+	}
+
+	return metav1.LabelSelectorAsSelector(selector)
+}
+
+// findContainerPort returns the container port of a pod that matches a resource port.
+func findContainerPort(port schemautil.IntOrString, pod corev1.Pod) int {
+	if port.Type == schemautil.Int {
+		return port.IntVal
+	}
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Name == port.StrVal {
+				return int(p.ContainerPort)
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,421 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	protoV2 "github.com/GoogleContainerTools/skaffold/proto/v2"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+// fakeDialer connects to fake pods that reply to each message with their name and the message.
+type fakeDialer struct {
+	sync.Mutex
+	connections []*fakeConnection
+	remoteErr   string
+	dialErr     error
+}
+
+func (d *fakeDialer) dialer(namespace, pod string) (httpstream.Dialer, error) {
+	return &podFakeDialer{parent: d, pod: pod}, nil
+}
+
+func (d *fakeDialer) last() *fakeConnection {
+	d.Lock()
+	defer d.Unlock()
+	if len(d.connections) == 0 {
+		return nil
+	}
+	return d.connections[len(d.connections)-1]
+}
+
+type podFakeDialer struct {
+	parent *fakeDialer
+	pod    string
+}
+
+func (d *podFakeDialer) Dial(protocols ...string) (httpstream.Connection, string, error) {
+	d.parent.Lock()
+	defer d.parent.Unlock()
+
+	if d.parent.dialErr != nil {
+		return nil, "", d.parent.dialErr
+	}
+	conn := &fakeConnection{pod: d.pod, remoteErr: d.parent.remoteErr, closed: make(chan bool)}
+	d.parent.connections = append(d.parent.connections, conn)
+	return conn, protocols[0], nil
+}
+
+type fakeConnection struct {
+	pod       string
+	remoteErr string
+	closeOnce sync.Once
+	closed    chan bool
+}
+
+func (c *fakeConnection) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	if headers.Get(corev1.StreamType) == corev1.StreamTypeError {
+		return &errorStream{Reader: strings.NewReader(c.remoteErr), headers: headers}, nil
+	}
+
+	local, remote := net.Pipe()
+	go func() {
+		defer remote.Close()
+		buf := make([]byte, 1024)
+		for {
+			n, err := remote.Read(buf)
+			if err != nil {
+				return
+			}
+			if _, err := fmt.Fprintf(remote, "%s:%s", c.pod, buf[:n]); err != nil {
+				return
+			}
+		}
+	}()
+	return &dataStream{Conn: local, headers: headers}, nil
+}
+
+func (c *fakeConnection) Close() error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	return nil
+}
+
+func (c *fakeConnection) CloseChan() <-chan bool       { return c.closed }
+func (c *fakeConnection) SetIdleTimeout(time.Duration) {}
+
+func (c *fakeConnection) isClosed() bool {
+	select {
+	case <-c.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// errorStream is the error stream of a connection, that the client only reads.
+type errorStream struct {
+	*strings.Reader
+	headers http.Header
+}
+
+func (s *errorStream) Write(p []byte) (int, error) { return 0, errors.New("read only") }
+func (s *errorStream) Close() error                { return nil }
+func (s *errorStream) Reset() error                { return nil }
+func (s *errorStream) Headers() http.Header        { return s.headers }
+func (s *errorStream) Identifier() uint32          { return 0 }
+
+// dataStream is the data stream of a connection.
+type dataStream struct {
+	net.Conn
+	headers http.Header
+}
+
+func (s *dataStream) Reset() error         { return s.Close() }
+func (s *dataStream) Headers() http.Header { return s.headers }
+func (s *dataStream) Identifier() uint32   { return 1 }
+
+// recordEvents records the port forward events sent by the tunnels.
+type recordEvents struct {
+	sync.Mutex
+	events []string
+}
+
+func (r *recordEvents) override(t *testutil.T) {
+	t.Override(&portForwarded, func(*protoV2.PortForwardEvent) { r.record("forwarded") })
+	t.Override(&portForwardFailed, func(*protoV2.PortForwardEvent, error) { r.record("failed") })
+}
+
+func (r *recordEvents) record(event string) {
+	r.Lock()
+	defer r.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *recordEvents) get() []string {
+	r.Lock()
+	defer r.Unlock()
+	return append([]string(nil), r.events...)
+}
+
+func TestClientForwarder(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		client := fakekubeclientset.NewSimpleClientset(
+			deployment("web", map[string]string{"app": "web"}),
+			runningPod("web-1", map[string]string{"app": "web"}, time.Now().Add(-time.Hour)),
+		)
+		t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+		dialer := &fakeDialer{}
+		t.Override(&newPodDialer, dialer.dialer)
+		t.Override(&waitReconnect, 10*time.Millisecond)
+
+		localPort := util.GetAvailablePort(util.Loopback, 9000, &util.PortSet{})
+		pfe := newPortForwardEntry(0, latest_v1.PortForwardResource{
+			Type:      "deployment",
+			Name:      "web",
+			Namespace: "default",
+			Port:      schemautil.FromInt(8080),
+		}, "", "", "", "", localPort, false)
+
+		f := NewClientForwarder(ioutil.Discard)
+		err := f.Forward(context.Background(), pfe)
+		t.CheckNoError(err)
+		defer f.Terminate(pfe)
+
+		t.CheckDeepEqual("web-1:hello", roundTrip(t, localPort, "hello"))
+
+		// The pod is replaced
+		t.CheckNoError(client.CoreV1().Pods("default").Delete(context.Background(), "web-1", metav1.DeleteOptions{}))
+		_, err = client.CoreV1().Pods("default").Create(context.Background(), runningPod("web-2", map[string]string{"app": "web"}, time.Now()), metav1.CreateOptions{})
+		t.CheckNoError(err)
+		dialer.last().Close()
+
+		waitFor(t, func() bool { return dialer.last().pod == "web-2" })
+		t.CheckDeepEqual("web-2:again", roundTrip(t, localPort, "again"))
+
+		f.Terminate(pfe)
+		waitFor(t, func() bool { return dialer.last().isClosed() })
+	})
+}
+
+func TestClientForwarderRetries(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		client := fakekubeclientset.NewSimpleClientset(runningPod("pod", nil, time.Now()))
+		t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+		dialer := &fakeDialer{dialErr: errors.New("connection refused")}
+		t.Override(&newPodDialer, dialer.dialer)
+		t.Override(&waitReconnect, 10*time.Millisecond)
+		events := &recordEvents{}
+		events.override(t)
+
+		localPort := util.GetAvailablePort(util.Loopback, 9000, &util.PortSet{})
+		pfe := newPortForwardEntry(0, latest_v1.PortForwardResource{
+			Type:      "pod",
+			Name:      "pod",
+			Namespace: "default",
+			Port:      schemautil.FromInt(8080),
+		}, "", "", "", "", localPort, false)
+
+		f := NewClientForwarder(ioutil.Discard)
+		err := f.Forward(context.Background(), pfe)
+		t.CheckErrorContains("connection refused", err)
+		defer f.Terminate(pfe)
+
+		// The pod becomes reachable
+		dialer.Lock()
+		dialer.dialErr = nil
+		dialer.Unlock()
+
+		waitFor(t, func() bool { return dialer.last() != nil })
+		t.CheckDeepEqual("pod:hello", roundTrip(t, localPort, "hello"))
+		t.CheckDeepEqual("pod:again", roundTrip(t, localPort, "again"))
+
+		// The failed retries and the successful connections aren't reported again.
+		waitFor(t, func() bool { return len(events.get()) > 0 })
+		t.CheckDeepEqual([]string{"forwarded"}, events.get())
+	})
+}
+
+func TestTunnelEvents(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		events := &recordEvents{}
+		events.override(t)
+		pfe := newPortForwardEntry(0, latest_v1.PortForwardResource{Type: "pod", Name: "pod", Namespace: "default"}, "", "", "", "", 9000, false)
+		tun := &tunnel{out: ioutil.Discard, entry: pfe}
+
+		tun.recovered()
+		tun.failed(errors.New("connection refused"))
+		tun.failed(errors.New("connection refused"))
+		tun.recovered()
+		tun.recovered()
+		tun.failed(errors.New("connection reset"))
+		tun.reconnected()
+
+		t.CheckDeepEqual([]string{"failed", "forwarded", "failed", "forwarded"}, events.get())
+	})
+}
+
+func TestClientForwarderRemoteError(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		client := fakekubeclientset.NewSimpleClientset(runningPod("pod", nil, time.Now()))
+		t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+		dialer := &fakeDialer{remoteErr: "connection refused"}
+		t.Override(&newPodDialer, dialer.dialer)
+
+		pfe := newPortForwardEntry(0, latest_v1.PortForwardResource{
+			Type:      "pod",
+			Name:      "pod",
+			Namespace: "default",
+			Port:      schemautil.FromInt(8080),
+		}, "", "", "", "", 9000, false)
+
+		tun := &tunnel{out: ioutil.Discard, entry: pfe}
+		local, remote := net.Pipe()
+		go func() {
+			fmt.Fprint(remote, "hello")
+			remote.Close()
+		}()
+
+		err := tun.forward(context.Background(), local)
+
+		t.CheckErrorContains("forwarding to pod pod: connection refused", err)
+		waitFor(t, func() bool { return atomic.LoadInt64(&tun.bytesSent) == 5 })
+	})
+}
+
+func TestFindTargetPod(t *testing.T) {
+	labels := map[string]string{"app": "web"}
+	namedPort := runningPod("named", nil, time.Now())
+	namedPort.Spec.Containers = []corev1.Container{{Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}}}}
+
+	tests := []struct {
+		description  string
+		resource     latest_v1.PortForwardResource
+		objects      []runtime.Object
+		expectedPod  string
+		expectedPort int
+		shouldErr    bool
+	}{
+		{
+			description:  "pod",
+			resource:     latest_v1.PortForwardResource{Type: "pod", Name: "pod", Port: schemautil.FromInt(8080)},
+			objects:      []runtime.Object{runningPod("pod", nil, time.Now())},
+			expectedPod:  "pod",
+			expectedPort: 8080,
+		},
+		{
+			description:  "pod with named port",
+			resource:     latest_v1.PortForwardResource{Type: "Pod", Name: "named", Port: schemautil.FromString("http")},
+			objects:      []runtime.Object{namedPort},
+			expectedPod:  "named",
+			expectedPort: 8080,
+		},
+		{
+			description: "missing pod",
+			resource:    latest_v1.PortForwardResource{Type: "pod", Name: "pod", Port: schemautil.FromInt(8080)},
+			shouldErr:   true,
+		},
+		{
+			description: "newest pod of deployment",
+			resource:    latest_v1.PortForwardResource{Type: "deployment", Name: "web", Port: schemautil.FromInt(8080)},
+			objects: []runtime.Object{
+				deployment("web", labels),
+				runningPod("old", labels, time.Now().Add(-time.Hour)),
+				runningPod("new", labels, time.Now()),
+				runningPod("other", map[string]string{"app": "other"}, time.Now().Add(time.Hour)),
+			},
+			expectedPod:  "new",
+			expectedPort: 8080,
+		},
+		{
+			description: "deployment without pods",
+			resource:    latest_v1.PortForwardResource{Type: "deployment", Name: "web", Port: schemautil.FromInt(8080)},
+			objects:     []runtime.Object{deployment("web", labels)},
+			shouldErr:   true,
+		},
+		{
+			description: "unsupported type",
+			resource:    latest_v1.PortForwardResource{Type: "cronjob", Name: "web", Port: schemautil.FromInt(8080)},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(test.objects...)
+			t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+			test.resource.Namespace = "default"
+
+			pod, port, err := findTargetPod(context.Background(), &portForwardEntry{resource: test.resource})
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expectedPod, pod)
+				t.CheckDeepEqual(test.expectedPort, port)
+			}
+		})
+	}
+}
+
+func roundTrip(t *testutil.T, localPort int, message string) string {
+	conn, err := net.Dial("tcp", net.JoinHostPort(util.Loopback, strconv.Itoa(localPort)))
+	t.CheckNoError(err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte(message))
+	t.CheckNoError(err)
+
+	buf := make([]byte, 1024)
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, err := conn.Read(buf)
+	t.CheckNoError(err)
+	return string(buf[:n])
+}
+
+func waitFor(t *testutil.T, condition func() bool) {
+	timeout := time.After(5 * time.Second)
+	for !condition() {
+		select {
+		case <-timeout:
+			t.Fatal("Timed out waiting")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func deployment(name string, labels map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+		},
+	}
+}
+
+func runningPod(name string, labels map[string]string, created time.Time) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			Labels:            labels,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
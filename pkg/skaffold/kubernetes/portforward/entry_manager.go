@@ -24,6 +24,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
@@ -120,8 +121,10 @@ func (b *EntryManager) forwardPortForwardEntry(ctx context.Context, entry *portF
 				entry.resource.Port.String(),
 				entry.resource.Address,
 				entry.localPort))
+		eventV2.PortForwarded(entry.v2Event())
 	} else {
 		color.Red.Fprintln(b.output, err)
+		eventV2.PortForwardFailed(entry.v2Event(), err)
 	}
 	portForwardEvent(entry)
 }
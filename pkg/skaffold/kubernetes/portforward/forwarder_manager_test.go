@@ -58,7 +58,7 @@ func TestNewForwarderManager(t *testing.T) {
 			options := config.PortForwardOptions{}
 			options.Set(test.fmOptions)
 			fm := NewForwarderManager(ioutil.Discard,
-				&kubectl.CLI{},
+				NewKubectlForwarder(ioutil.Discard, &kubectl.CLI{}),
 				&kubernetes.ImageList{},
 				"",
 				"",
@@ -23,6 +23,7 @@ import (
 	"sync"
 
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	protoV2 "github.com/GoogleContainerTools/skaffold/proto/v2"
 )
 
 type portForwardEntry struct {
@@ -67,3 +68,22 @@ func (p *portForwardEntry) key() string {
 func (p *portForwardEntry) String() string {
 	return fmt.Sprintf("%s-%s-%s-%s", strings.ToLower(string(p.resource.Type)), p.resource.Name, p.resource.Namespace, p.resource.Port.String())
 }
+
+// v2Event returns the v2 event that describes the port forward entry.
+func (p *portForwardEntry) v2Event() *protoV2.PortForwardEvent {
+	return &protoV2.PortForwardEvent{
+		LocalPort:     int32(p.localPort),
+		PodName:       p.podName,
+		ContainerName: p.containerName,
+		Namespace:     p.resource.Namespace,
+		PortName:      p.portName,
+		ResourceType:  string(p.resource.Type),
+		ResourceName:  p.resource.Name,
+		Address:       p.resource.Address,
+		TargetPort: &protoV2.IntOrString{
+			Type:   int32(p.resource.Port.Type),
+			IntVal: int32(p.resource.Port.IntVal),
+			StrVal: p.resource.Port.StrVal,
+		},
+	}
+}
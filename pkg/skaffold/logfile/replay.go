@@ -0,0 +1,288 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logfile
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Entry is a log line persisted by a Store.
+type Entry struct {
+	Iteration int
+	Pod       string
+	Container string
+	Time      time.Time
+	Text      string
+}
+
+// Query selects the persisted log lines.
+type Query struct {
+	// Iteration selects a single dev iteration. A negative value selects all of them.
+	Iteration int
+
+	// Container selects the logs of the containers with this name. An empty value selects all of them.
+	Container string
+
+	// Since selects the lines received after this time.
+	Since time.Time
+}
+
+// Read returns the log lines persisted in a directory, sorted by time.
+func Read(dir string, q Query) ([]Entry, error) {
+	r := &replayer{dir: dir, query: q, positions: map[string]position{}}
+	return r.poll(true)
+}
+
+// Follow calls `onEntry` with the log lines persisted in a directory, sorted by time,
+// and then with the lines persisted later on, until the context is cancelled.
+func Follow(ctx context.Context, dir string, q Query, interval time.Duration, onEntry func(Entry)) error {
+	r := &replayer{dir: dir, query: q, positions: map[string]position{}}
+
+	entries, err := r.poll(true)
+	for {
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			onEntry(e)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+			entries, err = r.poll(false)
+		}
+	}
+}
+
+// logFile is a file of a Store.
+type logFile struct {
+	path      string
+	iteration int
+	pod       string
+	container string
+	backup    int
+}
+
+// replayer reads the lines persisted in log files, remembering
+// how much of each file was already read.
+type replayer struct {
+	dir       string
+	query     Query
+	positions map[string]position
+}
+
+// position is how much of a log file was read.
+type position struct {
+	info   os.FileInfo
+	offset int64
+}
+
+func (r *replayer) poll(withBackups bool) ([]Entry, error) {
+	files, err := r.list(withBackups)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, f := range files {
+		read, err := r.read(f)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, read...)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+	return entries, nil
+}
+
+// list lists the log files that match the query, the older backups first.
+func (r *replayer) list(withBackups bool) ([]logFile, error) {
+	var files []logFile
+
+	err := filepath.Walk(r.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(r.dir, path)
+		if err != nil {
+			return err
+		}
+		f, ok := parseLogFile(rel)
+		if !ok {
+			return nil
+		}
+		f.path = path
+
+		if !withBackups && f.backup > 0 {
+			return nil
+		}
+		if r.query.Iteration >= 0 && f.iteration != r.query.Iteration {
+			return nil
+		}
+		if r.query.Container != "" && f.container != escape(r.query.Container) {
+			return nil
+		}
+		files = append(files, f)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing log files: %w", err)
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].backup > files[j].backup
+	})
+	return files, nil
+}
+
+// parseLogFile parses the path of a log file, relative to the Store directory.
+func parseLogFile(rel string) (logFile, bool) {
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	if len(parts) != 3 || !strings.HasPrefix(parts[0], iterationPrefix) {
+		return logFile{}, false
+	}
+
+	iteration, err := strconv.Atoi(strings.TrimPrefix(parts[0], iterationPrefix))
+	if err != nil {
+		return logFile{}, false
+	}
+
+	name := parts[2]
+	backup := 0
+	if ext := filepath.Ext(name); ext != logExt {
+		if backup, err = strconv.Atoi(strings.TrimPrefix(ext, ".")); err != nil {
+			return logFile{}, false
+		}
+		name = strings.TrimSuffix(name, ext)
+	}
+	if filepath.Ext(name) != logExt {
+		return logFile{}, false
+	}
+
+	return logFile{
+		iteration: iteration,
+		pod:       parts[1],
+		container: strings.TrimSuffix(name, logExt),
+		backup:    backup,
+	}, true
+}
+
+// read reads the complete lines that were appended to a log file since it was last read.
+func (r *replayer) read(f logFile) ([]Entry, error) {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// The file was rotated in the meantime.
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var entries []Entry
+	last, found := r.positions[f.path]
+	if found && !os.SameFile(last.info, info) {
+		// The file was rotated: finish reading the previous one before starting over.
+		if f.backup == 0 {
+			if entries, _, err = r.readFrom(f, backupPath(f.path, 1), last.offset); err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+		last.offset = 0
+	}
+
+	read, offset, err := r.readFrom(f, f.path, last.offset)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return entries, nil
+		}
+		return nil, err
+	}
+	r.positions[f.path] = position{info: info, offset: offset}
+
+	return append(entries, read...), nil
+}
+
+// readFrom reads the complete lines of a file, starting at the given offset,
+// and returns the offset of the first incomplete line.
+func (r *replayer) readFrom(f logFile, path string, offset int64) ([]Entry, int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return nil, 0, err
+	}
+	buf, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, 0, err
+	}
+	end := bytes.LastIndexByte(buf, '\n') + 1
+
+	var entries []Entry
+	for _, line := range strings.SplitAfter(string(buf[:end]), "\n") {
+		if line == "" {
+			continue
+		}
+		e, ok := parseEntry(line)
+		if !ok || e.Time.Before(r.query.Since) {
+			continue
+		}
+		e.Iteration = f.iteration
+		e.Pod = f.pod
+		e.Container = f.container
+		entries = append(entries, e)
+	}
+	return entries, offset + int64(end), nil
+}
+
+// parseEntry parses a line written by a Store.
+func parseEntry(line string) (Entry, bool) {
+	i := strings.IndexByte(line, ' ')
+	if i < 0 {
+		return Entry{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, line[:i])
+	if err != nil {
+		return Entry{}, false
+	}
+	return Entry{Time: t, Text: line[i+1:]}, true
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logfile
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+)
+
+var (
+	// StoreDir is the directory, relative to the working directory, where container logs are persisted.
+	StoreDir = filepath.Join(constants.DefaultSkaffoldDir, "logs")
+
+	// for testing
+	maxFileSize int64 = 10 * 1024 * 1024
+	maxBackups        = 3
+)
+
+// Store persists the container logs to rotating files, grouped by dev iteration.
+// Each line is prefixed with the time it was received.
+// The logs of a container are written to `<dir>/iteration-<N>/<pod>/<container>.log`.
+type Store struct {
+	dir       string
+	lock      sync.Mutex
+	iteration int
+	base      int
+	files     map[string]*rotatingFile
+	closed    bool
+}
+
+// NewStore creates a Store in the given directory, removing the logs of a previous run.
+func NewStore(dir string) (*Store, error) {
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, fmt.Errorf("removing previous logs: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("creating logs directory %q: %w", dir, err)
+	}
+	// Persisted logs should never be committed.
+	if err := ioutil.WriteFile(filepath.Join(dir, ".gitignore"), []byte("*\n"), 0600); err != nil {
+		return nil, fmt.Errorf("creating logs directory %q: %w", dir, err)
+	}
+
+	return &Store{
+		dir:   dir,
+		files: map[string]*rotatingFile{},
+	}, nil
+}
+
+// SetIteration sets the dev iteration of the logs written next.
+func (s *Store) SetIteration(iteration int) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// Iterations start over when the configuration is reloaded: keep them increasing.
+	if s.base+iteration < s.iteration {
+		s.base = s.iteration + 1
+	}
+	if s.base+iteration == s.iteration {
+		return
+	}
+	s.closeFiles()
+	s.iteration = s.base + iteration
+}
+
+// Write persists a log line of a container.
+func (s *Store) Write(pod, container string, t time.Time, line string) error {
+	if s == nil {
+		return nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	path := filepath.Join(s.dir, iterationDir(s.iteration), escape(pod), escape(container)+logExt)
+	f, found := s.files[path]
+	if !found {
+		var err error
+		if f, err = openRotatingFile(path); err != nil {
+			return err
+		}
+		s.files[path] = f
+	}
+
+	if !strings.HasSuffix(line, "\n") {
+		line += "\n"
+	}
+	return f.write(t.UTC().Format(time.RFC3339Nano) + " " + line)
+}
+
+// Close closes the files of the Store. Lines written afterwards are dropped.
+func (s *Store) Close() {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.closeFiles()
+	s.closed = true
+}
+
+func (s *Store) closeFiles() {
+	for path, f := range s.files {
+		f.close()
+		delete(s.files, path)
+	}
+}
+
+const (
+	logExt          = ".log"
+	iterationPrefix = "iteration-"
+)
+
+func iterationDir(iteration int) string {
+	return fmt.Sprintf("%s%d", iterationPrefix, iteration)
+}
+
+// rotatingFile is a log file that is rotated when it grows bigger than `maxFileSize`.
+// Up to `maxBackups` rotated files are kept, named `<path>.1`, `<path>.2`... from the most recent.
+type rotatingFile struct {
+	path string
+	file *os.File
+	size int64
+}
+
+func openRotatingFile(path string) (*rotatingFile, error) {
+	f := &rotatingFile{path: path}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *rotatingFile) open() error {
+	if err := os.MkdirAll(filepath.Dir(f.path), 0700); err != nil {
+		return fmt.Errorf("creating logs directory: %w", err)
+	}
+
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("opening log file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("opening log file: %w", err)
+	}
+
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *rotatingFile) write(line string) error {
+	if f.size > 0 && f.size+int64(len(line)) > maxFileSize {
+		if err := f.rotate(); err != nil {
+			return fmt.Errorf("rotating log file: %w", err)
+		}
+	}
+
+	n, err := f.file.WriteString(line)
+	f.size += int64(n)
+	return err
+}
+
+func (f *rotatingFile) rotate() error {
+	f.close()
+
+	os.Remove(backupPath(f.path, maxBackups))
+	for i := maxBackups - 1; i > 0; i-- {
+		if err := os.Rename(backupPath(f.path, i), backupPath(f.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(f.path, backupPath(f.path, 1)); err != nil {
+		return err
+	}
+
+	return f.open()
+}
+
+func (f *rotatingFile) close() {
+	if f.file != nil {
+		f.file.Close()
+		f.file = nil
+	}
+}
+
+func backupPath(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logfile
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+var t0 = time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC)
+
+// writeLogs writes lines formatted as `<pod> <container> <text>`, one second apart.
+func writeLogs(t *testutil.T, s *Store, lines ...string) {
+	for i, line := range lines {
+		parts := strings.SplitN(line, " ", 3)
+		t.CheckNoError(s.Write(parts[0], parts[1], t0.Add(time.Duration(i)*time.Second), parts[2]))
+	}
+}
+
+// listFiles lists the files of a directory, recursively.
+func listFiles(t *testutil.T, dir string) []string {
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			rel, _ := filepath.Rel(dir, path)
+			files = append(files, filepath.ToSlash(rel))
+		}
+		return err
+	})
+	t.CheckNoError(err)
+	sort.Strings(files)
+	return files
+}
+
+func readFile(t *testutil.T, path string) string {
+	buf, err := ioutil.ReadFile(path)
+	t.CheckNoError(err)
+	return string(buf)
+}
+
+func TestStore(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		dir := t.NewTempDir().Write("iteration-0/web-0/web.log", "previous run")
+
+		s, err := NewStore(dir.Root())
+		t.CheckNoError(err)
+		writeLogs(t, s, "web-1 web first", "db-1 db second\n")
+		s.SetIteration(1)
+		writeLogs(t, s, "web-2 web third")
+		s.Close()
+		writeLogs(t, s, "web-2 web dropped")
+
+		files := listFiles(t, dir.Root())
+		t.CheckDeepEqual([]string{".gitignore", "iteration-0/db-1/db.log", "iteration-0/web-1/web.log", "iteration-1/web-2/web.log"}, files)
+		t.CheckDeepEqual("*\n", readFile(t, dir.Path(".gitignore")))
+		t.CheckDeepEqual("2021-05-01T10:00:00Z first\n", readFile(t, dir.Path("iteration-0/web-1/web.log")))
+		t.CheckDeepEqual("2021-05-01T10:00:01Z second\n", readFile(t, dir.Path("iteration-0/db-1/db.log")))
+		t.CheckDeepEqual("2021-05-01T10:00:00Z third\n", readFile(t, dir.Path("iteration-1/web-2/web.log")))
+	})
+}
+
+func TestStoreIterations(t *testing.T) {
+	tests := []struct {
+		description string
+		iterations  []int
+		expected    int
+	}{
+		{description: "first iteration", iterations: []int{0}, expected: 0},
+		{description: "next iterations", iterations: []int{0, 1, 2}, expected: 2},
+		{description: "configuration reloaded", iterations: []int{0, 1, 2, 0}, expected: 3},
+		{description: "configuration reloaded twice", iterations: []int{0, 1, 0, 1, 0}, expected: 4},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			s := &Store{files: map[string]*rotatingFile{}}
+			for _, i := range test.iterations {
+				s.SetIteration(i)
+			}
+
+			t.CheckDeepEqual(test.expected, s.iteration)
+		})
+	}
+}
+
+func TestStoreRotation(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&maxFileSize, int64(60))
+		t.Override(&maxBackups, 2)
+		dir := t.NewTempDir()
+
+		s, err := NewStore(dir.Root())
+		t.CheckNoError(err)
+		writeLogs(t, s, "pod c line1", "pod c line2", "pod c line3", "pod c line4", "pod c line5", "pod c line6", "pod c line7")
+		s.Close()
+
+		files := listFiles(t, dir.Root())
+		t.CheckDeepEqual([]string{".gitignore", "iteration-0/pod/c.log", "iteration-0/pod/c.log.1", "iteration-0/pod/c.log.2"}, files)
+		t.CheckDeepEqual("2021-05-01T10:00:06Z line7\n", readFile(t, dir.Path("iteration-0/pod/c.log")))
+		t.CheckDeepEqual("2021-05-01T10:00:04Z line5\n2021-05-01T10:00:05Z line6\n", readFile(t, dir.Path("iteration-0/pod/c.log.1")))
+		t.CheckDeepEqual("2021-05-01T10:00:02Z line3\n2021-05-01T10:00:03Z line4\n", readFile(t, dir.Path("iteration-0/pod/c.log.2")))
+
+		entries, err := Read(dir.Root(), Query{Iteration: -1})
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"line3\n", "line4\n", "line5\n", "line6\n", "line7\n"}, texts(entries))
+	})
+}
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		description string
+		query       Query
+		expected    []Entry
+	}{
+		{
+			description: "all logs",
+			query:       Query{Iteration: -1},
+			expected: []Entry{
+				{Iteration: 0, Pod: "web-1", Container: "web", Time: t0, Text: "first\n"},
+				{Iteration: 0, Pod: "db-1", Container: "db", Time: t0.Add(time.Second), Text: "second\n"},
+				{Iteration: 1, Pod: "web-2", Container: "web", Time: t0.Add(2 * time.Second), Text: "third\n"},
+				{Iteration: 1, Pod: "db-1", Container: "db", Time: t0.Add(3 * time.Second), Text: "fourth\n"},
+			},
+		},
+		{
+			description: "single iteration",
+			query:       Query{Iteration: 1},
+			expected: []Entry{
+				{Iteration: 1, Pod: "web-2", Container: "web", Time: t0.Add(2 * time.Second), Text: "third\n"},
+				{Iteration: 1, Pod: "db-1", Container: "db", Time: t0.Add(3 * time.Second), Text: "fourth\n"},
+			},
+		},
+		{
+			description: "single container",
+			query:       Query{Iteration: -1, Container: "web"},
+			expected: []Entry{
+				{Iteration: 0, Pod: "web-1", Container: "web", Time: t0, Text: "first\n"},
+				{Iteration: 1, Pod: "web-2", Container: "web", Time: t0.Add(2 * time.Second), Text: "third\n"},
+			},
+		},
+		{
+			description: "since",
+			query:       Query{Iteration: -1, Since: t0.Add(2 * time.Second)},
+			expected: []Entry{
+				{Iteration: 1, Pod: "web-2", Container: "web", Time: t0.Add(2 * time.Second), Text: "third\n"},
+				{Iteration: 1, Pod: "db-1", Container: "db", Time: t0.Add(3 * time.Second), Text: "fourth\n"},
+			},
+		},
+		{
+			description: "no match",
+			query:       Query{Iteration: 5},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			dir := t.NewTempDir()
+			s, err := NewStore(dir.Root())
+			t.CheckNoError(err)
+			t.CheckNoError(s.Write("web-1", "web", t0, "first"))
+			t.CheckNoError(s.Write("db-1", "db", t0.Add(time.Second), "second"))
+			s.SetIteration(1)
+			t.CheckNoError(s.Write("db-1", "db", t0.Add(3*time.Second), "fourth"))
+			t.CheckNoError(s.Write("web-2", "web", t0.Add(2*time.Second), "third"))
+			s.Close()
+			dir.Write("iteration-1/db-1/other.txt", "ignored")
+
+			entries, err := Read(dir.Root(), test.query)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, entries)
+		})
+	}
+}
+
+func TestReadMissingDirectory(t *testing.T) {
+	entries, err := Read("does-not-exist", Query{Iteration: -1})
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, []Entry(nil), entries)
+}
+
+func TestFollow(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&maxFileSize, int64(60))
+		dir := t.NewTempDir()
+		s, err := NewStore(dir.Root())
+		t.CheckNoError(err)
+		t.CheckNoError(s.Write("pod", "c", t0, "before"))
+
+		var lock sync.Mutex
+		var received []string
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- Follow(ctx, dir.Root(), Query{Iteration: -1}, 10*time.Millisecond, func(e Entry) {
+				lock.Lock()
+				received = append(received, e.Text)
+				lock.Unlock()
+			})
+		}()
+
+		waitFor := func(count int) {
+			for i := 0; i < 500; i++ {
+				lock.Lock()
+				n := len(received)
+				lock.Unlock()
+				if n >= count {
+					return
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			t.Fatalf("timed out waiting for %d lines", count)
+		}
+
+		waitFor(1)
+		// The file is rotated after the second line.
+		t.CheckNoError(s.Write("pod", "c", t0.Add(time.Second), "after 1"))
+		t.CheckNoError(s.Write("pod", "c", t0.Add(2*time.Second), "after 2"))
+		t.CheckNoError(s.Write("pod", "c", t0.Add(3*time.Second), "after 3"))
+		s.SetIteration(1)
+		t.CheckNoError(s.Write("pod", "c", t0.Add(4*time.Second), "next iteration"))
+		waitFor(5)
+
+		cancel()
+		t.CheckNoError(<-done)
+		t.CheckDeepEqual([]string{"before\n", "after 1\n", "after 2\n", "after 3\n", "next iteration\n"}, received)
+		_, err = os.Stat(dir.Path("iteration-0/pod/c.log.1"))
+		t.CheckNoError(err)
+	})
+}
+
+func texts(entries []Entry) []string {
+	var texts []string
+	for _, e := range entries {
+		texts = append(texts, e.Text)
+	}
+	return texts
+}
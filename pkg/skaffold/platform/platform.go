@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+)
+
+// For testing
+var hostArch = runtime.GOARCH
+
+// Host returns the platform of the images that run natively on this machine.
+func Host() string {
+	return "linux/" + hostArch
+}
+
+// Parse parses a platform, such as `linux/arm64` or `linux/arm/v7`.
+func Parse(platform string) (v1.Platform, error) {
+	parts := strings.Split(platform, "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return v1.Platform{}, fmt.Errorf("invalid platform %q: expected os/arch[/variant]", platform)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return v1.Platform{}, fmt.Errorf("invalid platform %q: expected os/arch[/variant]", platform)
+		}
+	}
+
+	p := v1.Platform{OS: parts[0], Architecture: parts[1]}
+	if len(parts) == 3 {
+		p.Variant = parts[2]
+	}
+	return p, nil
+}
+
+// ForLocalDaemon returns the platforms an image can be built for when it's loaded into the local Docker daemon.
+// The daemon can't store manifest lists, so only the host platform, if it's listed, or else the first platform is kept.
+func ForLocalDaemon(platforms []string) []string {
+	if len(platforms) <= 1 {
+		return platforms
+	}
+
+	for _, platform := range platforms {
+		if platform == Host() {
+			return []string{platform}
+		}
+	}
+	return platforms[:1]
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		description string
+		platform    string
+		expected    v1.Platform
+		shouldErr   bool
+	}{
+		{
+			description: "os and architecture",
+			platform:    "linux/amd64",
+			expected:    v1.Platform{OS: "linux", Architecture: "amd64"},
+		},
+		{
+			description: "variant",
+			platform:    "linux/arm/v7",
+			expected:    v1.Platform{OS: "linux", Architecture: "arm", Variant: "v7"},
+		},
+		{
+			description: "missing architecture",
+			platform:    "linux",
+			shouldErr:   true,
+		},
+		{
+			description: "empty architecture",
+			platform:    "linux/",
+			shouldErr:   true,
+		},
+		{
+			description: "too many parts",
+			platform:    "linux/arm/v7/extra",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			p, err := Parse(test.platform)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, p)
+		})
+	}
+}
+
+func TestForLocalDaemon(t *testing.T) {
+	tests := []struct {
+		description string
+		platforms   []string
+		expected    []string
+	}{
+		{
+			description: "no platform",
+		},
+		{
+			description: "single platform",
+			platforms:   []string{"linux/arm64"},
+			expected:    []string{"linux/arm64"},
+		},
+		{
+			description: "host platform",
+			platforms:   []string{"linux/arm64", "linux/amd64"},
+			expected:    []string{"linux/amd64"},
+		},
+		{
+			description: "first platform",
+			platforms:   []string{"linux/arm64", "linux/s390x"},
+			expected:    []string{"linux/arm64"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&hostArch, "amd64")
+
+			t.CheckDeepEqual(test.expected, ForLocalDaemon(test.platforms))
+		})
+	}
+}
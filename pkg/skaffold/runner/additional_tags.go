@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+)
+
+// For testing
+var (
+	addRemoteTag = docker.RetagRemote
+	addLocalTag  = func(ctx context.Context, cfg docker.Config, image, tag string) error {
+		localDocker, err := docker.NewAPIClient(cfg)
+		if err != nil {
+			return err
+		}
+		return localDocker.Tag(ctx, image, tag)
+	}
+)
+
+// addAdditionalTags tags the images with their additional tags.
+// Pushed images are retagged in their registry, without uploading them again.
+// Other images are tagged in the local Docker daemon.
+func (r *Builder) addAdditionalTags(ctx context.Context, out io.Writer, artifacts []graph.Artifact, additionalTags map[string][]string) ([]graph.Artifact, error) {
+	if len(additionalTags) == 0 {
+		return artifacts, nil
+	}
+
+	var tagged []graph.Artifact
+	for _, a := range artifacts {
+		tags := additionalTags[a.ImageName]
+		if len(tags) == 0 {
+			tagged = append(tagged, a)
+			continue
+		}
+
+		ref, err := docker.ParseReference(a.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("parsing image %q: %w", a.Tag, err)
+		}
+
+		for _, tag := range tags {
+			if ref.Digest != "" {
+				color.Default.Fprintf(out, "Pushing additional tag %s\n", tag)
+				if err := addRemoteTag(ref.BaseName+"@"+ref.Digest, tag, r.runCtx); err != nil {
+					return nil, fmt.Errorf("pushing additional tag %q: %w", tag, err)
+				}
+			} else {
+				color.Default.Fprintf(out, "Tagging %s as %s\n", a.Tag, tag)
+				if err := addLocalTag(ctx, r.runCtx, a.Tag, tag); err != nil {
+					return nil, fmt.Errorf("tagging image %q: %w", tag, err)
+				}
+			}
+		}
+
+		a.AdditionalTags = tags
+		tagged = append(tagged, a)
+	}
+	return tagged, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestAddAdditionalTags(t *testing.T) {
+	const digest = "sha256:6bd2d52e4d1b5efa0fc8ad8e4a0b9bc1ee8b9ffe2e3f7e83bd2c1eafaba4f5b0"
+
+	tests := []struct {
+		description    string
+		artifacts      []graph.Artifact
+		additionalTags map[string][]string
+		tagErr         error
+		expected       []graph.Artifact
+		expectedRemote []string
+		expectedLocal  []string
+		shouldErr      bool
+	}{
+		{
+			description: "no additional tags",
+			artifacts:   []graph.Artifact{{ImageName: "img", Tag: "img:v1@" + digest}},
+			expected:    []graph.Artifact{{ImageName: "img", Tag: "img:v1@" + digest}},
+		},
+		{
+			description:    "pushed image",
+			artifacts:      []graph.Artifact{{ImageName: "img", Tag: "img:v1@" + digest}},
+			additionalTags: map[string][]string{"img": {"img:v1.0", "img:latest"}},
+			expected:       []graph.Artifact{{ImageName: "img", Tag: "img:v1@" + digest, AdditionalTags: []string{"img:v1.0", "img:latest"}}},
+			expectedRemote: []string{"img@" + digest + " -> img:v1.0", "img@" + digest + " -> img:latest"},
+		},
+		{
+			description:    "local image",
+			artifacts:      []graph.Artifact{{ImageName: "img", Tag: "img:abcdef"}, {ImageName: "other", Tag: "other:abcdef"}},
+			additionalTags: map[string][]string{"img": {"img:latest"}},
+			expected:       []graph.Artifact{{ImageName: "img", Tag: "img:abcdef", AdditionalTags: []string{"img:latest"}}, {ImageName: "other", Tag: "other:abcdef"}},
+			expectedLocal:  []string{"img:abcdef -> img:latest"},
+		},
+		{
+			description:    "tagging error",
+			artifacts:      []graph.Artifact{{ImageName: "img", Tag: "img:v1@" + digest}},
+			additionalTags: map[string][]string{"img": {"img:latest"}},
+			tagErr:         errors.New("unauthorized"),
+			shouldErr:      true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var remote, local []string
+			t.Override(&addRemoteTag, func(src, target string, _ docker.Config) error {
+				remote = append(remote, src+" -> "+target)
+				return test.tagErr
+			})
+			t.Override(&addLocalTag, func(_ context.Context, _ docker.Config, image, tag string) error {
+				local = append(local, image+" -> "+tag)
+				return test.tagErr
+			})
+
+			r := &Builder{runCtx: &runcontext.RunContext{}}
+			tagged, err := r.addAdditionalTags(context.Background(), ioutil.Discard, test.artifacts, test.additionalTags)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tagged)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expectedRemote, remote)
+				t.CheckDeepEqual(test.expectedLocal, local)
+			}
+		})
+	}
+}
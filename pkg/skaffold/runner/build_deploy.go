@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -66,7 +67,7 @@ func (r *Builder) Build(ctx context.Context, out io.Writer, artifacts []*latest_
 		return nil, err
 	}
 
-	tags, err := r.imageTags(ctx, out, artifacts)
+	tags, additionalTags, err := r.imageTags(ctx, out, artifacts)
 	if err != nil {
 		eventV2.TaskFailed(constants.Build, err)
 		return nil, err
@@ -78,8 +79,9 @@ func (r *Builder) Build(ctx context.Context, out io.Writer, artifacts []*latest_
 		var bRes []graph.Artifact
 		for _, artifact := range artifacts {
 			bRes = append(bRes, graph.Artifact{
-				ImageName: artifact.ImageName,
-				Tag:       tags[artifact.ImageName],
+				ImageName:      artifact.ImageName,
+				Tag:            tags[artifact.ImageName],
+				AdditionalTags: additionalTags[artifact.ImageName],
 			})
 		}
 
@@ -98,6 +100,10 @@ func (r *Builder) Build(ctx context.Context, out io.Writer, artifacts []*latest_
 			return nil, err
 		}
 
+		if err := r.attachSupplyChain(out, bRes); err != nil {
+			return nil, err
+		}
+
 		return bRes, nil
 	})
 	if err != nil {
@@ -105,6 +111,11 @@ func (r *Builder) Build(ctx context.Context, out io.Writer, artifacts []*latest_
 		return nil, err
 	}
 
+	if bRes, err = r.addAdditionalTags(ctx, out, bRes, additionalTags); err != nil {
+		eventV2.TaskFailed(constants.Build, err)
+		return nil, err
+	}
+
 	// Update which images are logged.
 	r.addTagsToPodSelector(bRes)
 
@@ -128,8 +139,8 @@ func (r *Builder) addTagsToPodSelector(artifacts []graph.Artifact) {
 }
 
 type tagErr struct {
-	tag string
-	err error
+	tags []string
+	err  error
 }
 
 // ApplyDefaultRepo applies the default repo to a given image tag.
@@ -137,8 +148,8 @@ func (r *Builder) ApplyDefaultRepo(tag string) (string, error) {
 	return deployutil.ApplyDefaultRepo(r.runCtx.GlobalConfig(), r.runCtx.DefaultRepo(), tag)
 }
 
-// imageTags generates tags for a list of artifacts
-func (r *Builder) imageTags(ctx context.Context, out io.Writer, artifacts []*latest_v1.Artifact) (tag.ImageTags, error) {
+// imageTags generates tags for a list of artifacts, along with the additional tags of each image.
+func (r *Builder) imageTags(ctx context.Context, out io.Writer, artifacts []*latest_v1.Artifact) (tag.ImageTags, map[string][]string, error) {
 	start := time.Now()
 	color.Default.Fprintln(out, "Generating tags...")
 
@@ -149,12 +160,13 @@ func (r *Builder) imageTags(ctx context.Context, out io.Writer, artifacts []*lat
 
 		i := i
 		go func() {
-			tag, err := tag.GenerateFullyQualifiedImageName(r.tagger, *artifacts[i])
-			tagErrs[i] <- tagErr{tag: tag, err: err}
+			tags, err := tag.GenerateFullyQualifiedImageNames(r.tagger, *artifacts[i])
+			tagErrs[i] <- tagErr{tags: tags, err: err}
 		}()
 	}
 
 	imageTags := make(tag.ImageTags, len(artifacts))
+	additionalTags := map[string][]string{}
 	showWarning := false
 
 	for i, artifact := range artifacts {
@@ -163,7 +175,7 @@ func (r *Builder) imageTags(ctx context.Context, out io.Writer, artifacts []*lat
 
 		select {
 		case <-ctx.Done():
-			return nil, context.Canceled
+			return nil, nil, context.Canceled
 
 		case t := <-tagErrs[i]:
 			if t.err != nil {
@@ -172,20 +184,27 @@ func (r *Builder) imageTags(ctx context.Context, out io.Writer, artifacts []*lat
 
 				fallbackTag, err := tag.GenerateFullyQualifiedImageName(&tag.ChecksumTagger{}, *artifact)
 				if err != nil {
-					return nil, fmt.Errorf("generating checksum as fall-back tag for %q: %w", imageName, err)
+					return nil, nil, fmt.Errorf("generating checksum as fall-back tag for %q: %w", imageName, err)
 				}
 
-				t.tag = fallbackTag
+				t.tags = []string{fallbackTag}
 				showWarning = true
 			}
 
-			tag, err := r.ApplyDefaultRepo(t.tag)
-			if err != nil {
-				return nil, err
+			var tags []string
+			for _, tag := range t.tags {
+				tag, err := r.ApplyDefaultRepo(tag)
+				if err != nil {
+					return nil, nil, err
+				}
+				tags = append(tags, tag)
 			}
 
-			fmt.Fprintln(out, tag)
-			imageTags[imageName] = tag
+			fmt.Fprintln(out, strings.Join(tags, ", "))
+			imageTags[imageName] = tags[0]
+			if len(tags) > 1 {
+				additionalTags[imageName] = tags[1:]
+			}
 		}
 	}
 
@@ -194,7 +213,7 @@ func (r *Builder) imageTags(ctx context.Context, out io.Writer, artifacts []*lat
 	}
 
 	logrus.Infoln("Tags generated in", util.ShowHumanizeTime(time.Since(start)))
-	return imageTags, nil
+	return imageTags, additionalTags, nil
 }
 
 func checkWorkspaces(artifacts []*latest_v1.Artifact) error {
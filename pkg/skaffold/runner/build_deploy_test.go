@@ -25,9 +25,11 @@ import (
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -189,6 +191,27 @@ func TestBuildPushFlag(t *testing.T) {
 	})
 }
 
+func TestBuildAdditionalTags(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var tagged []string
+		t.Override(&addLocalTag, func(_ context.Context, _ docker.Config, image, tag string) error {
+			tagged = append(tagged, image+" -> "+tag)
+			return nil
+		})
+
+		testBench := &TestBench{}
+		artifacts := []*latest_v1.Artifact{{ImageName: "img"}}
+		runner := createRunner(t, testBench, nil, artifacts, nil)
+		runner.tagger = tag.NewMultiTagger(&tag.CustomTag{Tag: "v1"}, &tag.CustomTag{Tag: "latest"})
+
+		bRes, err := runner.Build(context.Background(), ioutil.Discard, artifacts)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]graph.Artifact{{ImageName: "img", Tag: "img:1", AdditionalTags: []string{"img:latest"}}}, bRes)
+		t.CheckDeepEqual([]string{"img:1 -> img:latest"}, tagged)
+	})
+}
+
 func TestDigestSources(t *testing.T) {
 	artifacts := []*latest_v1.Artifact{
 		{ImageName: "img1"},
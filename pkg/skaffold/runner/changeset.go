@@ -17,6 +17,7 @@ limitations under the License.
 package runner
 
 import (
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
 )
@@ -24,6 +25,7 @@ import (
 type ChangeSet struct {
 	needsRebuild   []*latest_v1.Artifact
 	rebuildTracker map[string]*latest_v1.Artifact
+	fileChanges    map[string]filemon.Events // keyed on artifact image name
 	needsResync    []*sync.Item
 	resyncTracker  map[string]*sync.Item
 	needsRetest    map[string]bool // keyed on artifact image name
@@ -43,6 +45,19 @@ func (c *ChangeSet) AddRebuild(a *latest_v1.Artifact) {
 	c.needsRebuild = append(c.needsRebuild, a)
 }
 
+// AddFileChanges records the batch of file changes that triggered the rebuild of an artifact.
+func (c *ChangeSet) AddFileChanges(a *latest_v1.Artifact, e filemon.Events) {
+	if c.fileChanges == nil {
+		c.fileChanges = map[string]filemon.Events{}
+	}
+	c.fileChanges[a.ImageName] = e
+}
+
+// FileChanges returns the batch of file changes that triggered the rebuild of an artifact.
+func (c *ChangeSet) FileChanges(imageName string) filemon.Events {
+	return c.fileChanges[imageName]
+}
+
 func (c *ChangeSet) AddRetest(a *latest_v1.Artifact) {
 	if c.needsRetest == nil {
 		c.needsRetest = make(map[string]bool)
@@ -64,6 +79,7 @@ func (c *ChangeSet) AddResync(s *sync.Item) {
 
 func (c *ChangeSet) resetBuild() {
 	c.rebuildTracker = make(map[string]*latest_v1.Artifact)
+	c.fileChanges = make(map[string]filemon.Events)
 	c.needsRebuild = nil
 }
 
@@ -22,7 +22,7 @@ import (
 )
 
 func (r *SkaffoldRunner) createContainerManager() *debugging.ContainerManager {
-	if r.runCtx.Mode() != config.RunModes.Debug {
+	if r.runCtx.Mode() != config.RunModes.Debug || localDockerOnly(r.runCtx.Deployers()) {
 		return nil
 	}
 
@@ -32,8 +32,10 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
@@ -46,6 +48,7 @@ func (r *SkaffoldRunner) DeployAndLog(ctx context.Context, out io.Writer, artifa
 
 	logger := r.createLogger(out, artifacts)
 	defer logger.Stop()
+	defer closePersistentLogStore()
 
 	// Logs should be retrieved up to just before the deploy
 	logger.SetSince(time.Now())
@@ -104,18 +107,26 @@ They are tagged and referenced by a unique, local only, tag instead.
 See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	}
 
-	// Check that the cluster is reachable.
-	// This gives a better error message when the cluster can't
-	// be reached.
-	if err := failIfClusterIsNotReachable(); err != nil {
-		return fmt.Errorf("unable to connect to Kubernetes: %w", err)
-	}
+	usesCluster := !localDockerOnly(r.runCtx.Deployers())
 
-	if len(localImages) > 0 && r.runCtx.Cluster.LoadImages {
-		err := r.loadImagesIntoCluster(ctx, out, localImages)
-		if err != nil {
-			return err
+	if usesCluster {
+		// Check that the cluster is reachable.
+		// This gives a better error message when the cluster can't
+		// be reached.
+		if err := failIfClusterIsNotReachable(); err != nil {
+			return fmt.Errorf("unable to connect to Kubernetes: %w", err)
 		}
+
+		if len(localImages) > 0 && r.runCtx.Cluster.LoadImages {
+			err := r.loadImagesIntoCluster(ctx, out, localImages)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := r.deployHooks().RunPreHooks(ctx, out); err != nil {
+		return err
 	}
 
 	deployOut, postDeployFn, err := deployutil.WithLogFile(time.Now().Format(deployutil.TimeFormat)+".log", out, r.runCtx.Muted())
@@ -134,6 +145,9 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	}
 
 	r.hasDeployed = true
+	if r.drift != nil {
+		r.drift.deployed()
+	}
 
 	statusCheckOut, postStatusCheckFn, err := deployutil.WithStatusCheckLogFile(time.Now().Format(deployutil.TimeFormat)+".log", out, r.runCtx.Muted())
 	defer postStatusCheckFn()
@@ -143,8 +157,33 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	event.DeployComplete()
 	eventV2.TaskSucceeded(constants.Deploy)
 	r.runCtx.UpdateNamespaces(namespaces)
-	sErr := r.performStatusCheck(ctx, statusCheckOut)
-	return sErr
+	if usesCluster {
+		if err := r.performStatusCheck(ctx, statusCheckOut); err != nil {
+			return err
+		}
+	}
+	if err := r.deployHooks().RunPostHooks(ctx, out); err != nil {
+		return err
+	}
+	if r.runCtx.Verify() {
+		return r.Verify(ctx, out, artifacts)
+	}
+	return nil
+}
+
+// deployHooks returns the lifecycle hooks runner for the deploy configs of all pipelines.
+func (r *SkaffoldRunner) deployHooks() hooks.Runner {
+	var cfg latest_v1.DeployHooks
+	for _, d := range r.runCtx.DeployConfigs() {
+		cfg.PreHooks = append(cfg.PreHooks, d.LifecycleHooks.PreHooks...)
+		cfg.PostHooks = append(cfg.PostHooks, d.LifecycleHooks.PostHooks...)
+	}
+	opts := hooks.DeployEnvOpts{
+		RunID:       r.labeller.GetRunID(),
+		KubeContext: r.runCtx.GetKubeContext(),
+		Namespaces:  r.runCtx.GetNamespaces(),
+	}
+	return hooks.NewDeployRunner(r.kubectlCLI, cfg, opts)
 }
 
 func (r *SkaffoldRunner) loadImagesIntoCluster(ctx context.Context, out io.Writer, artifacts []graph.Artifact) error {
@@ -63,12 +63,16 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 	needsSync := syncIntent && len(r.changeSet.needsResync) > 0
 	needsBuild := buildIntent && len(r.changeSet.needsRebuild) > 0
 	needsTest := len(r.changeSet.needsRetest) > 0
+	if r.drift != nil && r.drift.prepareRedeploy() {
+		r.changeSet.needsRedeploy = true
+	}
 	needsDeploy := deployIntent && r.changeSet.needsRedeploy
 	if !needsSync && !needsBuild && !needsTest && !needsDeploy {
 		return nil
 	}
 
 	logger.Mute()
+	logger.SetIteration(r.devIteration)
 	// if any action is going to be performed, reset the monitor's changed component tracker for debouncing
 	defer r.monitor.Reset()
 	defer r.listener.LogWatchToUser(out)
@@ -89,7 +93,7 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 			color.Default.Fprintf(out, "Syncing %d files for %s\n", fileCount, s.Image)
 			fileSyncInProgress(fileCount, s.Image)
 
-			if err := r.syncer.Sync(ctx, s); err != nil {
+			if err := r.syncer.Sync(ctx, out, s); err != nil {
 				logrus.Warnln("Skipping deploy due to sync error:", err)
 				fileSyncFailed(fileCount, s.Image, err)
 				event.DevLoopFailedInPhase(r.devIteration, constants.Sync, err)
@@ -113,6 +117,12 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 			meterUpdated = true
 		}
 
+		for _, a := range r.changeSet.needsRebuild {
+			if e := r.changeSet.FileChanges(a.ImageName); e.HasChanged() {
+				logrus.Infof("Rebuilding %s after %d added, %d modified and %d deleted files", a.ImageName, len(e.Added), len(e.Modified), len(e.Deleted))
+			}
+		}
+
 		var err error
 		bRes, err = r.Build(ctx, out, r.changeSet.needsRebuild)
 		if err != nil {
@@ -178,10 +188,16 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 
 // Dev watches for changes and runs the skaffold build, test and deploy
 // config until interrupted by the user.
-func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*latest_v1.Artifact) error {
+func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*latest_v1.Artifact) (err error) {
 	event.DevLoopInProgress(r.devIteration)
 	eventV2.TaskInProgress(constants.DevLoop)
 	defer func() { r.devIteration++ }()
+	// The runner created after a configuration change keeps writing to the same log store.
+	defer func() {
+		if !errors.Is(err, ErrorConfigurationChanged) {
+			closePersistentLogStore()
+		}
+	}()
 	g := getTransposeGraph(artifacts)
 	// Watch artifacts
 	start := time.Now()
@@ -199,7 +215,13 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 		case <-ctx.Done():
 			return context.Canceled
 		default:
-			if err := r.monitor.Register(
+			opts, err := watchOptions(artifact)
+			if err != nil {
+				event.DevLoopFailedWithErrorCode(r.devIteration, proto.StatusCode_DEVINIT_REGISTER_BUILD_DEPS, err)
+				eventV2.TaskFailed(constants.DevLoop, err)
+				return fmt.Errorf("watching files for artifact %q: %w", artifact.ImageName, err)
+			}
+			if err := r.monitor.RegisterWithOptions(
 				func() ([]string, error) {
 					return r.sourceDependencies.ResolveForArtifact(ctx, artifact)
 				},
@@ -212,8 +234,10 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 						r.changeSet.AddResync(s)
 					default:
 						r.changeSet.AddRebuild(artifact)
+						r.changeSet.AddFileChanges(artifact, e)
 					}
 				},
+				opts,
 			); err != nil {
 				event.DevLoopFailedWithErrorCode(r.devIteration, proto.StatusCode_DEVINIT_REGISTER_BUILD_DEPS, err)
 				eventV2.TaskFailed(constants.DevLoop, err)
@@ -310,6 +334,10 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 		return fmt.Errorf("starting logger: %w", err)
 	}
 
+	if r.drift != nil {
+		r.drift.start(ctx, out)
+	}
+
 	color.Yellow.Fprintln(out, "Press Ctrl+C to exit")
 
 	event.DevLoopComplete(r.devIteration)
@@ -333,3 +361,25 @@ func getTransposeGraph(artifacts []*latest_v1.Artifact) devGraph {
 	}
 	return g
 }
+
+// watchOptions returns how the file changes of an artifact are detected and batched.
+func watchOptions(a *latest_v1.Artifact) (filemon.WatchOptions, error) {
+	opts := filemon.WatchOptions{Root: a.Workspace}
+	if a.Watch == nil {
+		return opts, nil
+	}
+
+	var err error
+	if a.Watch.QuietPeriod != "" {
+		if opts.QuietPeriod, err = time.ParseDuration(a.Watch.QuietPeriod); err != nil {
+			return opts, fmt.Errorf("parsing quiet period: %w", err)
+		}
+	}
+	if a.Watch.MaxBatchWindow != "" {
+		if opts.MaxBatchWindow, err = time.ParseDuration(a.Watch.MaxBatchWindow); err != nil {
+			return opts, fmt.Errorf("parsing max batch window: %w", err)
+		}
+	}
+	opts.Ignore = a.Watch.Ignore
+	return opts, nil
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	k8s "k8s.io/client-go/kubernetes"
@@ -45,6 +46,14 @@ func (t *NoopMonitor) Run(bool) error {
 	return nil
 }
 
+func (t *NoopMonitor) RegisterWithOptions(func() ([]string, error), func(filemon.Events), filemon.WatchOptions) error {
+	return nil
+}
+
+func (t *NoopMonitor) Pending() (time.Duration, bool) {
+	return 0, false
+}
+
 func (t *NoopMonitor) Reset() {}
 
 type FailMonitor struct{}
@@ -57,6 +66,14 @@ func (t *FailMonitor) Run(bool) error {
 	return errors.New("BUG")
 }
 
+func (t *FailMonitor) RegisterWithOptions(func() ([]string, error), func(filemon.Events), filemon.WatchOptions) error {
+	return nil
+}
+
+func (t *FailMonitor) Pending() (time.Duration, bool) {
+	return 0, false
+}
+
 func (t *FailMonitor) Reset() {}
 
 type TestMonitor struct {
@@ -92,6 +109,14 @@ func (t *TestMonitor) Run(bool) error {
 	return nil
 }
 
+func (t *TestMonitor) RegisterWithOptions(deps func() ([]string, error), onChange func(filemon.Events), _ filemon.WatchOptions) error {
+	return t.Register(deps, onChange)
+}
+
+func (t *TestMonitor) Pending() (time.Duration, bool) {
+	return 0, false
+}
+
 func (t *TestMonitor) Reset() {}
 
 func mockK8sClient() (k8s.Interface, error) {
@@ -548,3 +573,41 @@ func TestDevSync(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchOptions(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    *latest_v1.Artifact
+		expected    filemon.WatchOptions
+		shouldErr   bool
+	}{
+		{
+			description: "defaults",
+			artifact:    &latest_v1.Artifact{Workspace: "app"},
+			expected:    filemon.WatchOptions{Root: "app"},
+		},
+		{
+			description: "watch config",
+			artifact: &latest_v1.Artifact{
+				Workspace: "app",
+				Watch:     &latest_v1.WatchConfig{QuietPeriod: "500ms", MaxBatchWindow: "5s", Ignore: []string{"**/*.swp"}},
+			},
+			expected: filemon.WatchOptions{Root: "app", QuietPeriod: 500 * time.Millisecond, MaxBatchWindow: 5 * time.Second, Ignore: []string{"**/*.swp"}},
+		},
+		{
+			description: "invalid duration",
+			artifact:    &latest_v1.Artifact{Watch: &latest_v1.WatchConfig{QuietPeriod: "soon"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			opts, err := watchOptions(test.artifact)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expected, opts)
+			}
+		})
+	}
+}
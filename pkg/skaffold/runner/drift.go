@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/diff"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+var (
+	// For testing
+	driftCheckInterval = 10 * time.Second
+	computeDrift       = diff.Compute
+	driftDetected      = event.DriftDetected
+	driftDetectedV2    = eventV2.DriftDetected
+)
+
+// driftDetector periodically compares the live state of the resources labelled by Skaffold
+// with the manifests applied by the last deployment.
+type driftDetector struct {
+	tracker   deploy.ManifestTracker
+	namespace string
+
+	lock       sync.Mutex
+	manifests  manifest.ManifestList
+	generation int
+	reported   map[string]string
+	drifted    bool
+}
+
+func newDriftDetector(tracker deploy.ManifestTracker, namespace string) *driftDetector {
+	return &driftDetector{
+		tracker:   tracker,
+		namespace: namespace,
+		reported:  map[string]string{},
+	}
+}
+
+// deployed records the manifests applied by a deployment. The resources are compared with these manifests from now on.
+func (d *driftDetector) deployed() {
+	var labelled manifest.ManifestList
+	for _, m := range d.tracker.AppliedManifests() {
+		if hasRunIDLabel(m) {
+			labelled = append(labelled, m)
+		}
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.manifests = labelled
+	d.generation++
+	d.reported = map[string]string{}
+	d.drifted = false
+}
+
+// prepareRedeploy makes the next deployment re-apply all the manifests if drift was detected.
+// It returns true in that case.
+func (d *driftDetector) prepareRedeploy() bool {
+	d.lock.Lock()
+	drifted := d.drifted
+	d.drifted = false
+	d.lock.Unlock()
+
+	if drifted {
+		d.tracker.ForgetAppliedManifests()
+	}
+	return drifted
+}
+
+// start checks for drift until the context is cancelled.
+func (d *driftDetector) start(ctx context.Context, out io.Writer) {
+	go func() {
+		ticker := time.NewTicker(driftCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				d.check(ctx, out)
+			}
+		}
+	}()
+}
+
+func (d *driftDetector) check(ctx context.Context, out io.Writer) {
+	d.lock.Lock()
+	manifests, generation := d.manifests, d.generation
+	d.lock.Unlock()
+	if len(manifests) == 0 {
+		return
+	}
+
+	diffs, err := computeDrift(ctx, manifests, d.namespace)
+	if err != nil {
+		logrus.Debugln("Unable to check for drift:", err)
+		return
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	// The resources were redeployed during the check.
+	if generation != d.generation {
+		return
+	}
+
+	for _, od := range diffs {
+		if od.Status == diff.StatusUnchanged || d.reported[od.String()] == od.Diff {
+			continue
+		}
+		d.reported[od.String()] = od.Diff
+		d.drifted = true
+
+		resource := strings.ToLower(od.Kind) + "/" + od.Name
+		status := event.Changed
+		if od.Status == diff.StatusAdded {
+			status = event.Deleted
+			color.Yellow.Fprintf(out, "%s was deleted from the cluster. It will be re-applied with the next deployment.\n", od)
+		} else {
+			color.Yellow.Fprintf(out, "%s was changed in the cluster: %s. It will be re-applied with the next deployment.\n", od, strings.Join(od.Fields, ", "))
+		}
+
+		err := driftErr(od)
+		driftDetected(resource, od.Namespace, status, od.Fields, err)
+		driftDetectedV2(resource, od.Namespace, status, od.Fields, err)
+	}
+}
+
+func driftErr(od diff.ObjectDiff) error {
+	message := fmt.Sprintf("%s no longer matches the applied manifests", od)
+	if len(od.Fields) > 0 {
+		message += fmt.Sprintf(": %s", strings.Join(od.Fields, ", "))
+	}
+
+	return sErrors.NewErrorWithStatusCode(proto.ActionableErr{
+		Message: message,
+		ErrCode: proto.StatusCode_DEPLOY_DRIFT_DETECTED,
+		Suggestions: []*proto.Suggestion{
+			{
+				SuggestionCode: proto.SuggestionCode_REAPPLY_MANIFESTS,
+				Action:         "Trigger a deploy to re-apply the manifests",
+			},
+		},
+	})
+}
+
+// hasRunIDLabel returns true if a manifest is labelled with the run ID of a Skaffold session.
+func hasRunIDLabel(m []byte) bool {
+	var obj struct {
+		Metadata struct {
+			Labels map[string]string `yaml:"labels"`
+		} `yaml:"metadata"`
+	}
+	if err := yaml.Unmarshal(m, &obj); err != nil {
+		return false
+	}
+	return obj.Metadata.Labels[label.RunIDLabel] != ""
+}
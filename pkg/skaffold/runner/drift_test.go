@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/diff"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const (
+	labelledPod = `apiVersion: v1
+kind: Pod
+metadata:
+  labels:
+    skaffold.dev/run-id: abc
+  name: leeroy-web
+`
+	unlabelledPod = `apiVersion: v1
+kind: Pod
+metadata:
+  name: leeroy-app
+`
+)
+
+type fakeManifestTracker struct {
+	applied   manifest.ManifestList
+	forgotten bool
+}
+
+func (f *fakeManifestTracker) AppliedManifests() manifest.ManifestList { return f.applied }
+func (f *fakeManifestTracker) ForgetAppliedManifests()                 { f.forgotten = true }
+
+type driftReport struct {
+	resource  string
+	namespace string
+	status    string
+	fields    []string
+}
+
+func TestDriftDetectorDeployed(t *testing.T) {
+	tracker := &fakeManifestTracker{applied: manifest.ManifestList{[]byte(labelledPod), []byte(unlabelledPod)}}
+	detector := newDriftDetector(tracker, "")
+
+	detector.deployed()
+
+	testutil.CheckDeepEqual(t, manifest.ManifestList{[]byte(labelledPod)}, detector.manifests)
+	testutil.CheckDeepEqual(t, 1, detector.generation)
+}
+
+func TestDriftDetectorCheck(t *testing.T) {
+	changed := diff.ObjectDiff{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "leeroy-web", Status: diff.StatusChanged, Diff: "-replicas: 1\n+replicas: 3\n", Fields: []string{"spec.replicas"}}
+	deleted := diff.ObjectDiff{APIVersion: "v1", Kind: "Service", Namespace: "default", Name: "leeroy-web", Status: diff.StatusAdded, Diff: "+kind: Service\n"}
+	unchanged := diff.ObjectDiff{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "leeroy-app", Status: diff.StatusUnchanged}
+
+	tests := []struct {
+		description    string
+		diffs          []diff.ObjectDiff
+		expected       []driftReport
+		expectedOutput string
+	}{
+		{
+			description: "no drift",
+			diffs:       []diff.ObjectDiff{unchanged},
+		},
+		{
+			description:    "changed resource",
+			diffs:          []diff.ObjectDiff{changed, unchanged},
+			expected:       []driftReport{{resource: "deployment/leeroy-web", namespace: "default", status: event.Changed, fields: []string{"spec.replicas"}}},
+			expectedOutput: "deployment/leeroy-web in namespace default was changed in the cluster: spec.replicas. It will be re-applied with the next deployment.\n",
+		},
+		{
+			description:    "deleted resource",
+			diffs:          []diff.ObjectDiff{deleted},
+			expected:       []driftReport{{resource: "service/leeroy-web", namespace: "default", status: event.Deleted}},
+			expectedOutput: "service/leeroy-web in namespace default was deleted from the cluster. It will be re-applied with the next deployment.\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var reports []driftReport
+			report := func(resource, namespace, status string, fields []string, err error) {
+				reports = append(reports, driftReport{resource, namespace, status, fields})
+			}
+			t.Override(&driftDetected, report)
+			t.Override(&driftDetectedV2, report)
+			t.Override(&computeDrift, func(context.Context, manifest.ManifestList, string) ([]diff.ObjectDiff, error) {
+				return test.diffs, nil
+			})
+
+			tracker := &fakeManifestTracker{applied: manifest.ManifestList{[]byte(labelledPod)}}
+			detector := newDriftDetector(tracker, "")
+			detector.deployed()
+
+			var out bytes.Buffer
+			detector.check(context.Background(), &out)
+			// The same drift is only reported once.
+			detector.check(context.Background(), &out)
+
+			var expected []driftReport
+			for _, r := range test.expected {
+				// v1 and v2 events
+				expected = append(expected, r, r)
+			}
+			t.CheckDeepEqual(expected, reports, cmp.AllowUnexported(driftReport{}))
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+			t.CheckDeepEqual(len(test.expected) > 0, detector.prepareRedeploy())
+			t.CheckDeepEqual(len(test.expected) > 0, tracker.forgotten)
+			t.CheckFalse(detector.prepareRedeploy())
+		})
+	}
+}
+
+func TestDriftDetectorIgnoresStaleCheck(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tracker := &fakeManifestTracker{applied: manifest.ManifestList{[]byte(labelledPod)}}
+		detector := newDriftDetector(tracker, "")
+		detector.deployed()
+
+		t.Override(&computeDrift, func(context.Context, manifest.ManifestList, string) ([]diff.ObjectDiff, error) {
+			// A new deployment happens while the live state is being compared.
+			detector.deployed()
+			return []diff.ObjectDiff{{Kind: "Pod", Name: "leeroy-web", Status: diff.StatusChanged, Diff: "-a\n+b\n"}}, nil
+		})
+
+		var out bytes.Buffer
+		detector.check(context.Background(), &out)
+
+		t.CheckEmpty(out.String())
+		t.CheckFalse(detector.prepareRedeploy())
+	})
+}
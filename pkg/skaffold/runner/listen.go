@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -74,10 +75,23 @@ func (l *SkaffoldListener) WatchForChanges(ctx context.Context, out io.Writer, d
 			if err := l.do(devLoop); err != nil {
 				return err
 			}
+		case <-l.pendingChanges():
+			if err := l.do(devLoop); err != nil {
+				return err
+			}
 		}
 	}
 }
 
+// pendingChanges fires when file changes held by a quiet period are ready to be reported.
+// Triggers that only fire on file system events wouldn't otherwise run the monitor again.
+func (l *SkaffoldListener) pendingChanges() <-chan time.Time {
+	if wait, pending := l.Monitor.Pending(); pending {
+		return time.After(wait)
+	}
+	return nil
+}
+
 func (l *SkaffoldListener) do(devLoop func() error) error {
 	// reset the dependencies resolver cache at the start of every dev loop.
 	l.sourceDependenciesCache.Reset()
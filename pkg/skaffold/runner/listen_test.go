@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
@@ -45,6 +46,10 @@ func (f *fakeMonitor) Run(debounce bool) error {
 	return nil
 }
 
+func (f *fakeMonitor) Pending() (time.Duration, bool) {
+	return 0, false
+}
+
 type fakeTriggger struct {
 	trigger.Trigger
 }
@@ -18,13 +18,26 @@ package runner
 
 import (
 	"io"
+	"sync"
+
+	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/logfile"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/server"
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+)
+
+var (
+	logStore     *logfile.Store
+	logStoreOnce sync.Once
 )
 
 func (r *SkaffoldRunner) createLogger(out io.Writer, artifacts []graph.Artifact) *kubernetes.LogAggregator {
-	if !r.runCtx.Tail() {
+	// Logs of containers run by the Docker deployer are streamed by the deployer itself.
+	if !r.runCtx.Tail() || localDockerOnly(r.runCtx.Deployers()) {
 		return nil
 	}
 
@@ -33,5 +46,54 @@ func (r *SkaffoldRunner) createLogger(out io.Writer, artifacts []graph.Artifact)
 		imageNames = append(imageNames, artifact.Tag)
 	}
 
-	return kubernetes.NewLogAggregator(out, r.kubectlCLI, imageNames, r.podSelector, r.runCtx)
+	logger := kubernetes.NewLogAggregator(out, r.kubectlCLI, imageNames, r.podSelector, r.runCtx)
+	if r.runCtx.PersistLogs() {
+		logger.PersistTo(persistentLogStore())
+	}
+	logger.SetIteration(r.devIteration)
+	// give the server a callback to replace the log filters when a user request is received
+	server.SetLogFilterCallback(func(req *proto.LogFilterRequest) error {
+		return setLogFilter(logger, req)
+	})
+	return logger
+}
+
+// persistentLogStore returns the store that persists the container logs of this Skaffold process.
+// It's shared by the runners created when the configuration is reloaded so that the logs
+// of the previous iterations are kept.
+func persistentLogStore() *logfile.Store {
+	logStoreOnce.Do(func() {
+		store, err := logfile.NewStore(logfile.StoreDir)
+		if err != nil {
+			logrus.Warnln("Container logs won't be persisted:", err)
+			return
+		}
+		logStore = store
+	})
+	return logStore
+}
+
+// closePersistentLogStore closes the store, if any, once the runner shuts down.
+func closePersistentLogStore() {
+	logStore.Close()
+}
+
+func setLogFilter(logger *kubernetes.LogAggregator, req *proto.LogFilterRequest) error {
+	if req.GetRestore() {
+		logrus.Debugln("restoring the configured log filters")
+		logger.SetFilter(nil)
+		return nil
+	}
+
+	filter, err := kubernetes.NewLogFilter(&latest_v1.LogFilter{
+		MinLevel: req.GetMinLevel(),
+		Fields:   req.GetFields(),
+		Regex:    req.GetRegex(),
+	})
+	if err != nil {
+		return err
+	}
+	logrus.Debugf("log filter update received: %v", req)
+	logger.SetFilter(filter)
+	return nil
 }
@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSetLogFilter(t *testing.T) {
+	tests := []struct {
+		description string
+		request     *proto.LogFilterRequest
+		shouldErr   bool
+	}{
+		{
+			description: "filter",
+			request:     &proto.LogFilterRequest{MinLevel: "warn", Fields: map[string]string{"trace_id": "abc"}, Regex: "^GET"},
+		},
+		{
+			description: "restore",
+			request:     &proto.LogFilterRequest{Restore: true},
+		},
+		{
+			description: "invalid level",
+			request:     &proto.LogFilterRequest{MinLevel: "verbose"},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid regex",
+			request:     &proto.LogFilterRequest{Regex: "("},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			logger := &kubernetes.LogAggregator{}
+
+			err := setLogFilter(logger, test.request)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cache"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/helm"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kpt"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
@@ -47,6 +48,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/trigger"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/verify"
 )
 
 // NewForConfig returns a new SkaffoldRunner for a SkaffoldConfig
@@ -80,12 +82,13 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 	if err != nil {
 		return nil, fmt.Errorf("creating tester: %w", err)
 	}
-	syncer := getSyncer(runCtx)
 	var deployer deploy.Deployer
 	deployer, err = getDeployer(runCtx, labeller.Labels())
 	if err != nil {
 		return nil, fmt.Errorf("creating deployer: %w", err)
 	}
+	syncer := getSyncer(runCtx, deployer, labeller.Labels())
+	drift := getDriftDetector(runCtx, deployer)
 
 	depLister := func(ctx context.Context, artifact *latest_v1.Artifact) ([]string, error) {
 		buildDependencies, err := sourceDependencies.ResolveForArtifact(ctx, artifact)
@@ -136,6 +139,7 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 		deployer: deployer,
 		syncer:   syncer,
 		monitor:  monitor,
+		verifier: verify.NewVerifier(runCtx, labeller.Labels()),
 		listener: &SkaffoldListener{
 			Monitor:                 monitor,
 			Trigger:                 trigger,
@@ -151,6 +155,7 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 		runCtx:             runCtx,
 		intents:            intents,
 		isLocalImage:       isLocalImage,
+		drift:              drift,
 	}, nil
 }
 
@@ -204,6 +209,9 @@ func isImageLocal(runCtx *runcontext.RunContext, imageName string) (bool, error)
 	case runCtx.Opts.PushImages.Value() != nil:
 		logrus.Debugf("push value set via skaffold build --push flag, --push=%t", *runCtx.Opts.PushImages.Value())
 		pushImages = *runCtx.Opts.PushImages.Value()
+	case pipeline.Build.LocalBuild.Push == nil && localDockerOnly([]latest_v1.DeployType{pipeline.Deploy.DeployType}):
+		logrus.Debugln("push value not present, defaulting to false because images are only deployed to the local Docker daemon")
+		pushImages = false
 	case pipeline.Build.LocalBuild.Push == nil:
 		pushImages = cl.PushImages
 		logrus.Debugf("push value not present, defaulting to %t because cluster.PushImages is %t", pushImages, cl.PushImages)
@@ -222,8 +230,80 @@ func getTester(cfg test.Config, isLocalImage func(imageName string) (bool, error
 	return tester, nil
 }
 
-func getSyncer(cfg sync.Config) sync.Syncer {
-	return sync.NewSyncer(cfg)
+// getSyncer returns the Docker deployers as the syncer when nothing is deployed to a Kubernetes cluster.
+func getSyncer(runCtx *runcontext.RunContext, deployer deploy.Deployer, labels map[string]string) sync.Syncer {
+	if localDockerOnly(runCtx.Deployers()) {
+		switch syncers := deployerSyncers(deployer); len(syncers) {
+		case 0:
+		case 1:
+			return syncers[0]
+		default:
+			return syncers
+		}
+	}
+	return sync.NewSyncer(runCtx, labels)
+}
+
+// deployerSyncers lists the deployers that sync files themselves.
+// The deployers wrapped in a DeployerMux are listed instead of the mux itself.
+func deployerSyncers(deployer deploy.Deployer) sync.SyncerMux {
+	if mux, ok := deployer.(deploy.DeployerMux); ok {
+		var syncers sync.SyncerMux
+		for _, d := range mux {
+			syncers = append(syncers, deployerSyncers(d)...)
+		}
+		return syncers
+	}
+	if syncer, ok := deployer.(sync.Syncer); ok {
+		return sync.SyncerMux{syncer}
+	}
+	return nil
+}
+
+// getDriftDetector returns a drift detector when drift detection is enabled and the deployers track the manifests they apply.
+func getDriftDetector(runCtx *runcontext.RunContext, deployer deploy.Deployer) *driftDetector {
+	if !runCtx.DetectDrift() {
+		return nil
+	}
+
+	tracked, untracked := countManifestTrackers(deployer)
+	if untracked > 0 {
+		logrus.Warnln("Drift detection is only supported by the kubectl and kustomize deployers")
+	}
+	if tracked == 0 {
+		return nil
+	}
+	return newDriftDetector(deployer.(deploy.ManifestTracker), runCtx.GetKubeNamespace())
+}
+
+// countManifestTrackers counts the deployers that track, or don't track, the manifests they apply.
+// The deployers wrapped in a DeployerMux are counted instead of the mux itself.
+func countManifestTrackers(deployer deploy.Deployer) (tracked int, untracked int) {
+	if mux, ok := deployer.(deploy.DeployerMux); ok {
+		for _, d := range mux {
+			t, u := countManifestTrackers(d)
+			tracked += t
+			untracked += u
+		}
+		return tracked, untracked
+	}
+	if _, ok := deployer.(deploy.ManifestTracker); ok {
+		return 1, 0
+	}
+	return 0, 1
+}
+
+// localDockerOnly returns true if the application is only deployed to the local Docker daemon,
+// in which case no Kubernetes cluster is needed.
+func localDockerOnly(deployers []latest_v1.DeployType) bool {
+	docker := false
+	for _, d := range deployers {
+		if d.HelmDeploy != nil || d.KptDeploy != nil || d.KubectlDeploy != nil || d.KustomizeDeploy != nil {
+			return false
+		}
+		docker = docker || d.DockerDeploy != nil
+	}
+	return docker
 }
 
 /*
@@ -332,6 +412,14 @@ func getDeployer(runCtx *runcontext.RunContext, labels map[string]string) (deplo
 
 	var deployers deploy.DeployerMux
 	for _, d := range deployerCfg {
+		if d.DockerDeploy != nil {
+			deployer, err := docker.NewDeployer(runCtx, labels, d.DockerDeploy)
+			if err != nil {
+				return nil, err
+			}
+			deployers = append(deployers, deployer)
+		}
+
 		if d.HelmDeploy != nil {
 			h, err := helm.NewDeployer(runCtx, labels, d.HelmDeploy)
 			if err != nil {
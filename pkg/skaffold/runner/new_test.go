@@ -22,12 +22,15 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	dockerdeploy "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/helm"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kpt"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kustomize"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -75,6 +78,11 @@ func TestGetDeployer(tOuter *testing.T) {
 					Flags: latest_v1.KubectlFlags{},
 				})).(deploy.Deployer),
 			},
+			{
+				description: "docker deployer",
+				cfg:         latest_v1.DeployType{DockerDeploy: &latest_v1.DockerDeploy{}},
+				expected:    &dockerdeploy.Deployer{},
+			},
 			{
 				description: "kpt deployer",
 				cfg:         latest_v1.DeployType{KptDeploy: &latest_v1.KptDeploy{}},
@@ -101,6 +109,9 @@ func TestGetDeployer(tOuter *testing.T) {
 						test.helmVersion,
 					))
 				}
+				t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+					return dummyLocalDaemon{}, nil
+				})
 
 				deployer, err := getDeployer(&runcontext.RunContext{
 					Pipelines: runcontext.NewPipelines([]latest_v1.Pipeline{{
@@ -240,13 +251,142 @@ func TestGetDefaultDeployer(tOuter *testing.T) {
 	})
 }
 
+func TestGetSyncer(t *testing.T) {
+	tests := []struct {
+		description     string
+		cfgs            []latest_v1.DeployType
+		expectedDockers int
+	}{
+		{
+			description:     "docker deployer syncs files to containers",
+			cfgs:            []latest_v1.DeployType{{DockerDeploy: &latest_v1.DockerDeploy{}}},
+			expectedDockers: 1,
+		},
+		{
+			description:     "docker deployers of several configs sync files to their containers",
+			cfgs:            []latest_v1.DeployType{{DockerDeploy: &latest_v1.DockerDeploy{}}, {DockerDeploy: &latest_v1.DockerDeploy{Network: "other"}}},
+			expectedDockers: 2,
+		},
+		{
+			description: "kubectl deployer syncs files to pods",
+			cfgs:        []latest_v1.DeployType{{KubectlDeploy: &latest_v1.KubectlDeploy{}}},
+		},
+		{
+			description: "files are synced to pods when a deployer targets a cluster",
+			cfgs: []latest_v1.DeployType{{
+				DockerDeploy:  &latest_v1.DockerDeploy{},
+				KubectlDeploy: &latest_v1.KubectlDeploy{},
+			}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+				return dummyLocalDaemon{}, nil
+			})
+			var pipelines []latest_v1.Pipeline
+			for _, cfg := range test.cfgs {
+				pipelines = append(pipelines, latest_v1.Pipeline{Deploy: latest_v1.DeployConfig{DeployType: cfg}})
+			}
+			runCtx := &runcontext.RunContext{Pipelines: runcontext.NewPipelines(pipelines)}
+			deployer, err := getDeployer(runCtx, nil)
+			t.RequireNoError(err)
+
+			syncer := getSyncer(runCtx, deployer, nil)
+
+			syncers, isMux := syncer.(sync.SyncerMux)
+			if !isMux {
+				syncers = sync.SyncerMux{syncer}
+			}
+			dockers := 0
+			for _, s := range syncers {
+				if _, isDocker := s.(*dockerdeploy.Deployer); isDocker {
+					dockers++
+				}
+			}
+			t.CheckDeepEqual(test.expectedDockers, dockers)
+		})
+	}
+}
+
+func TestGetDriftDetector(t *testing.T) {
+	kubectlDeployer, err := kubectl.NewDeployer(&runcontext.RunContext{
+		Pipelines: runcontext.NewPipelines([]latest_v1.Pipeline{{}}),
+	}, nil, &latest_v1.KubectlDeploy{})
+	testutil.CheckError(t, false, err)
+	kptDeployer := kpt.NewDeployer(&runcontext.RunContext{}, nil, &latest_v1.KptDeploy{})
+
+	tests := []struct {
+		description string
+		detectDrift bool
+		deployer    deploy.Deployer
+		expected    bool
+	}{
+		{
+			description: "disabled",
+			deployer:    kubectlDeployer,
+		},
+		{
+			description: "kubectl deployer",
+			detectDrift: true,
+			deployer:    kubectlDeployer,
+			expected:    true,
+		},
+		{
+			description: "helm deployer",
+			detectDrift: true,
+			deployer:    &helm.Deployer{},
+		},
+		{
+			description: "mux with a kubectl deployer",
+			detectDrift: true,
+			deployer:    deploy.DeployerMux{kptDeployer, kubectlDeployer},
+			expected:    true,
+		},
+		{
+			description: "mux without a kubectl deployer",
+			detectDrift: true,
+			deployer:    deploy.DeployerMux{&helm.Deployer{}, kptDeployer},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			runCtx := &runcontext.RunContext{Opts: config.SkaffoldOptions{DetectDrift: test.detectDrift}}
+
+			drift := getDriftDetector(runCtx, test.deployer)
+
+			t.CheckDeepEqual(test.expected, drift != nil)
+		})
+	}
+}
+
 func TestIsImageLocal(t *testing.T) {
 	tests := []struct {
 		description       string
 		pushImagesFlagVal *bool
 		localBuildConfig  *bool
+		deployType        latest_v1.DeployType
 		expected          bool
 	}{
+		{
+			description: "skaffold build --push=nil, pipeline.Build.LocalBuild.Push=nil, docker deployer only",
+			deployType:  latest_v1.DeployType{DockerDeploy: &latest_v1.DockerDeploy{}},
+			expected:    true,
+		},
+		{
+			description:      "skaffold build --push=nil, pipeline.Build.LocalBuild.Push=true, docker deployer only",
+			localBuildConfig: util.BoolPtr(true),
+			deployType:       latest_v1.DeployType{DockerDeploy: &latest_v1.DockerDeploy{}},
+			expected:         false,
+		},
+		{
+			description: "skaffold build --push=nil, pipeline.Build.LocalBuild.Push=nil, docker and kubectl deployers",
+			deployType: latest_v1.DeployType{
+				DockerDeploy:  &latest_v1.DockerDeploy{},
+				KubectlDeploy: &latest_v1.KubectlDeploy{},
+			},
+			expected: false,
+		},
 		{
 			description:       "skaffold build --push=nil, pipeline.Build.LocalBuild.Push=nil",
 			pushImagesFlagVal: nil,
@@ -323,6 +463,9 @@ func TestIsImageLocal(t *testing.T) {
 							},
 						},
 					},
+					Deploy: latest_v1.DeployConfig{
+						DeployType: test.deployType,
+					},
 				}})}
 			output, _ := isImageLocal(rctx, imageName)
 			if output != test.expected {
@@ -331,3 +474,7 @@ func TestIsImageLocal(t *testing.T) {
 		})
 	}
 }
+
+type dummyLocalDaemon struct {
+	docker.LocalDaemon
+}
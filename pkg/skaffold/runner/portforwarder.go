@@ -19,19 +19,36 @@ package runner
 import (
 	"io"
 
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
 )
 
 func (r *SkaffoldRunner) createForwarder(out io.Writer) *portforward.ForwarderManager {
-	if !r.runCtx.PortForward() {
+	// Ports of containers run by the Docker deployer are published by the deployer itself.
+	if !r.runCtx.PortForward() || localDockerOnly(r.runCtx.Deployers()) {
 		return nil
 	}
 
 	return portforward.NewForwarderManager(out,
-		r.kubectlCLI,
+		r.entryForwarder(out),
 		r.podSelector,
 		r.labeller.RunIDSelector(),
 		r.runCtx.Mode(),
 		r.runCtx.Opts.PortForward,
 		r.runCtx.PortForwardResources())
 }
+
+// entryForwarder returns the port forwarding implementation selected in the global config.
+func (r *SkaffoldRunner) entryForwarder(out io.Writer) portforward.EntryForwarder {
+	forwarder, err := config.GetPortForwarder(r.runCtx.Opts.GlobalConfig)
+	if err != nil {
+		logrus.Warnf("%v, using %s", err, config.KubectlPortForwarder)
+	}
+
+	if forwarder == config.NativePortForwarder {
+		return portforward.NewClientForwarder(out)
+	}
+	return portforward.NewKubectlForwarder(out, r.kubectlCLI)
+}
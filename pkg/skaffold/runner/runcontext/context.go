@@ -110,6 +110,14 @@ func (ps Pipelines) TestCases() []*latest_v1.TestCase {
 	return tests
 }
 
+func (ps Pipelines) VerifyTestCases() []*latest_v1.VerifyTestCase {
+	var tests []*latest_v1.VerifyTestCase
+	for _, p := range ps.pipelines {
+		tests = append(tests, p.Verify...)
+	}
+	return tests
+}
+
 func (ps Pipelines) StatusCheckDeadlineSeconds() int {
 	c := 0
 	// set the group status check deadline to maximum of any individually specified value
@@ -146,6 +154,10 @@ func (rc *RunContext) Deployers() []latest_v1.DeployType { return rc.Pipelines.D
 
 func (rc *RunContext) TestCases() []*latest_v1.TestCase { return rc.Pipelines.TestCases() }
 
+func (rc *RunContext) VerifyTestCases() []*latest_v1.VerifyTestCase {
+	return rc.Pipelines.VerifyTestCases()
+}
+
 func (rc *RunContext) StatusCheck() bool {
 	sc := rc.Opts.StatusCheck.Value()
 	if sc == nil {
@@ -176,8 +188,10 @@ func (rc *RunContext) CustomLabels() []string                    { return rc.Opt
 func (rc *RunContext) CustomTag() string                         { return rc.Opts.CustomTag }
 func (rc *RunContext) DefaultRepo() *string                      { return rc.Opts.DefaultRepo.Value() }
 func (rc *RunContext) Mode() config.RunMode                      { return rc.Opts.Mode() }
+func (rc *RunContext) DetectDrift() bool                         { return rc.Opts.DetectDrift }
 func (rc *RunContext) DigestSource() string                      { return rc.Opts.DigestSource }
 func (rc *RunContext) DryRun() bool                              { return rc.Opts.DryRun }
+func (rc *RunContext) ExplainCache() bool                        { return rc.Opts.ExplainCache }
 func (rc *RunContext) ForceDeploy() bool                         { return rc.Opts.Force }
 func (rc *RunContext) GetKubeConfig() string                     { return rc.Opts.KubeConfig }
 func (rc *RunContext) GetKubeNamespace() string                  { return rc.Opts.Namespace }
@@ -185,16 +199,21 @@ func (rc *RunContext) GlobalConfig() string                      { return rc.Opt
 func (rc *RunContext) HydratedManifests() []string               { return rc.Opts.HydratedManifests }
 func (rc *RunContext) MinikubeProfile() string                   { return rc.Opts.MinikubeProfile }
 func (rc *RunContext) Muted() config.Muted                       { return rc.Opts.Muted }
+func (rc *RunContext) NativeSync() bool                          { return rc.Opts.NativeSync }
 func (rc *RunContext) NoPruneChildren() bool                     { return rc.Opts.NoPruneChildren }
 func (rc *RunContext) Notification() bool                        { return rc.Opts.Notification }
+func (rc *RunContext) PersistLogs() bool                         { return rc.Opts.PersistLogs }
 func (rc *RunContext) PortForward() bool                         { return rc.Opts.PortForward.Enabled() }
 func (rc *RunContext) Prune() bool                               { return rc.Opts.Prune() }
 func (rc *RunContext) RenderOnly() bool                          { return rc.Opts.RenderOnly }
 func (rc *RunContext) RenderOutput() string                      { return rc.Opts.RenderOutput }
+func (rc *RunContext) RepoCacheDir() string                      { return rc.Opts.RepoCacheDir }
+func (rc *RunContext) SharedCache() string                       { return rc.Opts.SharedCache }
 func (rc *RunContext) SkipRender() bool                          { return rc.Opts.SkipRender }
 func (rc *RunContext) SkipTests() bool                           { return rc.Opts.SkipTests }
 func (rc *RunContext) Tail() bool                                { return rc.Opts.Tail }
 func (rc *RunContext) Trigger() string                           { return rc.Opts.Trigger }
+func (rc *RunContext) Verify() bool                              { return rc.Opts.Verify }
 func (rc *RunContext) WaitForDeletions() config.WaitForDeletions { return rc.Opts.WaitForDeletions }
 func (rc *RunContext) WatchPollInterval() int                    { return rc.Opts.WatchPollInterval }
 func (rc *RunContext) BuildConcurrency() int                     { return rc.Opts.BuildConcurrency }
@@ -32,6 +32,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/verify"
 )
 
 const (
@@ -55,6 +56,7 @@ type Runner interface {
 	Prune(context.Context, io.Writer) error
 	Render(context.Context, io.Writer, []graph.Artifact, bool, string) error
 	Test(context.Context, io.Writer, []graph.Artifact) error
+	Verify(context.Context, io.Writer, []graph.Artifact) error
 }
 
 // SkaffoldRunner is responsible for running the skaffold build, test and deploy config.
@@ -67,6 +69,7 @@ type SkaffoldRunner struct {
 	syncer   sync.Syncer
 	monitor  filemon.Monitor
 	listener Listener
+	verifier verify.Verifier
 
 	kubectlCLI         *kubectl.CLI
 	cache              cache.Cache
@@ -82,6 +85,7 @@ type SkaffoldRunner struct {
 	isLocalImage func(imageName string) (bool, error)
 	hasDeployed  bool
 	intents      *Intents
+	drift        *driftDetector
 }
 
 // for testing
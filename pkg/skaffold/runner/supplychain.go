@@ -0,0 +1,39 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/supplychain"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+)
+
+// attachSupplyChain attaches the SBOMs and signatures configured in the pipelines to the built images.
+func (r *Builder) attachSupplyChain(out io.Writer, artifacts []graph.Artifact) error {
+	for _, a := range artifacts {
+		pipeline, found := r.runCtx.PipelineForImage(a.ImageName)
+		if !found || pipeline.Build.SupplyChain == nil {
+			continue
+		}
+
+		if err := supplychain.Attach(out, r.runCtx, pipeline.Build.SupplyChain, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
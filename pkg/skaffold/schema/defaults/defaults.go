@@ -18,6 +18,7 @@ package defaults
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/google/uuid"
 	"github.com/mitchellh/go-homedir"
@@ -49,6 +50,7 @@ func Set(c *latest_v1.SkaffoldConfig) error {
 	for _, a := range c.Build.Artifacts {
 		setDefaultWorkspace(a)
 		setDefaultSync(a)
+		setDefaultPlatforms(a, c.Build.Platforms)
 
 		if c.Build.Cluster != nil && a.CustomArtifact == nil && a.BuildpackArtifact == nil {
 			defaultToKanikoArtifact(a)
@@ -178,11 +180,13 @@ func setDefaultCloudBuildPackImage(gcb *latest_v1.GoogleCloudBuild) {
 }
 
 func setDefaultTagger(c *latest_v1.SkaffoldConfig) {
-	if c.Build.TagPolicy != (latest_v1.TagPolicy{}) {
+	tagger := c.Build.TagPolicy
+	tagger.AdditionalTags = nil
+	if !reflect.DeepEqual(tagger, latest_v1.TagPolicy{}) {
 		return
 	}
 
-	c.Build.TagPolicy = latest_v1.TagPolicy{GitTagger: &latest_v1.GitTagger{}}
+	c.Build.TagPolicy.GitTagger = &latest_v1.GitTagger{}
 }
 
 func setDefaultKustomizePath(c *latest_v1.SkaffoldConfig) {
@@ -242,6 +246,12 @@ func setDefaultWorkspace(a *latest_v1.Artifact) {
 	a.Workspace = valueOrDefault(a.Workspace, ".")
 }
 
+func setDefaultPlatforms(a *latest_v1.Artifact, platforms []string) {
+	if len(a.Platforms) == 0 {
+		a.Platforms = platforms
+	}
+}
+
 func setDefaultSync(a *latest_v1.Artifact) {
 	if a.Sync != nil {
 		if len(a.Sync.Manual) == 0 && len(a.Sync.Infer) == 0 && a.Sync.Auto == nil {
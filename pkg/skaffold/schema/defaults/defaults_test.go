@@ -129,6 +129,61 @@ func TestSetDefaults(t *testing.T) {
 	testutil.CheckDeepEqual(t, util.BoolPtr(false), cfg.Build.Artifacts[6].Sync.Auto)
 }
 
+func TestSetDefaultTagger(t *testing.T) {
+	tests := []struct {
+		description string
+		tagPolicy   latest_v1.TagPolicy
+		expected    latest_v1.TagPolicy
+	}{
+		{
+			description: "no tag policy",
+			expected:    latest_v1.TagPolicy{GitTagger: &latest_v1.GitTagger{}},
+		},
+		{
+			description: "tag policy",
+			tagPolicy:   latest_v1.TagPolicy{ShaTagger: &latest_v1.ShaTagger{}},
+			expected:    latest_v1.TagPolicy{ShaTagger: &latest_v1.ShaTagger{}},
+		},
+		{
+			description: "only additional tags",
+			tagPolicy:   latest_v1.TagPolicy{AdditionalTags: []latest_v1.TagPolicy{{ShaTagger: &latest_v1.ShaTagger{}}}},
+			expected: latest_v1.TagPolicy{
+				GitTagger:      &latest_v1.GitTagger{},
+				AdditionalTags: []latest_v1.TagPolicy{{ShaTagger: &latest_v1.ShaTagger{}}},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			cfg := &latest_v1.SkaffoldConfig{Pipeline: latest_v1.Pipeline{Build: latest_v1.BuildConfig{TagPolicy: test.tagPolicy}}}
+
+			setDefaultTagger(cfg)
+
+			t.CheckDeepEqual(test.expected, cfg.Build.TagPolicy)
+		})
+	}
+}
+
+func TestSetDefaultPlatforms(t *testing.T) {
+	cfg := &latest_v1.SkaffoldConfig{
+		Pipeline: latest_v1.Pipeline{
+			Build: latest_v1.BuildConfig{
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+				Artifacts: []*latest_v1.Artifact{
+					{ImageName: "default"},
+					{ImageName: "custom", Platforms: []string{"linux/arm/v7"}},
+				},
+			},
+		},
+	}
+
+	err := Set(cfg)
+
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, []string{"linux/amd64", "linux/arm64"}, cfg.Build.Artifacts[0].Platforms)
+	testutil.CheckDeepEqual(t, []string{"linux/arm/v7"}, cfg.Build.Artifacts[1].Platforms)
+}
+
 func TestSetDefaultsOnCluster(t *testing.T) {
 	testutil.Run(t, "no docker config", func(t *testutil.T) {
 		t.SetupFakeKubernetesContext(api.Config{
@@ -25,8 +25,8 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
 )
 
-// !!! WARNING !!! This config version is already released, please DO NOT MODIFY the structs in this file.
-const Version string = "skaffold/v2beta15"
+// This config version is not yet released, it is SAFE TO MODIFY the structs in this file.
+const Version string = "skaffold/v2beta16"
 
 // NewSkaffoldConfig creates a SkaffoldConfig
 func NewSkaffoldConfig() util.VersionedConfig {
@@ -71,6 +71,9 @@ type Pipeline struct {
 	// Deploy describes how images are deployed.
 	Deploy DeployConfig `yaml:"deploy,omitempty"`
 
+	// Verify describes the tests to run once the application is deployed.
+	Verify []*VerifyTestCase `yaml:"verify,omitempty"`
+
 	// PortForward describes user defined resources to port-forward.
 	PortForward []*PortForwardResource `yaml:"portForward,omitempty"`
 }
@@ -158,9 +161,29 @@ type BuildConfig struct {
 	// If not specified, it defaults to `gitCommit: {variant: Tags}`.
 	TagPolicy TagPolicy `yaml:"tagPolicy,omitempty"`
 
+	// SupplyChain *alpha* describes the SBOM and the signature attached to the images pushed by Skaffold.
+	SupplyChain *SupplyChain `yaml:"supplyChain,omitempty"`
+
+	// Platforms *alpha* lists the platforms the artifacts are built for, unless they list their own.
+	// Images are pushed as a manifest list when they target several platforms.
+	// For example: `["linux/amd64", "linux/arm64"]`.
+	Platforms []string `yaml:"platforms,omitempty"`
+
 	BuildType `yaml:",inline"`
 }
 
+// SupplyChain *alpha* describes the supply-chain artifacts attached to pushed images as OCI referrers.
+type SupplyChain struct {
+	// SBOM is the format of the software bill of materials generated from the image filesystem.
+	// Valid values are `spdx` and `cyclonedx`.
+	SBOM string `yaml:"sbom,omitempty"`
+
+	// KeyFile is the path to the PEM-encoded ECDSA private key that signs the images.
+	// Keys generated by `cosign generate-key-pair` are supported, with their password in `COSIGN_PASSWORD`.
+	// Signatures can be verified with `cosign verify --key <public key>`.
+	KeyFile string `yaml:"keyFile,omitempty" skaffold:"filepath"`
+}
+
 // TagPolicy contains all the configuration for the tagging step.
 type TagPolicy struct {
 	// GitTagger *beta* tags images with the git tag or commit of the artifact's workspace.
@@ -180,6 +203,14 @@ type TagPolicy struct {
 
 	// InputDigest *beta* tags images with their sha256 digest of their content.
 	InputDigest *InputDigest `yaml:"inputDigest,omitempty" yamltags:"oneOf=tag"`
+
+	// SemverTagger *alpha* tags images with a semantic version derived from the nearest git tag.
+	SemverTagger *SemverTagger `yaml:"semver,omitempty" yamltags:"oneOf=tag"`
+
+	// AdditionalTags *alpha* lists tagging strategies for additional tags.
+	// Images are deployed with the tag of the strategy above and are also pushed with these tags.
+	// For example: `[{envTemplate: {template: "latest"}}]`.
+	AdditionalTags []TagPolicy `yaml:"additionalTags,omitempty"`
 }
 
 // ShaTagger *beta* tags images with their sha256 digest.
@@ -205,6 +236,44 @@ type GitTagger struct {
 	IgnoreChanges bool `yaml:"ignoreChanges,omitempty"`
 }
 
+// SemverTagger *alpha* tags images with a semantic version derived from the nearest git tag,
+// like `git describe` does. For example: `1.4.2` on a tagged commit or `1.4.2-3-gabc123`
+// three commits after that tag.
+type SemverTagger struct {
+	// TagPrefix selects the git tags holding the versions, for example `frontend/v` in a monorepo.
+	// It's removed before parsing the version. A leading `v` is always accepted.
+	// Defaults to the tags that start with a digit or with `v` and a digit.
+	TagPrefix string `yaml:"tagPrefix,omitempty"`
+
+	// Bump determines how the version is bumped for the commits made after the nearest tag. Valid values are:
+	// `None` (default): keep the version of the tag.
+	// `Patch`, `Minor` or `Major`: always bump this part of the version.
+	// `Conventional`: bump the version based on the [Conventional Commits](https://www.conventionalcommits.org)
+	// messages since the tag: `major` for breaking changes, `minor` for `feat` commits and `patch` otherwise.
+	Bump string `yaml:"bump,omitempty"`
+
+	// PreRelease is the template of the pre-release of the commits made after the nearest tag.
+	// See golang [text/template](https://golang.org/pkg/text/template/).
+	// The template is executed against `{{.Distance}}`, the number of commits since the tag, and
+	// `{{.Commit}}`, the abbreviated commit sha.
+	// Defaults to `{{.Distance}}-g{{.Commit}}`.
+	PreRelease string `yaml:"preRelease,omitempty"`
+
+	// BuildMetadata is the template of the build metadata, executed like `preRelease`.
+	// Since `+` isn't allowed in image tags, it's separated from the version by `_`.
+	BuildMetadata string `yaml:"buildMetadata,omitempty"`
+
+	// Prefix adds a fixed prefix to the tag.
+	Prefix string `yaml:"prefix,omitempty"`
+
+	// IgnoreChanges specifies whether to omit the `dirty` pre-release if there are uncommitted changes.
+	IgnoreChanges bool `yaml:"ignoreChanges,omitempty"`
+
+	// FloatingTags specifies whether to also tag released versions with their minor and major versions.
+	// For example: `1.4.2`, `1.4` and `1`.
+	FloatingTags bool `yaml:"floatingTags,omitempty"`
+}
+
 // EnvTemplateTagger *beta* tags images with a configurable template string.
 type EnvTemplateTagger struct {
 	// Template used to produce the image name and tag.
@@ -493,6 +562,33 @@ type TestCase struct {
 	StructureTests []string `yaml:"structureTests,omitempty" skaffold:"filepath"`
 }
 
+// VerifyTestCase describes a test container to run as a Kubernetes Job once the application is deployed.
+type VerifyTestCase struct {
+	// Name is the name of the test. Must be unique.
+	Name string `yaml:"name" yamltags:"required"`
+
+	// Container is the container running the test. The test fails if the container exits with a non-zero code.
+	Container VerifyContainer `yaml:"container" yamltags:"required"`
+}
+
+// VerifyContainer describes the container running a verify test.
+type VerifyContainer struct {
+	// Image is the image of the container. Images built by Skaffold are replaced with their tags.
+	// For example: `gcr.io/k8s-skaffold/integration-tests`.
+	Image string `yaml:"image" yamltags:"required"`
+
+	// Command overrides the entrypoint of the image.
+	// For example: `["go", "test", "./..."]`.
+	Command []string `yaml:"command,omitempty"`
+
+	// Args are the arguments passed to the entrypoint.
+	Args []string `yaml:"args,omitempty"`
+
+	// Env are the environment variables set in the container.
+	// For example: `{"BASE_URL": "http://frontend"}`.
+	Env map[string]string `yaml:"env,omitempty"`
+}
+
 // DeployConfig contains all the configuration needed by the deploy steps.
 type DeployConfig struct {
 	DeployType `yaml:",inline"`
@@ -506,12 +602,18 @@ type DeployConfig struct {
 
 	// Logs configures how container logs are printed as a result of a deployment.
 	Logs LogsConfig `yaml:"logs,omitempty"`
+
+	// LifecycleHooks describes a set of lifecycle hooks that are executed before and after every deploy.
+	LifecycleHooks DeployHooks `yaml:"hooks,omitempty"`
 }
 
 // DeployType contains the specific implementation and parameters needed
 // for the deploy step. All three deployer types can be used at the same
 // time for hybrid workflows.
 type DeployType struct {
+	// DockerDeploy *alpha* uses the local Docker daemon to run the application containers, without a Kubernetes cluster.
+	DockerDeploy *DockerDeploy `yaml:"docker,omitempty"`
+
 	// HelmDeploy *beta* uses the `helm` CLI to apply the charts to the cluster.
 	HelmDeploy *HelmDeploy `yaml:"helm,omitempty"`
 
@@ -522,10 +624,25 @@ type DeployType struct {
 	// You'll need a `kubectl` CLI version installed that's compatible with your cluster.
 	KubectlDeploy *KubectlDeploy `yaml:"kubectl,omitempty"`
 
-	// KustomizeDeploy *beta* uses the `kustomize` CLI to "patch" a deployment for a target environment.
+	// KustomizeDeploy *beta* uses kustomize to "patch" a deployment for a target environment.
 	KustomizeDeploy *KustomizeDeploy `yaml:"kustomize,omitempty"`
 }
 
+// DockerDeploy *alpha* uses the local Docker daemon to run the application containers, without a Kubernetes cluster.
+type DockerDeploy struct {
+	// Images are the images of the artifacts to run, one container each.
+	// Defaults to all the artifacts if no `composeFile` is set.
+	Images []string `yaml:"images,omitempty"`
+
+	// ComposeFile is the path to a Docker Compose file describing the containers to run.
+	// The images of services that are built by Skaffold are replaced with the built tags.
+	ComposeFile string `yaml:"composeFile,omitempty" skaffold:"filepath"`
+
+	// Network is the name of the Docker network the containers are attached to.
+	// Defaults to `skaffold-network`.
+	Network string `yaml:"network,omitempty"`
+}
+
 // KubectlDeploy *beta* uses a client side `kubectl apply` to deploy manifests.
 // You'll need a `kubectl` CLI version installed that's compatible with your cluster.
 type KubectlDeploy struct {
@@ -541,6 +658,35 @@ type KubectlDeploy struct {
 
 	// DefaultNamespace is the default namespace passed to kubectl on deployment if no other override is given.
 	DefaultNamespace *string `yaml:"defaultNamespace,omitempty"`
+
+	// Prune configures the deletion of the resources that are removed from the manifests between two deployments,
+	// during `skaffold dev`.
+	Prune *KubectlPrune `yaml:"prune,omitempty"`
+
+	// ServerSide applies the manifests with server-side apply instead of `kubectl apply`.
+	ServerSide *KubectlServerSideApply `yaml:"serverSide,omitempty"`
+}
+
+// KubectlServerSideApply configures server-side apply.
+// Fields are tracked by the API server instead of a `last-applied-configuration` annotation.
+type KubectlServerSideApply struct {
+	// FieldManager is the name of the manager that owns the applied fields.
+	// Defaults to `skaffold`.
+	FieldManager string `yaml:"fieldManager,omitempty"`
+
+	// ForceConflicts takes ownership of the fields that are owned by other managers, such as HPAs or operators.
+	ForceConflicts bool `yaml:"forceConflicts,omitempty"`
+}
+
+// KubectlPrune configures the deletion of the resources that are removed from the manifests.
+// Only the resources that are still labelled with the current run ID are deleted,
+// and resources annotated with `skaffold.dev/prune: "false"` are never deleted.
+type KubectlPrune struct {
+	// Disabled keeps the removed resources until cleanup.
+	Disabled bool `yaml:"disabled,omitempty"`
+
+	// DryRun reports the resources that would be deleted, without deleting them.
+	DryRun bool `yaml:"dryRun,omitempty"`
 }
 
 // KubectlFlags are additional flags passed on the command
@@ -584,7 +730,7 @@ type HelmDeployFlags struct {
 	Upgrade []string `yaml:"upgrade,omitempty"`
 }
 
-// KustomizeDeploy *beta* uses the `kustomize` CLI to "patch" a deployment for a target environment.
+// KustomizeDeploy *beta* uses kustomize to "patch" a deployment for a target environment.
 type KustomizeDeploy struct {
 	// KustomizePaths is the path to Kustomization files.
 	// Defaults to `["."]`.
@@ -594,6 +740,7 @@ type KustomizeDeploy struct {
 	Flags KubectlFlags `yaml:"flags,omitempty"`
 
 	// BuildArgs are additional args passed to `kustomize build`.
+	// Supported args are `--load-restrictor`, `--reorder`, `--enable-managedby-label` and `--enable-alpha-plugins`.
 	BuildArgs []string `yaml:"buildArgs,omitempty"`
 
 	// DefaultNamespace is the default namespace passed to kubectl on deployment if no other override is given.
@@ -692,6 +839,8 @@ type HelmRelease struct {
 	ChartPath string `yaml:"chartPath,omitempty" yamltags:"oneOf=chartSource" skaffold:"filepath"`
 
 	// RemoteChart refers to a remote Helm chart reference or URL.
+	// Charts stored in an OCI registry are referenced with `oci://`, for example `oci://gcr.io/my-project/charts/my-chart`,
+	// and must be pinned with `version`.
 	RemoteChart string `yaml:"remoteChart,omitempty" yamltags:"oneOf=chartSource"`
 
 	// ValuesFiles are the paths to the Helm `values` files.
@@ -707,6 +856,7 @@ type HelmRelease struct {
 	Namespace string `yaml:"namespace,omitempty"`
 
 	// Version is the version of the chart.
+	// For charts stored in an OCI registry, it's the tag of the chart.
 	Version string `yaml:"version,omitempty"`
 
 	// SetValues are key-value pairs.
@@ -810,6 +960,44 @@ type LogsConfig struct {
 	// `none`: don't add a prefix.
 	// Defaults to `auto`.
 	Prefix string `yaml:"prefix,omitempty"`
+
+	// JSONParse *alpha* parses log lines written as JSON objects and renders them in a compact format.
+	JSONParse *JSONParseConfig `yaml:"jsonParse,omitempty"`
+
+	// Filter *alpha* only prints the log lines that match all the given criteria.
+	Filter *LogFilter `yaml:"filter,omitempty"`
+}
+
+// JSONParseConfig *alpha* describes how JSON log lines are rendered.
+// Log lines that are not JSON objects are printed as is.
+type JSONParseConfig struct {
+	// Fields lists the fields to print after the message, as `key=value`.
+	// For example: `["trace_id", "span_id"]`.
+	Fields []string `yaml:"fields,omitempty"`
+
+	// LevelField is the field that holds the log level.
+	// Defaults to the first of `level`, `severity` or `lvl` found in the line.
+	LevelField string `yaml:"levelField,omitempty"`
+
+	// MessageField is the field that holds the log message.
+	// Defaults to the first of `message`, `msg` or `text` found in the line.
+	MessageField string `yaml:"messageField,omitempty"`
+}
+
+// LogFilter *alpha* describes which log lines are printed.
+type LogFilter struct {
+	// MinLevel hides the JSON log lines with a lower level. Valid values are
+	// `trace`, `debug`, `info`, `warn`, `error` and `fatal`, or the aliases
+	// `notice`, `warning`, `err`, `panic`, `critical` and `crit`.
+	// Lines without a level are always printed.
+	MinLevel string `yaml:"minLevel,omitempty"`
+
+	// Fields only prints the JSON log lines whose fields have the given values.
+	// For example: `{"trace_id": "4bf92f3577b34da6"}`.
+	Fields map[string]string `yaml:"fields,omitempty"`
+
+	// Regex only prints the log lines that match this regular expression.
+	Regex string `yaml:"regex,omitempty"`
 }
 
 // Artifact are the items that need to be built, along with the context in which
@@ -829,11 +1017,38 @@ type Artifact struct {
 	// Defaults to `infer: ["**/*"]`.
 	Sync *Sync `yaml:"sync,omitempty"`
 
+	// Watch describes how changes to the artifact's files are detected in dev mode.
+	Watch *WatchConfig `yaml:"watch,omitempty"`
+
 	// ArtifactType describes how to build an artifact.
 	ArtifactType `yaml:",inline"`
 
 	// Dependencies describes build artifacts that this artifact depends on.
 	Dependencies []*ArtifactDependency `yaml:"requires,omitempty"`
+
+	// LifecycleHooks describes a set of lifecycle hooks that are executed before and after each build of the target artifact.
+	LifecycleHooks BuildHooks `yaml:"hooks,omitempty"`
+
+	// Platforms *alpha* lists the platforms the image is built for.
+	// Defaults to the platforms of the `build` section.
+	// For example: `["linux/amd64", "linux/arm64"]`.
+	Platforms []string `yaml:"platforms,omitempty"`
+}
+
+// WatchConfig describes how changes to an artifact's files are detected and batched in dev mode.
+type WatchConfig struct {
+	// QuietPeriod is how long no file change must be detected before the accumulated changes
+	// trigger a rebuild or a sync.
+	// For example: `500ms`.
+	QuietPeriod string `yaml:"quietPeriod,omitempty"`
+
+	// MaxBatchWindow is the maximum amount of time changes are accumulated, even if files keep changing.
+	// For example: `5s`.
+	MaxBatchWindow string `yaml:"maxBatchWindow,omitempty"`
+
+	// Ignore lists glob patterns, relative to the artifact's context, of files whose changes are ignored.
+	// For example: `["**/*.swp", "tmp/**"]`.
+	Ignore []string `yaml:"ignore,omitempty"`
 }
 
 // Sync *beta* specifies what files to sync into the container.
@@ -854,6 +1069,9 @@ type Sync struct {
 	// Auto delegates discovery of sync rules to the build system.
 	// Only available for jib and buildpacks.
 	Auto *bool `yaml:"auto,omitempty" yamltags:"oneOf=sync"`
+
+	// LifecycleHooks describes a set of lifecycle hooks that are executed before and after each file sync action on the target artifact's containers.
+	LifecycleHooks SyncHooks `yaml:"hooks,omitempty"`
 }
 
 // SyncRule specifies which local files to sync to remote folders.
@@ -873,6 +1091,83 @@ type SyncRule struct {
 	Strip string `yaml:"strip,omitempty"`
 }
 
+// BuildHooks describes the list of lifecycle hooks to execute before and after each artifact build step.
+type BuildHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each artifact build step.
+	PreHooks []HostHook `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each artifact build step.
+	PostHooks []HostHook `yaml:"after,omitempty"`
+}
+
+// SyncHookItem describes a single lifecycle hook to execute before or after each artifact sync step.
+type SyncHookItem struct {
+	// HostHook describes a single lifecycle hook to run on the host machine.
+	HostHook *HostHook `yaml:"host,omitempty" yamltags:"oneOf=sync_hook"`
+
+	// ContainerHook describes a single lifecycle hook to run in the synced container.
+	ContainerHook *ContainerHook `yaml:"container,omitempty" yamltags:"oneOf=sync_hook"`
+}
+
+// SyncHooks describes the list of lifecycle hooks to execute before and after each artifact sync step.
+type SyncHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each artifact sync step.
+	PreHooks []SyncHookItem `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each artifact sync step.
+	PostHooks []SyncHookItem `yaml:"after,omitempty"`
+}
+
+// DeployHookItem describes a single lifecycle hook to execute before or after each deploy step.
+type DeployHookItem struct {
+	// HostHook describes a single lifecycle hook to run on the host machine.
+	HostHook *HostHook `yaml:"host,omitempty" yamltags:"oneOf=deploy_hook"`
+
+	// ContainerHook describes a single lifecycle hook to run in a deployed container.
+	ContainerHook *NamedContainerHook `yaml:"container,omitempty" yamltags:"oneOf=deploy_hook"`
+}
+
+// DeployHooks describes the list of lifecycle hooks to execute before and after each deploy step.
+type DeployHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each deploy step. Container hooks will only run if the container exists from a previous deployment step (for instance the successive iterations of a dev-loop during `skaffold dev`).
+	PreHooks []DeployHookItem `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each deploy step.
+	PostHooks []DeployHookItem `yaml:"after,omitempty"`
+}
+
+// HostHook describes a lifecycle hook definition to execute on the host machine.
+type HostHook struct {
+	// Command is the command to execute.
+	// For example: `["sh", "-c", "./generate.sh"]`.
+	Command []string `yaml:"command" yamltags:"required"`
+
+	// OS is an optional slice of operating system names. If the host machine OS is different, then it skips execution.
+	// For example: `["linux", "darwin"]`.
+	OS []string `yaml:"os,omitempty"`
+}
+
+// ContainerHook describes a lifecycle hook definition to execute on a container. The container name is inferred from where it is specified.
+type ContainerHook struct {
+	// Command is the command to execute.
+	// For example: `["kill", "-HUP", "1"]`.
+	Command []string `yaml:"command" yamltags:"required"`
+}
+
+// NamedContainerHook describes a lifecycle hook definition to execute on a named container.
+type NamedContainerHook struct {
+	// ContainerHook describes a lifecycle hook definition to execute on a container.
+	ContainerHook `yaml:",inline" yamltags:"skipTrim"`
+
+	// PodName is the name of the pod to execute the command in.
+	// Can be a glob pattern, for example: `"backend-*"`.
+	PodName string `yaml:"podName" yamltags:"required"`
+
+	// ContainerName is the name of the container to execute the command in.
+	// Can be a glob pattern. Defaults to all containers of the matching pods.
+	ContainerName string `yaml:"containerName,omitempty"`
+}
+
 // Profile is used to override any `build`, `test` or `deploy` configuration.
 type Profile struct {
 	// Name is a unique profile name.
@@ -951,6 +1246,9 @@ type ArtifactType struct {
 
 	// CustomArtifact *beta* builds images using a custom build script written by the user.
 	CustomArtifact *CustomArtifact `yaml:"custom,omitempty" yamltags:"oneOf=artifact"`
+
+	// KoArtifact *alpha* builds images from Go sources, without a Dockerfile, like [ko](https://github.com/google/ko).
+	KoArtifact *KoArtifact `yaml:"ko,omitempty" yamltags:"oneOf=artifact"`
 }
 
 // ArtifactDependency describes a specific build dependency for an artifact.
@@ -1260,6 +1558,46 @@ type BazelArtifact struct {
 	BuildArgs []string `yaml:"args,omitempty"`
 }
 
+// KoArtifact *alpha* builds images from Go sources, like [ko](https://github.com/google/ko).
+// The Go binary is added on top of a base image, without a Dockerfile.
+type KoArtifact struct {
+	// BaseImage overrides the default base image, `gcr.io/distroless/static:nonroot`.
+	BaseImage string `yaml:"fromImage,omitempty"`
+
+	// Main is the path of the `main` package to build, relative to the artifact's context.
+	// Defaults to `.`.
+	// For example: `./cmd/server`.
+	Main string `yaml:"main,omitempty"`
+
+	// Flags are additional flags passed to `go build`.
+	// For example: `["-trimpath", "-tags=netgo"]`.
+	Flags []string `yaml:"flags,omitempty"`
+
+	// Ldflags are the linker flags passed to `go build` with `-ldflags`.
+	// Values can be templated with environment variables.
+	// For example: `["-s", "-w", "-X main.version={{.VERSION}}"]`.
+	Ldflags []string `yaml:"ldflags,omitempty"`
+
+	// Env are environment variables, in the `key=value` form, passed to `go build`.
+	// `CGO_ENABLED` defaults to `0`.
+	// For example: `["GOPRIVATE=github.com/example/*"]`.
+	Env []string `yaml:"env,omitempty"`
+
+	// Dependencies are the file dependencies that skaffold should watch for both rebuilding and file syncing for this artifact.
+	// Defaults to the Go files of the packages imported by the `main` package in the Go module, and the module's `go.mod` and `go.sum`.
+	Dependencies *KoDependencies `yaml:"dependencies,omitempty"`
+}
+
+// KoDependencies is used to specify dependencies for an artifact built by ko.
+type KoDependencies struct {
+	// Paths should be set to the file dependencies for this artifact, so that the skaffold file watcher knows when to rebuild and perform file synchronization.
+	Paths []string `yaml:"paths,omitempty"`
+
+	// Ignore specifies the paths that should be ignored by skaffold's file watcher. If a file exists in both `paths` and in `ignore`, it will be ignored, and will be excluded from both rebuilds and file synchronization.
+	// Will only work in conjunction with `paths`.
+	Ignore []string `yaml:"ignore,omitempty"`
+}
+
 // JibArtifact builds images using the
 // [Jib plugins for Maven and Gradle](https://github.com/GoogleContainerTools/jib/).
 type JibArtifact struct {
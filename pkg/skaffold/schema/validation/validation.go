@@ -20,16 +20,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/docker/docker/api/types"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/supplychain"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -51,15 +56,21 @@ func Process(configs []*latest_v1.SkaffoldConfig) error {
 		errs = append(errs, validateDockerNetworkMode(config.Build.Artifacts)...)
 		errs = append(errs, validateCustomDependencies(config.Build.Artifacts)...)
 		errs = append(errs, validateSyncRules(config.Build.Artifacts)...)
+		errs = append(errs, validateWatchConfigs(config.Build.Artifacts)...)
 		errs = append(errs, validatePortForwardResources(config.PortForward)...)
 		errs = append(errs, validateJibPluginTypes(config.Build.Artifacts)...)
 		errs = append(errs, validateLogPrefix(config.Deploy.Logs)...)
+		errs = append(errs, validateLogFilter(config.Deploy.Logs.Filter)...)
+		errs = append(errs, validateHelmReleases(config.Deploy.HelmDeploy)...)
 		errs = append(errs, validateArtifactTypes(config.Build)...)
 		errs = append(errs, validateTaggingPolicy(config.Build)...)
+		errs = append(errs, validateSupplyChain(config.Build.SupplyChain)...)
+		errs = append(errs, validatePlatforms(config.Build)...)
 		errs = append(errs, validateCustomTest(config.Test)...)
 	}
 	errs = append(errs, validateArtifactDependencies(configs)...)
 	errs = append(errs, validateSingleKubeContext(configs)...)
+	errs = append(errs, validateVerifyTestNames(configs)...)
 	if len(errs) == 0 {
 		return nil
 	}
@@ -98,6 +109,38 @@ func validateTaggingPolicy(bc latest_v1.BuildConfig) (errs []error) {
 	return
 }
 
+// validateSupplyChain makes sure the SBOM format is supported.
+func validateSupplyChain(sc *latest_v1.SupplyChain) (errs []error) {
+	if sc == nil || sc.SBOM == "" {
+		return
+	}
+	if sc.SBOM != supplychain.SPDX && sc.SBOM != supplychain.CycloneDX {
+		errs = append(errs, fmt.Errorf("unsupported SBOM format %q, must be one of %q or %q", sc.SBOM, supplychain.SPDX, supplychain.CycloneDX))
+	}
+	return
+}
+
+// validatePlatforms makes sure the build and artifact platforms are valid `os/arch[/variant]` platforms.
+func validatePlatforms(bc latest_v1.BuildConfig) (errs []error) {
+	platforms := append([]string{}, bc.Platforms...)
+	for _, a := range bc.Artifacts {
+		platforms = append(platforms, a.Platforms...)
+	}
+
+	seen := map[string]bool{}
+	for _, p := range platforms {
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+
+		if _, err := platform.Parse(p); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return
+}
+
 // validateImageNames makes sure the artifact image names are unique and valid base names,
 // without tags nor digests.
 func validateImageNames(configs []*latest_v1.SkaffoldConfig) (errs []error) {
@@ -128,6 +171,25 @@ func validateImageNames(configs []*latest_v1.SkaffoldConfig) (errs []error) {
 	return
 }
 
+// validateVerifyTestNames makes sure the verify test names are unique and can be used as Kubernetes container names.
+func validateVerifyTestNames(configs []*latest_v1.SkaffoldConfig) (errs []error) {
+	seen := make(map[string]bool)
+	for _, c := range configs {
+		for _, tc := range c.Verify {
+			if seen[tc.Name] {
+				errs = append(errs, fmt.Errorf("found duplicate verify tests %q: verify test names must be unique across all configurations", tc.Name))
+				continue
+			}
+
+			seen[tc.Name] = true
+			if msgs := k8svalidation.IsDNS1123Label(tc.Name); len(msgs) > 0 {
+				errs = append(errs, fmt.Errorf("invalid verify test name %q: %s", tc.Name, strings.Join(msgs, ", ")))
+			}
+		}
+	}
+	return
+}
+
 func validateArtifactDependencies(configs []*latest_v1.SkaffoldConfig) (errs []error) {
 	var artifacts []*latest_v1.Artifact
 	for _, c := range configs {
@@ -452,6 +514,34 @@ func validateSyncRules(artifacts []*latest_v1.Artifact) []error {
 	return errs
 }
 
+// validateWatchConfigs makes sure the watch settings of the artifacts are valid durations and patterns.
+func validateWatchConfigs(artifacts []*latest_v1.Artifact) (errs []error) {
+	for _, a := range artifacts {
+		if a.Watch == nil {
+			continue
+		}
+
+		durations := []struct{ name, value string }{
+			{"quietPeriod", a.Watch.QuietPeriod},
+			{"maxBatchWindow", a.Watch.MaxBatchWindow},
+		}
+		for _, d := range durations {
+			if d.value == "" {
+				continue
+			}
+			if parsed, err := time.ParseDuration(d.value); err != nil || parsed < 0 {
+				errs = append(errs, fmt.Errorf("artifact %s has invalid watch.%s %q: must be a positive duration, for example 500ms", a.ImageName, d.name, d.value))
+			}
+		}
+		for _, pattern := range a.Watch.Ignore {
+			if _, err := filepath.Match(pattern, ""); err != nil {
+				errs = append(errs, fmt.Errorf("artifact %s has invalid watch.ignore pattern %q: %w", a.ImageName, pattern, err))
+			}
+		}
+	}
+	return
+}
+
 // validatePortForwardResources checks that all user defined port forward resources
 // have a valid resourceType
 func validatePortForwardResources(pfrs []*latest_v1.PortForwardResource) []error {
@@ -528,6 +618,45 @@ func validateLogPrefix(lc latest_v1.LogsConfig) []error {
 	return nil
 }
 
+// validateLogFilter checks that logs are filtered with a valid level and regular expression.
+func validateLogFilter(f *latest_v1.LogFilter) []error {
+	if f == nil {
+		return nil
+	}
+
+	var errs []error
+	// Accept the same levels, and aliases, as the log filters set through the API.
+	validLevels := kubernetes.ValidLogLevels()
+	if f.MinLevel != "" && !util.StrSliceContains(validLevels, strings.ToLower(f.MinLevel)) {
+		errs = append(errs, fmt.Errorf("invalid log level '%s'. Valid values are '%s'", f.MinLevel, strings.Join(validLevels, "', '")))
+	}
+	if _, err := regexp.Compile(f.Regex); err != nil {
+		errs = append(errs, fmt.Errorf("invalid log filter regex '%s': %w", f.Regex, err))
+	}
+	return errs
+}
+
+// validateHelmReleases checks that charts stored in OCI registries are pinned to a version
+// and are not combined with a chart repository.
+func validateHelmReleases(h *latest_v1.HelmDeploy) (errs []error) {
+	if h == nil {
+		return nil
+	}
+
+	for _, r := range h.Releases {
+		if !strings.HasPrefix(r.RemoteChart, "oci://") {
+			continue
+		}
+		if r.Version == "" {
+			errs = append(errs, fmt.Errorf("helm release %q: the OCI chart %q must be pinned with `version`", r.Name, r.RemoteChart))
+		}
+		if r.Repo != "" {
+			errs = append(errs, fmt.Errorf("helm release %q: `repo` can't be used with the OCI chart %q", r.Name, r.RemoteChart))
+		}
+	}
+	return errs
+}
+
 func validateSingleKubeContext(configs []*latest_v1.SkaffoldConfig) []error {
 	if len(configs) < 2 {
 		return nil
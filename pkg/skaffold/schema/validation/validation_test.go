@@ -972,6 +972,82 @@ func TestValidateImageNames(t *testing.T) {
 	}
 }
 
+func TestValidateVerifyTestNames(t *testing.T) {
+	tests := []struct {
+		description string
+		verify      []*latest_v1.VerifyTestCase
+		shouldErr   bool
+	}{
+		{
+			description: "valid",
+			verify:      []*latest_v1.VerifyTestCase{{Name: "smoke"}, {Name: "integration-tests"}},
+		},
+		{
+			description: "duplicates",
+			verify:      []*latest_v1.VerifyTestCase{{Name: "smoke"}, {Name: "smoke"}},
+			shouldErr:   true,
+		},
+		{
+			description: "not a valid container name",
+			verify:      []*latest_v1.VerifyTestCase{{Name: "Smoke_Tests"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(
+				[]*latest_v1.SkaffoldConfig{{
+					Pipeline: latest_v1.Pipeline{
+						Verify: test.verify,
+					},
+				}})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateWatchConfigs(t *testing.T) {
+	tests := []struct {
+		description string
+		watch       *latest_v1.WatchConfig
+		shouldErr   bool
+	}{
+		{
+			description: "no watch config",
+		},
+		{
+			description: "valid",
+			watch:       &latest_v1.WatchConfig{QuietPeriod: "500ms", MaxBatchWindow: "5s", Ignore: []string{"**/*.swp"}},
+		},
+		{
+			description: "invalid quiet period",
+			watch:       &latest_v1.WatchConfig{QuietPeriod: "500"},
+			shouldErr:   true,
+		},
+		{
+			description: "negative max batch window",
+			watch:       &latest_v1.WatchConfig{MaxBatchWindow: "-1s"},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid ignore pattern",
+			watch:       &latest_v1.WatchConfig{Ignore: []string{"[a-"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateWatchConfigs([]*latest_v1.Artifact{{ImageName: "img", Watch: test.watch}})
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
 func TestValidateJibPluginType(t *testing.T) {
 	tests := []struct {
 		description string
@@ -1109,6 +1185,73 @@ func TestValidateLogsConfig(t *testing.T) {
 	}
 }
 
+func TestValidateLogFilter(t *testing.T) {
+	tests := []struct {
+		description string
+		filter      *latest_v1.LogFilter
+		shouldErr   bool
+	}{
+		{description: "no filter"},
+		{description: "valid filter", filter: &latest_v1.LogFilter{MinLevel: "Warn", Regex: "^GET .*", Fields: map[string]string{"trace_id": "abc"}}},
+		{description: "level alias", filter: &latest_v1.LogFilter{MinLevel: "warning"}},
+		{description: "level alias in upper case", filter: &latest_v1.LogFilter{MinLevel: "CRITICAL"}},
+		{description: "invalid level", filter: &latest_v1.LogFilter{MinLevel: "verbose"}, shouldErr: true},
+		{description: "invalid regex", filter: &latest_v1.LogFilter{Regex: "("}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(
+				[]*latest_v1.SkaffoldConfig{{
+					Pipeline: latest_v1.Pipeline{
+						Deploy: latest_v1.DeployConfig{
+							Logs: latest_v1.LogsConfig{
+								Filter: test.filter,
+							},
+						},
+					},
+				}})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateHelmReleases(t *testing.T) {
+	tests := []struct {
+		description string
+		release     latest_v1.HelmRelease
+		shouldErr   bool
+	}{
+		{description: "local chart", release: latest_v1.HelmRelease{Name: "app", ChartPath: "charts/app"}},
+		{description: "chart repository", release: latest_v1.HelmRelease{Name: "app", RemoteChart: "stable/redis", Repo: "https://charts.helm.sh/stable"}},
+		{description: "pinned OCI chart", release: latest_v1.HelmRelease{Name: "app", RemoteChart: "oci://gcr.io/project/charts/app", Version: "1.2.3"}},
+		{description: "OCI chart without version", release: latest_v1.HelmRelease{Name: "app", RemoteChart: "oci://gcr.io/project/charts/app"}, shouldErr: true},
+		{description: "OCI chart with repo", release: latest_v1.HelmRelease{Name: "app", RemoteChart: "oci://gcr.io/project/charts/app", Version: "1.2.3", Repo: "https://charts.example.com"}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(
+				[]*latest_v1.SkaffoldConfig{{
+					Pipeline: latest_v1.Pipeline{
+						Deploy: latest_v1.DeployConfig{
+							DeployType: latest_v1.DeployType{
+								HelmDeploy: &latest_v1.HelmDeploy{Releases: []latest_v1.HelmRelease{test.release}},
+							},
+						},
+					},
+				}})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
 func TestValidateAcyclicDependencies(t *testing.T) {
 	tests := []struct {
 		description string
@@ -1432,6 +1575,96 @@ func TestValidateTaggingPolicy(t *testing.T) {
 	}
 }
 
+func TestValidateSupplyChain(t *testing.T) {
+	tests := []struct {
+		description string
+		sc          *latest_v1.SupplyChain
+		shouldErr   bool
+	}{
+		{
+			description: "no supply chain",
+		},
+		{
+			description: "signing only",
+			sc:          &latest_v1.SupplyChain{KeyFile: "cosign.key"},
+		},
+		{
+			description: "spdx",
+			sc:          &latest_v1.SupplyChain{SBOM: "spdx"},
+		},
+		{
+			description: "cyclonedx",
+			sc:          &latest_v1.SupplyChain{SBOM: "cyclonedx"},
+		},
+		{
+			description: "unsupported format",
+			sc:          &latest_v1.SupplyChain{SBOM: "syft"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(
+				[]*latest_v1.SkaffoldConfig{{
+					Pipeline: latest_v1.Pipeline{
+						Build: latest_v1.BuildConfig{SupplyChain: test.sc},
+					},
+				}})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidatePlatforms(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latest_v1.BuildConfig
+		shouldErr   bool
+	}{
+		{
+			description: "no platforms",
+		},
+		{
+			description: "valid platforms",
+			cfg: latest_v1.BuildConfig{
+				Platforms: []string{"linux/amd64", "linux/arm/v7"},
+				Artifacts: []*latest_v1.Artifact{{ImageName: "image", Platforms: []string{"linux/arm64"}}},
+			},
+		},
+		{
+			description: "invalid build platform",
+			cfg:         latest_v1.BuildConfig{Platforms: []string{"amd64"}},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid artifact platform",
+			cfg: latest_v1.BuildConfig{
+				Artifacts: []*latest_v1.Artifact{{ImageName: "image", Platforms: []string{"linux/"}}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(
+				[]*latest_v1.SkaffoldConfig{{
+					Pipeline: latest_v1.Pipeline{
+						Build: test.cfg,
+					},
+				}})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
 func TestValidateCustomTest(t *testing.T) {
 	tests := []struct {
 		description    string
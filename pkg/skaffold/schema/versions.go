@@ -63,6 +63,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/v2beta12"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/v2beta13"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/v2beta14"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/v2beta15"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/v2beta2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/v2beta3"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/v2beta4"
@@ -120,6 +121,7 @@ var SchemaVersions = Versions{
 	{v2beta12.Version, v2beta12.NewSkaffoldConfig},
 	{v2beta13.Version, v2beta13.NewSkaffoldConfig},
 	{v2beta14.Version, v2beta14.NewSkaffoldConfig},
+	{v2beta15.Version, v2beta15.NewSkaffoldConfig},
 	{latest_v1.Version, latest_v1.NewSkaffoldConfig},
 }
 
@@ -92,6 +92,12 @@ func SetAutoSyncCallback(callback func(bool)) {
 	}
 }
 
+func SetLogFilterCallback(callback func(*protoV2.LogFilterRequest) error) {
+	if v2.Srv != nil {
+		v2.Srv.LogFilterCallback = callback
+	}
+}
+
 // Initialize creates the gRPC and HTTP servers for serving the state and event log.
 // It returns a shutdown callback for tearing down the grpc server,
 // which the runner is responsible for calling.
@@ -161,6 +167,7 @@ func newGRPCServer(preferredPort int, usedPorts *util.PortSet) (func() error, in
 		AutoBuildCallback:    func(bool) {},
 		AutoSyncCallback:     func(bool) {},
 		AutoDeployCallback:   func(bool) {},
+		LogFilterCallback:    func(*protoV2.LogFilterRequest) error { return nil },
 	}
 	proto.RegisterSkaffoldServiceServer(s, srv)
 	protoV2.RegisterSkaffoldV2ServiceServer(s, v2.Srv)
@@ -18,6 +18,7 @@ package v2
 
 import (
 	"context"
+	"errors"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
@@ -38,8 +39,15 @@ func (s *Server) GetState(context.Context, *empty.Empty) (*proto.State, error) {
 	return event.GetState()
 }
 
-func (s *Server) Events(_ *empty.Empty, stream proto.SkaffoldV2Service_EventsServer) error {
-	return event.ForEachEvent(stream.Send)
+func (s *Server) Events(request *proto.EventsRequest, stream proto.SkaffoldV2Service_EventsServer) error {
+	if err := event.ValidateEventsRequest(request); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	err := event.ForEachFilteredEvent(request, stream.Send)
+	if errors.Is(err, event.ErrEventsDropped) {
+		return status.Error(codes.OutOfRange, err.Error())
+	}
+	return err
 }
 
 func (s *Server) Handle(ctx context.Context, e *proto.Event) (*empty.Empty, error) {
@@ -83,6 +91,13 @@ func (s *Server) AutoSync(ctx context.Context, request *proto.TriggerRequest) (r
 	return executeAutoTrigger(constants.Sync, request, event.UpdateStateAutoSyncTrigger, func() {}, s.AutoSyncCallback)
 }
 
+func (s *Server) SetLogFilter(ctx context.Context, request *proto.LogFilterRequest) (*empty.Empty, error) {
+	if err := s.LogFilterCallback(request); err != nil {
+		return &empty.Empty{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &empty.Empty{}, nil
+}
+
 func executeAutoTrigger(triggerName constants.Phase, request *proto.TriggerRequest, updateTriggerStateFunc func(bool), resetPhaseStateFunc func(), serverCallback func(bool)) (res *empty.Empty, err error) {
 	res = &empty.Empty{}
 
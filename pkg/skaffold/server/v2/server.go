@@ -16,6 +16,10 @@ limitations under the License.
 
 package v2
 
+import (
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+)
+
 var (
 	Srv *Server
 )
@@ -27,6 +31,7 @@ type Server struct {
 	AutoBuildCallback    func(bool)
 	AutoSyncCallback     func(bool)
 	AutoDeployCallback   func(bool)
+	LogFilterCallback    func(*proto.LogFilterRequest) error
 }
 
 // TODO(marlongamez): Add Set*Callback() funcs once going for v1 feature parity
@@ -18,8 +18,12 @@ package v2
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -148,3 +152,46 @@ func TestServer_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_SetLogFilter(t *testing.T) {
+	tests := []struct {
+		description string
+		callbackErr error
+		shouldErr   bool
+	}{
+		{
+			description: "filter set",
+		},
+		{
+			description: "invalid filter",
+			callbackErr: errors.New("invalid log level"),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			request := &proto.LogFilterRequest{MinLevel: "warn"}
+
+			var received *proto.LogFilterRequest
+			server := &Server{
+				LogFilterCallback: func(r *proto.LogFilterRequest) error {
+					received = r
+					return test.callbackErr
+				},
+			}
+			_, err := server.SetLogFilter(context.Background(), request)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(request, received)
+		})
+	}
+}
+
+func TestServer_EventsInvalidRequest(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		server := &Server{}
+		err := server.Events(&proto.EventsRequest{Types: []string{"unknownEvent"}}, nil)
+
+		t.CheckDeepEqual(codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -37,6 +37,13 @@ func (s *podSyncer) deleteFileFn(ctx context.Context, pod v1.Pod, container v1.C
 
 func (s *podSyncer) copyFileFn(ctx context.Context, pod v1.Pod, container v1.Container, files syncMap) *exec.Cmd {
 	// Use "m" flag to touch the files as they are copied.
+	copyCmd := s.kubectl.Command(ctx, "exec", pod.Name, "--namespace", pod.Namespace, "-c", container.Name, "-i", "--", "tar", "xmf", "-", "-C", "/", "--no-same-owner")
+	copyCmd.Stdin = mappedTarReader(files)
+	return copyCmd
+}
+
+// mappedTarReader streams a tar archive of the files, mapped to their destinations.
+func mappedTarReader(files syncMap) *io.PipeReader {
 	reader, writer := io.Pipe()
 	go func() {
 		if err := util.CreateMappedTar(writer, "/", files); err != nil {
@@ -45,8 +52,5 @@ func (s *podSyncer) copyFileFn(ctx context.Context, pod v1.Pod, container v1.Con
 			writer.Close()
 		}
 	}()
-
-	copyCmd := s.kubectl.Command(ctx, "exec", pod.Name, "--namespace", pod.Namespace, "-c", container.Name, "-i", "--", "tar", "xmf", "-", "-C", "/", "--no-same-owner")
-	copyCmd.Stdin = reader
-	return copyCmd
+	return reader
 }
@@ -0,0 +1,228 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+)
+
+const (
+	// syncHelperImage is the image of the ephemeral container used to sync files
+	// into containers that don't ship with `tar` and `rm`.
+	syncHelperImage = "busybox:1.33"
+
+	// syncHelperRoot is where the helper sees the target container's filesystem.
+	// The helper shares the target container's process namespace, in which
+	// the target's main process has pid 1.
+	syncHelperRoot = "/proc/1/root"
+)
+
+// For testing
+var (
+	execInContainer   = streamExec
+	injectSyncHelper  = ensureSyncHelper
+	syncHelperTimeout = time.Minute
+)
+
+// nativeCopyFileFn streams a tar of the files to the container over the exec API.
+// If the container has no `tar` binary, the files are extracted by a helper container instead.
+func (s *podSyncer) nativeCopyFileFn(ctx context.Context, pod v1.Pod, container v1.Container, files syncMap) error {
+	err := execInContainer(ctx, pod, container.Name, []string{"tar", "xmf", "-", "-C", "/", "--no-same-owner"}, mappedTarReader(files))
+	if !isCommandNotFound(err) {
+		return err
+	}
+
+	logrus.Debugf("Container %s/%s has no tar binary, syncing through a helper container", pod.Name, container.Name)
+	helper, err := injectSyncHelper(ctx, pod, container)
+	if err != nil {
+		return err
+	}
+	return execInContainer(ctx, pod, helper, []string{"tar", "xmf", "-", "-C", syncHelperRoot, "--no-same-owner"}, mappedTarReader(files))
+}
+
+// nativeDeleteFileFn deletes the files from the container over the exec API.
+// If the container has no `rm` binary, the files are deleted by a helper container instead.
+func (s *podSyncer) nativeDeleteFileFn(ctx context.Context, pod v1.Pod, container v1.Container, files syncMap) error {
+	var dsts []string
+	for _, d := range files {
+		dsts = append(dsts, d...)
+	}
+
+	err := execInContainer(ctx, pod, container.Name, append([]string{"rm", "-rf", "--"}, dsts...), nil)
+	if !isCommandNotFound(err) {
+		return err
+	}
+
+	logrus.Debugf("Container %s/%s has no rm binary, syncing through a helper container", pod.Name, container.Name)
+	helper, err := injectSyncHelper(ctx, pod, container)
+	if err != nil {
+		return err
+	}
+	args := []string{"rm", "-rf", "--"}
+	for _, d := range dsts {
+		args = append(args, path.Join(syncHelperRoot, d))
+	}
+	return execInContainer(ctx, pod, helper, args, nil)
+}
+
+// streamExec runs a command in a container through the Kubernetes exec API, without spawning `kubectl`.
+// It returns when the context is cancelled, closing stdin so that the command can exit.
+func streamExec(ctx context.Context, pod v1.Pod, container string, command []string, stdin io.Reader) error {
+	if c, ok := stdin.(io.Closer); ok {
+		// Unblock the producer if the command exits without consuming stdin.
+		defer c.Close()
+	}
+
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+	config, err := kubectx.GetRestClientConfig()
+	if err != nil {
+		return fmt.Errorf("getting client config for Kubernetes client: %w", err)
+	}
+
+	req := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", req.URL())
+	if err != nil {
+		return fmt.Errorf("creating executor for pod %q: %w", pod.Name, err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	done := make(chan error, 1)
+	go func() {
+		done <- executor.Stream(remotecommand.StreamOptions{
+			Stdin:  stdin,
+			Stdout: &stdout,
+			Stderr: &stderr,
+		})
+	}()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("running %s in container %s/%s: %w", command, pod.Name, container, ctx.Err())
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("running %s in container %s/%s\n - stdout: %q\n - stderr: %q\n - cause: %w", command, pod.Name, container, stdout.String(), stderr.String(), err)
+		}
+	}
+
+	logrus.Debugf("Command %s in container %s/%s output: [%s]", command, pod.Name, container, stdout.String())
+	return nil
+}
+
+// isCommandNotFound returns true if the error indicates that the executed binary doesn't exist in the container.
+func isCommandNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) && (exitErr.ExitStatus() == 126 || exitErr.ExitStatus() == 127) {
+		return true
+	}
+	// The container runtime fails to start the process before any exit code is known.
+	return strings.Contains(err.Error(), "executable file not found")
+}
+
+// ensureSyncHelper adds an ephemeral helper container targeting the given container, unless one already exists,
+// and waits for it to be running. It returns the name of the helper container.
+// Ephemeral containers can't be removed, so the helper lives as long as the pod.
+func ensureSyncHelper(ctx context.Context, pod v1.Pod, container v1.Container) (string, error) {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return "", fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	name := "skaffold-sync-" + container.Name
+	pods := client.CoreV1().Pods(pod.Namespace)
+
+	ecs, err := pods.GetEphemeralContainers(ctx, pod.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("getting ephemeral containers of pod %q: %w", pod.Name, err)
+	}
+
+	exists := false
+	for _, ec := range ecs.EphemeralContainers {
+		if ec.Name == name {
+			exists = true
+			break
+		}
+	}
+
+	if !exists {
+		ecs.EphemeralContainers = append(ecs.EphemeralContainers, v1.EphemeralContainer{
+			EphemeralContainerCommon: v1.EphemeralContainerCommon{
+				Name:    name,
+				Image:   syncHelperImage,
+				Command: []string{"tail", "-f", "/dev/null"},
+			},
+			TargetContainerName: container.Name,
+		})
+		if _, err := pods.UpdateEphemeralContainers(ctx, pod.Name, ecs, metav1.UpdateOptions{}); err != nil {
+			return "", fmt.Errorf("adding sync helper to pod %q (ephemeral containers may not be enabled on this cluster): %w", pod.Name, err)
+		}
+	}
+
+	err = wait.PollImmediate(time.Second, syncHelperTimeout, func() (bool, error) {
+		p, err := pods.Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		for _, s := range p.Status.EphemeralContainerStatuses {
+			if s.Name == name && s.State.Running != nil {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("waiting for sync helper in pod %q: %w", pod.Name, err)
+	}
+
+	return name, nil
+}
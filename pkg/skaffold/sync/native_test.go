@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type execRecorder struct {
+	execs []string
+	errs  []error
+}
+
+func (r *execRecorder) exec(_ context.Context, pod v1.Pod, container string, command []string, stdin io.Reader) error {
+	if stdin != nil {
+		ioutil.ReadAll(stdin)
+	}
+	r.execs = append(r.execs, fmt.Sprintf("%s/%s: %s", pod.Name, container, strings.Join(command, " ")))
+
+	if len(r.errs) == 0 {
+		return nil
+	}
+	err := r.errs[0]
+	r.errs = r.errs[1:]
+	return err
+}
+
+func TestNativeSync(t *testing.T) {
+	notFound := utilexec.CodeExitError{Err: errors.New("command terminated with exit code 127"), Code: 127}
+
+	tests := []struct {
+		description    string
+		delete         bool
+		execErrs       []error
+		helperErr      error
+		expectedExecs  []string
+		expectedHelper bool
+		shouldErr      bool
+	}{
+		{
+			description:   "copy with tar in container",
+			expectedExecs: []string{"podname/container_name: tar xmf - -C / --no-same-owner"},
+		},
+		{
+			description: "copy through helper",
+			execErrs:    []error{notFound},
+			expectedExecs: []string{
+				"podname/container_name: tar xmf - -C / --no-same-owner",
+				"podname/helper: tar xmf - -C /proc/1/root --no-same-owner",
+			},
+			expectedHelper: true,
+		},
+		{
+			description:   "copy fails",
+			execErrs:      []error{errors.New("connection refused")},
+			expectedExecs: []string{"podname/container_name: tar xmf - -C / --no-same-owner"},
+			shouldErr:     true,
+		},
+		{
+			description:    "helper can't be injected",
+			execErrs:       []error{notFound},
+			helperErr:      errors.New("ephemeral containers disabled"),
+			expectedExecs:  []string{"podname/container_name: tar xmf - -C / --no-same-owner"},
+			expectedHelper: true,
+			shouldErr:      true,
+		},
+		{
+			description:   "delete with rm in container",
+			delete:        true,
+			expectedExecs: []string{"podname/container_name: rm -rf -- /test.go"},
+		},
+		{
+			description: "delete through helper",
+			delete:      true,
+			execErrs:    []error{errors.New(`exec: "rm": executable file not found in $PATH`)},
+			expectedExecs: []string{
+				"podname/container_name: rm -rf -- /test.go",
+				"podname/helper: rm -rf -- /proc/1/root/test.go",
+			},
+			expectedHelper: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			recorder := &execRecorder{errs: test.execErrs}
+			helperInjected := false
+			t.Override(&execInContainer, recorder.exec)
+			t.Override(&injectSyncHelper, func(context.Context, v1.Pod, v1.Container) (string, error) {
+				helperInjected = true
+				return "helper", test.helperErr
+			})
+
+			s := &podSyncer{}
+			files := syncMap{"test.go": {"/test.go"}}
+			var err error
+			if test.delete {
+				err = s.nativeDeleteFileFn(context.Background(), *pod, pod.Spec.Containers[0], files)
+			} else {
+				err = s.nativeCopyFileFn(context.Background(), *pod, pod.Spec.Containers[0], files)
+			}
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedExecs, recorder.execs)
+			t.CheckDeepEqual(test.expectedHelper, helperInjected)
+		})
+	}
+}
+
+func TestIsCommandNotFound(t *testing.T) {
+	tests := []struct {
+		description string
+		err         error
+		expected    bool
+	}{
+		{
+			description: "no error",
+		},
+		{
+			description: "exit code 126",
+			err:         fmt.Errorf("running tar: %w", utilexec.CodeExitError{Err: errors.New("exit 126"), Code: 126}),
+			expected:    true,
+		},
+		{
+			description: "exit code 1",
+			err:         utilexec.CodeExitError{Err: errors.New("exit 1"), Code: 1},
+		},
+		{
+			description: "missing file",
+			err:         fmt.Errorf("running rm: %w", utilexec.CodeExitError{Err: errors.New("rm: can't remove '/app/foo': No such file or directory"), Code: 1}),
+		},
+		{
+			description: "missing file without exit code",
+			err:         errors.New("tar: /app/foo: Cannot open: no such file or directory"),
+		},
+		{
+			description: "runtime error message",
+			err:         errors.New(`OCI runtime exec failed: exec failed: container_linux.go:349: starting container process caused "exec: \"tar\": executable file not found in $PATH": unknown`),
+			expected:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, isCommandNotFound(test.err))
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os/exec"
 	"path"
 	"path/filepath"
@@ -37,6 +38,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -100,7 +102,7 @@ func syncItem(a *latest_v1.Artifact, tag string, e filemon.Events, syncRules []*
 		return nil, nil
 	}
 
-	return &Item{Image: tag, Copy: toCopy, Delete: toDelete}, nil
+	return &Item{Image: tag, Artifact: a, Copy: toCopy, Delete: toDelete}, nil
 }
 
 func inferredSyncItem(a *latest_v1.Artifact, tag string, e filemon.Events, cfg docker.Config) (*Item, error) {
@@ -144,7 +146,7 @@ func inferredSyncItem(a *latest_v1.Artifact, tag string, e filemon.Events, cfg d
 		}
 	}
 
-	return &Item{Image: tag, Copy: toCopy}, nil
+	return &Item{Image: tag, Artifact: a, Copy: toCopy}, nil
 }
 
 func syncMapForArtifact(a *latest_v1.Artifact, cfg docker.Config) (map[string][]string, error) {
@@ -187,7 +189,7 @@ func autoSyncItem(ctx context.Context, a *latest_v1.Artifact, tag string, e file
 			// do a rebuild
 			return nil, nil
 		}
-		return &Item{Image: tag, Copy: toCopy, Delete: toDelete}, nil
+		return &Item{Image: tag, Artifact: a, Copy: toCopy, Delete: toDelete}, nil
 
 	default:
 		// TODO: this error does appear a little late in the build, perhaps it could surface at first run, rather than first sync?
@@ -252,11 +254,20 @@ func matchSyncRules(syncRules []*latest_v1.SyncRule, relPath, containerWd string
 	return dsts, nil
 }
 
-func (s *podSyncer) Sync(ctx context.Context, item *Item) error {
+func (s *podSyncer) Sync(ctx context.Context, out io.Writer, item *Item) error {
+	var hooksRunner hooks.Runner
+	if item.Artifact != nil && item.Artifact.Sync != nil {
+		opts := hooks.NewSyncEnvOpts(item.Artifact, item.Image, item.Copy, item.Delete, s.namespaces, s.kubeContext)
+		hooksRunner = hooks.NewSyncRunner(s.kubectl, item.Artifact.Sync.LifecycleHooks, opts, s.runID)
+		if err := hooksRunner.RunPreHooks(ctx, out); err != nil {
+			return err
+		}
+	}
+
 	if len(item.Copy) > 0 {
 		logrus.Infoln("Copying files:", item.Copy, "to", item.Image)
 
-		if err := Perform(ctx, item.Image, item.Copy, s.copyFileFn, s.namespaces); err != nil {
+		if err := perform(ctx, item.Image, item.Copy, s.copyFn, s.namespaces); err != nil {
 			return fmt.Errorf("copying files: %w", err)
 		}
 	}
@@ -264,15 +275,30 @@ func (s *podSyncer) Sync(ctx context.Context, item *Item) error {
 	if len(item.Delete) > 0 {
 		logrus.Infoln("Deleting files:", item.Delete, "from", item.Image)
 
-		if err := Perform(ctx, item.Image, item.Delete, s.deleteFileFn, s.namespaces); err != nil {
+		if err := perform(ctx, item.Image, item.Delete, s.deleteFn, s.namespaces); err != nil {
 			return fmt.Errorf("deleting files: %w", err)
 		}
 	}
 
+	if hooksRunner != nil {
+		return hooksRunner.RunPostHooks(ctx, out)
+	}
 	return nil
 }
 
 func Perform(ctx context.Context, image string, files syncMap, cmdFn func(context.Context, v1.Pod, v1.Container, syncMap) *exec.Cmd, namespaces []string) error {
+	return perform(ctx, image, files, runCmdFn(cmdFn), namespaces)
+}
+
+// runCmdFn turns a function creating a sync command into a syncFn running that command.
+func runCmdFn(cmdFn func(context.Context, v1.Pod, v1.Container, syncMap) *exec.Cmd) syncFn {
+	return func(ctx context.Context, p v1.Pod, c v1.Container, files syncMap) error {
+		_, err := util.RunCmdOut(cmdFn(ctx, p, c, files))
+		return err
+	}
+}
+
+func perform(ctx context.Context, image string, files syncMap, fn syncFn, namespaces []string) error {
 	if len(files) == 0 {
 		return nil
 	}
@@ -301,10 +327,9 @@ func Perform(ctx context.Context, image string, files syncMap, cmdFn func(contex
 					continue
 				}
 
-				cmd := cmdFn(ctx, p, c, files)
+				p, c := p, c
 				errs.Go(func() error {
-					_, err := util.RunCmdOut(cmd)
-					return err
+					return fn(ctx, p, c, files)
 				})
 				numSynced++
 			}
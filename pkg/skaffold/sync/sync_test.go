@@ -757,6 +757,10 @@ func TestNewSyncItem(t *testing.T) {
 				return map[string][]string{"file.class": {"/some/file.class"}}, nil, nil
 			})
 
+			if test.expected != nil {
+				test.expected.Artifact = test.artifact
+			}
+
 			actual, err := NewItem(ctx, test.artifact, test.evt, test.builds, &mockConfig{}, 0)
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, actual)
@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// SyncerMux forwards the files to sync to all the syncers it contains,
+// such as the deployers of each configuration that run containers themselves.
+// Each syncer only reaches its own containers, so syncing fails only if none
+// of the syncers could sync the files. The first error is returned in that case.
+type SyncerMux []Syncer
+
+func (m SyncerMux) Sync(ctx context.Context, out io.Writer, item *Item) error {
+	synced := false
+	var firstErr error
+	for _, syncer := range m {
+		if err := syncer.Sync(ctx, out, item); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		synced = true
+	}
+
+	switch {
+	case synced:
+		return nil
+	case firstErr != nil:
+		return firstErr
+	default:
+		return errors.New("didn't sync any files")
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type fakeSyncer struct {
+	err    error
+	synced int
+}
+
+func (f *fakeSyncer) Sync(context.Context, io.Writer, *Item) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.synced++
+	return nil
+}
+
+func TestSyncerMux(t *testing.T) {
+	tests := []struct {
+		description    string
+		syncers        []*fakeSyncer
+		expectedSynced []int
+		expectedErr    string
+	}{
+		{
+			description:    "sync with every syncer",
+			syncers:        []*fakeSyncer{{}, {}},
+			expectedSynced: []int{1, 1},
+		},
+		{
+			description:    "one syncer reaches the containers",
+			syncers:        []*fakeSyncer{{err: errors.New("no container")}, {}},
+			expectedSynced: []int{0, 1},
+		},
+		{
+			description:    "no syncer reaches the containers",
+			syncers:        []*fakeSyncer{{err: errors.New("first")}, {err: errors.New("second")}},
+			expectedSynced: []int{0, 0},
+			expectedErr:    "first",
+		},
+		{
+			description: "no syncer",
+			expectedErr: "didn't sync any files",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var mux SyncerMux
+			for _, s := range test.syncers {
+				mux = append(mux, s)
+			}
+
+			err := mux.Sync(context.Background(), ioutil.Discard, &Item{Image: "app"})
+
+			if test.expectedErr != "" {
+				t.CheckErrorContains(test.expectedErr, err)
+			} else {
+				t.CheckNoError(err)
+			}
+			var synced []int
+			for _, s := range test.syncers {
+				synced = append(synced, s.synced)
+			}
+			t.CheckDeepEqual(test.expectedSynced, synced)
+		})
+	}
+}
@@ -18,37 +18,62 @@ package sync
 
 import (
 	"context"
+	"io"
+
+	v1 "k8s.io/api/core/v1"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
 	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 )
 
 type syncMap map[string][]string
 
 type Item struct {
-	Image  string
-	Copy   map[string][]string
-	Delete map[string][]string
+	Image    string
+	Artifact *latest_v1.Artifact
+	Copy     map[string][]string
+	Delete   map[string][]string
 }
 
 type Syncer interface {
-	Sync(context.Context, *Item) error
+	Sync(context.Context, io.Writer, *Item) error
 }
 
+// syncFn syncs files to a single container.
+type syncFn func(context.Context, v1.Pod, v1.Container, syncMap) error
+
 type podSyncer struct {
-	kubectl    *pkgkubectl.CLI
-	namespaces []string
+	kubectl     *pkgkubectl.CLI
+	namespaces  []string
+	kubeContext string
+	runID       string
+	copyFn      syncFn
+	deleteFn    syncFn
 }
 
 type Config interface {
 	kubectl.Config
 
 	GetNamespaces() []string
+	NativeSync() bool
 }
 
-func NewSyncer(cfg Config) Syncer {
-	return &podSyncer{
-		kubectl:    pkgkubectl.NewCLI(cfg, ""),
-		namespaces: cfg.GetNamespaces(),
+func NewSyncer(cfg Config, labels map[string]string) Syncer {
+	s := &podSyncer{
+		kubectl:     pkgkubectl.NewCLI(cfg, ""),
+		namespaces:  cfg.GetNamespaces(),
+		kubeContext: cfg.GetKubeContext(),
+		runID:       labels[label.RunIDLabel],
+	}
+
+	if cfg.NativeSync() {
+		s.copyFn = s.nativeCopyFileFn
+		s.deleteFn = s.nativeDeleteFileFn
+	} else {
+		s.copyFn = runCmdFn(s.copyFileFn)
+		s.deleteFn = runCmdFn(s.deleteFileFn)
 	}
+	return s
 }
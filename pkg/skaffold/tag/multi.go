@@ -0,0 +1,56 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"fmt"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// multiTagger combines the tags of several taggers.
+// multiTagger implements MultiTagger
+type multiTagger struct {
+	taggers []Tagger
+}
+
+// NewMultiTagger creates a tagger that generates the tags of all the given taggers.
+// The first tagger generates the main tag.
+func NewMultiTagger(taggers ...Tagger) MultiTagger {
+	return &multiTagger{taggers: taggers}
+}
+
+// GenerateTag generates the main tag.
+func (t *multiTagger) GenerateTag(image latest_v1.Artifact) (string, error) {
+	return t.taggers[0].GenerateTag(image)
+}
+
+// GenerateTags generates the tags of all the taggers.
+func (t *multiTagger) GenerateTags(image latest_v1.Artifact) ([]string, error) {
+	var all []string
+	for i, tagger := range t.taggers {
+		tags, err := generateTags(tagger, image)
+		if err != nil {
+			if i == 0 {
+				return nil, err
+			}
+			return nil, fmt.Errorf("generating additional tag: %w", err)
+		}
+		all = append(all, tags...)
+	}
+	return all, nil
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/blang/semver"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+const defaultPreRelease = "{{.Distance}}-g{{.Commit}}"
+
+var (
+	bumps = map[string]bool{
+		"":             true,
+		"none":         true,
+		"patch":        true,
+		"minor":        true,
+		"major":        true,
+		"conventional": true,
+	}
+
+	// describeRegex parses the output of `git describe --long`, such as `v1.4.2-3-gabc123`.
+	describeRegex = regexp.MustCompile(`^(.+)-(\d+)-g([0-9a-f]+)$`)
+
+	// conventionalRegex parses the header of a Conventional Commits message, such as `feat(api)!: remove v1`.
+	conventionalRegex = regexp.MustCompile(`^(\w+)(?:\([^)]*\))?(!)?: `)
+	breakingRegex     = regexp.MustCompile(`(?m)^BREAKING[ -]CHANGE: `)
+)
+
+// semverTagger tags an image with a semantic version derived from the nearest git tag.
+type semverTagger struct {
+	tagPrefix     string
+	bump          string
+	preRelease    *template.Template
+	buildMetadata *template.Template
+	prefix        string
+	ignoreChanges bool
+	floatingTags  bool
+	runGitFn      func(workingDir string, arg ...string) (string, error)
+}
+
+// semverData is what the pre-release and build metadata templates are executed against.
+type semverData struct {
+	Distance int
+	Commit   string
+}
+
+// NewSemverTagger creates a new semver tagger. It fails if the bump rule or the templates are invalid.
+func NewSemverTagger(t *latest_v1.SemverTagger) (Tagger, error) {
+	bump := strings.ToLower(t.Bump)
+	if !bumps[bump] {
+		return nil, fmt.Errorf("%q is not a valid semver bump", t.Bump)
+	}
+
+	preRelease := t.PreRelease
+	if preRelease == "" {
+		preRelease = defaultPreRelease
+	}
+	preReleaseTmpl, err := template.New("preRelease").Option("missingkey=error").Parse(preRelease)
+	if err != nil {
+		return nil, fmt.Errorf("parsing pre-release template: %w", err)
+	}
+
+	var buildMetadataTmpl *template.Template
+	if t.BuildMetadata != "" {
+		if buildMetadataTmpl, err = template.New("buildMetadata").Option("missingkey=error").Parse(t.BuildMetadata); err != nil {
+			return nil, fmt.Errorf("parsing build metadata template: %w", err)
+		}
+	}
+
+	return &semverTagger{
+		tagPrefix:     t.TagPrefix,
+		bump:          bump,
+		preRelease:    preReleaseTmpl,
+		buildMetadata: buildMetadataTmpl,
+		prefix:        t.Prefix,
+		ignoreChanges: t.IgnoreChanges,
+		floatingTags:  t.FloatingTags,
+		runGitFn:      runGit,
+	}, nil
+}
+
+// GenerateTag generates a tag from the nearest git tag.
+func (t *semverTagger) GenerateTag(image latest_v1.Artifact) (string, error) {
+	tags, err := t.GenerateTags(image)
+	if err != nil {
+		return "", err
+	}
+	return tags[0], nil
+}
+
+// GenerateTags generates a tag from the nearest git tag and, for released versions
+// with floating tags, tags with their minor and major versions.
+func (t *semverTagger) GenerateTags(image latest_v1.Artifact) ([]string, error) {
+	version, err := t.version(image.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if !t.ignoreChanges {
+		changes, err := t.runGitFn(image.Workspace, "status", ".", "--porcelain")
+		if err != nil {
+			return nil, fmt.Errorf("getting git status: %w", err)
+		}
+
+		if len(changes) > 0 {
+			version.Pre = append(version.Pre, semver.PRVersion{VersionStr: "dirty"})
+		}
+	}
+
+	// `+` isn't allowed in image tags.
+	tags := []string{t.prefix + strings.Replace(version.String(), "+", "_", 1)}
+	if t.floatingTags && len(version.Pre) == 0 {
+		tags = append(tags, fmt.Sprintf("%s%d.%d", t.prefix, version.Major, version.Minor), fmt.Sprintf("%s%d", t.prefix, version.Major))
+	}
+	return tags, nil
+}
+
+// version computes the version of the current commit.
+func (t *semverTagger) version(workingDir string) (semver.Version, error) {
+	var version semver.Version
+	var tag string
+	data := semverData{}
+
+	described, err := t.runGitFn(workingDir, t.describeArgs()...)
+	if err == nil {
+		match := describeRegex.FindStringSubmatch(described)
+		if match == nil {
+			return version, fmt.Errorf("unexpected output of git describe: %q", described)
+		}
+		tag, data.Commit = match[1], match[3]
+		data.Distance, _ = strconv.Atoi(match[2])
+
+		if version, err = semver.ParseTolerant(strings.TrimPrefix(tag, t.tagPrefix)); err != nil {
+			return version, fmt.Errorf("parsing version of git tag %q: %w", tag, err)
+		}
+	} else {
+		// Without tag, versions start at 0.0.0
+		if data.Commit, err = t.runGitFn(workingDir, "rev-parse", "--short", "HEAD"); err != nil {
+			return version, fmt.Errorf("unable to find git commit: %w", err)
+		}
+		count, err := t.runGitFn(workingDir, "rev-list", "--count", "HEAD")
+		if err != nil {
+			return version, fmt.Errorf("counting git commits: %w", err)
+		}
+		if data.Distance, err = strconv.Atoi(count); err != nil {
+			return version, fmt.Errorf("counting git commits: %w", err)
+		}
+	}
+
+	if data.Distance > 0 {
+		bump := t.bump
+		if bump == "conventional" {
+			if bump, err = t.conventionalBump(workingDir, tag); err != nil {
+				return version, err
+			}
+		}
+
+		switch bump {
+		case "major":
+			version = semver.Version{Major: version.Major + 1}
+		case "minor":
+			version = semver.Version{Major: version.Major, Minor: version.Minor + 1}
+		case "patch":
+			version = semver.Version{Major: version.Major, Minor: version.Minor, Patch: version.Patch + 1}
+		}
+
+		preRelease, err := executeTemplate(t.preRelease, data)
+		if err != nil {
+			return version, err
+		}
+		for _, identifier := range strings.Split(preRelease, ".") {
+			pr, err := semver.NewPRVersion(identifier)
+			if err != nil {
+				return version, fmt.Errorf("invalid pre-release %q: %w", preRelease, err)
+			}
+			version.Pre = append(version.Pre, pr)
+		}
+	}
+
+	if t.buildMetadata != nil {
+		buildMetadata, err := executeTemplate(t.buildMetadata, data)
+		if err != nil {
+			return version, err
+		}
+		version.Build = nil
+		for _, identifier := range strings.Split(buildMetadata, ".") {
+			build, err := semver.NewBuildVersion(identifier)
+			if err != nil {
+				return version, fmt.Errorf("invalid build metadata %q: %w", buildMetadata, err)
+			}
+			version.Build = append(version.Build, build)
+		}
+	}
+
+	return version, nil
+}
+
+func (t *semverTagger) describeArgs() []string {
+	args := []string{"describe", "--tags", "--long"}
+	if t.tagPrefix != "" {
+		return append(args, "--match", t.tagPrefix+"*")
+	}
+	return append(args, "--match", "[0-9]*", "--match", "v[0-9]*")
+}
+
+// conventionalBump finds how to bump the version from the Conventional Commits messages since a tag.
+func (t *semverTagger) conventionalBump(workingDir, tag string) (string, error) {
+	revisions := "HEAD"
+	if tag != "" {
+		revisions = tag + "..HEAD"
+	}
+
+	log, err := t.runGitFn(workingDir, "log", "--format=%B%x00", revisions)
+	if err != nil {
+		return "", fmt.Errorf("reading git log: %w", err)
+	}
+
+	bump := "patch"
+	for _, message := range strings.Split(log, "\x00") {
+		message = strings.TrimSpace(message)
+		match := conventionalRegex.FindStringSubmatch(message)
+
+		switch {
+		case (match != nil && match[2] == "!") || breakingRegex.MatchString(message):
+			return "major", nil
+		case match != nil && strings.ToLower(match[1]) == "feat":
+			bump = "minor"
+		}
+	}
+	return bump, nil
+}
+
+func executeTemplate(tmpl *template.Template, data semverData) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("executing template: %w", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSemver_GenerateTag(t *testing.T) {
+	const describe = "describe --tags --long --match [0-9]* --match v[0-9]*"
+
+	tests := []struct {
+		description string
+		config      latest_v1.SemverTagger
+		git         map[string]string
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "tagged commit",
+			git:         map[string]string{describe: "v1.4.2-0-gabc123"},
+			expected:    []string{"1.4.2"},
+		},
+		{
+			description: "commits after tag",
+			git:         map[string]string{describe: "v1.4.2-3-gabc123"},
+			expected:    []string{"1.4.2-3-gabc123"},
+		},
+		{
+			description: "pre-release tag",
+			git:         map[string]string{describe: "1.5.0-rc.1-3-gabc123"},
+			expected:    []string{"1.5.0-rc.1.3-gabc123"},
+		},
+		{
+			description: "uncommitted changes",
+			git: map[string]string{
+				describe:               "v1.4.2-0-gabc123",
+				"status . --porcelain": "M file",
+			},
+			expected: []string{"1.4.2-dirty"},
+		},
+		{
+			description: "ignore uncommitted changes",
+			config:      latest_v1.SemverTagger{IgnoreChanges: true},
+			git: map[string]string{
+				describe:               "v1.4.2-0-gabc123",
+				"status . --porcelain": "M file",
+			},
+			expected: []string{"1.4.2"},
+		},
+		{
+			description: "tag prefix",
+			config:      latest_v1.SemverTagger{TagPrefix: "frontend/v", Prefix: "v"},
+			git:         map[string]string{"describe --tags --long --match frontend/v*": "frontend/v2.0.1-0-gabc123"},
+			expected:    []string{"v2.0.1"},
+		},
+		{
+			description: "no tag",
+			git: map[string]string{
+				"rev-parse --short HEAD": "abc123",
+				"rev-list --count HEAD":  "12",
+			},
+			expected: []string{"0.0.0-12-gabc123"},
+		},
+		{
+			description: "patch bump",
+			config:      latest_v1.SemverTagger{Bump: "Patch"},
+			git:         map[string]string{describe: "v1.4.2-3-gabc123"},
+			expected:    []string{"1.4.3-3-gabc123"},
+		},
+		{
+			description: "no bump on tagged commit",
+			config:      latest_v1.SemverTagger{Bump: "Major"},
+			git:         map[string]string{describe: "v1.4.2-0-gabc123"},
+			expected:    []string{"1.4.2"},
+		},
+		{
+			description: "conventional fix",
+			config:      latest_v1.SemverTagger{Bump: "Conventional"},
+			git: map[string]string{
+				describe:                           "v1.4.2-2-gabc123",
+				"log --format=%B%x00 v1.4.2..HEAD": "fix: typo\n\x00\ndocs(readme): update\n\x00",
+			},
+			expected: []string{"1.4.3-2-gabc123"},
+		},
+		{
+			description: "conventional feature",
+			config:      latest_v1.SemverTagger{Bump: "Conventional"},
+			git: map[string]string{
+				describe:                           "v1.4.2-2-gabc123",
+				"log --format=%B%x00 v1.4.2..HEAD": "fix: typo\n\x00\nfeat(api): add v2\n\x00",
+			},
+			expected: []string{"1.5.0-2-gabc123"},
+		},
+		{
+			description: "conventional breaking change",
+			config:      latest_v1.SemverTagger{Bump: "Conventional"},
+			git: map[string]string{
+				describe:                           "v1.4.2-2-gabc123",
+				"log --format=%B%x00 v1.4.2..HEAD": "feat: add v2\n\nBREAKING CHANGE: remove v1\n\x00\nfix: typo\n\x00",
+			},
+			expected: []string{"2.0.0-2-gabc123"},
+		},
+		{
+			description: "conventional breaking change with !",
+			config:      latest_v1.SemverTagger{Bump: "Conventional"},
+			git: map[string]string{
+				describe:                           "v1.4.2-1-gabc123",
+				"log --format=%B%x00 v1.4.2..HEAD": "refactor(api)!: drop v1\n\x00",
+			},
+			expected: []string{"2.0.0-1-gabc123"},
+		},
+		{
+			description: "floating tags",
+			config:      latest_v1.SemverTagger{FloatingTags: true, Prefix: "v"},
+			git:         map[string]string{describe: "v1.4.2-0-gabc123"},
+			expected:    []string{"v1.4.2", "v1.4", "v1"},
+		},
+		{
+			description: "no floating tags for pre-releases",
+			config:      latest_v1.SemverTagger{FloatingTags: true},
+			git:         map[string]string{describe: "v1.4.2-3-gabc123"},
+			expected:    []string{"1.4.2-3-gabc123"},
+		},
+		{
+			description: "pre-release and build metadata templates",
+			config:      latest_v1.SemverTagger{Bump: "Minor", PreRelease: "dev.{{.Distance}}", BuildMetadata: "sha.{{.Commit}}"},
+			git:         map[string]string{describe: "v1.4.2-3-gabc123"},
+			expected:    []string{"1.5.0-dev.3_sha.abc123"},
+		},
+		{
+			description: "build metadata on tagged commit",
+			config:      latest_v1.SemverTagger{BuildMetadata: "{{.Commit}}"},
+			git:         map[string]string{describe: "v1.4.2-0-gabc123"},
+			expected:    []string{"1.4.2_abc123"},
+		},
+		{
+			description: "invalid pre-release",
+			config:      latest_v1.SemverTagger{PreRelease: "{{.Distance}}_{{.Commit}}"},
+			git:         map[string]string{describe: "v1.4.2-3-gabc123"},
+			shouldErr:   true,
+		},
+		{
+			description: "tag is not a version",
+			git:         map[string]string{describe: "v1-beta-3-gabc123"},
+			shouldErr:   true,
+		},
+		{
+			description: "not a git repository",
+			git:         map[string]string{},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tagger, err := NewSemverTagger(&test.config)
+			t.CheckNoError(err)
+
+			tagger.(*semverTagger).runGitFn = func(_ string, arg ...string) (string, error) {
+				if out, found := test.git[strings.Join(arg, " ")]; found {
+					return strings.TrimSpace(out), nil
+				}
+				if arg[0] == "status" {
+					return "", nil
+				}
+				return "", errors.New("fatal: git error")
+			}
+
+			tags, err := tagger.(MultiTagger).GenerateTags(latest_v1.Artifact{ImageName: "test"})
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tags)
+		})
+	}
+}
+
+func TestSemver_NewSemverTagger(t *testing.T) {
+	tests := []struct {
+		description string
+		config      latest_v1.SemverTagger
+		shouldErr   bool
+	}{
+		{
+			description: "defaults",
+		},
+		{
+			description: "valid config",
+			config:      latest_v1.SemverTagger{Bump: "conventional", PreRelease: "rc.{{.Distance}}", BuildMetadata: "{{.Commit}}"},
+		},
+		{
+			description: "invalid bump",
+			config:      latest_v1.SemverTagger{Bump: "huge"},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid pre-release template",
+			config:      latest_v1.SemverTagger{PreRelease: "{{.Distance"},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid build metadata template",
+			config:      latest_v1.SemverTagger{BuildMetadata: "{{.Commit"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			_, err := NewSemverTagger(&test.config)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
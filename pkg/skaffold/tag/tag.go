@@ -31,6 +31,15 @@ type Tagger interface {
 	GenerateTag(image latest_v1.Artifact) (string, error)
 }
 
+// MultiTagger is implemented by the taggers that can generate more than one tag for an artifact.
+type MultiTagger interface {
+	Tagger
+
+	// GenerateTags generates all the tags for an artifact.
+	// The first tag is the one returned by GenerateTag.
+	GenerateTags(image latest_v1.Artifact) ([]string, error)
+}
+
 // GenerateFullyQualifiedImageName resolves the fully qualified image name for an artifact.
 // The workingDir is the root directory of the artifact with respect to the Skaffold root,
 // and imageName is the base name of the image.
@@ -47,3 +56,40 @@ func GenerateFullyQualifiedImageName(t Tagger, image latest_v1.Artifact) (string
 
 	return fmt.Sprintf("%s:%s", image.ImageName, tag), nil
 }
+
+// GenerateFullyQualifiedImageNames resolves all the fully qualified image names for an artifact.
+// The first name is the one used to deploy. The others are additional tags pushed with the image.
+func GenerateFullyQualifiedImageNames(t Tagger, image latest_v1.Artifact) ([]string, error) {
+	tags, err := generateTags(t, image)
+	if err != nil {
+		return nil, fmt.Errorf("generating tag: %w", err)
+	}
+
+	var names []string
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		name := image.ImageName
+		// Do not append :tag to imageName if tag is empty.
+		if tag != "" {
+			name = fmt.Sprintf("%s:%s", image.ImageName, tag)
+		}
+
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func generateTags(t Tagger, image latest_v1.Artifact) ([]string, error) {
+	if multi, ok := t.(MultiTagger); ok {
+		return multi.GenerateTags(image)
+	}
+
+	tag, err := t.GenerateTag(image)
+	if err != nil {
+		return nil, err
+	}
+	return []string{tag}, nil
+}
@@ -114,3 +114,52 @@ func TestTagger_GenerateFullyQualifiedImageName(t *testing.T) {
 		})
 	}
 }
+
+func TestTagger_GenerateFullyQualifiedImageNames(t *testing.T) {
+	latestTagger, _ := NewEnvTemplateTagger("latest")
+	envTemplateExample, _ := NewEnvTemplateTagger("{{.FOO}}")
+	invalidEnvTemplate, _ := NewEnvTemplateTagger("{{.BAR}}")
+	env := []string{"FOO=BAR"}
+
+	tests := []struct {
+		description string
+		tagger      Tagger
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "single tag",
+			tagger:      envTemplateExample,
+			expected:    []string{"test:BAR"},
+		},
+		{
+			description: "additional tags",
+			tagger:      NewMultiTagger(envTemplateExample, latestTagger, &CustomTag{Tag: "v1"}),
+			expected:    []string{"test:BAR", "test:latest", "test:v1"},
+		},
+		{
+			description: "duplicate tags",
+			tagger:      NewMultiTagger(latestTagger, &ChecksumTagger{}, envTemplateExample),
+			expected:    []string{"test:latest", "test:BAR"},
+		},
+		{
+			description: "invalid main tag",
+			tagger:      NewMultiTagger(invalidEnvTemplate, latestTagger),
+			shouldErr:   true,
+		},
+		{
+			description: "invalid additional tag",
+			tagger:      NewMultiTagger(latestTagger, invalidEnvTemplate),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.OSEnviron, func() []string { return env })
+
+			tags, err := GenerateFullyQualifiedImageNames(test.tagger, latest_v1.Artifact{ImageName: "test"})
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tags)
+		})
+	}
+}
@@ -37,6 +37,14 @@ func (t *TaggerMux) GenerateTag(image latest_v1.Artifact) (string, error) {
 	return tagger.GenerateTag(image)
 }
 
+func (t *TaggerMux) GenerateTags(image latest_v1.Artifact) ([]string, error) {
+	tagger, found := t.byImageName[image.ImageName]
+	if !found {
+		return nil, fmt.Errorf("no valid tagger found for artifact: %q", image.ImageName)
+	}
+	return generateTags(tagger, image)
+}
+
 func NewTaggerMux(runCtx *runcontext.RunContext) (Tagger, error) {
 	pipelines := runCtx.GetPipelines()
 	m := make(map[string]Tagger)
@@ -55,12 +63,40 @@ func NewTaggerMux(runCtx *runcontext.RunContext) (Tagger, error) {
 }
 
 func getTagger(runCtx *runcontext.RunContext, t *latest_v1.TagPolicy) (Tagger, error) {
-	switch {
-	case runCtx.CustomTag() != "":
-		return &CustomTag{
+	var tagger Tagger
+	if runCtx.CustomTag() != "" {
+		tagger = &CustomTag{
 			Tag: runCtx.CustomTag(),
-		}, nil
+		}
+	} else {
+		var err error
+		if tagger, err = newTagger(runCtx, t); err != nil {
+			return nil, err
+		}
+	}
 
+	if len(t.AdditionalTags) == 0 {
+		return tagger, nil
+	}
+
+	taggers := []Tagger{tagger}
+	for i := range t.AdditionalTags {
+		additional := &t.AdditionalTags[i]
+		if len(additional.AdditionalTags) > 0 {
+			return nil, fmt.Errorf("nested additionalTags are not supported in skaffold")
+		}
+
+		additionalTagger, err := newTagger(runCtx, additional)
+		if err != nil {
+			return nil, fmt.Errorf("creating additional tagger: %w", err)
+		}
+		taggers = append(taggers, additionalTagger)
+	}
+	return NewMultiTagger(taggers...), nil
+}
+
+func newTagger(runCtx *runcontext.RunContext, t *latest_v1.TagPolicy) (Tagger, error) {
+	switch {
 	case t.EnvTemplateTagger != nil:
 		return NewEnvTemplateTagger(t.EnvTemplateTagger.Template)
 
@@ -77,6 +113,9 @@ func getTagger(runCtx *runcontext.RunContext, t *latest_v1.TagPolicy) (Tagger, e
 		graph := graph.ToArtifactGraph(runCtx.Artifacts())
 		return NewInputDigestTagger(runCtx, graph)
 
+	case t.SemverTagger != nil:
+		return NewSemverTagger(t.SemverTagger)
+
 	case t.CustomTemplateTagger != nil:
 		components, err := CreateComponents(runCtx, t.CustomTemplateTagger)
 
@@ -102,6 +141,10 @@ func CreateComponents(runCtx *runcontext.RunContext, t *latest_v1.CustomTemplate
 			return nil, fmt.Errorf("multiple components with name %s", name)
 		}
 
+		if len(c.AdditionalTags) > 0 {
+			return nil, fmt.Errorf("additionalTags are not supported in customTemplate components (%s)", name)
+		}
+
 		switch {
 		case c.EnvTemplateTagger != nil:
 			components[name], _ = NewEnvTemplateTagger(c.EnvTemplateTagger.Template)
@@ -120,6 +163,13 @@ func CreateComponents(runCtx *runcontext.RunContext, t *latest_v1.CustomTemplate
 			inputDigest, _ := NewInputDigestTagger(runCtx, graph)
 			components[name] = inputDigest
 
+		case c.SemverTagger != nil:
+			semverTagger, err := NewSemverTagger(c.SemverTagger)
+			if err != nil {
+				return nil, fmt.Errorf("creating semver component %s: %w", name, err)
+			}
+			components[name] = semverTagger
+
 		case c.CustomTemplateTagger != nil:
 			return nil, fmt.Errorf("nested customTemplate components are not supported in skaffold (%s)", name)
 
@@ -0,0 +1,309 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/segmentio/textio"
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	// TestLabel is the label set on verify Jobs and their pods, with the name of the test.
+	TestLabel = "skaffold.dev/verify-test"
+
+	jobNameLabel = "job-name"
+)
+
+// for testing
+var pollInterval = time.Second
+
+// Verifier runs the tests that verify a deployed application.
+type Verifier interface {
+	Verify(ctx context.Context, out io.Writer, builds []graph.Artifact) error
+}
+
+// Config contains the configuration needed by the Verifier.
+type Config interface {
+	GetKubeNamespace() string
+	VerifyTestCases() []*latest_v1.VerifyTestCase
+}
+
+type jobVerifier struct {
+	tests     []*latest_v1.VerifyTestCase
+	namespace string
+	labels    map[string]string
+}
+
+// NewVerifier returns a Verifier that runs each test as a Kubernetes Job.
+func NewVerifier(cfg Config, labels map[string]string) Verifier {
+	return &jobVerifier{
+		tests:     cfg.VerifyTestCases(),
+		namespace: cfg.GetKubeNamespace(),
+		labels:    labels,
+	}
+}
+
+// Verify runs the tests one after the other, streaming their logs, and fails on the first failing test.
+func (v *jobVerifier) Verify(ctx context.Context, out io.Writer, builds []graph.Artifact) error {
+	if len(v.tests) == 0 {
+		return nil
+	}
+
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	namespace, err := resolveNamespace(v.namespace)
+	if err != nil {
+		return err
+	}
+
+	for _, tc := range v.tests {
+		color.Default.Fprintf(out, "Running verify test %s...\n", tc.Name)
+		start := time.Now()
+
+		if err := v.run(ctx, out, client, namespace, tc, builds); err != nil {
+			return err
+		}
+
+		color.Default.Fprintf(out, "Verify test %s passed in %s\n", tc.Name, util.ShowHumanizeTime(time.Since(start)))
+	}
+	return nil
+}
+
+// run creates the Job of a test, streams the logs of its container and waits for the Job to complete.
+func (v *jobVerifier) run(ctx context.Context, out io.Writer, client kubernetes.Interface, namespace string, tc *latest_v1.VerifyTestCase, builds []graph.Artifact) error {
+	jobs := client.BatchV1().Jobs(namespace)
+	job, err := jobs.Create(ctx, v.job(tc, builds), metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("creating job for verify test %q: %w", tc.Name, err)
+	}
+	defer func() {
+		propagation := metav1.DeletePropagationBackground
+		if err := jobs.Delete(context.Background(), job.Name, metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil {
+			logrus.Debugf("deleting job %s: %v", job.Name, err)
+		}
+	}()
+
+	pod, err := waitForStart(ctx, client, namespace, job.Name)
+	if err != nil {
+		return fmt.Errorf("verify test %q: %w", tc.Name, err)
+	}
+	if pod != nil {
+		streamLogs(ctx, out, client, pod, tc.Name)
+	}
+
+	var failure error
+	err = wait.PollImmediateUntil(pollInterval, func() (bool, error) {
+		job, err := jobs.Get(ctx, job.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		done, jobErr := jobStatus(job)
+		failure = jobErr
+		return done, nil
+	}, ctx.Done())
+	if err != nil {
+		return fmt.Errorf("waiting for verify test %q: %w", tc.Name, err)
+	}
+	if failure != nil {
+		return fmt.Errorf("verify test %q failed: %w", tc.Name, failure)
+	}
+	return nil
+}
+
+// job returns the Job running a test. Images built by Skaffold are replaced with their tags.
+func (v *jobVerifier) job(tc *latest_v1.VerifyTestCase, builds []graph.Artifact) *batchv1.Job {
+	labels := map[string]string{}
+	for k, val := range v.labels {
+		labels[k] = val
+	}
+	labels[TestLabel] = tc.Name
+
+	var env []v1.EnvVar
+	for name, value := range tc.Container.Env {
+		env = append(env, v1.EnvVar{Name: name, Value: value})
+	}
+	sort.Slice(env, func(i, j int) bool { return env[i].Name < env[j].Name })
+
+	backoffLimit := int32(0)
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: tc.Name + "-",
+			Labels:       labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: v1.PodSpec{
+					RestartPolicy: v1.RestartPolicyNever,
+					Containers: []v1.Container{{
+						Name:    tc.Name,
+						Image:   resolveImage(tc.Container.Image, builds),
+						Command: tc.Container.Command,
+						Args:    tc.Container.Args,
+						Env:     env,
+					}},
+				},
+			},
+		},
+	}
+}
+
+// waitForStart waits for the container of a Job to start, and returns its pod.
+// It returns a nil pod if the Job completes before a pod is found.
+func waitForStart(ctx context.Context, client kubernetes.Interface, namespace, jobName string) (*v1.Pod, error) {
+	var pod *v1.Pod
+	err := wait.PollImmediateUntil(pollInterval, func() (bool, error) {
+		job, err := client.BatchV1().Jobs(namespace).Get(ctx, jobName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if done, _ := jobStatus(job); done {
+			return true, nil
+		}
+
+		pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", jobNameLabel, jobName),
+		})
+		if err != nil {
+			return false, err
+		}
+		for i := range pods.Items {
+			p := &pods.Items[i]
+			if p.Status.Phase != v1.PodPending {
+				pod = p
+				return true, nil
+			}
+			if err := containerError(p); err != nil {
+				return false, err
+			}
+		}
+		return false, nil
+	}, ctx.Done())
+	return pod, err
+}
+
+// containerError detects containers that can't start.
+func containerError(pod *v1.Pod) error {
+	for _, c := range pod.Status.ContainerStatuses {
+		if c.State.Waiting == nil {
+			continue
+		}
+		switch c.State.Waiting.Reason {
+		case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CreateContainerConfigError":
+			return fmt.Errorf("container can't start: %s: %s", c.State.Waiting.Reason, c.State.Waiting.Message)
+		}
+	}
+	return nil
+}
+
+// jobStatus returns whether a Job is done and, if it failed, why.
+func jobStatus(job *batchv1.Job) (bool, error) {
+	for _, c := range job.Status.Conditions {
+		if c.Status != v1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			return true, nil
+		case batchv1.JobFailed:
+			return true, fmt.Errorf("%s: %s", c.Reason, c.Message)
+		}
+	}
+
+	switch {
+	case job.Status.Succeeded > 0:
+		return true, nil
+	case job.Status.Failed > 0:
+		return true, fmt.Errorf("container exited with a non-zero code")
+	default:
+		return false, nil
+	}
+}
+
+// streamLogs prints the logs of a test container, until it terminates.
+func streamLogs(ctx context.Context, out io.Writer, client kubernetes.Interface, pod *v1.Pod, container string) {
+	rc, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &v1.PodLogOptions{
+		Container: container,
+		Follow:    true,
+	}).Stream(ctx)
+	if err != nil {
+		logrus.Warnf("unable to get logs of verify test %s: %v", container, err)
+		return
+	}
+	defer rc.Close()
+
+	w := textio.NewPrefixWriter(out, fmt.Sprintf("[%s] ", container))
+	if _, err := io.Copy(w, rc); err != nil && ctx.Err() == nil {
+		logrus.Debugf("streaming logs of verify test %s: %v", container, err)
+	}
+	w.Flush()
+}
+
+// resolveImage replaces an image with the tag of the artifact that builds it, if any.
+func resolveImage(image string, builds []graph.Artifact) string {
+	ref, err := docker.ParseReference(image)
+	if err != nil {
+		return image
+	}
+	for _, b := range builds {
+		if b.ImageName == ref.BaseName {
+			return b.Tag
+		}
+	}
+	return image
+}
+
+func resolveNamespace(ns string) (string, error) {
+	if ns != "" {
+		return ns, nil
+	}
+	cfg, err := kubectx.CurrentConfig()
+	if err != nil {
+		return "", fmt.Errorf("getting kubeconfig: %w", err)
+	}
+
+	current, present := cfg.Contexts[cfg.CurrentContext]
+	if present && current.Namespace != "" {
+		return current.Namespace, nil
+	}
+	return "default", nil
+}
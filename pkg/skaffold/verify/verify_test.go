@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latest_v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type mockConfig struct {
+	namespace string
+	tests     []*latest_v1.VerifyTestCase
+}
+
+func (c *mockConfig) GetKubeNamespace() string                     { return c.namespace }
+func (c *mockConfig) VerifyTestCases() []*latest_v1.VerifyTestCase { return c.tests }
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		description string
+		tests       []*latest_v1.VerifyTestCase
+		failing     map[string]bool
+		expectedRun []string
+		shouldErr   bool
+	}{
+		{
+			description: "no tests",
+		},
+		{
+			description: "all tests pass",
+			tests: []*latest_v1.VerifyTestCase{
+				{Name: "smoke", Container: latest_v1.VerifyContainer{Image: "app"}},
+				{Name: "integration", Container: latest_v1.VerifyContainer{Image: "tests"}},
+			},
+			expectedRun: []string{"smoke", "integration"},
+		},
+		{
+			description: "stop at first failure",
+			tests: []*latest_v1.VerifyTestCase{
+				{Name: "smoke", Container: latest_v1.VerifyContainer{Image: "app"}},
+				{Name: "integration", Container: latest_v1.VerifyContainer{Image: "tests"}},
+			},
+			failing:     map[string]bool{"smoke": true},
+			expectedRun: []string{"smoke"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&pollInterval, time.Millisecond)
+
+			var run []string
+			client := fakekubeclientset.NewSimpleClientset()
+			client.PrependReactor("create", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				job := action.(k8stesting.CreateAction).GetObject().(*batchv1.Job)
+				name := job.Labels[TestLabel]
+				run = append(run, name)
+
+				job.Name = job.GenerateName + "abcde"
+				if test.failing[name] {
+					job.Status.Failed = 1
+				} else {
+					job.Status.Succeeded = 1
+				}
+				return false, nil, nil
+			})
+			t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+
+			verifier := NewVerifier(&mockConfig{namespace: "ns", tests: test.tests}, map[string]string{"run-id": "1234"})
+			err := verifier.Verify(context.Background(), &bytes.Buffer{}, nil)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedRun, run)
+
+			jobs, err := client.BatchV1().Jobs("ns").List(context.Background(), metav1.ListOptions{})
+			t.CheckNoError(err)
+			t.CheckEmpty(jobs.Items)
+		})
+	}
+}
+
+func TestJob(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		verifier := &jobVerifier{labels: map[string]string{"run-id": "1234"}}
+		tc := &latest_v1.VerifyTestCase{
+			Name: "integration",
+			Container: latest_v1.VerifyContainer{
+				Image:   "gcr.io/project/tests",
+				Command: []string{"go"},
+				Args:    []string{"test", "./..."},
+				Env:     map[string]string{"URL": "http://app", "LEVEL": "debug"},
+			},
+		}
+		builds := []graph.Artifact{{ImageName: "gcr.io/project/tests", Tag: "gcr.io/project/tests:v1"}}
+
+		job := verifier.job(tc, builds)
+
+		labels := map[string]string{"run-id": "1234", TestLabel: "integration"}
+		t.CheckDeepEqual("integration-", job.GenerateName)
+		t.CheckDeepEqual(labels, job.Labels)
+		t.CheckDeepEqual(labels, job.Spec.Template.Labels)
+		t.CheckDeepEqual(int32(0), *job.Spec.BackoffLimit)
+		t.CheckDeepEqual(v1.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+		t.CheckDeepEqual([]v1.Container{{
+			Name:    "integration",
+			Image:   "gcr.io/project/tests:v1",
+			Command: []string{"go"},
+			Args:    []string{"test", "./..."},
+			Env:     []v1.EnvVar{{Name: "LEVEL", Value: "debug"}, {Name: "URL", Value: "http://app"}},
+		}}, job.Spec.Template.Spec.Containers)
+	})
+}
+
+func TestJobStatus(t *testing.T) {
+	tests := []struct {
+		description  string
+		status       batchv1.JobStatus
+		expectedDone bool
+		shouldErr    bool
+	}{
+		{
+			description: "running",
+			status:      batchv1.JobStatus{Active: 1},
+		},
+		{
+			description:  "complete",
+			status:       batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: v1.ConditionTrue}}},
+			expectedDone: true,
+		},
+		{
+			description:  "failed condition",
+			status:       batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Reason: "BackoffLimitExceeded"}}},
+			expectedDone: true,
+			shouldErr:    true,
+		},
+		{
+			description:  "failed pod",
+			status:       batchv1.JobStatus{Failed: 1},
+			expectedDone: true,
+			shouldErr:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			done, err := jobStatus(&batchv1.Job{Status: test.status})
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedDone, done)
+		})
+	}
+}
@@ -38,6 +38,8 @@ const (
 	BuilderType_KANIKO BuilderType = 5
 	// Docker Builder
 	BuilderType_DOCKER BuilderType = 6
+	// Ko Builder
+	BuilderType_KO BuilderType = 7
 )
 
 var BuilderType_name = map[int32]string{
@@ -48,6 +50,7 @@ var BuilderType_name = map[int32]string{
 	4: "CUSTOM",
 	5: "KANIKO",
 	6: "DOCKER",
+	7: "KO",
 }
 
 var BuilderType_value = map[string]int32{
@@ -58,6 +61,7 @@ var BuilderType_value = map[string]int32{
 	"CUSTOM":               4,
 	"KANIKO":               5,
 	"DOCKER":               6,
+	"KO":                   7,
 }
 
 func (x BuilderType) String() string {
@@ -334,6 +338,12 @@ const (
 	StatusCode_STATUSCHECK_CONTAINER_TERMINATED StatusCode = 303
 	// Deployment waiting for rollout
 	StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING StatusCode = 304
+	// Standalone pods pending to stabilize
+	StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING StatusCode = 305
+	// Job waiting for completion
+	StatusCode_STATUSCHECK_JOB_PENDING StatusCode = 306
+	// Job failed to complete
+	StatusCode_STATUSCHECK_JOB_FAILED StatusCode = 307
 	// Container restarting error
 	StatusCode_STATUSCHECK_CONTAINER_RESTARTING StatusCode = 356
 	// Readiness probe failed
@@ -477,6 +487,10 @@ const (
 	StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE StatusCode = 1022
 	// Kubernetes cluster reported an internal system error
 	StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR StatusCode = 1023
+	// Server-side apply failed because fields are owned by another field manager
+	StatusCode_DEPLOY_SERVER_SIDE_APPLY_CONFLICT_ERR StatusCode = 1024
+	// A deployed resource no longer matches the applied manifests
+	StatusCode_DEPLOY_DRIFT_DETECTED StatusCode = 1025
 	// Error expanding paths
 	StatusCode_TEST_USER_CONFIG_ERR StatusCode = 1101
 	// Error running container-structure-test
@@ -525,6 +539,14 @@ const (
 	StatusCode_CONFIG_FILE_PATHS_SUBSTITUTION_ERR StatusCode = 1210
 	// Same config imported at least twice with different set of profiles
 	StatusCode_CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR StatusCode = 1211
+	// Lifecycle hook command exited with a non-zero exit code
+	StatusCode_HOOK_NON_ZERO_EXIT_ERR StatusCode = 1301
+	// Lifecycle hook command could not be started
+	StatusCode_HOOK_RUN_ERR StatusCode = 1302
+	// No running container matched the lifecycle hook definition
+	StatusCode_HOOK_CONTAINER_NOT_FOUND_ERR StatusCode = 1303
+	// Lifecycle hook cancelled
+	StatusCode_HOOK_CANCELLED StatusCode = 1304
 )
 
 var StatusCode_name = map[int32]string{
@@ -564,6 +586,9 @@ var StatusCode_name = map[int32]string{
 	302:  "STATUSCHECK_RUN_CONTAINER_ERR",
 	303:  "STATUSCHECK_CONTAINER_TERMINATED",
 	304:  "STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING",
+	305:  "STATUSCHECK_STANDALONE_PODS_PENDING",
+	306:  "STATUSCHECK_JOB_PENDING",
+	307:  "STATUSCHECK_JOB_FAILED",
 	356:  "STATUSCHECK_CONTAINER_RESTARTING",
 	357:  "STATUSCHECK_UNHEALTHY",
 	400:  "STATUSCHECK_NODE_MEMORY_PRESSURE",
@@ -636,6 +661,8 @@ var StatusCode_name = map[int32]string{
 	1021: "DEPLOY_PARSE_MANIFEST_IMAGES_ERR",
 	1022: "DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE",
 	1023: "DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR",
+	1024: "DEPLOY_SERVER_SIDE_APPLY_CONFLICT_ERR",
+	1025: "DEPLOY_DRIFT_DETECTED",
 	1101: "TEST_USER_CONFIG_ERR",
 	1102: "TEST_CST_USER_ERR",
 	1103: "TEST_IMG_PULL_ERR",
@@ -660,6 +687,10 @@ var StatusCode_name = map[int32]string{
 	1209: "CONFIG_DEFAULT_VALUES_ERR",
 	1210: "CONFIG_FILE_PATHS_SUBSTITUTION_ERR",
 	1211: "CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR",
+	1301: "HOOK_NON_ZERO_EXIT_ERR",
+	1302: "HOOK_RUN_ERR",
+	1303: "HOOK_CONTAINER_NOT_FOUND_ERR",
+	1304: "HOOK_CANCELLED",
 }
 
 var StatusCode_value = map[string]int32{
@@ -699,6 +730,9 @@ var StatusCode_value = map[string]int32{
 	"STATUSCHECK_RUN_CONTAINER_ERR":                302,
 	"STATUSCHECK_CONTAINER_TERMINATED":             303,
 	"STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING":       304,
+	"STATUSCHECK_STANDALONE_PODS_PENDING":          305,
+	"STATUSCHECK_JOB_PENDING":                      306,
+	"STATUSCHECK_JOB_FAILED":                       307,
 	"STATUSCHECK_CONTAINER_RESTARTING":             356,
 	"STATUSCHECK_UNHEALTHY":                        357,
 	"STATUSCHECK_NODE_MEMORY_PRESSURE":             400,
@@ -771,6 +805,8 @@ var StatusCode_value = map[string]int32{
 	"DEPLOY_PARSE_MANIFEST_IMAGES_ERR":             1021,
 	"DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE":          1022,
 	"DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR":           1023,
+	"DEPLOY_SERVER_SIDE_APPLY_CONFLICT_ERR":        1024,
+	"DEPLOY_DRIFT_DETECTED":                        1025,
 	"TEST_USER_CONFIG_ERR":                         1101,
 	"TEST_CST_USER_ERR":                            1102,
 	"TEST_IMG_PULL_ERR":                            1103,
@@ -795,6 +831,10 @@ var StatusCode_value = map[string]int32{
 	"CONFIG_DEFAULT_VALUES_ERR":                    1209,
 	"CONFIG_FILE_PATHS_SUBSTITUTION_ERR":           1210,
 	"CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR":     1211,
+	"HOOK_NON_ZERO_EXIT_ERR":                       1301,
+	"HOOK_RUN_ERR":                                 1302,
+	"HOOK_CONTAINER_NOT_FOUND_ERR":                 1303,
+	"HOOK_CANCELLED":                               1304,
 }
 
 func (x StatusCode) String() string {
@@ -858,6 +898,12 @@ const (
 	SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE SuggestionCode = 207
 	// Install kubectl tool
 	SuggestionCode_INSTALL_KUBECTL SuggestionCode = 220
+	// Set `deploy.kubectl.serverSide.forceConflicts` to true to take ownership of conflicting fields.
+	SuggestionCode_FIX_SKAFFOLD_CONFIG_KUBECTL_FORCE_CONFLICTS SuggestionCode = 221
+	// Remove fields owned by another field manager from the manifests.
+	SuggestionCode_REMOVE_CONFLICTING_FIELDS SuggestionCode = 222
+	// Re-apply the manifests with a new deployment.
+	SuggestionCode_REAPPLY_MANIFESTS SuggestionCode = 223
 	// Container run error
 	SuggestionCode_CHECK_CONTAINER_LOGS SuggestionCode = 301
 	// Pod Health check error
@@ -910,6 +956,10 @@ const (
 	SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD   SuggestionCode = 1002
 	SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS SuggestionCode = 1003
 	SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME       SuggestionCode = 1004
+	// Check the failing lifecycle hook command and its output
+	SuggestionCode_CHECK_HOOK_COMMAND SuggestionCode = 1100
+	// Check that the container targeted by the lifecycle hook is running
+	SuggestionCode_CHECK_HOOK_CONTAINER SuggestionCode = 1101
 )
 
 var SuggestionCode_name = map[int32]string{
@@ -937,6 +987,9 @@ var SuggestionCode_name = map[int32]string{
 	206:  "UPGRADE_HELM32",
 	207:  "FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE",
 	220:  "INSTALL_KUBECTL",
+	221:  "FIX_SKAFFOLD_CONFIG_KUBECTL_FORCE_CONFLICTS",
+	222:  "REMOVE_CONFLICTING_FIELDS",
+	223:  "REAPPLY_MANIFESTS",
 	301:  "CHECK_CONTAINER_LOGS",
 	302:  "CHECK_READINESS_PROBE",
 	303:  "CHECK_CONTAINER_IMAGE",
@@ -965,6 +1018,8 @@ var SuggestionCode_name = map[int32]string{
 	1002: "CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD",
 	1003: "CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS",
 	1004: "CHECK_TEST_COMMAND_AND_IMAGE_NAME",
+	1100: "CHECK_HOOK_COMMAND",
+	1101: "CHECK_HOOK_CONTAINER",
 }
 
 var SuggestionCode_value = map[string]int32{
@@ -992,6 +1047,9 @@ var SuggestionCode_value = map[string]int32{
 	"UPGRADE_HELM32":                                         206,
 	"FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE":              207,
 	"INSTALL_KUBECTL":                                        220,
+	"FIX_SKAFFOLD_CONFIG_KUBECTL_FORCE_CONFLICTS":            221,
+	"REMOVE_CONFLICTING_FIELDS":                              222,
+	"REAPPLY_MANIFESTS":                                      223,
 	"CHECK_CONTAINER_LOGS":                                   301,
 	"CHECK_READINESS_PROBE":                                  302,
 	"CHECK_CONTAINER_IMAGE":                                  303,
@@ -1020,6 +1078,8 @@ var SuggestionCode_value = map[string]int32{
 	"CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD":                  1002,
 	"CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS":                1003,
 	"CHECK_TEST_COMMAND_AND_IMAGE_NAME":                      1004,
+	"CHECK_HOOK_COMMAND":                                     1100,
+	"CHECK_HOOK_CONTAINER":                                   1101,
 }
 
 func (x SuggestionCode) String() string {
@@ -1044,175 +1104,185 @@ func init() {
 func init() { proto.RegisterFile("enums.proto", fileDescriptor_888b6bd9597961ff) }
 
 var fileDescriptor_888b6bd9597961ff = []byte{
-	// 2713 bytes of a gzipped FileDescriptorProto
+	// 2879 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x59, 0x57, 0x90, 0x5c, 0xc5,
-	0xd5, 0x66, 0x76, 0x76, 0x77, 0x66, 0x5b, 0x02, 0x9a, 0x46, 0x12, 0xab, 0x1c, 0x40, 0x02, 0x16,
-	0x7e, 0x89, 0xff, 0xe7, 0x2f, 0x1e, 0xfc, 0xd6, 0x73, 0xef, 0x99, 0x99, 0xd6, 0xdc, 0xdb, 0xf7,
-	0x56, 0x77, 0xdf, 0x5d, 0xad, 0x5e, 0x6e, 0x81, 0x35, 0x5a, 0x04, 0x2b, 0x8d, 0xd0, 0xee, 0x62,
-	0xe3, 0x54, 0x7e, 0x70, 0x0e, 0x55, 0xc6, 0x39, 0x3d, 0x38, 0x96, 0x5f, 0x9c, 0x73, 0x36, 0x94,
-	0x5f, 0x8c, 0x31, 0xe0, 0x6c, 0x70, 0xf9, 0xd1, 0xae, 0x72, 0xc0, 0xa1, 0x30, 0x39, 0xba, 0x4e,
-	0xf7, 0x4d, 0x13, 0x16, 0x3f, 0x50, 0x8c, 0xfa, 0xfb, 0xfa, 0xf4, 0x39, 0xa7, 0x4f, 0x9f, 0x70,
-	0x97, 0x6c, 0xe9, 0x9f, 0xdb, 0x38, 0xbb, 0x76, 0xf4, 0xfc, 0x85, 0xc1, 0xfa, 0x80, 0x6d, 0xb1,
-	0xff, 0x3b, 0x6a, 0x97, 0x16, 0x06, 0x64, 0x4b, 0x6b, 0xe3, 0xcc, 0xea, 0xa9, 0xfe, 0x05, 0x73,
-	0xd7, 0xf9, 0x3e, 0x9b, 0x27, 0xdb, 0x12, 0xd9, 0x93, 0xd1, 0x92, 0x4c, 0x5b, 0x89, 0x08, 0x7c,
-	0x50, 0xa9, 0x59, 0x8e, 0x81, 0x5e, 0xc4, 0x1a, 0xa4, 0x7e, 0x5c, 0xb4, 0x68, 0x8d, 0xcd, 0x91,
-	0x99, 0x16, 0x3f, 0x09, 0x01, 0x9d, 0x62, 0x97, 0x10, 0x62, 0x59, 0x31, 0xf7, 0x7a, 0x9a, 0xd6,
-	0x19, 0x21, 0xb3, 0x5e, 0xa2, 0x4d, 0x14, 0xd2, 0x69, 0xfc, 0xdd, 0xe3, 0x52, 0xf4, 0x22, 0x3a,
-	0x83, 0xbf, 0xfd, 0xc8, 0xeb, 0x81, 0xa2, 0xb3, 0x0b, 0x3e, 0x99, 0xb3, 0x07, 0xda, 0xe3, 0x76,
-	0x10, 0x36, 0x74, 0x5c, 0x7e, 0xd8, 0x16, 0xd2, 0xf0, 0x82, 0x44, 0x1b, 0x50, 0xb4, 0x86, 0x27,
-	0x77, 0xbc, 0x16, 0x9d, 0xc2, 0x93, 0x83, 0xc8, 0xe3, 0x01, 0xad, 0x2f, 0xf4, 0x08, 0x31, 0xfd,
-	0xb5, 0xf5, 0x4c, 0xeb, 0xed, 0xe4, 0xb2, 0x5c, 0x8c, 0x01, 0x6d, 0x72, 0x29, 0x4d, 0x32, 0x9d,
-	0x48, 0x61, 0x68, 0x8d, 0xed, 0x21, 0xf3, 0x5e, 0x24, 0x0d, 0x17, 0x12, 0x54, 0xaa, 0x8d, 0x4a,
-	0x3c, 0x93, 0x28, 0xb0, 0x64, 0x3a, 0xb5, 0x10, 0x91, 0xad, 0x7e, 0xff, 0xfc, 0xea, 0xe0, 0xae,
-	0x4c, 0xdc, 0x4e, 0xb2, 0x3d, 0x17, 0xe7, 0x43, 0x1c, 0x44, 0xcb, 0xa5, 0x17, 0x9a, 0x64, 0xba,
-	0x0b, 0x41, 0x48, 0x6b, 0xec, 0x62, 0x32, 0xd7, 0xb3, 0xb6, 0x8a, 0x93, 0x40, 0xa7, 0x50, 0xe3,
-	0x5e, 0xd2, 0x02, 0xcf, 0xa0, 0x76, 0x82, 0x6c, 0xf1, 0x56, 0x37, 0x0a, 0xf5, 0x2a, 0x4e, 0xcd,
-	0xac, 0xca, 0xc5, 0x6d, 0x25, 0xcd, 0x50, 0x48, 0x81, 0x3b, 0x33, 0x43, 0x7b, 0xe0, 0x0c, 0x8d,
-	0x4c, 0x17, 0x14, 0xad, 0x2f, 0x1c, 0x27, 0xcd, 0x60, 0xb0, 0x12, 0xf4, 0xef, 0xec, 0xaf, 0xe2,
-	0xb2, 0x0f, 0xad, 0xa4, 0xe3, 0xf4, 0x10, 0xb2, 0x1d, 0xd1, 0x1a, 0xfe, 0x5a, 0xe2, 0x4a, 0xba,
-	0x5d, 0xa0, 0x54, 0xa4, 0x68, 0x1d, 0x7f, 0xb6, 0xb9, 0xe1, 0x01, 0x9d, 0xc6, 0x9f, 0x31, 0x97,
-	0xc2, 0xa3, 0x33, 0x0b, 0x77, 0x1f, 0x22, 0x44, 0xaf, 0xdf, 0xbc, 0xbe, 0xb1, 0xe6, 0x0d, 0x4e,
-	0xf5, 0xd9, 0x2c, 0x99, 0x8a, 0x7a, 0xf4, 0x22, 0x36, 0x4f, 0x2e, 0xd7, 0x86, 0x9b, 0x44, 0x7b,
-	0x5d, 0xf0, 0x7a, 0xa9, 0x4e, 0x3c, 0x0f, 0xb4, 0xa6, 0x3f, 0xa9, 0x31, 0x46, 0x2e, 0x76, 0xd7,
-	0x92, 0xaf, 0xdd, 0x57, 0x63, 0x97, 0x93, 0x4b, 0x9c, 0x53, 0x8a, 0xc5, 0x9f, 0xd6, 0xd8, 0x65,
-	0x64, 0xab, 0x75, 0x7c, 0xbe, 0x74, 0xbf, 0x75, 0xb9, 0xdb, 0x1b, 0x27, 0xba, 0x9b, 0x72, 0xbb,
-	0x9e, 0xfa, 0x20, 0x05, 0xf8, 0xb4, 0xcf, 0x76, 0x93, 0x2b, 0x32, 0x54, 0x45, 0xc7, 0xc1, 0x33,
-	0xa9, 0x8c, 0x4c, 0xda, 0x8e, 0x12, 0xe9, 0xd3, 0xd3, 0xec, 0x4a, 0xb2, 0xdf, 0x81, 0x2e, 0x68,
-	0x52, 0x9f, 0x43, 0x18, 0x49, 0x4b, 0x51, 0x89, 0x94, 0x42, 0x76, 0xe8, 0x0a, 0xdb, 0x46, 0xa8,
-	0x23, 0x25, 0x1a, 0x54, 0xea, 0x0c, 0xbf, 0xb5, 0x3c, 0x35, 0xdb, 0x9a, 0x48, 0xbe, 0xc8, 0x45,
-	0xc0, 0x5b, 0x01, 0xd0, 0x33, 0x6c, 0x2f, 0xd9, 0x39, 0x8a, 0x26, 0xa6, 0x1b, 0x29, 0x71, 0x12,
-	0x7c, 0x7a, 0x5b, 0xa9, 0x54, 0x06, 0xeb, 0x65, 0x6d, 0x20, 0x44, 0xd9, 0xf4, 0x76, 0x76, 0x90,
-	0xec, 0x1d, 0x02, 0x51, 0x9b, 0x30, 0xf2, 0x45, 0x5b, 0x80, 0x6f, 0x29, 0xab, 0xec, 0x2a, 0x72,
-	0x60, 0x8c, 0x22, 0xc2, 0x38, 0x80, 0x10, 0xa4, 0xc9, 0x58, 0x67, 0xd9, 0x3e, 0xb2, 0x6b, 0xc4,
-	0x3a, 0xc3, 0xd3, 0x20, 0xd2, 0xda, 0xe2, 0xe7, 0xc6, 0xf0, 0x76, 0xa4, 0x5a, 0xc2, 0xf7, 0x41,
-	0x5a, 0x7c, 0x30, 0x66, 0x84, 0x17, 0xc9, 0x76, 0x20, 0x3c, 0x63, 0xe1, 0xf3, 0xec, 0x00, 0xd9,
-	0x33, 0x04, 0x5b, 0xcf, 0x54, 0xdc, 0x7b, 0x07, 0x3b, 0x44, 0xf6, 0x0d, 0x31, 0x84, 0x5c, 0xe4,
-	0x81, 0xf0, 0xd3, 0x98, 0x2b, 0xee, 0xac, 0xbd, 0x30, 0xaa, 0x44, 0x5b, 0x04, 0x50, 0x91, 0xb1,
-	0x36, 0x66, 0xaa, 0xc7, 0xbd, 0x2e, 0xa4, 0x6d, 0x15, 0x85, 0x69, 0x9c, 0x04, 0x81, 0x95, 0xb2,
-	0xce, 0xf6, 0x93, 0xdd, 0x43, 0xac, 0x0e, 0x98, 0xd4, 0x17, 0x1d, 0x8c, 0x14, 0x24, 0x6c, 0x94,
-	0x4e, 0x55, 0xd0, 0x11, 0xda, 0xa8, 0xe5, 0x51, 0xca, 0x9d, 0x25, 0x25, 0x7f, 0x42, 0xc7, 0x45,
-	0x2b, 0x8d, 0x83, 0xa4, 0x23, 0xa4, 0x7b, 0x45, 0xaf, 0x2a, 0x2f, 0x1d, 0xa1, 0x8e, 0xe2, 0x7e,
-	0x00, 0xf8, 0x70, 0xad, 0x80, 0x57, 0x97, 0xb7, 0x8a, 0x68, 0xc8, 0x17, 0x41, 0x16, 0xe0, 0x5d,
-	0x6c, 0x81, 0x1c, 0x11, 0x52, 0x98, 0xe2, 0xc6, 0xc0, 0x2c, 0x45, 0xaa, 0x97, 0x06, 0x42, 0x1b,
-	0x21, 0x3b, 0x69, 0x91, 0x34, 0x34, 0x7d, 0x0d, 0x3b, 0x4a, 0x16, 0x26, 0x71, 0x73, 0xf7, 0x95,
-	0x09, 0x46, 0xf2, 0x10, 0xe8, 0x6b, 0xd9, 0x0d, 0xe4, 0xfa, 0x49, 0xfc, 0x92, 0xe7, 0x47, 0xa0,
-	0xad, 0x57, 0xe1, 0x84, 0xd0, 0x86, 0xbe, 0x0e, 0xbd, 0xfa, 0x72, 0x27, 0x84, 0x91, 0x0f, 0xf4,
-	0xf5, 0xe8, 0x91, 0x49, 0xac, 0x98, 0x2b, 0x0d, 0xd6, 0xac, 0x37, 0xb0, 0xfd, 0x64, 0x57, 0xf5,
-	0x49, 0x8b, 0x90, 0x77, 0xa0, 0xbc, 0x98, 0xcf, 0x4f, 0xb1, 0x2b, 0xc9, 0xbe, 0x2a, 0xa1, 0xd4,
-	0xc9, 0x53, 0xc0, 0xd1, 0x74, 0xfa, 0x85, 0x29, 0x76, 0x88, 0xec, 0xad, 0x92, 0x54, 0x22, 0x2b,
-	0x44, 0x14, 0xf4, 0xc5, 0x29, 0x76, 0x98, 0x1c, 0x98, 0x2c, 0xc8, 0x80, 0x0a, 0x85, 0xe4, 0x06,
-	0x7c, 0xfa, 0xa5, 0x29, 0x76, 0x1d, 0x39, 0x52, 0xa5, 0xb9, 0x0c, 0x82, 0xcf, 0x22, 0x55, 0x51,
-	0x10, 0x44, 0x89, 0x49, 0x63, 0x90, 0x3e, 0x9e, 0xfb, 0xe5, 0x97, 0x91, 0xa9, 0x40, 0x1b, 0xae,
-	0xac, 0x7a, 0x7f, 0x9c, 0x62, 0xbb, 0xc8, 0xf6, 0x2a, 0x2d, 0x91, 0x5d, 0xe0, 0x81, 0xe9, 0x2e,
-	0xd3, 0x3f, 0x8d, 0x89, 0x90, 0x91, 0x0f, 0x69, 0x08, 0x61, 0xa4, 0x96, 0xd3, 0x58, 0x81, 0xd6,
-	0x89, 0x02, 0xfa, 0x9e, 0xfa, 0xa8, 0x1b, 0x2c, 0xcd, 0x17, 0xba, 0x57, 0x92, 0xee, 0xae, 0xb3,
-	0x6b, 0xc9, 0x55, 0x63, 0xa4, 0xdc, 0xe9, 0xd5, 0xfc, 0xf2, 0xde, 0xfa, 0xa8, 0xc7, 0x2c, 0x35,
-	0xc6, 0xa7, 0x95, 0x8b, 0x7b, 0xdf, 0xe4, 0x33, 0x13, 0x89, 0xff, 0xf2, 0x13, 0x27, 0xe8, 0xfd,
-	0x75, 0x76, 0x90, 0xec, 0x99, 0x40, 0x52, 0xc0, 0xbd, 0xae, 0xa5, 0x7c, 0xa0, 0x3e, 0x7a, 0xc7,
-	0x4e, 0x2d, 0x4c, 0x91, 0xc0, 0xfd, 0x65, 0xfa, 0xc1, 0x31, 0x65, 0xda, 0x5c, 0x04, 0xe0, 0xa7,
-	0xd9, 0x41, 0xe8, 0xc3, 0x0f, 0xd5, 0xd9, 0xd5, 0xe4, 0x50, 0x95, 0x93, 0x95, 0x30, 0x74, 0xb9,
-	0x04, 0xcf, 0x88, 0xc8, 0x25, 0x9d, 0x8f, 0x8c, 0x69, 0x9d, 0x13, 0xd1, 0xb8, 0x9e, 0x08, 0x02,
-	0xf0, 0xe9, 0x47, 0xc7, 0x3c, 0x55, 0x48, 0x0b, 0x04, 0xde, 0x74, 0x1b, 0x8c, 0xd7, 0xb5, 0xf2,
-	0x3e, 0x56, 0x1f, 0xbd, 0xa0, 0x4a, 0x40, 0x94, 0xb4, 0x8f, 0x8f, 0xf9, 0x21, 0x8e, 0xfc, 0x14,
-	0x63, 0x5f, 0xf0, 0x40, 0x9c, 0x44, 0x13, 0x7e, 0x5c, 0xc7, 0x22, 0x95, 0xa7, 0x06, 0x57, 0x05,
-	0x1e, 0xaf, 0x8f, 0x96, 0xb4, 0x0c, 0xa7, 0x4f, 0xd4, 0xd9, 0x11, 0x72, 0x70, 0x02, 0x32, 0x72,
-	0x01, 0x4f, 0xd6, 0xd9, 0x02, 0x39, 0x3c, 0x39, 0x06, 0x97, 0xb8, 0xb0, 0xa9, 0x21, 0x97, 0xf9,
-	0x54, 0x9d, 0xed, 0x23, 0x3b, 0x27, 0xc9, 0x84, 0x45, 0x90, 0x86, 0xbe, 0x50, 0xaf, 0x94, 0xcc,
-	0x7c, 0xd3, 0xd3, 0x75, 0x2c, 0x99, 0x7a, 0x59, 0x7a, 0xc5, 0xd2, 0x33, 0xf5, 0xb2, 0xdc, 0xe6,
-	0x6b, 0xcf, 0xd6, 0xd9, 0x36, 0x72, 0xa9, 0x0f, 0x8b, 0xf6, 0xbd, 0xe7, 0xab, 0xcf, 0xd9, 0x55,
-	0x2f, 0x00, 0x2e, 0x93, 0xb8, 0x58, 0x7d, 0xde, 0x8a, 0x1c, 0x22, 0xbe, 0x58, 0x67, 0x3b, 0xc9,
-	0xb6, 0x91, 0x8a, 0xe7, 0xa0, 0x97, 0xea, 0x45, 0xcd, 0xce, 0x97, 0xde, 0x38, 0x8d, 0x62, 0xad,
-	0x4e, 0x56, 0x8a, 0x73, 0xe6, 0x23, 0xd3, 0xec, 0x00, 0xd9, 0x9d, 0xab, 0xe0, 0xd2, 0x34, 0xa8,
-	0xac, 0x5b, 0xf3, 0x21, 0xd6, 0xf4, 0x07, 0x33, 0x18, 0x8a, 0x63, 0x0c, 0x2b, 0xdb, 0x12, 0x7e,
-	0x38, 0x83, 0xd7, 0x38, 0x46, 0xc8, 0x5c, 0x62, 0x29, 0x3f, 0x9a, 0x99, 0x78, 0x0a, 0x96, 0x36,
-	0xd1, 0x41, 0x0a, 0xbd, 0x67, 0x86, 0x5d, 0x45, 0xf6, 0x97, 0xae, 0xd0, 0x49, 0x1c, 0x47, 0x0a,
-	0xab, 0xea, 0xe2, 0xff, 0xa6, 0x21, 0x97, 0xa2, 0x8d, 0xbd, 0xdc, 0xbd, 0x33, 0xa3, 0xcf, 0xc2,
-	0x76, 0x07, 0x1e, 0x97, 0x1e, 0xd8, 0x20, 0xfd, 0xc4, 0xec, 0xe8, 0xb3, 0xf0, 0x81, 0xfb, 0x81,
-	0x90, 0x90, 0xc2, 0x09, 0x0f, 0xc0, 0x07, 0x9f, 0x7e, 0x72, 0x16, 0x1d, 0xe1, 0x2c, 0x2c, 0x77,
-	0x7e, 0x6a, 0x96, 0x6d, 0x27, 0x34, 0x53, 0xba, 0x5c, 0xfe, 0xf4, 0x2c, 0xdb, 0x4d, 0x76, 0x8c,
-	0xd4, 0xc2, 0x1c, 0xfc, 0xcc, 0x2c, 0x26, 0xa9, 0xe1, 0x6a, 0x9f, 0x1d, 0x47, 0x3f, 0x3b, 0xcb,
-	0xf6, 0x92, 0x79, 0x6b, 0x8d, 0xcd, 0xb9, 0x90, 0x1a, 0xde, 0xe9, 0x14, 0xad, 0xcc, 0x9b, 0x1b,
-	0x68, 0x89, 0x85, 0xf3, 0x0e, 0x31, 0x8d, 0x79, 0xa2, 0x5d, 0x1b, 0x11, 0x29, 0xfa, 0x96, 0x06,
-	0x3a, 0x64, 0x98, 0x50, 0xe9, 0x90, 0x32, 0xd6, 0x5b, 0x1b, 0x18, 0x9d, 0xd5, 0x53, 0xf2, 0xb6,
-	0xde, 0xe1, 0x6f, 0x2b, 0x8f, 0xc9, 0xf0, 0xa2, 0xe3, 0x75, 0x84, 0xb7, 0x8f, 0x11, 0xf2, 0x8b,
-	0xcd, 0x08, 0xef, 0x68, 0xa0, 0x5f, 0x1c, 0xc1, 0x36, 0x01, 0x6e, 0xf9, 0x9d, 0xa5, 0x7a, 0xd9,
-	0xbe, 0x25, 0x8e, 0xef, 0xda, 0x28, 0x51, 0xb1, 0xf2, 0x5d, 0x0d, 0x4c, 0x2c, 0x55, 0x16, 0xa6,
-	0xf7, 0x36, 0xf7, 0xaa, 0x27, 0xbc, 0xbb, 0x81, 0x77, 0x96, 0x7b, 0x3e, 0x6b, 0xa0, 0x47, 0x32,
-	0xd4, 0x5f, 0x1a, 0x98, 0x51, 0x8a, 0x90, 0x6a, 0x25, 0x9d, 0xb4, 0x0b, 0x41, 0x6c, 0x6b, 0x86,
-	0x51, 0x02, 0x16, 0x5d, 0x65, 0xfc, 0x6b, 0x83, 0x5d, 0x41, 0x58, 0x21, 0xca, 0xbd, 0x20, 0x04,
-	0xfe, 0xd6, 0xc0, 0xdb, 0xc8, 0x00, 0xec, 0xf0, 0x53, 0x1e, 0xc7, 0xc1, 0x72, 0x1a, 0xf0, 0x16,
-	0x04, 0x9a, 0x3e, 0xda, 0xc0, 0x97, 0x54, 0x85, 0xf3, 0xae, 0x93, 0xfe, 0xbd, 0xba, 0x53, 0x46,
-	0x69, 0x88, 0x66, 0xe2, 0x05, 0x58, 0x47, 0xd3, 0x7f, 0x34, 0xd8, 0x1e, 0x72, 0x45, 0x75, 0xe7,
-	0x22, 0x28, 0x9d, 0xab, 0xfd, 0xcf, 0x86, 0x8b, 0xfb, 0x12, 0x0d, 0x85, 0x1c, 0x62, 0xfc, 0xab,
-	0xe1, 0x5e, 0x97, 0x65, 0xe4, 0x09, 0xb5, 0x4a, 0xf8, 0x4d, 0xd3, 0x3d, 0x8c, 0x21, 0x42, 0xd4,
-	0x6e, 0xdb, 0x98, 0xc6, 0x8e, 0xc1, 0xb2, 0x1e, 0x6b, 0x54, 0x58, 0xa0, 0xca, 0x34, 0xd6, 0x8e,
-	0x30, 0x26, 0x03, 0x40, 0x4f, 0xd2, 0x7f, 0x57, 0x6d, 0xc1, 0x3a, 0x52, 0xbc, 0x2c, 0x2b, 0xe4,
-	0xf1, 0xaa, 0x10, 0x0b, 0x2b, 0x08, 0x23, 0x03, 0xc3, 0xac, 0x27, 0xaa, 0x42, 0xb0, 0x91, 0x1a,
-	0x86, 0x9f, 0xac, 0x3a, 0x24, 0xd7, 0xb7, 0xf0, 0xe6, 0x53, 0x36, 0x5e, 0x0b, 0x34, 0x9b, 0xaf,
-	0x4a, 0xfc, 0xe9, 0x61, 0x0d, 0xe3, 0x80, 0x7b, 0x90, 0xb5, 0x37, 0x08, 0x3f, 0x53, 0x0d, 0x15,
-	0xa3, 0xb8, 0xd4, 0xed, 0x48, 0x85, 0xc3, 0x0a, 0x3c, 0x5b, 0xbd, 0x4b, 0x0d, 0xc6, 0xdd, 0xb1,
-	0x85, 0x9e, 0xab, 0x9e, 0x5e, 0x6c, 0x5a, 0x52, 0xc2, 0x38, 0xf1, 0xcf, 0x57, 0xa3, 0xcc, 0xf5,
-	0x5b, 0x05, 0xcb, 0x2a, 0xe1, 0x7a, 0xf8, 0x17, 0x1a, 0xec, 0x1a, 0x72, 0x65, 0xf5, 0x56, 0xb3,
-	0xe0, 0x96, 0xae, 0xdd, 0x2b, 0x5b, 0x86, 0x17, 0x1b, 0x58, 0x81, 0x47, 0x42, 0x5b, 0x48, 0x03,
-	0x4a, 0xf2, 0xa0, 0x3a, 0x7f, 0xbc, 0x64, 0x95, 0x76, 0xf9, 0x5a, 0x97, 0x99, 0x11, 0xa1, 0x07,
-	0x9a, 0x6c, 0x07, 0xb9, 0xcc, 0x42, 0x5e, 0x0e, 0xe3, 0xfa, 0x83, 0xe5, 0xba, 0x08, 0x3b, 0x65,
-	0xf7, 0xf7, 0x50, 0x13, 0x8d, 0x74, 0x7c, 0xeb, 0xe0, 0xd4, 0x0b, 0xfd, 0x4a, 0xf7, 0xf8, 0xf3,
-	0x26, 0x16, 0xbf, 0x51, 0x1c, 0x9b, 0x3f, 0x19, 0xc9, 0xf4, 0x24, 0xa8, 0x08, 0xfb, 0x55, 0xe7,
-	0xcb, 0x5f, 0x34, 0xd1, 0x21, 0x93, 0xb8, 0x46, 0x84, 0xe0, 0x63, 0x5f, 0x87, 0xb4, 0x5f, 0x36,
-	0xb1, 0xee, 0x4e, 0xa2, 0x15, 0xb9, 0xd2, 0xf2, 0x7e, 0xb5, 0x29, 0x0f, 0x4e, 0x80, 0x97, 0x14,
-	0xaf, 0xfd, 0xd7, 0x4d, 0x4c, 0x1b, 0x93, 0x79, 0x22, 0x9f, 0xb4, 0x7e, 0xdb, 0xc4, 0x40, 0x9b,
-	0x48, 0x52, 0x8a, 0xfe, 0x6e, 0x4c, 0x73, 0x1f, 0xb0, 0x05, 0x05, 0xe9, 0x09, 0xd0, 0x96, 0x8a,
-	0xb4, 0x87, 0x9b, 0xec, 0x7a, 0x72, 0xf5, 0xa6, 0xb4, 0x44, 0x86, 0x5c, 0xe9, 0x2e, 0xcf, 0x5c,
-	0xfb, 0x48, 0x13, 0x2b, 0xdd, 0xd8, 0x91, 0xd5, 0x0c, 0xf4, 0x7b, 0xab, 0x55, 0x76, 0x7d, 0x76,
-	0xac, 0x42, 0xcf, 0x67, 0x09, 0x9b, 0x7e, 0x65, 0x0e, 0xef, 0xa6, 0x8a, 0x16, 0x43, 0x97, 0xc5,
-	0xbf, 0x3a, 0x87, 0x2f, 0xb0, 0x2c, 0x8b, 0x4e, 0x93, 0xe5, 0x11, 0xd6, 0xd7, 0xe6, 0xb0, 0x13,
-	0xcb, 0x59, 0x49, 0x1c, 0x08, 0xcf, 0x46, 0x1f, 0x0f, 0x41, 0xa7, 0x9a, 0x87, 0xe0, 0x44, 0x23,
-	0xf5, 0xeb, 0x73, 0x68, 0xdf, 0x26, 0x54, 0xee, 0x29, 0x1c, 0x4b, 0x91, 0xec, 0x02, 0xfb, 0x1b,
-	0x73, 0x58, 0xcf, 0x32, 0x76, 0x8b, 0xfb, 0x08, 0x99, 0x2c, 0xdc, 0xbe, 0x59, 0xc5, 0x6c, 0x94,
-	0x94, 0x0a, 0x7d, 0xab, 0x6a, 0x96, 0x4b, 0xac, 0xb1, 0x8a, 0x4a, 0xb9, 0xdf, 0xae, 0xe2, 0x3e,
-	0xb4, 0x79, 0x12, 0x98, 0x74, 0x91, 0x07, 0x49, 0x86, 0x7f, 0x67, 0x0e, 0x9f, 0xc9, 0xb0, 0xd3,
-	0x4c, 0x57, 0xa7, 0x3a, 0x69, 0x69, 0x23, 0x4c, 0x19, 0x18, 0xdf, 0x9d, 0x63, 0xff, 0x43, 0xae,
-	0xc9, 0x88, 0x61, 0x12, 0x18, 0x81, 0x03, 0x78, 0xa4, 0x4c, 0x7e, 0xde, 0xf0, 0xb4, 0xfc, 0xbd,
-	0xb9, 0x85, 0xc7, 0xb6, 0x92, 0x4b, 0xf4, 0xc6, 0xca, 0x4a, 0x7f, 0x6d, 0xfd, 0xcc, 0xe0, 0x9c,
-	0xfd, 0x2e, 0xd2, 0x20, 0x75, 0x29, 0x02, 0x7a, 0x11, 0xdb, 0x46, 0x28, 0xf7, 0xfd, 0x42, 0x21,
-	0x05, 0x71, 0x44, 0x4f, 0xb1, 0x1d, 0x84, 0xe5, 0x9d, 0x43, 0x65, 0xbd, 0x8f, 0xc3, 0xdb, 0xf8,
-	0x7a, 0xda, 0x09, 0xa2, 0x16, 0x0f, 0xb2, 0xf7, 0x4a, 0x4f, 0xe3, 0x78, 0xde, 0xf1, 0x82, 0x28,
-	0x29, 0x1a, 0x02, 0x9e, 0x98, 0x6e, 0x06, 0xe3, 0x80, 0xb0, 0xc2, 0x76, 0x92, 0xed, 0x93, 0xa1,
-	0x5b, 0xd9, 0x3c, 0xd9, 0xe6, 0x8e, 0xc8, 0x44, 0x64, 0x1f, 0x4f, 0xe8, 0x99, 0x12, 0xc9, 0xb6,
-	0xe6, 0xdf, 0x49, 0x6e, 0x43, 0x75, 0xdb, 0xe2, 0x84, 0x4b, 0x0b, 0xae, 0x13, 0x71, 0xdf, 0x33,
-	0x76, 0x10, 0x96, 0x71, 0xf3, 0x09, 0xdc, 0xa8, 0x65, 0xba, 0xca, 0x0e, 0x91, 0x7d, 0xc8, 0xaf,
-	0x0c, 0xf4, 0x45, 0x49, 0xce, 0x8c, 0x38, 0x9b, 0x73, 0x74, 0x8f, 0xb7, 0xdb, 0x51, 0xe0, 0x17,
-	0x7d, 0x5a, 0xf1, 0xad, 0x80, 0x9e, 0x43, 0x43, 0x91, 0x53, 0x99, 0xd6, 0x73, 0x4b, 0xb8, 0xad,
-	0x35, 0x03, 0x76, 0x98, 0x1c, 0x44, 0xc6, 0xa6, 0xe3, 0xb1, 0x1d, 0xa3, 0xcf, 0xe3, 0x88, 0x3e,
-	0x64, 0xda, 0x38, 0x31, 0x37, 0xf6, 0x0e, 0xf6, 0x0a, 0x72, 0xd3, 0x04, 0x91, 0xb6, 0x0a, 0x2e,
-	0x75, 0x01, 0xd3, 0x83, 0x51, 0xdc, 0x1b, 0x1e, 0xed, 0xdd, 0x39, 0x17, 0xb0, 0xae, 0x64, 0xbd,
-	0xfe, 0x58, 0x8b, 0x41, 0xef, 0xab, 0xd9, 0xe0, 0xb6, 0x70, 0xd1, 0x6d, 0xb9, 0x2e, 0x32, 0xfb,
-	0xfc, 0x25, 0xa4, 0x36, 0x98, 0x06, 0xec, 0xd7, 0xc1, 0xfb, 0xed, 0x52, 0x12, 0x77, 0x14, 0xf7,
-	0xc1, 0x2d, 0xfd, 0xac, 0xc6, 0x6e, 0x20, 0xd7, 0x4d, 0xf2, 0x9a, 0xeb, 0x36, 0x72, 0x1f, 0x47,
-	0x8b, 0xa0, 0x94, 0xf0, 0x41, 0xd3, 0x07, 0xec, 0xb7, 0xb6, 0xaa, 0x90, 0x1b, 0xff, 0x8f, 0x3e,
-	0x58, 0x63, 0x47, 0xc9, 0xb5, 0x9b, 0x8a, 0xc9, 0xeb, 0x0c, 0x3e, 0xdf, 0x98, 0x7b, 0x40, 0x1f,
-	0xaa, 0x61, 0x2f, 0x9b, 0x2b, 0x97, 0x7f, 0xa1, 0xfc, 0x43, 0x0d, 0xab, 0xc9, 0xe8, 0x64, 0x13,
-	0x44, 0x1d, 0x8d, 0x63, 0x7f, 0x61, 0x29, 0x96, 0x78, 0x21, 0x41, 0x6b, 0x0c, 0xb4, 0x16, 0xe0,
-	0xb8, 0x5f, 0x60, 0xe5, 0x36, 0x5b, 0xf8, 0x70, 0xc6, 0x3f, 0x48, 0xf6, 0x70, 0xdf, 0xc7, 0x49,
-	0x77, 0xd3, 0x79, 0x7b, 0x3f, 0xd9, 0x35, 0x44, 0x19, 0x9b, 0xb5, 0x0f, 0x93, 0x03, 0x43, 0x84,
-	0x4d, 0xe6, 0xec, 0x7d, 0x64, 0xe7, 0x10, 0x6d, 0x74, 0xc6, 0x1e, 0x3d, 0x67, 0x6c, 0xbe, 0xde,
-	0x4b, 0xe6, 0x47, 0x08, 0x43, 0xb3, 0xf5, 0x6e, 0xb2, 0x63, 0x58, 0x8d, 0xea, 0x5c, 0x5d, 0x39,
-	0x7c, 0xe2, 0x4c, 0x5d, 0xf8, 0xa8, 0x1b, 0x69, 0x53, 0x8d, 0xa2, 0x0f, 0xdb, 0x51, 0xd0, 0x7e,
-	0xc2, 0x28, 0xa2, 0x08, 0x67, 0xd2, 0xed, 0x84, 0x26, 0xd2, 0x36, 0xf7, 0xe5, 0xf2, 0x93, 0x76,
-	0xc8, 0xc3, 0x6a, 0x95, 0x05, 0x33, 0xd6, 0x6f, 0xfa, 0xb9, 0x69, 0x3b, 0xbe, 0x00, 0x6a, 0x23,
-	0xb1, 0x8b, 0x6f, 0x07, 0xbc, 0x53, 0x74, 0x7b, 0x6d, 0x1e, 0x68, 0xa0, 0x0f, 0x4f, 0xa3, 0xfa,
-	0x59, 0x54, 0x64, 0xa3, 0x7f, 0x9e, 0x32, 0xe9, 0xf7, 0x67, 0x2a, 0x99, 0xb4, 0x18, 0xbd, 0xf3,
-	0x32, 0xe2, 0x43, 0xdb, 0x4e, 0xd7, 0x91, 0xc4, 0xa9, 0x6e, 0x9e, 0x5c, 0x5e, 0x10, 0xb9, 0xec,
-	0x64, 0x61, 0x85, 0xe3, 0x5c, 0x15, 0xc9, 0xe4, 0x1b, 0x50, 0x38, 0xc5, 0x95, 0xd5, 0x29, 0x1b,
-	0xd8, 0xb3, 0xb4, 0x5b, 0x91, 0x7c, 0xcf, 0x0c, 0x3b, 0x46, 0x16, 0x36, 0x53, 0xa1, 0xa8, 0x0b,
-	0x1a, 0x82, 0xcc, 0x6d, 0xf7, 0xce, 0xb0, 0x4b, 0x09, 0x89, 0x62, 0x90, 0xa9, 0xd0, 0x3a, 0x01,
-	0xfa, 0xa6, 0x46, 0x25, 0x7c, 0xb3, 0x3a, 0x1b, 0x85, 0x21, 0x97, 0x3e, 0xfd, 0xb3, 0x6d, 0x97,
-	0x6d, 0x02, 0x1b, 0x02, 0x6c, 0x3f, 0x12, 0x25, 0x06, 0x07, 0x85, 0x05, 0x72, 0x78, 0xd2, 0xde,
-	0xb1, 0x06, 0x00, 0xa7, 0x05, 0x2c, 0x8e, 0xff, 0x95, 0x6b, 0x8b, 0x11, 0x8e, 0x10, 0x47, 0xc8,
-	0x41, 0xc7, 0x76, 0x2d, 0x40, 0xc6, 0xc5, 0xff, 0x5c, 0x8b, 0x6a, 0x33, 0xcd, 0xa3, 0x8d, 0xd6,
-	0x4d, 0x27, 0xff, 0x7f, 0xe5, 0xcc, 0xfa, 0xad, 0x1b, 0xb7, 0x1c, 0x7d, 0xe5, 0xe0, 0xec, 0xb1,
-	0xce, 0x60, 0xb0, 0xb2, 0xda, 0xf7, 0x06, 0xe7, 0xd6, 0x6f, 0x3e, 0x73, 0xae, 0x7f, 0xc1, 0x0c,
-	0x06, 0xab, 0x6b, 0xc7, 0xd6, 0x6e, 0xbf, 0xf9, 0xf4, 0xe9, 0xc1, 0xea, 0xa9, 0x63, 0xf6, 0x8f,
-	0x35, 0xc7, 0xec, 0x1f, 0x6b, 0x6e, 0x99, 0xb5, 0xff, 0xb8, 0xf1, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x15, 0x48, 0xc5, 0x08, 0xcf, 0x19, 0x00, 0x00,
+	0xd5, 0x66, 0x77, 0x76, 0x77, 0x66, 0x5b, 0x20, 0x9a, 0x46, 0x12, 0xca, 0x01, 0x24, 0x01, 0x0b,
+	0xbf, 0xc4, 0x6f, 0x5c, 0x3c, 0xf8, 0xad, 0xe7, 0xde, 0x33, 0x33, 0xad, 0xb9, 0xd3, 0x7d, 0xab,
+	0xbb, 0xef, 0x4a, 0xab, 0x97, 0x5b, 0x60, 0xad, 0x84, 0x60, 0xa5, 0x11, 0xda, 0x15, 0x18, 0xa7,
+	0xc2, 0x55, 0xce, 0xa1, 0x0a, 0x07, 0x82, 0xc3, 0x83, 0xb3, 0x5f, 0x6c, 0x6c, 0xc0, 0x39, 0x60,
+	0x28, 0xbf, 0xd8, 0x26, 0x39, 0x1b, 0x5c, 0x0e, 0x2f, 0x76, 0x95, 0x03, 0x0e, 0x65, 0x93, 0xa3,
+	0xeb, 0x74, 0xdf, 0x34, 0x61, 0xf1, 0x03, 0xc5, 0xa8, 0xcf, 0x77, 0x4f, 0x9f, 0x73, 0xfa, 0xf4,
+	0x39, 0x5f, 0x9f, 0x25, 0x6b, 0x16, 0x4f, 0x9e, 0x39, 0xb1, 0xbc, 0xef, 0xd4, 0xe9, 0xfe, 0x4a,
+	0x9f, 0xad, 0x71, 0xff, 0xdb, 0xe7, 0x96, 0xe6, 0x6e, 0x22, 0x6b, 0x9a, 0x67, 0x8e, 0x2f, 0x1d,
+	0x59, 0x3c, 0x6d, 0x6f, 0x3e, 0xb5, 0xc8, 0x36, 0x92, 0x75, 0x89, 0xec, 0x4a, 0x75, 0x50, 0xa6,
+	0xcd, 0x44, 0x44, 0x21, 0xe8, 0xd4, 0x2e, 0xc4, 0x40, 0xcf, 0x62, 0x75, 0x52, 0x3b, 0x20, 0x9a,
+	0x74, 0x82, 0xcd, 0x92, 0xe9, 0x26, 0x3f, 0x0c, 0x11, 0x9d, 0x64, 0x6b, 0x09, 0x71, 0xa8, 0x98,
+	0x07, 0x5d, 0x43, 0x6b, 0x8c, 0x90, 0x99, 0x20, 0x31, 0x56, 0xf5, 0xe8, 0x14, 0xfe, 0xee, 0x72,
+	0x29, 0xba, 0x8a, 0x4e, 0xe3, 0xef, 0x50, 0x05, 0x5d, 0xd0, 0x74, 0x86, 0xcd, 0x90, 0xc9, 0xae,
+	0xa2, 0xf5, 0xb9, 0x90, 0xcc, 0xba, 0x8d, 0xdd, 0xb6, 0x1b, 0x08, 0x1b, 0xd8, 0x36, 0xdf, 0x74,
+	0x0d, 0xa9, 0x07, 0x51, 0x62, 0x2c, 0x68, 0x3a, 0x81, 0x16, 0xb4, 0x83, 0x26, 0x9d, 0x44, 0x0b,
+	0x22, 0x15, 0xf0, 0x88, 0xd6, 0xe6, 0xba, 0x84, 0xd8, 0xc5, 0xe5, 0x95, 0xcc, 0xfa, 0xf5, 0xe4,
+	0xbc, 0x5c, 0x8d, 0x05, 0x63, 0x73, 0x2d, 0x0d, 0x32, 0x95, 0x48, 0x61, 0xe9, 0x04, 0xdb, 0x4a,
+	0x36, 0x06, 0x4a, 0x5a, 0x2e, 0x24, 0xe8, 0xd4, 0x58, 0x9d, 0x04, 0x36, 0xd1, 0xe0, 0xc0, 0x74,
+	0x72, 0x4e, 0x91, 0xb3, 0xc3, 0xc5, 0x53, 0x4b, 0xfd, 0x9b, 0x33, 0x75, 0x9b, 0xc8, 0xfa, 0x5c,
+	0x5d, 0x08, 0x71, 0xa4, 0x16, 0xca, 0x68, 0x34, 0xc8, 0x54, 0x07, 0xa2, 0x1e, 0x9d, 0x60, 0xe7,
+	0x90, 0xd9, 0xae, 0xf3, 0x59, 0x1c, 0x06, 0x3a, 0x89, 0x16, 0x77, 0x93, 0x26, 0x04, 0x16, 0xad,
+	0x13, 0x64, 0x4d, 0xb0, 0x74, 0x66, 0x79, 0x65, 0x34, 0xb8, 0x99, 0x57, 0xb9, 0xba, 0xb3, 0x49,
+	0xa3, 0x27, 0xa4, 0xc0, 0x2f, 0x33, 0x47, 0xbb, 0xe0, 0x1d, 0x55, 0xb6, 0x03, 0x9a, 0xd6, 0xe6,
+	0x0e, 0x90, 0x46, 0xd4, 0x3f, 0x16, 0x2d, 0xde, 0xb8, 0xb8, 0x84, 0xcb, 0x21, 0x34, 0x93, 0xb6,
+	0xb7, 0x43, 0xc8, 0x96, 0xa2, 0x13, 0xf8, 0xeb, 0x20, 0xd7, 0xd2, 0x7f, 0x05, 0x5a, 0x2b, 0x4d,
+	0x6b, 0xf8, 0xb3, 0xc5, 0x2d, 0x8f, 0xe8, 0x14, 0xfe, 0x8c, 0xb9, 0x14, 0x01, 0x9d, 0x9e, 0xfb,
+	0xfc, 0x6e, 0x42, 0xcc, 0xca, 0xd5, 0x2b, 0x67, 0x96, 0x83, 0xfe, 0x91, 0x45, 0x3c, 0x11, 0xd5,
+	0xa5, 0x67, 0xb1, 0x8d, 0xe4, 0x7c, 0x63, 0xb9, 0x4d, 0x4c, 0xd0, 0x81, 0xa0, 0x9b, 0x9a, 0x24,
+	0x08, 0xc0, 0x18, 0xfa, 0xc3, 0x09, 0xc6, 0xc8, 0x39, 0xfe, 0x58, 0xf2, 0xb5, 0x1f, 0x4d, 0xb0,
+	0xf3, 0xc9, 0x5a, 0x1f, 0x94, 0x62, 0xf1, 0xc7, 0x13, 0xec, 0x3c, 0x72, 0xb6, 0x0b, 0x7c, 0xbe,
+	0xf4, 0xa0, 0x0b, 0xb9, 0xff, 0x36, 0x4e, 0x4c, 0x27, 0xe5, 0x6e, 0x3d, 0x0d, 0x41, 0x0a, 0x08,
+	0xe9, 0x22, 0xdb, 0x42, 0x2e, 0xc8, 0xa4, 0x5a, 0x1d, 0x80, 0xc0, 0xa6, 0x52, 0xd9, 0xb4, 0xa5,
+	0x12, 0x19, 0xd2, 0xa3, 0xec, 0x22, 0xb2, 0xc3, 0x0b, 0x7d, 0xf2, 0xa4, 0x21, 0x87, 0x9e, 0x92,
+	0x0e, 0xa2, 0x13, 0x29, 0x85, 0x6c, 0xd3, 0x63, 0x6c, 0x1d, 0xa1, 0x1e, 0x94, 0x18, 0xd0, 0xa9,
+	0x77, 0xfc, 0xda, 0x72, 0xd7, 0xec, 0xd3, 0x44, 0xf2, 0x79, 0x2e, 0x22, 0xde, 0x8c, 0x80, 0x1e,
+	0x67, 0xdb, 0xc8, 0xa6, 0x61, 0x69, 0x62, 0x3b, 0x4a, 0x8b, 0xc3, 0x10, 0xd2, 0xeb, 0x4a, 0xa3,
+	0x32, 0xb1, 0x59, 0x30, 0x16, 0x7a, 0xa8, 0x9b, 0x5e, 0xcf, 0x76, 0x91, 0x6d, 0x03, 0x42, 0xb4,
+	0xa6, 0xa7, 0x42, 0xd1, 0x12, 0x10, 0x3a, 0xc8, 0x12, 0xdb, 0x4d, 0x76, 0x8e, 0x40, 0x44, 0x2f,
+	0x8e, 0xa0, 0x07, 0xd2, 0x66, 0xa8, 0x13, 0x6c, 0x3b, 0xd9, 0x3c, 0xe4, 0x9d, 0xe5, 0x69, 0xa4,
+	0x8c, 0x71, 0xf2, 0x93, 0x23, 0xf2, 0x96, 0xd2, 0x4d, 0x11, 0x86, 0x20, 0x9d, 0xbc, 0x3f, 0xe2,
+	0x44, 0xa0, 0x64, 0x2b, 0x12, 0x81, 0x75, 0xe2, 0x53, 0x6c, 0x27, 0xd9, 0x3a, 0x20, 0x76, 0x91,
+	0xa9, 0x84, 0xf7, 0x06, 0x76, 0x21, 0xd9, 0x3e, 0x80, 0x10, 0x72, 0x9e, 0x47, 0x22, 0x4c, 0x63,
+	0xae, 0xb9, 0xf7, 0xf6, 0xf4, 0xb0, 0x11, 0x2d, 0x11, 0x41, 0x45, 0xc7, 0xf2, 0x88, 0xab, 0x01,
+	0x0f, 0x3a, 0x90, 0xb6, 0xb4, 0xea, 0xa5, 0x71, 0x12, 0x45, 0x4e, 0xcb, 0x0a, 0xdb, 0x41, 0xb6,
+	0x0c, 0xa0, 0xda, 0x60, 0xd3, 0x50, 0xb4, 0x31, 0x53, 0x10, 0x70, 0xa6, 0x0c, 0xaa, 0x86, 0xb6,
+	0x30, 0x56, 0x2f, 0x0c, 0x43, 0x6e, 0x2c, 0x21, 0xf9, 0x15, 0x3a, 0x20, 0x9a, 0x69, 0x1c, 0x25,
+	0x6d, 0x21, 0xfd, 0x2d, 0xba, 0xa9, 0x3c, 0x74, 0x14, 0xb5, 0x35, 0x0f, 0x23, 0xc0, 0x8b, 0xeb,
+	0x14, 0xbc, 0xa1, 0x3c, 0x55, 0x94, 0xf6, 0xf8, 0x3c, 0xc8, 0x42, 0x78, 0x33, 0x9b, 0x23, 0x7b,
+	0x85, 0x14, 0xb6, 0x38, 0x31, 0xb0, 0x07, 0x95, 0xee, 0xa6, 0x91, 0x30, 0x56, 0xc8, 0x76, 0x5a,
+	0x14, 0x0d, 0x43, 0xdf, 0xc8, 0xf6, 0x91, 0xb9, 0x71, 0xd8, 0x3c, 0x7c, 0x05, 0x36, 0x95, 0xbc,
+	0x07, 0xf4, 0x4d, 0xec, 0x0a, 0x72, 0xf9, 0x38, 0x7c, 0x89, 0x0b, 0x15, 0x18, 0x17, 0x55, 0x38,
+	0x24, 0x8c, 0xa5, 0x6f, 0xc6, 0xa8, 0xbe, 0xda, 0x0e, 0x3d, 0x15, 0x02, 0x7d, 0x0b, 0x46, 0x64,
+	0x1c, 0x2a, 0xe6, 0xda, 0x80, 0x73, 0xeb, 0xad, 0x6c, 0x07, 0xd9, 0x5c, 0xbd, 0xd2, 0xa2, 0xc7,
+	0xdb, 0x50, 0x1e, 0xcc, 0x17, 0x27, 0xd9, 0x45, 0x64, 0x7b, 0x15, 0x50, 0xda, 0x14, 0x68, 0xe0,
+	0xe8, 0x3a, 0xfd, 0xd2, 0x24, 0xbb, 0x90, 0x6c, 0xab, 0x82, 0x74, 0x22, 0x2b, 0x40, 0x54, 0x74,
+	0xd7, 0x24, 0xdb, 0x43, 0x76, 0x8e, 0x57, 0x64, 0x41, 0xf7, 0x84, 0xe4, 0x16, 0x42, 0xfa, 0xe5,
+	0x49, 0x76, 0x19, 0xd9, 0x5b, 0x85, 0xf9, 0x0a, 0x82, 0xd7, 0x22, 0xd5, 0x2a, 0x8a, 0x54, 0x62,
+	0xd3, 0x18, 0x64, 0x88, 0xfb, 0x7e, 0x65, 0x92, 0x5d, 0x42, 0x2e, 0xaa, 0x82, 0x8d, 0xe5, 0x32,
+	0xe4, 0x91, 0x92, 0x90, 0xc6, 0x2a, 0x34, 0x05, 0xf2, 0xee, 0x49, 0xb6, 0x95, 0x5c, 0x50, 0x45,
+	0x1e, 0x50, 0xcd, 0x42, 0x7a, 0xcf, 0x24, 0xdb, 0x42, 0x36, 0x0c, 0x4b, 0x5b, 0x5c, 0x44, 0x10,
+	0xd2, 0x7b, 0x5f, 0xc5, 0x70, 0x0d, 0xc6, 0x72, 0xed, 0x62, 0xf0, 0xc7, 0x49, 0xb6, 0x99, 0xac,
+	0xaf, 0xc2, 0x12, 0xd9, 0x01, 0x1e, 0xd9, 0xce, 0x02, 0xfd, 0xd3, 0x88, 0x0a, 0xa9, 0x42, 0x48,
+	0x7b, 0xd0, 0x53, 0x7a, 0x21, 0x8d, 0x35, 0x18, 0x93, 0x68, 0xa0, 0xb7, 0xd6, 0x86, 0x63, 0xed,
+	0x60, 0xa1, 0x30, 0xdd, 0x12, 0xf4, 0xc1, 0x1a, 0xbb, 0x94, 0xec, 0x1e, 0x01, 0xe5, 0x27, 0x5b,
+	0x2d, 0x62, 0x1f, 0xaa, 0x0d, 0x1f, 0x8b, 0x83, 0xc6, 0x22, 0x2c, 0xd5, 0x7d, 0x78, 0xfc, 0x9e,
+	0x89, 0xc4, 0x7f, 0x85, 0x89, 0x57, 0xf4, 0x91, 0x1a, 0xdb, 0x45, 0xb6, 0x8e, 0x01, 0x69, 0xe0,
+	0x41, 0xc7, 0x41, 0x6e, 0xab, 0x0d, 0x27, 0x92, 0x37, 0x4b, 0xd9, 0x54, 0x03, 0x0f, 0x17, 0xe8,
+	0xed, 0x23, 0xc6, 0xf8, 0xf8, 0xa6, 0xd9, 0x46, 0x18, 0xc3, 0x3b, 0x6a, 0xec, 0x62, 0x72, 0x61,
+	0x15, 0x93, 0xf5, 0x49, 0x0c, 0xb9, 0x84, 0xc0, 0x0a, 0xe5, 0x2b, 0xdb, 0x47, 0x47, 0xac, 0xce,
+	0x81, 0xe8, 0x5c, 0x57, 0x44, 0x78, 0x70, 0x1f, 0x1b, 0x89, 0x54, 0xa1, 0x2d, 0x12, 0x98, 0x4e,
+	0x2d, 0xb0, 0x41, 0xc7, 0xe9, 0xfb, 0x78, 0x6d, 0xf8, 0x80, 0x2a, 0x59, 0x57, 0xc2, 0x3e, 0x31,
+	0x12, 0x87, 0x58, 0x85, 0x29, 0x5e, 0x30, 0xc1, 0x23, 0x71, 0x18, 0x5d, 0xf8, 0x41, 0x0d, 0x3b,
+	0x61, 0x5e, 0x7f, 0x7c, 0xab, 0x79, 0xb2, 0x36, 0xdc, 0x37, 0x33, 0x39, 0x7d, 0xaa, 0xc6, 0xf6,
+	0x92, 0x5d, 0x63, 0x24, 0x43, 0x07, 0xf0, 0x74, 0x8d, 0xcd, 0x91, 0x3d, 0xe3, 0x73, 0xf0, 0x20,
+	0x17, 0xae, 0xfe, 0xe4, 0x3a, 0x9f, 0xa9, 0xb1, 0xed, 0x64, 0xd3, 0x38, 0x9d, 0x30, 0x0f, 0xd2,
+	0xd2, 0x97, 0x6a, 0x95, 0xbe, 0x9c, 0x7f, 0xf4, 0x6c, 0x0d, 0xfb, 0xb2, 0x59, 0x90, 0x41, 0xb1,
+	0xf4, 0x5c, 0xad, 0xec, 0xe9, 0xf9, 0xda, 0xf3, 0x35, 0xb6, 0x8e, 0x9c, 0x1b, 0xc2, 0xbc, 0x2b,
+	0x2a, 0xf9, 0xea, 0x0b, 0x6e, 0x35, 0x88, 0x80, 0xcb, 0x24, 0x2e, 0x56, 0x5f, 0x74, 0x2a, 0x07,
+	0x80, 0x2f, 0xd7, 0xd8, 0x26, 0xb2, 0x6e, 0xa8, 0xad, 0x7a, 0xd1, 0x2b, 0xb5, 0x82, 0x18, 0xe4,
+	0x4b, 0xb7, 0x4c, 0xa1, 0x5a, 0x67, 0x93, 0xd3, 0xe2, 0x83, 0xf9, 0xf8, 0x14, 0xdb, 0x49, 0xb6,
+	0xe4, 0x26, 0xf8, 0x5e, 0x00, 0x3a, 0xa3, 0x84, 0x21, 0xc4, 0x86, 0x7e, 0x6f, 0x1a, 0x53, 0x71,
+	0x04, 0xe1, 0x74, 0x3b, 0xc0, 0x7d, 0xd3, 0x78, 0x8c, 0x23, 0x80, 0x2c, 0x24, 0x0e, 0xf2, 0xfd,
+	0xe9, 0xb1, 0xbb, 0x60, 0xff, 0x14, 0x6d, 0x84, 0xd0, 0xfb, 0xa7, 0xd9, 0x6e, 0xb2, 0xa3, 0x0c,
+	0x85, 0x49, 0xe2, 0x58, 0x69, 0x6c, 0xdd, 0xf3, 0xff, 0x9f, 0xf6, 0xb8, 0x14, 0x2d, 0x24, 0x8c,
+	0x0f, 0x4c, 0x0f, 0x5f, 0x0b, 0x47, 0x41, 0x02, 0x2e, 0x03, 0x70, 0x49, 0xfa, 0xc9, 0x99, 0xe1,
+	0x6b, 0x11, 0x02, 0x0f, 0x23, 0x21, 0x21, 0x85, 0x43, 0x01, 0x40, 0x08, 0x21, 0xfd, 0xd4, 0x0c,
+	0x06, 0xc2, 0x7b, 0x58, 0x7e, 0xf9, 0xe9, 0x19, 0xb6, 0x9e, 0xd0, 0xcc, 0xe8, 0x72, 0xf9, 0x33,
+	0x33, 0x58, 0xcb, 0x86, 0x1a, 0x6e, 0x2e, 0xfc, 0xec, 0x0c, 0x16, 0xa9, 0x01, 0x61, 0xbe, 0x1d,
+	0xfd, 0xdc, 0x0c, 0xdb, 0x46, 0x36, 0x3a, 0x6f, 0x5c, 0x61, 0x87, 0xd4, 0xf2, 0x76, 0xbb, 0xe0,
+	0x4b, 0xef, 0xa8, 0xa3, 0x27, 0x4e, 0x9c, 0xd3, 0xd0, 0x34, 0xe6, 0x89, 0xf1, 0x5c, 0x45, 0x69,
+	0xfa, 0xce, 0x3a, 0x06, 0x64, 0x10, 0x50, 0xa1, 0x61, 0x19, 0xea, 0x5d, 0x75, 0xcc, 0xce, 0xea,
+	0x2e, 0xf9, 0x1b, 0xc2, 0xcb, 0xdf, 0x5d, 0x6e, 0x93, 0xc9, 0x0b, 0x5a, 0xed, 0x01, 0xef, 0x19,
+	0x01, 0xe4, 0x07, 0x9b, 0x01, 0xde, 0x5b, 0xc7, 0xb8, 0x78, 0x80, 0x63, 0x1a, 0x7e, 0xf9, 0x7d,
+	0xa5, 0x79, 0xd9, 0x77, 0x07, 0x39, 0xde, 0x6b, 0xab, 0x45, 0xc5, 0xcb, 0xf7, 0xd7, 0xb1, 0xb0,
+	0x54, 0x51, 0x58, 0xde, 0x5b, 0x3c, 0xa8, 0xee, 0xf0, 0x81, 0x3a, 0x9e, 0x59, 0x1e, 0xf9, 0x8c,
+	0xa5, 0x0f, 0x55, 0xa8, 0xbf, 0xd4, 0xb1, 0xa2, 0x14, 0x29, 0xd5, 0x4c, 0xda, 0x69, 0x07, 0xa2,
+	0xd8, 0xf5, 0x0c, 0xab, 0x05, 0xcc, 0xfb, 0xf6, 0xfb, 0xd7, 0x3a, 0xbb, 0x80, 0xb0, 0x42, 0x95,
+	0xbf, 0x41, 0x28, 0xf8, 0x5b, 0x1d, 0x4f, 0x23, 0x13, 0xe0, 0x33, 0x22, 0xe5, 0x71, 0x1c, 0x2d,
+	0xa4, 0x11, 0x6f, 0x42, 0x64, 0xe8, 0x13, 0x75, 0xbc, 0x49, 0x55, 0x71, 0x4e, 0x6d, 0xe9, 0xdf,
+	0xab, 0x5f, 0x4a, 0x95, 0xf6, 0xd0, 0x4d, 0x3c, 0x00, 0x17, 0x68, 0xfa, 0x8f, 0x3a, 0x76, 0xc2,
+	0xea, 0x97, 0xf3, 0xa0, 0x4d, 0x6e, 0xf6, 0x3f, 0xeb, 0x3e, 0xef, 0x4b, 0x69, 0x4f, 0xc8, 0x01,
+	0xc4, 0xbf, 0xea, 0xfe, 0x76, 0x39, 0x44, 0x5e, 0x50, 0xab, 0x80, 0x5f, 0x36, 0xfc, 0xc5, 0x18,
+	0x00, 0xa8, 0x56, 0xcb, 0xe5, 0x34, 0xd2, 0x12, 0x87, 0xfa, 0x77, 0xbd, 0x82, 0x02, 0x5d, 0x96,
+	0xb1, 0x96, 0xc2, 0x9c, 0x8c, 0x00, 0x23, 0x49, 0xff, 0x53, 0xf5, 0x05, 0xfb, 0x48, 0x71, 0xb3,
+	0x9c, 0x92, 0x27, 0xab, 0x4a, 0x9c, 0x58, 0x43, 0x4f, 0x59, 0x18, 0x44, 0x3d, 0x55, 0x55, 0x82,
+	0x6c, 0x6d, 0x50, 0xfc, 0x74, 0x35, 0x20, 0xb9, 0xbd, 0x45, 0x34, 0x9f, 0x71, 0xf9, 0x5a, 0x48,
+	0xb3, 0x47, 0x5c, 0x29, 0x7f, 0x76, 0xd0, 0xc2, 0x38, 0xe2, 0x01, 0x64, 0x1c, 0x0a, 0xc5, 0xcf,
+	0x55, 0x53, 0xc5, 0x6a, 0x2e, 0x4d, 0x4b, 0xe9, 0xde, 0xa0, 0x01, 0xcf, 0x57, 0xcf, 0xd2, 0x80,
+	0xf5, 0x67, 0xec, 0x44, 0x2f, 0x54, 0x77, 0x2f, 0x3e, 0x3a, 0xa8, 0x85, 0xf5, 0xea, 0x5f, 0xac,
+	0x66, 0x99, 0x27, 0x75, 0x05, 0xca, 0x19, 0xe1, 0x1f, 0x0a, 0x2f, 0xd5, 0x91, 0x27, 0x55, 0x4f,
+	0x35, 0x4b, 0x6e, 0xe9, 0x39, 0x65, 0x49, 0x19, 0x5e, 0xae, 0x63, 0x07, 0x1e, 0x4a, 0x6d, 0x21,
+	0x2d, 0x68, 0xc9, 0xa3, 0xea, 0x23, 0xe7, 0x95, 0x3a, 0x76, 0xa4, 0xc2, 0x68, 0x3d, 0x0f, 0x3a,
+	0x35, 0x22, 0x84, 0x2c, 0x4d, 0x07, 0x1e, 0x1a, 0xb7, 0x34, 0xb0, 0xea, 0xe4, 0x77, 0x41, 0x8b,
+	0x16, 0x5e, 0x26, 0x0b, 0x01, 0xf2, 0xbd, 0xb7, 0x35, 0xd0, 0x79, 0x5f, 0xf7, 0x4d, 0x59, 0x61,
+	0xf1, 0xb3, 0x87, 0x1b, 0x6c, 0x03, 0x39, 0xcf, 0x89, 0x82, 0x5c, 0x8c, 0xeb, 0x8f, 0x94, 0xeb,
+	0xa2, 0xd7, 0x2e, 0xa9, 0xea, 0xa3, 0x0d, 0x0c, 0x96, 0xc7, 0xbb, 0x83, 0x4a, 0x83, 0x5e, 0x58,
+	0xa1, 0xba, 0x3f, 0x69, 0xa0, 0xc9, 0xc3, 0x72, 0x9d, 0xc8, 0x54, 0x2a, 0x99, 0x1e, 0x06, 0xad,
+	0x90, 0x5c, 0x7b, 0x93, 0x7f, 0xda, 0xc0, 0xc0, 0x8e, 0xc3, 0x5a, 0xd1, 0x83, 0x50, 0x25, 0x1e,
+	0xf6, 0xb3, 0x06, 0xf6, 0xef, 0x71, 0xb0, 0xa2, 0xe6, 0x3a, 0xdc, 0xcf, 0x57, 0xc5, 0xc1, 0x21,
+	0x08, 0x92, 0xa2, 0x6a, 0xfc, 0xa2, 0x81, 0xe5, 0x67, 0x3c, 0x4e, 0xe4, 0xcf, 0xc2, 0x5f, 0x35,
+	0x30, 0x61, 0xc7, 0x82, 0xb4, 0xa6, 0xbf, 0x1e, 0xb1, 0x3c, 0x04, 0xe4, 0xb9, 0x20, 0x03, 0x01,
+	0xc6, 0x41, 0x11, 0xf6, 0x58, 0x83, 0x5d, 0x4e, 0x2e, 0x5e, 0x15, 0x96, 0xc8, 0x1e, 0xd7, 0xa6,
+	0xc3, 0xb3, 0xd0, 0x3e, 0xde, 0xc0, 0x8e, 0x39, 0xb2, 0x65, 0xb5, 0x92, 0xfd, 0xc6, 0x59, 0x95,
+	0x1d, 0x9f, 0x7b, 0x03, 0x62, 0xe4, 0xb3, 0xc2, 0x4f, 0xef, 0x9e, 0xc5, 0xb3, 0xa9, 0x4a, 0x8b,
+	0x17, 0xa2, 0x93, 0xdf, 0x33, 0x8b, 0x37, 0xb9, 0x6c, 0xaf, 0xde, 0x92, 0x85, 0x21, 0xd4, 0xbd,
+	0xb3, 0xc8, 0xe8, 0x72, 0x54, 0x12, 0x47, 0x22, 0x70, 0x59, 0xcc, 0x7b, 0x60, 0x52, 0xc3, 0x7b,
+	0xe0, 0x55, 0x23, 0xf4, 0xab, 0xb3, 0xe8, 0xdf, 0x2a, 0x50, 0x1e, 0x68, 0x7c, 0x43, 0x23, 0xd8,
+	0x5f, 0x90, 0xaf, 0xcd, 0x62, 0x86, 0x66, 0xe8, 0x26, 0x0f, 0x51, 0x64, 0xb3, 0x74, 0xfb, 0x7a,
+	0x55, 0xe6, 0xb2, 0xa4, 0x34, 0xe8, 0x1b, 0x55, 0xb7, 0x7c, 0xe6, 0xc7, 0x5a, 0x95, 0x7a, 0xbf,
+	0x59, 0x95, 0x87, 0xd0, 0xe2, 0x49, 0x64, 0xd3, 0x79, 0x1e, 0x25, 0x99, 0xfc, 0x5b, 0xb3, 0x78,
+	0xdd, 0x06, 0x83, 0x66, 0x3b, 0x26, 0x35, 0x49, 0xd3, 0x58, 0x61, 0xcb, 0xc4, 0xf8, 0xf6, 0x2c,
+	0xfb, 0x3f, 0x72, 0x49, 0x06, 0xec, 0x25, 0x91, 0x15, 0x38, 0x2d, 0x50, 0xda, 0xe6, 0xfb, 0x0d,
+	0xde, 0xb8, 0xef, 0xcc, 0x22, 0x09, 0xe8, 0x28, 0xd5, 0x1d, 0x93, 0xdb, 0xb7, 0x11, 0xa4, 0x5a,
+	0x4e, 0x98, 0x27, 0xcd, 0xed, 0x04, 0xcf, 0xd7, 0x2d, 0x55, 0x9e, 0xa6, 0x03, 0xb1, 0xbf, 0x83,
+	0x20, 0x6d, 0xf4, 0x90, 0x82, 0x4f, 0xdc, 0x49, 0xe6, 0x6e, 0x5d, 0x4b, 0xd6, 0x9a, 0x33, 0xc7,
+	0x8e, 0x2d, 0x2e, 0xaf, 0x1c, 0xef, 0x9f, 0x74, 0xc3, 0xa2, 0x3a, 0xa9, 0x49, 0x11, 0xd1, 0xb3,
+	0x70, 0xee, 0xc2, 0xc3, 0xb0, 0x70, 0x5c, 0x43, 0xac, 0xe8, 0x11, 0x1c, 0xe4, 0xe5, 0x4c, 0xa7,
+	0xb2, 0xbe, 0x88, 0x2f, 0xda, 0xd1, 0xf5, 0xb4, 0x1d, 0xa9, 0x26, 0x8f, 0xb2, 0xba, 0x40, 0x8f,
+	0xe2, 0xcc, 0xa2, 0x1d, 0x44, 0x2a, 0x29, 0x08, 0x0c, 0x8e, 0x65, 0x32, 0x31, 0x3e, 0x68, 0x8e,
+	0xe1, 0x48, 0x6e, 0xbc, 0xe8, 0x5a, 0x9c, 0xae, 0xf9, 0x2d, 0x32, 0x15, 0xd9, 0x44, 0x89, 0x1e,
+	0x2f, 0x25, 0xd9, 0xa7, 0xf9, 0xf0, 0xe8, 0x3a, 0x34, 0xb7, 0x25, 0x0e, 0xf9, 0xf2, 0xe3, 0x99,
+	0x93, 0x1f, 0xf2, 0x6c, 0x20, 0x2c, 0xc3, 0xfa, 0x55, 0xbc, 0x0d, 0x0b, 0x74, 0x09, 0x47, 0x26,
+	0x88, 0xaf, 0x4c, 0x39, 0x0a, 0x0a, 0x91, 0x39, 0x71, 0x22, 0xc7, 0x98, 0x2e, 0x6f, 0xb5, 0x54,
+	0x14, 0x16, 0xbc, 0xb2, 0x18, 0xa0, 0xd0, 0x93, 0xe8, 0x28, 0x62, 0x2a, 0x23, 0x8c, 0xdc, 0x13,
+	0xee, 0x7a, 0x63, 0x9f, 0xed, 0x21, 0xbb, 0x10, 0xb1, 0xea, 0xcc, 0xc0, 0xcd, 0x16, 0x4e, 0xe1,
+	0xdc, 0x62, 0xc0, 0xb5, 0x51, 0x60, 0xee, 0xec, 0x0d, 0xec, 0x75, 0xe4, 0xaa, 0x31, 0x2a, 0x5d,
+	0xd7, 0x3e, 0xd8, 0x01, 0x2c, 0x43, 0x56, 0xf3, 0x60, 0x70, 0xde, 0xe1, 0xf7, 0x39, 0x8d, 0x7d,
+	0xd0, 0xef, 0x33, 0x4a, 0x89, 0x70, 0x18, 0x88, 0x97, 0xc8, 0x89, 0x0b, 0x76, 0xe8, 0x59, 0x6f,
+	0x36, 0x13, 0x14, 0xd2, 0x58, 0x2c, 0x37, 0x6e, 0x64, 0xfa, 0xa0, 0x5b, 0x4a, 0x62, 0x1c, 0xd0,
+	0x80, 0x5f, 0x7a, 0x68, 0x82, 0x5d, 0x41, 0x2e, 0x1b, 0x17, 0x35, 0xcf, 0x8e, 0xf2, 0x18, 0xab,
+	0x79, 0xd0, 0x5a, 0x84, 0x60, 0xe8, 0xc3, 0x6e, 0x00, 0x59, 0x55, 0x72, 0xe5, 0x6b, 0xe8, 0x23,
+	0x13, 0x6c, 0x1f, 0xb9, 0x74, 0x55, 0x35, 0x79, 0x5f, 0xc4, 0x32, 0x11, 0xf3, 0x00, 0xe8, 0xa3,
+	0x13, 0xc8, 0xbd, 0x73, 0xe3, 0xf2, 0xb1, 0xed, 0x6f, 0x57, 0x35, 0x26, 0x43, 0x20, 0x8d, 0x09,
+	0xca, 0x4b, 0x69, 0xe8, 0xef, 0x26, 0xb0, 0x12, 0x20, 0x3d, 0x99, 0x2f, 0x97, 0x1d, 0xdf, 0x11,
+	0x10, 0x85, 0x86, 0xfe, 0x7e, 0x02, 0x9b, 0x9a, 0x06, 0x5f, 0x44, 0xf2, 0x26, 0x6e, 0xe8, 0x1f,
+	0x26, 0xb0, 0x3f, 0x0e, 0xbf, 0xf9, 0x22, 0xd5, 0x36, 0x38, 0x75, 0x29, 0x62, 0x8a, 0xe4, 0x47,
+	0x48, 0x30, 0x06, 0x53, 0xba, 0x09, 0xf4, 0xae, 0x8a, 0xac, 0xfc, 0xcc, 0x51, 0x02, 0x1c, 0xb1,
+	0xec, 0x22, 0x5b, 0x79, 0x18, 0x6a, 0xc4, 0xaf, 0x36, 0x89, 0xd8, 0x41, 0x36, 0x0f, 0x40, 0x46,
+	0xa6, 0x10, 0x7b, 0xc8, 0xce, 0x01, 0xc0, 0x2a, 0x13, 0x88, 0xed, 0x64, 0xd3, 0x00, 0x6c, 0x78,
+	0xfa, 0x30, 0xbc, 0xcf, 0xc8, 0xe4, 0x61, 0x1b, 0xd9, 0x38, 0x04, 0x18, 0x98, 0x3a, 0x6c, 0x21,
+	0x1b, 0x06, 0xcd, 0xa8, 0x4e, 0x1c, 0x2a, 0x9b, 0x8f, 0x9d, 0x36, 0x14, 0x31, 0xea, 0x28, 0x63,
+	0xab, 0xf9, 0x7a, 0xa7, 0x7b, 0x24, 0xbb, 0xe1, 0x4e, 0x91, 0xaf, 0xf8, 0x5a, 0x5f, 0x4f, 0x68,
+	0x22, 0xdd, 0xb3, 0xa7, 0x5c, 0x7e, 0xda, 0x3d, 0x7f, 0xb1, 0x94, 0x66, 0xd7, 0x06, 0x19, 0x09,
+	0xfd, 0xc2, 0x94, 0x7b, 0xd8, 0x01, 0x5a, 0x23, 0x43, 0xd0, 0x69, 0x2b, 0xe2, 0xed, 0x82, 0x07,
+	0xb7, 0x78, 0x64, 0x80, 0x3e, 0x36, 0x85, 0xe6, 0x67, 0x99, 0x93, 0x0d, 0x45, 0xf2, 0x26, 0x40,
+	0xbf, 0x3b, 0x5d, 0xe9, 0x0d, 0xc5, 0x50, 0x22, 0x6f, 0x8c, 0x21, 0xb4, 0xdc, 0xdc, 0x41, 0x49,
+	0x7c, 0xef, 0x6e, 0x24, 0xe7, 0x17, 0x40, 0x2e, 0xdb, 0x59, 0x02, 0xd3, 0xfb, 0x06, 0x25, 0x99,
+	0x7e, 0x0b, 0x1a, 0xdf, 0xb7, 0x65, 0xbf, 0xf5, 0x92, 0xbc, 0x91, 0x54, 0x34, 0xdf, 0x3f, 0xcd,
+	0xf6, 0x93, 0xb9, 0xd5, 0x4c, 0x28, 0x3a, 0x9d, 0x81, 0x28, 0x0b, 0xdb, 0x03, 0xd3, 0xec, 0x5c,
+	0x42, 0x54, 0x0c, 0x32, 0x15, 0xc6, 0x24, 0x40, 0xdf, 0x5e, 0xaf, 0xa4, 0x6f, 0xc6, 0x1c, 0x54,
+	0xaf, 0xc7, 0x65, 0x48, 0xff, 0xec, 0x1e, 0x12, 0xae, 0x54, 0x0e, 0x08, 0x1c, 0xc3, 0x52, 0x89,
+	0xc5, 0x27, 0xd4, 0x1c, 0xd9, 0x33, 0xee, 0xdb, 0x11, 0x4a, 0x83, 0xef, 0x28, 0x6c, 0xf7, 0xff,
+	0x13, 0xeb, 0xda, 0x2b, 0x3e, 0xae, 0xf6, 0x92, 0x5d, 0x1e, 0xed, 0x49, 0x4d, 0x86, 0xc5, 0xff,
+	0x3c, 0x79, 0x77, 0x35, 0xed, 0x09, 0xf7, 0x3a, 0xcb, 0x33, 0x44, 0x75, 0x73, 0x1c, 0x7d, 0xa8,
+	0x51, 0xba, 0x35, 0xd8, 0x35, 0xe9, 0xc3, 0x8d, 0xe6, 0x55, 0x87, 0x5f, 0x7b, 0xec, 0xf8, 0xca,
+	0xb5, 0x67, 0xae, 0xd9, 0xf7, 0xfa, 0xfe, 0x89, 0xfd, 0xed, 0x7e, 0xff, 0xd8, 0xd2, 0x62, 0xd0,
+	0x3f, 0xb9, 0x72, 0xf5, 0xf1, 0x93, 0x8b, 0xa7, 0x6d, 0xbf, 0xbf, 0xb4, 0xbc, 0x7f, 0xf9, 0xfa,
+	0xab, 0x8f, 0x1e, 0xed, 0x2f, 0x1d, 0xd9, 0xef, 0xfe, 0xce, 0xb6, 0xdf, 0xfd, 0x9d, 0xed, 0x9a,
+	0x19, 0xf7, 0x8f, 0x2b, 0xff, 0x3b, 0x00, 0x45, 0x03, 0x63, 0xd3, 0x8a, 0x1b, 0x00, 0x00,
 }
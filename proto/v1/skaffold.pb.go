@@ -41,6 +41,7 @@ const BuilderType_BUILDPACKS = BuilderType(enums.BuilderType_BUILDPACKS)
 const BuilderType_CUSTOM = BuilderType(enums.BuilderType_CUSTOM)
 const BuilderType_KANIKO = BuilderType(enums.BuilderType_KANIKO)
 const BuilderType_DOCKER = BuilderType(enums.BuilderType_DOCKER)
+const BuilderType_KO = BuilderType(enums.BuilderType_KO)
 
 // BuildType from public import enums/enums.proto
 type BuildType = enums.BuildType
@@ -140,6 +141,9 @@ const StatusCode_STATUSCHECK_CONTAINER_CREATING = StatusCode(enums.StatusCode_ST
 const StatusCode_STATUSCHECK_RUN_CONTAINER_ERR = StatusCode(enums.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR)
 const StatusCode_STATUSCHECK_CONTAINER_TERMINATED = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_TERMINATED)
 const StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING)
+const StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING)
+const StatusCode_STATUSCHECK_JOB_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_JOB_PENDING)
+const StatusCode_STATUSCHECK_JOB_FAILED = StatusCode(enums.StatusCode_STATUSCHECK_JOB_FAILED)
 const StatusCode_STATUSCHECK_CONTAINER_RESTARTING = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_RESTARTING)
 const StatusCode_STATUSCHECK_UNHEALTHY = StatusCode(enums.StatusCode_STATUSCHECK_UNHEALTHY)
 const StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE = StatusCode(enums.StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE)
@@ -212,6 +216,8 @@ const StatusCode_DEPLOY_MANIFEST_WRITE_ERR = StatusCode(enums.StatusCode_DEPLOY_
 const StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR = StatusCode(enums.StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR)
 const StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE = StatusCode(enums.StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE)
 const StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR = StatusCode(enums.StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR)
+const StatusCode_DEPLOY_SERVER_SIDE_APPLY_CONFLICT_ERR = StatusCode(enums.StatusCode_DEPLOY_SERVER_SIDE_APPLY_CONFLICT_ERR)
+const StatusCode_DEPLOY_DRIFT_DETECTED = StatusCode(enums.StatusCode_DEPLOY_DRIFT_DETECTED)
 const StatusCode_TEST_USER_CONFIG_ERR = StatusCode(enums.StatusCode_TEST_USER_CONFIG_ERR)
 const StatusCode_TEST_CST_USER_ERR = StatusCode(enums.StatusCode_TEST_CST_USER_ERR)
 const StatusCode_TEST_IMG_PULL_ERR = StatusCode(enums.StatusCode_TEST_IMG_PULL_ERR)
@@ -236,6 +242,10 @@ const StatusCode_CONFIG_APPLY_PROFILES_ERR = StatusCode(enums.StatusCode_CONFIG_
 const StatusCode_CONFIG_DEFAULT_VALUES_ERR = StatusCode(enums.StatusCode_CONFIG_DEFAULT_VALUES_ERR)
 const StatusCode_CONFIG_FILE_PATHS_SUBSTITUTION_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_PATHS_SUBSTITUTION_ERR)
 const StatusCode_CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR = StatusCode(enums.StatusCode_CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR)
+const StatusCode_HOOK_NON_ZERO_EXIT_ERR = StatusCode(enums.StatusCode_HOOK_NON_ZERO_EXIT_ERR)
+const StatusCode_HOOK_RUN_ERR = StatusCode(enums.StatusCode_HOOK_RUN_ERR)
+const StatusCode_HOOK_CONTAINER_NOT_FOUND_ERR = StatusCode(enums.StatusCode_HOOK_CONTAINER_NOT_FOUND_ERR)
+const StatusCode_HOOK_CANCELLED = StatusCode(enums.StatusCode_HOOK_CANCELLED)
 
 // SuggestionCode from public import enums/enums.proto
 type SuggestionCode = enums.SuggestionCode
@@ -267,6 +277,9 @@ const SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_ARTIFACT_OVERRIDES = SuggestionCod
 const SuggestionCode_UPGRADE_HELM32 = SuggestionCode(enums.SuggestionCode_UPGRADE_HELM32)
 const SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE = SuggestionCode(enums.SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE)
 const SuggestionCode_INSTALL_KUBECTL = SuggestionCode(enums.SuggestionCode_INSTALL_KUBECTL)
+const SuggestionCode_FIX_SKAFFOLD_CONFIG_KUBECTL_FORCE_CONFLICTS = SuggestionCode(enums.SuggestionCode_FIX_SKAFFOLD_CONFIG_KUBECTL_FORCE_CONFLICTS)
+const SuggestionCode_REMOVE_CONFLICTING_FIELDS = SuggestionCode(enums.SuggestionCode_REMOVE_CONFLICTING_FIELDS)
+const SuggestionCode_REAPPLY_MANIFESTS = SuggestionCode(enums.SuggestionCode_REAPPLY_MANIFESTS)
 const SuggestionCode_CHECK_CONTAINER_LOGS = SuggestionCode(enums.SuggestionCode_CHECK_CONTAINER_LOGS)
 const SuggestionCode_CHECK_READINESS_PROBE = SuggestionCode(enums.SuggestionCode_CHECK_READINESS_PROBE)
 const SuggestionCode_CHECK_CONTAINER_IMAGE = SuggestionCode(enums.SuggestionCode_CHECK_CONTAINER_IMAGE)
@@ -295,6 +308,8 @@ const SuggestionCode_FIX_CUSTOM_COMMAND_TIMEOUT = SuggestionCode(enums.Suggestio
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD = SuggestionCode(enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD)
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS = SuggestionCode(enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS)
 const SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME = SuggestionCode(enums.SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME)
+const SuggestionCode_CHECK_HOOK_COMMAND = SuggestionCode(enums.SuggestionCode_CHECK_HOOK_COMMAND)
+const SuggestionCode_CHECK_HOOK_CONTAINER = SuggestionCode(enums.SuggestionCode_CHECK_HOOK_CONTAINER)
 
 type StateResponse struct {
 	State                *State   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
@@ -1160,6 +1175,7 @@ type Event struct {
 	//	*Event_DevLoopEvent
 	//	*Event_TerminationEvent
 	//	*Event_TestEvent
+	//	*Event_DriftEvent
 	EventType            isEvent_EventType `protobuf_oneof:"event_type"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -1239,6 +1255,10 @@ type Event_TestEvent struct {
 	TestEvent *TestEvent `protobuf:"bytes,11,opt,name=TestEvent,proto3,oneof"`
 }
 
+type Event_DriftEvent struct {
+	DriftEvent *DriftEvent `protobuf:"bytes,12,opt,name=driftEvent,proto3,oneof"`
+}
+
 func (*Event_MetaEvent) isEvent_EventType() {}
 
 func (*Event_BuildEvent) isEvent_EventType() {}
@@ -1261,6 +1281,8 @@ func (*Event_TerminationEvent) isEvent_EventType() {}
 
 func (*Event_TestEvent) isEvent_EventType() {}
 
+func (*Event_DriftEvent) isEvent_EventType() {}
+
 func (m *Event) GetEventType() isEvent_EventType {
 	if m != nil {
 		return m.EventType
@@ -1345,6 +1367,13 @@ func (m *Event) GetTestEvent() *TestEvent {
 	return nil
 }
 
+func (m *Event) GetDriftEvent() *DriftEvent {
+	if x, ok := m.GetEventType().(*Event_DriftEvent); ok {
+		return x.DriftEvent
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1359,6 +1388,7 @@ func (*Event) XXX_OneofWrappers() []interface{} {
 		(*Event_DevLoopEvent)(nil),
 		(*Event_TerminationEvent)(nil),
 		(*Event_TestEvent)(nil),
+		(*Event_DriftEvent)(nil),
 	}
 }
 
@@ -2107,6 +2137,78 @@ func (m *FileSyncEvent) GetActionableErr() *ActionableErr {
 	return nil
 }
 
+// DriftEvent is raised when a deployed resource no longer matches the manifests applied by Skaffold.
+type DriftEvent struct {
+	Resource             string         `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Namespace            string         `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Status               string         `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Fields               []string       `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	ActionableErr        *ActionableErr `protobuf:"bytes,5,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DriftEvent) Reset()         { *m = DriftEvent{} }
+func (m *DriftEvent) String() string { return proto.CompactTextString(m) }
+func (*DriftEvent) ProtoMessage()    {}
+func (*DriftEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ef8072bea85606e, []int{25}
+}
+
+func (m *DriftEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DriftEvent.Unmarshal(m, b)
+}
+func (m *DriftEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DriftEvent.Marshal(b, m, deterministic)
+}
+func (m *DriftEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DriftEvent.Merge(m, src)
+}
+func (m *DriftEvent) XXX_Size() int {
+	return xxx_messageInfo_DriftEvent.Size(m)
+}
+func (m *DriftEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DriftEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DriftEvent proto.InternalMessageInfo
+
+func (m *DriftEvent) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *DriftEvent) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DriftEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DriftEvent) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *DriftEvent) GetActionableErr() *ActionableErr {
+	if m != nil {
+		return m.ActionableErr
+	}
+	return nil
+}
+
 // DebuggingContainerEvent is raised when a debugging container is started or terminated
 type DebuggingContainerEvent struct {
 	Status               string            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -2126,7 +2228,7 @@ func (m *DebuggingContainerEvent) Reset()         { *m = DebuggingContainerEvent
 func (m *DebuggingContainerEvent) String() string { return proto.CompactTextString(m) }
 func (*DebuggingContainerEvent) ProtoMessage()    {}
 func (*DebuggingContainerEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ef8072bea85606e, []int{25}
+	return fileDescriptor_9ef8072bea85606e, []int{26}
 }
 
 func (m *DebuggingContainerEvent) XXX_Unmarshal(b []byte) error {
@@ -2217,7 +2319,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ef8072bea85606e, []int{26}
+	return fileDescriptor_9ef8072bea85606e, []int{27}
 }
 
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
@@ -2270,7 +2372,7 @@ func (m *UserIntentRequest) Reset()         { *m = UserIntentRequest{} }
 func (m *UserIntentRequest) String() string { return proto.CompactTextString(m) }
 func (*UserIntentRequest) ProtoMessage()    {}
 func (*UserIntentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ef8072bea85606e, []int{27}
+	return fileDescriptor_9ef8072bea85606e, []int{28}
 }
 
 func (m *UserIntentRequest) XXX_Unmarshal(b []byte) error {
@@ -2309,7 +2411,7 @@ func (m *TriggerRequest) Reset()         { *m = TriggerRequest{} }
 func (m *TriggerRequest) String() string { return proto.CompactTextString(m) }
 func (*TriggerRequest) ProtoMessage()    {}
 func (*TriggerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ef8072bea85606e, []int{28}
+	return fileDescriptor_9ef8072bea85606e, []int{29}
 }
 
 func (m *TriggerRequest) XXX_Unmarshal(b []byte) error {
@@ -2351,7 +2453,7 @@ func (m *TriggerState) Reset()         { *m = TriggerState{} }
 func (m *TriggerState) String() string { return proto.CompactTextString(m) }
 func (*TriggerState) ProtoMessage()    {}
 func (*TriggerState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ef8072bea85606e, []int{29}
+	return fileDescriptor_9ef8072bea85606e, []int{30}
 }
 
 func (m *TriggerState) XXX_Unmarshal(b []byte) error {
@@ -2417,7 +2519,7 @@ func (m *Intent) Reset()         { *m = Intent{} }
 func (m *Intent) String() string { return proto.CompactTextString(m) }
 func (*Intent) ProtoMessage()    {}
 func (*Intent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ef8072bea85606e, []int{30}
+	return fileDescriptor_9ef8072bea85606e, []int{31}
 }
 
 func (m *Intent) XXX_Unmarshal(b []byte) error {
@@ -2472,7 +2574,7 @@ func (m *Suggestion) Reset()         { *m = Suggestion{} }
 func (m *Suggestion) String() string { return proto.CompactTextString(m) }
 func (*Suggestion) ProtoMessage()    {}
 func (*Suggestion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ef8072bea85606e, []int{31}
+	return fileDescriptor_9ef8072bea85606e, []int{32}
 }
 
 func (m *Suggestion) XXX_Unmarshal(b []byte) error {
@@ -2521,7 +2623,7 @@ func (m *IntOrString) Reset()         { *m = IntOrString{} }
 func (m *IntOrString) String() string { return proto.CompactTextString(m) }
 func (*IntOrString) ProtoMessage()    {}
 func (*IntOrString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ef8072bea85606e, []int{32}
+	return fileDescriptor_9ef8072bea85606e, []int{33}
 }
 
 func (m *IntOrString) XXX_Unmarshal(b []byte) error {
@@ -2597,6 +2699,7 @@ func init() {
 	proto.RegisterType((*ResourceStatusCheckEvent)(nil), "proto.ResourceStatusCheckEvent")
 	proto.RegisterType((*PortEvent)(nil), "proto.PortEvent")
 	proto.RegisterType((*FileSyncEvent)(nil), "proto.FileSyncEvent")
+	proto.RegisterType((*DriftEvent)(nil), "proto.DriftEvent")
 	proto.RegisterType((*DebuggingContainerEvent)(nil), "proto.DebuggingContainerEvent")
 	proto.RegisterMapType((map[string]uint32)(nil), "proto.DebuggingContainerEvent.DebugPortsEntry")
 	proto.RegisterType((*LogEntry)(nil), "proto.LogEntry")
@@ -2611,137 +2714,140 @@ func init() {
 func init() { proto.RegisterFile("v1/skaffold.proto", fileDescriptor_9ef8072bea85606e) }
 
 var fileDescriptor_9ef8072bea85606e = []byte{
-	// 2066 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4f, 0x73, 0x1b, 0x49,
-	0x15, 0xf7, 0x48, 0x1a, 0x49, 0xf3, 0x24, 0xd9, 0x72, 0x27, 0xb6, 0xc5, 0x24, 0x24, 0x66, 0x2a,
-	0x1b, 0x42, 0x76, 0x57, 0x8a, 0x9d, 0x2d, 0x58, 0x4c, 0x02, 0x95, 0xd8, 0xde, 0x38, 0x4b, 0x48,
-	0x42, 0xcb, 0x50, 0x14, 0x05, 0x95, 0x1a, 0x4b, 0xed, 0xd9, 0x29, 0x4b, 0x33, 0x62, 0x66, 0xe4,
-	0x45, 0x17, 0x0a, 0xf8, 0x00, 0x54, 0x6d, 0xf1, 0x1d, 0xf8, 0x0e, 0x1c, 0x39, 0xec, 0x27, 0xd8,
-	0x13, 0x14, 0x47, 0x28, 0x8e, 0x5c, 0xf8, 0x00, 0x54, 0xff, 0x9b, 0xe9, 0x96, 0x66, 0x2c, 0x3b,
-	0x29, 0x8a, 0x4b, 0xa2, 0x7e, 0xfd, 0x7b, 0x7f, 0xfb, 0xf5, 0x7b, 0x6f, 0xda, 0xb0, 0x7e, 0xbe,
-	0xd3, 0x8b, 0xcf, 0xdc, 0xd3, 0xd3, 0x70, 0x34, 0xec, 0x4e, 0xa2, 0x30, 0x09, 0x91, 0xc9, 0xfe,
-	0xb3, 0x6f, 0x7a, 0x61, 0xe8, 0x8d, 0x48, 0xcf, 0x9d, 0xf8, 0x3d, 0x37, 0x08, 0xc2, 0xc4, 0x4d,
-	0xfc, 0x30, 0x88, 0x39, 0xc8, 0xbe, 0x2d, 0x76, 0xd9, 0xea, 0x64, 0x7a, 0xda, 0x4b, 0xfc, 0x31,
-	0x89, 0x13, 0x77, 0x3c, 0x11, 0x80, 0x1b, 0xf3, 0x00, 0x32, 0x9e, 0x24, 0x33, 0xb1, 0xb9, 0x4e,
-	0x82, 0xe9, 0x38, 0xee, 0xb1, 0x7f, 0x39, 0xc9, 0x79, 0x08, 0xad, 0x7e, 0xe2, 0x26, 0x04, 0x93,
-	0x78, 0x12, 0x06, 0x31, 0x41, 0x0e, 0x98, 0x31, 0x25, 0x74, 0x8c, 0x6d, 0xe3, 0x5e, 0x63, 0xb7,
-	0xc9, 0x71, 0x5d, 0x0e, 0xe2, 0x5b, 0xce, 0x4d, 0xa8, 0xa7, 0xf8, 0x36, 0x94, 0xc7, 0xb1, 0xc7,
-	0xd0, 0x16, 0xa6, 0x3f, 0x9d, 0xaf, 0x43, 0x0d, 0x93, 0x5f, 0x4d, 0x49, 0x9c, 0x20, 0x04, 0x95,
-	0xc0, 0x1d, 0x13, 0xb1, 0xcb, 0x7e, 0x3b, 0x5f, 0x56, 0xc0, 0x64, 0xd2, 0xd0, 0x0e, 0xc0, 0xc9,
-	0xd4, 0x1f, 0x0d, 0xfb, 0x8a, 0xbe, 0x75, 0xa1, 0xef, 0x69, 0xba, 0x81, 0x15, 0x10, 0xfa, 0x08,
-	0x1a, 0x43, 0x32, 0x19, 0x85, 0x33, 0xce, 0x53, 0x62, 0x3c, 0x48, 0xf0, 0x1c, 0x64, 0x3b, 0x58,
-	0x85, 0xa1, 0x23, 0x58, 0x3d, 0x0d, 0xa3, 0xcf, 0xdd, 0x68, 0x48, 0x86, 0xaf, 0xc3, 0x28, 0x89,
-	0x3b, 0x95, 0xed, 0xf2, 0xbd, 0xc6, 0xee, 0xb6, 0xea, 0x5c, 0xf7, 0x13, 0x0d, 0x72, 0x18, 0x24,
-	0xd1, 0x0c, 0xcf, 0xf1, 0xa1, 0x7d, 0x68, 0xd3, 0x10, 0x4c, 0xe3, 0xfd, 0xcf, 0xc8, 0xe0, 0x8c,
-	0x1b, 0x61, 0x32, 0x23, 0xb6, 0x14, 0x59, 0xea, 0x36, 0x5e, 0x60, 0x40, 0x7b, 0xd0, 0x3a, 0xf5,
-	0x47, 0xa4, 0x3f, 0x0b, 0x06, 0x5c, 0x42, 0x95, 0x49, 0xb8, 0x2e, 0x24, 0x7c, 0xa2, 0xee, 0x61,
-	0x1d, 0x8a, 0x5e, 0xc3, 0xb5, 0x21, 0x39, 0x99, 0x7a, 0x9e, 0x1f, 0x78, 0xfb, 0x61, 0x90, 0xb8,
-	0x7e, 0x40, 0xa2, 0xb8, 0x53, 0x63, 0xfe, 0xdc, 0x4a, 0x03, 0x31, 0x8f, 0x38, 0x3c, 0x27, 0x41,
-	0x82, 0xf3, 0x58, 0xd1, 0xfb, 0x50, 0x1f, 0x93, 0xc4, 0x1d, 0xba, 0x89, 0xdb, 0xa9, 0x33, 0x43,
-	0xd6, 0x84, 0x98, 0x1f, 0x09, 0x32, 0x4e, 0x01, 0xa8, 0x0b, 0x56, 0x42, 0xe2, 0x84, 0x9b, 0x6d,
-	0x31, 0x74, 0x5b, 0xa0, 0x8f, 0x25, 0x1d, 0x67, 0x10, 0xbb, 0x0f, 0xd7, 0x72, 0xc2, 0x4a, 0x93,
-	0xe6, 0x8c, 0xcc, 0xd8, 0x91, 0x9b, 0x98, 0xfe, 0x44, 0x77, 0xc1, 0x3c, 0x77, 0x47, 0x53, 0x79,
-	0xa4, 0x52, 0x28, 0xe5, 0xe1, 0xb6, 0xf3, 0xed, 0xbd, 0xd2, 0xc7, 0xc6, 0xa7, 0x95, 0x7a, 0xb9,
-	0x5d, 0x71, 0xfe, 0x50, 0x82, 0xba, 0xb4, 0x10, 0xdd, 0x07, 0x93, 0x65, 0x89, 0xc8, 0xa2, 0xeb,
-	0x6a, 0x16, 0xa5, 0x6e, 0x70, 0x08, 0xfa, 0x10, 0xaa, 0x3c, 0x39, 0x84, 0xae, 0x0d, 0x2d, 0x7d,
-	0x52, 0xb4, 0x00, 0xa1, 0x6f, 0x42, 0x85, 0xfa, 0xd3, 0x29, 0x33, 0xf0, 0x35, 0xc5, 0xdb, 0x14,
-	0xca, 0x00, 0xe8, 0x07, 0x00, 0xee, 0x70, 0xe8, 0xd3, 0xeb, 0xea, 0x8e, 0x3a, 0x03, 0x76, 0x22,
-	0xb7, 0xe7, 0x42, 0xd9, 0x7d, 0x92, 0x22, 0x78, 0x82, 0x29, 0x2c, 0xf6, 0x63, 0x58, 0x9b, 0xdb,
-	0x56, 0x03, 0x65, 0xf1, 0x40, 0x5d, 0x57, 0x03, 0x65, 0x29, 0x61, 0x71, 0x7e, 0x57, 0x86, 0x96,
-	0xe6, 0x30, 0xfa, 0x00, 0xd6, 0x83, 0xe9, 0xf8, 0x84, 0x44, 0xaf, 0x4e, 0x9f, 0x44, 0x89, 0x7f,
-	0xea, 0x0e, 0x92, 0x58, 0x04, 0x7d, 0x71, 0x03, 0x3d, 0x86, 0x3a, 0x0b, 0x10, 0xcd, 0xa7, 0x12,
-	0xb3, 0xfe, 0x1b, 0x79, 0x61, 0xec, 0x3e, 0x1f, 0xbb, 0x1e, 0x79, 0xca, 0x91, 0x38, 0x65, 0x41,
-	0xf7, 0xa1, 0x92, 0xcc, 0x26, 0x84, 0xc5, 0x69, 0x75, 0x77, 0x53, 0xb0, 0xf2, 0x5a, 0xc3, 0xd0,
-	0xc7, 0xb3, 0x09, 0xc1, 0x0c, 0x83, 0x0e, 0x72, 0x42, 0x75, 0x27, 0x57, 0xd9, 0x45, 0xf1, 0xc2,
-	0xd0, 0x54, 0x6d, 0x41, 0x1f, 0x08, 0x0b, 0x0c, 0x66, 0x41, 0x67, 0xd1, 0x02, 0x12, 0x29, 0x36,
-	0x5c, 0x07, 0x73, 0x10, 0x4e, 0x83, 0x84, 0x05, 0xd2, 0xc4, 0x7c, 0xf1, 0xae, 0x67, 0xf0, 0x85,
-	0x01, 0x4d, 0x35, 0x35, 0xd0, 0x47, 0x50, 0xa3, 0x6b, 0x1a, 0x53, 0x83, 0xb9, 0x69, 0xe7, 0x24,
-	0x50, 0x97, 0x43, 0xb0, 0x84, 0xda, 0x3f, 0x84, 0x2a, 0xff, 0x89, 0xde, 0xd7, 0x7c, 0xda, 0xd2,
-	0x7c, 0xe2, 0x90, 0x65, 0x2e, 0x39, 0x5f, 0x19, 0xb0, 0xaa, 0xe7, 0x36, 0x7a, 0x04, 0x16, 0xcf,
-	0xee, 0xcc, 0xae, 0x5b, 0xb9, 0xb7, 0x40, 0x2c, 0x49, 0x84, 0x33, 0x06, 0xb4, 0x0b, 0xb5, 0xc1,
-	0x68, 0x4a, 0x75, 0x33, 0x45, 0xf3, 0xa1, 0xde, 0xe7, 0x7b, 0xcc, 0x2e, 0x09, 0xb4, 0x5f, 0x41,
-	0x5d, 0x8a, 0x42, 0x1f, 0x6a, 0x3e, 0x7d, 0x4d, 0x63, 0x96, 0xa0, 0xa5, 0x5e, 0xfd, 0xd3, 0x00,
-	0xc8, 0x9a, 0x04, 0xfa, 0x3e, 0x58, 0xae, 0x92, 0xe2, 0x6a, 0x75, 0xcf, 0x50, 0xdd, 0x34, 0xd9,
-	0x79, 0x32, 0x65, 0x2c, 0x68, 0x1b, 0x1a, 0xee, 0x34, 0x09, 0x8f, 0x23, 0xdf, 0xf3, 0x84, 0x5f,
-	0x75, 0xac, 0x92, 0xd0, 0x77, 0x00, 0x44, 0x25, 0x0f, 0x87, 0x32, 0xcb, 0xf5, 0xf3, 0xe8, 0xa7,
-	0xdb, 0x58, 0x81, 0xda, 0x8f, 0x60, 0x55, 0xd7, 0x7b, 0xa5, 0x8c, 0xfa, 0x05, 0x58, 0x69, 0x65,
-	0x45, 0x9b, 0x50, 0xe5, 0x82, 0x05, 0xaf, 0x58, 0xcd, 0xd9, 0x56, 0xba, 0xb4, 0x6d, 0xce, 0x6f,
-	0x0d, 0x68, 0x28, 0x6d, 0xb3, 0x50, 0xc1, 0xff, 0x2e, 0x3c, 0xce, 0xbf, 0x0c, 0x68, 0xcf, 0x37,
-	0xcd, 0x42, 0x3b, 0x0e, 0xc0, 0x8a, 0x48, 0x1c, 0x4e, 0xa3, 0x01, 0x91, 0x45, 0xea, 0x6e, 0x41,
-	0xe3, 0xed, 0x62, 0x09, 0x14, 0x87, 0x9d, 0x32, 0xbe, 0xd3, 0x51, 0xea, 0x52, 0xaf, 0x74, 0x94,
-	0xcf, 0xa1, 0xa5, 0xf5, 0xf6, 0xb7, 0x8f, 0xb6, 0xf3, 0x37, 0x13, 0x4c, 0xd6, 0x17, 0xd1, 0x03,
-	0xb0, 0x68, 0x77, 0x66, 0x0b, 0xd1, 0xfd, 0xda, 0x4a, 0xd3, 0x61, 0xf4, 0xa3, 0x15, 0x9c, 0x81,
-	0xd0, 0x43, 0x31, 0x76, 0x71, 0x96, 0xd2, 0xe2, 0xd8, 0x25, 0x79, 0x14, 0x18, 0xfa, 0xb6, 0x1c,
-	0xbc, 0x38, 0x57, 0x39, 0x67, 0xf0, 0x92, 0x6c, 0x2a, 0x90, 0x9a, 0x37, 0x91, 0x3d, 0xbc, 0x53,
-	0xc9, 0xef, 0xed, 0xd4, 0xbc, 0x14, 0x84, 0x0e, 0xb5, 0x11, 0x8b, 0x33, 0x16, 0x8e, 0x58, 0x92,
-	0x7f, 0x81, 0x05, 0xfd, 0x12, 0x3a, 0xf2, 0xc0, 0xe7, 0xf1, 0x62, 0xde, 0x92, 0xbd, 0x19, 0x17,
-	0xc0, 0x8e, 0x56, 0x70, 0xa1, 0x08, 0xf4, 0x28, 0x9b, 0xe1, 0xb8, 0xcc, 0x5a, 0xee, 0x0c, 0x27,
-	0x05, 0xe9, 0x60, 0xf4, 0x73, 0xd8, 0x1a, 0xe6, 0xcf, 0x68, 0x62, 0x04, 0x5b, 0x32, 0xc9, 0x1d,
-	0xad, 0xe0, 0x22, 0x01, 0xe8, 0xbb, 0xd0, 0x1c, 0x92, 0xf3, 0x17, 0x61, 0x38, 0xe1, 0x02, 0x2d,
-	0x6d, 0x6e, 0x39, 0x50, 0xb6, 0x8e, 0x56, 0xb0, 0x06, 0xa5, 0xa1, 0x4f, 0x48, 0x34, 0xf6, 0x03,
-	0xf6, 0xcd, 0xc1, 0xd9, 0x41, 0x0b, 0xfd, 0xf1, 0xdc, 0x36, 0x0d, 0xfd, 0x3c, 0x0b, 0x3d, 0x73,
-	0x5a, 0xb2, 0x38, 0x7f, 0x63, 0x61, 0x48, 0x4c, 0xcf, 0x3c, 0x5d, 0x3c, 0x6d, 0x02, 0x10, 0xfa,
-	0xe3, 0x0d, 0x2d, 0xf8, 0x0e, 0x86, 0xf6, 0xbc, 0x9e, 0xc2, 0xab, 0x72, 0x17, 0xca, 0x24, 0x8a,
-	0x44, 0x16, 0xcb, 0xe8, 0x3f, 0x19, 0xb0, 0xfe, 0x7d, 0x32, 0x22, 0x87, 0x51, 0x84, 0x29, 0xc0,
-	0x19, 0x41, 0x53, 0x75, 0x1d, 0xdd, 0x04, 0xcb, 0x4f, 0x48, 0xc4, 0x34, 0x88, 0x91, 0x28, 0x23,
-	0x28, 0xda, 0x4a, 0x79, 0xda, 0xca, 0xcb, 0xb4, 0x7d, 0x61, 0x40, 0x4b, 0x23, 0xa3, 0x1d, 0xa8,
-	0x91, 0x28, 0x62, 0xf5, 0xc6, 0xb8, 0xb8, 0xde, 0x48, 0x1c, 0xea, 0x40, 0x6d, 0x4c, 0xe2, 0xd8,
-	0xf5, 0x64, 0x29, 0x91, 0x4b, 0xf4, 0x10, 0x1a, 0xf1, 0xd4, 0xf3, 0x48, 0xcc, 0x3e, 0x0d, 0x3b,
-	0x65, 0x56, 0x07, 0xe5, 0x15, 0xee, 0xa7, 0x3b, 0x58, 0x45, 0x39, 0x2f, 0xc1, 0x4a, 0x0b, 0x02,
-	0x2d, 0x52, 0x84, 0xd6, 0x2f, 0x11, 0x4d, 0xbe, 0xd0, 0x3e, 0x05, 0x4a, 0x4b, 0x3e, 0x05, 0x9c,
-	0xbf, 0xc8, 0x06, 0xcc, 0x25, 0xda, 0x50, 0x97, 0xdd, 0x54, 0x08, 0x4d, 0xd7, 0x85, 0xe1, 0x6c,
-	0x67, 0xe1, 0xb4, 0x58, 0xe0, 0xd4, 0x30, 0x55, 0x2e, 0x19, 0xa6, 0x3d, 0x68, 0xb9, 0x6a, 0xa8,
-	0x45, 0xb1, 0xc8, 0x3f, 0x1d, 0x1d, 0xea, 0xbc, 0x51, 0x32, 0xb5, 0x30, 0xc5, 0x16, 0x14, 0x94,
-	0x2e, 0xaf, 0xe0, 0x4f, 0x69, 0x7f, 0xbd, 0x58, 0x47, 0x3b, 0x4b, 0xe3, 0xc5, 0x48, 0x94, 0xdf,
-	0x36, 0x12, 0x95, 0xcb, 0x1b, 0xfa, 0xa5, 0xde, 0x85, 0x2f, 0xb6, 0xb6, 0x38, 0x33, 0xff, 0xef,
-	0x27, 0xfa, 0x6f, 0x03, 0x3a, 0x45, 0x05, 0x9d, 0xe6, 0xa8, 0x2c, 0xe8, 0x32, 0x47, 0xe5, 0xba,
-	0x30, 0x47, 0x15, 0x5f, 0xcb, 0xb9, 0xbe, 0x56, 0x32, 0x5f, 0xf5, 0xb9, 0xc2, 0xbc, 0xf4, 0x5c,
-	0xb1, 0xe8, 0x71, 0xf5, 0xf2, 0x1e, 0xff, 0xb5, 0x04, 0x56, 0xda, 0x4a, 0x69, 0x5d, 0x1b, 0x85,
-	0x03, 0x77, 0x44, 0x29, 0xb2, 0xae, 0xa5, 0x04, 0x74, 0x0b, 0x20, 0x22, 0xe3, 0x30, 0x21, 0x6c,
-	0x9b, 0xcf, 0xd3, 0x0a, 0x85, 0x3a, 0x3b, 0x09, 0x87, 0x2f, 0xdd, 0x71, 0xea, 0xac, 0x58, 0xa2,
-	0x3b, 0xd0, 0x1a, 0xc8, 0x3e, 0xc3, 0xf6, 0xb9, 0xdb, 0x3a, 0x91, 0x6a, 0x0f, 0xdc, 0x31, 0x89,
-	0x27, 0xee, 0x80, 0xfb, 0x6f, 0xe1, 0x8c, 0x40, 0xc3, 0x4f, 0xdb, 0x3c, 0x63, 0xaf, 0xf2, 0xf0,
-	0xcb, 0x35, 0x72, 0xa0, 0x29, 0x8f, 0x82, 0x8e, 0xfe, 0xac, 0x9d, 0x5a, 0x58, 0xa3, 0xa9, 0x18,
-	0x26, 0xa3, 0xae, 0x63, 0x98, 0x9c, 0x0e, 0xd4, 0xdc, 0xe1, 0x30, 0x22, 0x71, 0xcc, 0x1a, 0x9f,
-	0x85, 0xe5, 0x12, 0xed, 0x02, 0x24, 0x6e, 0xe4, 0x91, 0x84, 0xf9, 0x0e, 0xda, 0x00, 0xf3, 0x3c,
-	0x48, 0x5e, 0x45, 0xfd, 0x24, 0xf2, 0x03, 0x0f, 0x2b, 0x28, 0xe7, 0xef, 0x46, 0x36, 0xb2, 0xa5,
-	0xf1, 0xa5, 0xad, 0x7c, 0x9f, 0x7d, 0x90, 0x88, 0xf8, 0xa6, 0x04, 0x5a, 0x56, 0xfd, 0x71, 0x76,
-	0x2d, 0xf8, 0x42, 0x49, 0xad, 0x72, 0xde, 0xa5, 0xaf, 0xe4, 0x5e, 0x16, 0xf3, 0x6d, 0x2f, 0xcb,
-	0x15, 0x52, 0xe7, 0x3f, 0x25, 0xd8, 0x2a, 0x98, 0x30, 0x2e, 0xba, 0xfb, 0x32, 0x45, 0x4a, 0x4b,
-	0x52, 0xa4, 0xbc, 0x34, 0x45, 0x2a, 0x39, 0x29, 0x92, 0x76, 0x11, 0x73, 0xae, 0x8b, 0x74, 0xa0,
-	0x16, 0x4d, 0x83, 0xc4, 0x4f, 0xb3, 0x47, 0x2e, 0x69, 0x5a, 0x7f, 0x1e, 0x46, 0x67, 0x7e, 0xe0,
-	0x1d, 0xf8, 0x91, 0x48, 0x1d, 0x85, 0x82, 0x5e, 0x02, 0xb0, 0x69, 0x89, 0xbf, 0xfd, 0xd5, 0x59,
-	0xbb, 0xec, 0x5e, 0x3c, 0x61, 0x71, 0xba, 0xf2, 0x12, 0xa8, 0x48, 0xb0, 0x1f, 0xc3, 0xda, 0xdc,
-	0xf6, 0xb2, 0xef, 0x80, 0x96, 0xfa, 0x1d, 0xf0, 0x1b, 0xa8, 0xbf, 0x08, 0x3d, 0xce, 0xf7, 0x31,
-	0x58, 0xe9, 0x13, 0xae, 0x18, 0xdf, 0xed, 0x2e, 0x7f, 0xc3, 0xed, 0xca, 0x37, 0xdc, 0xee, 0xb1,
-	0x44, 0xe0, 0x0c, 0x8c, 0x1c, 0x30, 0x89, 0x32, 0xc1, 0xcb, 0x87, 0x5a, 0xf1, 0x5a, 0x46, 0xf4,
-	0x36, 0x5f, 0x56, 0xda, 0xbc, 0xb3, 0x07, 0xeb, 0x3f, 0x89, 0x49, 0xf4, 0x3c, 0x48, 0x28, 0x54,
-	0x3c, 0xd5, 0xbe, 0x07, 0x55, 0x9f, 0x11, 0x84, 0x15, 0xad, 0xec, 0x6a, 0x50, 0x94, 0xd8, 0x74,
-	0xbe, 0x07, 0xab, 0xe2, 0x1b, 0x44, 0x32, 0x7e, 0x4b, 0x7f, 0x30, 0x4e, 0x1f, 0xc8, 0x38, 0x4a,
-	0x7b, 0x37, 0xde, 0x81, 0xa6, 0x4a, 0x46, 0x36, 0xd4, 0x08, 0x4b, 0x46, 0xfe, 0x6e, 0x57, 0x3f,
-	0x5a, 0xc1, 0x92, 0xf0, 0xd4, 0x84, 0xf2, 0xb9, 0x3b, 0x72, 0x3e, 0x85, 0x2a, 0xb7, 0x80, 0xfa,
-	0x92, 0x3d, 0xf1, 0xd5, 0xe5, 0x63, 0x1e, 0x82, 0x4a, 0x3c, 0x0b, 0x06, 0xe2, 0x1b, 0x89, 0xfd,
-	0xa6, 0xa9, 0x2b, 0x1e, 0xf8, 0xca, 0x8c, 0x2a, 0x56, 0x8e, 0x0f, 0x90, 0x0d, 0x47, 0x68, 0x1f,
-	0x56, 0xb3, 0xf1, 0x48, 0x19, 0xcc, 0x6e, 0xe8, 0x57, 0x4e, 0x83, 0xe0, 0x39, 0x16, 0xaa, 0x8a,
-	0x5f, 0x29, 0xd9, 0x35, 0xf8, 0xca, 0xf9, 0x31, 0x34, 0x94, 0x9a, 0x42, 0xad, 0x4c, 0x5f, 0x3c,
-	0x4c, 0xf1, 0xac, 0xb1, 0xc9, 0x02, 0xfe, 0x53, 0x77, 0x24, 0xea, 0xb0, 0x58, 0xf1, 0x8b, 0x17,
-	0x51, 0x7a, 0x5a, 0x2d, 0xe8, 0x6a, 0xf7, 0xcf, 0x26, 0xac, 0xf5, 0xc5, 0x9f, 0x0c, 0xfa, 0x24,
-	0x3a, 0xf7, 0x07, 0x04, 0xed, 0x43, 0xfd, 0x19, 0x91, 0x6f, 0x03, 0x0b, 0x69, 0x73, 0x38, 0x9e,
-	0x24, 0x33, 0x5b, 0x7b, 0xc1, 0x77, 0xd6, 0x7f, 0xff, 0xd5, 0x3f, 0xfe, 0x58, 0x6a, 0x20, 0xab,
-	0x77, 0xbe, 0xd3, 0x63, 0xa7, 0x82, 0x9e, 0x41, 0x9d, 0x25, 0xcd, 0x8b, 0xd0, 0x43, 0x72, 0xde,
-	0x93, 0xf9, 0x69, 0xcf, 0x13, 0x9c, 0x0d, 0x26, 0x60, 0x0d, 0xb5, 0xa8, 0x00, 0x3e, 0xb4, 0x8f,
-	0x42, 0xef, 0x9e, 0xf1, 0xc0, 0x40, 0xcf, 0xa0, 0xca, 0x04, 0xc5, 0x85, 0xb6, 0x2c, 0x48, 0x43,
-	0x4c, 0x5a, 0x13, 0x41, 0x2a, 0x2d, 0x7e, 0x60, 0xa0, 0x9f, 0x41, 0xed, 0xf0, 0xd7, 0x64, 0x30,
-	0x4d, 0x08, 0x92, 0x4f, 0x4b, 0x0b, 0x09, 0x6b, 0x17, 0xe8, 0x70, 0x6e, 0x30, 0x91, 0x1b, 0x4e,
-	0x83, 0x89, 0xe4, 0x62, 0xf6, 0x44, 0xfa, 0x22, 0x17, 0xac, 0x27, 0xd3, 0x24, 0x64, 0x73, 0x2b,
-	0xda, 0xd0, 0x53, 0x75, 0x99, 0xe0, 0xf7, 0x98, 0xe0, 0xdb, 0xf6, 0x26, 0x15, 0xcc, 0xb2, 0xaf,
-	0x47, 0x3f, 0xc9, 0xdf, 0x48, 0x1d, 0x3c, 0xc9, 0xd1, 0x1b, 0xa8, 0x53, 0x15, 0xb4, 0x65, 0x5c,
-	0x55, 0xc3, 0x1d, 0xa6, 0xe1, 0x96, 0xbd, 0xc1, 0x0e, 0x67, 0x16, 0x0c, 0x72, 0x15, 0x0c, 0x00,
-	0xa8, 0x02, 0x3e, 0x56, 0x5e, 0x55, 0xc5, 0x5d, 0xa6, 0x62, 0xdb, 0xde, 0xa2, 0x2a, 0xf8, 0xbd,
-	0xc8, 0x55, 0xf2, 0x02, 0xaa, 0x47, 0x6e, 0x30, 0x1c, 0x11, 0xa4, 0x15, 0x96, 0x42, 0xb9, 0x37,
-	0x99, 0xdc, 0x4d, 0x67, 0x3d, 0x3b, 0xc8, 0xde, 0x67, 0x4c, 0xc0, 0x9e, 0x71, 0xff, 0x75, 0xf9,
-	0xa4, 0xca, 0xf0, 0x0f, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x04, 0x2e, 0xed, 0x6c, 0xf9, 0x1a,
-	0x00, 0x00,
+	// 2113 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdd, 0x72, 0xdc, 0x48,
+	0x15, 0x8e, 0x46, 0xf3, 0xa7, 0x33, 0x33, 0x8e, 0xdd, 0x89, 0x6d, 0x31, 0x09, 0x89, 0x51, 0x65,
+	0x43, 0xc8, 0xee, 0x8e, 0x13, 0x67, 0x0b, 0x16, 0x93, 0x85, 0x4a, 0x6c, 0x6f, 0x9c, 0x25, 0x9b,
+	0x84, 0xb6, 0xa1, 0x28, 0x0a, 0x2a, 0x25, 0x8f, 0xda, 0x5a, 0x55, 0x34, 0xd2, 0xa0, 0x1f, 0x2f,
+	0xbe, 0xa1, 0x80, 0x07, 0x80, 0xda, 0xe2, 0x1d, 0x78, 0x00, 0xee, 0xb8, 0xe4, 0x62, 0x9f, 0x60,
+	0xaf, 0xa8, 0xe2, 0x12, 0x8a, 0x4b, 0x6e, 0x78, 0x00, 0xaa, 0xff, 0xa4, 0xee, 0x19, 0xc9, 0x63,
+	0x27, 0x45, 0x71, 0x63, 0xab, 0xbb, 0xbf, 0xf3, 0xdb, 0xa7, 0xfb, 0x9c, 0x3e, 0x03, 0x2b, 0x27,
+	0xf7, 0x37, 0xd3, 0xd7, 0xee, 0xf1, 0x71, 0x1c, 0x7a, 0xa3, 0x69, 0x12, 0x67, 0x31, 0x6a, 0xb1,
+	0x7f, 0xc3, 0xeb, 0x7e, 0x1c, 0xfb, 0x21, 0xd9, 0x74, 0xa7, 0xc1, 0xa6, 0x1b, 0x45, 0x71, 0xe6,
+	0x66, 0x41, 0x1c, 0xa5, 0x1c, 0x34, 0xbc, 0x29, 0x56, 0xd9, 0xe8, 0x28, 0x3f, 0xde, 0xcc, 0x82,
+	0x09, 0x49, 0x33, 0x77, 0x32, 0x15, 0x80, 0x6b, 0xb3, 0x00, 0x32, 0x99, 0x66, 0xa7, 0x62, 0x71,
+	0x85, 0x44, 0xf9, 0x24, 0xdd, 0x64, 0x7f, 0xf9, 0x94, 0xf3, 0x00, 0x06, 0x07, 0x99, 0x9b, 0x11,
+	0x4c, 0xd2, 0x69, 0x1c, 0xa5, 0x04, 0x39, 0xd0, 0x4a, 0xe9, 0x84, 0x6d, 0x6c, 0x18, 0x77, 0x7a,
+	0x5b, 0x7d, 0x8e, 0x1b, 0x71, 0x10, 0x5f, 0x72, 0xae, 0x43, 0xb7, 0xc0, 0x2f, 0x83, 0x39, 0x49,
+	0x7d, 0x86, 0xb6, 0x30, 0xfd, 0x74, 0xbe, 0x0e, 0x1d, 0x4c, 0x7e, 0x99, 0x93, 0x34, 0x43, 0x08,
+	0x9a, 0x91, 0x3b, 0x21, 0x62, 0x95, 0x7d, 0x3b, 0x5f, 0x36, 0xa1, 0xc5, 0xb8, 0xa1, 0xfb, 0x00,
+	0x47, 0x79, 0x10, 0x7a, 0x07, 0x8a, 0xbc, 0x15, 0x21, 0xef, 0x71, 0xb1, 0x80, 0x15, 0x10, 0xfa,
+	0x00, 0x7a, 0x1e, 0x99, 0x86, 0xf1, 0x29, 0xa7, 0x69, 0x30, 0x1a, 0x24, 0x68, 0x76, 0xcb, 0x15,
+	0xac, 0xc2, 0xd0, 0x3e, 0x2c, 0x1d, 0xc7, 0xc9, 0xe7, 0x6e, 0xe2, 0x11, 0xef, 0x65, 0x9c, 0x64,
+	0xa9, 0xdd, 0xdc, 0x30, 0xef, 0xf4, 0xb6, 0x36, 0x54, 0xe3, 0x46, 0x1f, 0x6b, 0x90, 0xbd, 0x28,
+	0x4b, 0x4e, 0xf1, 0x0c, 0x1d, 0xda, 0x81, 0x65, 0xea, 0x82, 0x3c, 0xdd, 0xf9, 0x8c, 0x8c, 0x5f,
+	0x73, 0x25, 0x5a, 0x4c, 0x89, 0x75, 0x85, 0x97, 0xba, 0x8c, 0xe7, 0x08, 0xd0, 0x36, 0x0c, 0x8e,
+	0x83, 0x90, 0x1c, 0x9c, 0x46, 0x63, 0xce, 0xa1, 0xcd, 0x38, 0x5c, 0x15, 0x1c, 0x3e, 0x56, 0xd7,
+	0xb0, 0x0e, 0x45, 0x2f, 0xe1, 0x8a, 0x47, 0x8e, 0x72, 0xdf, 0x0f, 0x22, 0x7f, 0x27, 0x8e, 0x32,
+	0x37, 0x88, 0x48, 0x92, 0xda, 0x1d, 0x66, 0xcf, 0x8d, 0xc2, 0x11, 0xb3, 0x88, 0xbd, 0x13, 0x12,
+	0x65, 0xb8, 0x8a, 0x14, 0xbd, 0x0b, 0xdd, 0x09, 0xc9, 0x5c, 0xcf, 0xcd, 0x5c, 0xbb, 0xcb, 0x14,
+	0xb9, 0x2c, 0xd8, 0x7c, 0x2a, 0xa6, 0x71, 0x01, 0x40, 0x23, 0xb0, 0x32, 0x92, 0x66, 0x5c, 0x6d,
+	0x8b, 0xa1, 0x97, 0x05, 0xfa, 0x50, 0xce, 0xe3, 0x12, 0x32, 0x3c, 0x80, 0x2b, 0x15, 0x6e, 0xa5,
+	0x41, 0xf3, 0x9a, 0x9c, 0xb2, 0x2d, 0x6f, 0x61, 0xfa, 0x89, 0x6e, 0x43, 0xeb, 0xc4, 0x0d, 0x73,
+	0xb9, 0xa5, 0x92, 0x29, 0xa5, 0xe1, 0xba, 0xf3, 0xe5, 0xed, 0xc6, 0x87, 0xc6, 0x27, 0xcd, 0xae,
+	0xb9, 0xdc, 0x74, 0x7e, 0xdf, 0x80, 0xae, 0xd4, 0x10, 0xdd, 0x85, 0x16, 0x8b, 0x12, 0xdb, 0xd0,
+	0x5c, 0xc9, 0xa2, 0xa8, 0x30, 0x83, 0x43, 0xd0, 0xfb, 0xd0, 0xe6, 0xc1, 0x21, 0x64, 0xad, 0x6a,
+	0xe1, 0x53, 0xa0, 0x05, 0x08, 0x7d, 0x13, 0x9a, 0xd4, 0x1e, 0xdb, 0x64, 0xe0, 0x2b, 0x8a, 0xb5,
+	0x05, 0x94, 0x01, 0xd0, 0x0f, 0x00, 0x5c, 0xcf, 0x0b, 0xe8, 0x71, 0x75, 0x43, 0x7b, 0xcc, 0x76,
+	0xe4, 0xe6, 0x8c, 0x2b, 0x47, 0x8f, 0x0a, 0x04, 0x0f, 0x30, 0x85, 0x64, 0xf8, 0x11, 0x5c, 0x9e,
+	0x59, 0x56, 0x1d, 0x65, 0x71, 0x47, 0x5d, 0x55, 0x1d, 0x65, 0x29, 0x6e, 0x71, 0x7e, 0x6b, 0xc2,
+	0x40, 0x33, 0x18, 0xbd, 0x07, 0x2b, 0x51, 0x3e, 0x39, 0x22, 0xc9, 0x8b, 0xe3, 0x47, 0x49, 0x16,
+	0x1c, 0xbb, 0xe3, 0x2c, 0x15, 0x4e, 0x9f, 0x5f, 0x40, 0x1f, 0x41, 0x97, 0x39, 0x88, 0xc6, 0x53,
+	0x83, 0x69, 0xff, 0x8d, 0x2a, 0x37, 0x8e, 0x9e, 0x4e, 0x5c, 0x9f, 0x3c, 0xe6, 0x48, 0x5c, 0x90,
+	0xa0, 0xbb, 0xd0, 0xcc, 0x4e, 0xa7, 0x84, 0xf9, 0x69, 0x69, 0x6b, 0x4d, 0x90, 0xf2, 0xbb, 0x86,
+	0xa1, 0x0f, 0x4f, 0xa7, 0x04, 0x33, 0x0c, 0xda, 0xad, 0x70, 0xd5, 0xad, 0x4a, 0x61, 0x67, 0xf9,
+	0x0b, 0x43, 0x5f, 0xd5, 0x05, 0xbd, 0x27, 0x34, 0x30, 0x98, 0x06, 0xf6, 0xbc, 0x06, 0x24, 0x51,
+	0x74, 0xb8, 0x0a, 0xad, 0x71, 0x9c, 0x47, 0x19, 0x73, 0x64, 0x0b, 0xf3, 0xc1, 0xdb, 0xee, 0xc1,
+	0x17, 0x06, 0xf4, 0xd5, 0xd0, 0x40, 0x1f, 0x40, 0x87, 0x8e, 0xa9, 0x4f, 0x0d, 0x66, 0xe6, 0xb0,
+	0x22, 0x80, 0x46, 0x1c, 0x82, 0x25, 0x74, 0xf8, 0x43, 0x68, 0xf3, 0x4f, 0xf4, 0xae, 0x66, 0xd3,
+	0xba, 0x66, 0x13, 0x87, 0x2c, 0x32, 0xc9, 0xf9, 0xca, 0x80, 0x25, 0x3d, 0xb6, 0xd1, 0x43, 0xb0,
+	0x78, 0x74, 0x97, 0x7a, 0xdd, 0xa8, 0x3c, 0x05, 0x62, 0x48, 0x12, 0x5c, 0x12, 0xa0, 0x2d, 0xe8,
+	0x8c, 0xc3, 0x9c, 0xca, 0xb6, 0x1b, 0x15, 0xae, 0xde, 0x09, 0xf3, 0x42, 0x2f, 0x09, 0x1c, 0xbe,
+	0x80, 0xae, 0x64, 0x85, 0xde, 0xd7, 0x6c, 0xfa, 0x9a, 0x46, 0x2c, 0x41, 0x0b, 0xad, 0xfa, 0xa7,
+	0x01, 0x50, 0x26, 0x09, 0xf4, 0x7d, 0xb0, 0x5c, 0x25, 0xc4, 0xd5, 0xdb, 0xbd, 0x44, 0x8d, 0x8a,
+	0x60, 0xe7, 0xc1, 0x54, 0x92, 0xa0, 0x0d, 0xe8, 0xb9, 0x79, 0x16, 0x1f, 0x26, 0x81, 0xef, 0x0b,
+	0xbb, 0xba, 0x58, 0x9d, 0x42, 0xdf, 0x01, 0x10, 0x37, 0x79, 0xec, 0xc9, 0x28, 0xd7, 0xf7, 0xe3,
+	0xa0, 0x58, 0xc6, 0x0a, 0x74, 0xf8, 0x10, 0x96, 0x74, 0xb9, 0x17, 0x8a, 0xa8, 0x9f, 0x83, 0x55,
+	0xdc, 0xac, 0x68, 0x0d, 0xda, 0x9c, 0xb1, 0xa0, 0x15, 0xa3, 0x19, 0xdd, 0x1a, 0xe7, 0xd6, 0xcd,
+	0xf9, 0x8d, 0x01, 0x3d, 0x25, 0x6d, 0xd6, 0x0a, 0xf8, 0xdf, 0xb9, 0xc7, 0xf9, 0x97, 0x01, 0xcb,
+	0xb3, 0x49, 0xb3, 0x56, 0x8f, 0x5d, 0xb0, 0x12, 0x92, 0xc6, 0x79, 0x32, 0x26, 0xf2, 0x92, 0xba,
+	0x5d, 0x93, 0x78, 0x47, 0x58, 0x02, 0xc5, 0x66, 0x17, 0x84, 0x6f, 0xb5, 0x95, 0x3a, 0xd7, 0x0b,
+	0x6d, 0xe5, 0x53, 0x18, 0x68, 0xb9, 0xfd, 0xcd, 0xbd, 0xed, 0xfc, 0xa1, 0x0d, 0x2d, 0x96, 0x17,
+	0xd1, 0x3d, 0xb0, 0x68, 0x76, 0x66, 0x03, 0xdb, 0xd0, 0x92, 0xe7, 0xa7, 0x72, 0x7e, 0xff, 0x12,
+	0x2e, 0x41, 0xe8, 0x81, 0x28, 0xbb, 0x38, 0x49, 0x63, 0xbe, 0xec, 0x92, 0x34, 0x0a, 0x0c, 0x7d,
+	0x5b, 0x16, 0x5e, 0x9c, 0xca, 0xac, 0x28, 0xbc, 0x24, 0x99, 0x0a, 0xa4, 0xea, 0x4d, 0x65, 0x0e,
+	0xb7, 0x9b, 0xd5, 0xb9, 0x9d, 0xaa, 0x57, 0x80, 0xd0, 0x9e, 0x56, 0x62, 0x71, 0xc2, 0xda, 0x12,
+	0x4b, 0xd2, 0xcf, 0x91, 0xa0, 0x5f, 0x80, 0x2d, 0x37, 0x7c, 0x16, 0x2f, 0xea, 0x2d, 0x99, 0x9b,
+	0x71, 0x0d, 0x6c, 0xff, 0x12, 0xae, 0x65, 0x81, 0x1e, 0x96, 0x35, 0x1c, 0xe7, 0xd9, 0xa9, 0xac,
+	0xe1, 0x24, 0x23, 0x1d, 0x8c, 0x7e, 0x06, 0xeb, 0x5e, 0x75, 0x8d, 0x26, 0x4a, 0xb0, 0x05, 0x95,
+	0xdc, 0xfe, 0x25, 0x5c, 0xc7, 0x00, 0x7d, 0x17, 0xfa, 0x1e, 0x39, 0x79, 0x16, 0xc7, 0x53, 0xce,
+	0xd0, 0xd2, 0xea, 0x96, 0x5d, 0x65, 0x69, 0xff, 0x12, 0xd6, 0xa0, 0xd4, 0xf5, 0x19, 0x49, 0x26,
+	0x41, 0xc4, 0xde, 0x1c, 0x9c, 0x1c, 0x34, 0xd7, 0x1f, 0xce, 0x2c, 0x53, 0xd7, 0xcf, 0x92, 0xa0,
+	0x7b, 0xfc, 0xca, 0xe2, 0xf4, 0xbd, 0xb9, 0x22, 0xb1, 0xd8, 0xf3, 0x62, 0x40, 0x43, 0xd2, 0x4b,
+	0x82, 0x63, 0x41, 0xd2, 0xd7, 0x42, 0x72, 0xb7, 0x58, 0xa0, 0x21, 0x59, 0xc2, 0x1e, 0xf7, 0x01,
+	0x08, 0xfd, 0x78, 0x45, 0xb3, 0x84, 0x83, 0x61, 0x79, 0x56, 0xb9, 0xda, 0xf3, 0x75, 0x1b, 0x4c,
+	0x92, 0x24, 0x76, 0x43, 0xdb, 0xb2, 0x47, 0x63, 0x4a, 0xe8, 0x1e, 0x85, 0x64, 0x2f, 0x49, 0x30,
+	0x05, 0x38, 0x21, 0xf4, 0x55, 0x7f, 0xa1, 0xeb, 0x60, 0x05, 0x19, 0x49, 0x98, 0x04, 0x51, 0x47,
+	0x95, 0x13, 0x8a, 0xb4, 0x46, 0x95, 0x34, 0x73, 0x91, 0xb4, 0x2f, 0x0c, 0x18, 0x68, 0xd3, 0xe8,
+	0x3e, 0x74, 0x48, 0x92, 0xb0, 0x4b, 0xca, 0x38, 0xfb, 0x92, 0x92, 0x38, 0x64, 0x43, 0x67, 0x42,
+	0xd2, 0xd4, 0xf5, 0xe5, 0xfd, 0x23, 0x87, 0xe8, 0x01, 0xf4, 0xd2, 0xdc, 0xf7, 0x49, 0x4a, 0x25,
+	0xa4, 0xb6, 0xb9, 0x61, 0x2a, 0x4e, 0x3e, 0x28, 0x56, 0xb0, 0x8a, 0x72, 0x9e, 0x83, 0x55, 0xdc,
+	0x22, 0xf4, 0x66, 0x23, 0xf4, 0xd2, 0x13, 0xde, 0xe4, 0x03, 0xed, 0xfd, 0xd0, 0x58, 0xf0, 0x7e,
+	0x70, 0xfe, 0x2a, 0xb3, 0x36, 0xe7, 0x38, 0x84, 0xae, 0x4c, 0xc1, 0x82, 0x69, 0x31, 0xae, 0x75,
+	0xe7, 0x72, 0xe9, 0x4e, 0x8b, 0x39, 0x4e, 0x75, 0x53, 0xf3, 0x9c, 0x6e, 0xda, 0x86, 0x81, 0xab,
+	0xba, 0xda, 0x6e, 0x9d, 0xb1, 0x3b, 0x3a, 0xd4, 0x79, 0xa5, 0x84, 0x77, 0x6d, 0x88, 0xcd, 0x09,
+	0x68, 0x9c, 0x5f, 0xc0, 0x9f, 0x8a, 0xa4, 0x7c, 0xb6, 0x8c, 0xe5, 0x32, 0x8c, 0xe7, 0x3d, 0x61,
+	0xbe, 0xa9, 0x27, 0x9a, 0xe7, 0x57, 0xf4, 0x4b, 0x3d, 0x75, 0x9f, 0xad, 0x6d, 0x7d, 0x64, 0xfe,
+	0xdf, 0x77, 0xf4, 0xdf, 0x06, 0xd8, 0x75, 0x59, 0x80, 0xc6, 0xa8, 0xcc, 0x02, 0x32, 0x46, 0xe5,
+	0xb8, 0x36, 0x46, 0x15, 0x5b, 0xcd, 0x4a, 0x5b, 0x9b, 0xa5, 0xad, 0x7a, 0x31, 0xd2, 0x3a, 0x77,
+	0x31, 0x32, 0x6f, 0x71, 0xfb, 0xfc, 0x16, 0xff, 0xad, 0x01, 0x56, 0x91, 0x7f, 0xe9, 0xbd, 0x16,
+	0xc6, 0x63, 0x37, 0xa4, 0x33, 0xf2, 0x5e, 0x2b, 0x26, 0xd0, 0x0d, 0x80, 0x84, 0x4c, 0xe2, 0x8c,
+	0xb0, 0x65, 0x5e, 0x84, 0x2b, 0x33, 0xd4, 0xd8, 0x69, 0xec, 0x3d, 0x77, 0x27, 0x85, 0xb1, 0x62,
+	0x88, 0x6e, 0xc1, 0x60, 0x2c, 0x93, 0x13, 0x5b, 0xe7, 0x66, 0xeb, 0x93, 0x54, 0x3a, 0x6d, 0x0c,
+	0xa5, 0x53, 0x77, 0xcc, 0xed, 0xb7, 0x70, 0x39, 0x41, 0xdd, 0x3f, 0x8d, 0x93, 0x8c, 0x91, 0xb7,
+	0xb9, 0xfb, 0xe5, 0x18, 0x39, 0xd0, 0x97, 0x5b, 0x41, 0xdf, 0x0b, 0x2c, 0x07, 0x5b, 0x58, 0x9b,
+	0x53, 0x31, 0x8c, 0x47, 0x57, 0xc7, 0x30, 0x3e, 0x36, 0x74, 0x5c, 0xcf, 0x4b, 0x48, 0x9a, 0xb2,
+	0x6c, 0x69, 0x61, 0x39, 0x44, 0x5b, 0x00, 0x99, 0x9b, 0xf8, 0x24, 0x63, 0xb6, 0x83, 0x56, 0xf5,
+	0x3c, 0x8d, 0xb2, 0x17, 0xc9, 0x41, 0x96, 0x04, 0x91, 0x8f, 0x15, 0x94, 0xf3, 0x77, 0xa3, 0xac,
+	0xf3, 0x0a, 0xff, 0xd2, 0xfc, 0xbf, 0xc3, 0x5e, 0x31, 0xc2, 0xbf, 0xc5, 0x04, 0xbd, 0x56, 0x83,
+	0x49, 0x79, 0x2c, 0xf8, 0x40, 0x09, 0x2d, 0xb3, 0xea, 0xd0, 0x37, 0x2b, 0x0f, 0x4b, 0xeb, 0x4d,
+	0x0f, 0xcb, 0x05, 0x42, 0xe7, 0xcf, 0x06, 0x40, 0x99, 0x93, 0xcf, 0x3c, 0x1e, 0xda, 0xce, 0x36,
+	0x66, 0x77, 0xb6, 0xce, 0xc2, 0x35, 0x68, 0x1f, 0x07, 0x24, 0xf4, 0x78, 0x97, 0xce, 0xc2, 0x62,
+	0xf4, 0x56, 0x27, 0xfc, 0x3f, 0x0d, 0x58, 0xaf, 0xa9, 0xa5, 0xce, 0xba, 0xb0, 0x64, 0x5c, 0x37,
+	0x16, 0xc4, 0xb5, 0xb9, 0x30, 0xae, 0x9b, 0x15, 0x71, 0x5d, 0xa4, 0xbe, 0xd6, 0x4c, 0xea, 0xb3,
+	0xa1, 0x93, 0xe4, 0x11, 0x6d, 0xed, 0x8a, 0x90, 0x97, 0x43, 0x7a, 0x16, 0x3f, 0x8f, 0x93, 0xd7,
+	0x41, 0xe4, 0xef, 0x06, 0x89, 0x88, 0x77, 0x65, 0x06, 0x3d, 0x07, 0x60, 0x75, 0x21, 0xef, 0x72,
+	0x76, 0x59, 0x8e, 0x1f, 0x9d, 0x5d, 0x4b, 0x8e, 0x76, 0x0b, 0x02, 0xd1, 0x62, 0x29, 0x39, 0xd0,
+	0x76, 0xc8, 0xcc, 0xf2, 0xa2, 0x17, 0xcf, 0x40, 0x7d, 0xf1, 0xfc, 0x1a, 0xba, 0xcf, 0x62, 0x9f,
+	0xd3, 0x7d, 0x08, 0x56, 0xd1, 0xac, 0x16, 0x0f, 0x95, 0xe1, 0x88, 0x77, 0xab, 0x47, 0xb2, 0x5b,
+	0x3d, 0x3a, 0x94, 0x08, 0x5c, 0x82, 0x69, 0x4b, 0x9a, 0x28, 0x6f, 0x15, 0xd9, 0x92, 0x16, 0x7d,
+	0x41, 0xa2, 0xd7, 0x26, 0xa6, 0x52, 0x9b, 0x38, 0xdb, 0xb0, 0xf2, 0xe3, 0x94, 0x24, 0x4f, 0xa3,
+	0x8c, 0x42, 0x45, 0x53, 0xfa, 0x1d, 0x68, 0x07, 0x6c, 0x42, 0x68, 0x31, 0x28, 0xcf, 0x33, 0x45,
+	0x89, 0x45, 0xe7, 0x7b, 0xb0, 0x24, 0x5e, 0x5b, 0x92, 0xf0, 0x5b, 0x7a, 0x6b, 0xbc, 0x68, 0x05,
+	0x72, 0x94, 0xd6, 0x21, 0xbf, 0x0f, 0x7d, 0x75, 0x1a, 0x0d, 0xa1, 0x43, 0x58, 0x30, 0xf2, 0x0e,
+	0x65, 0x77, 0xff, 0x12, 0x96, 0x13, 0x8f, 0x5b, 0x60, 0x9e, 0xb8, 0xa1, 0xf3, 0x09, 0xb4, 0xb9,
+	0x06, 0xd4, 0x96, 0xb2, 0x99, 0xd9, 0x95, 0x6d, 0x4b, 0x04, 0xcd, 0xf4, 0x34, 0x1a, 0x8b, 0xd7,
+	0x20, 0xfb, 0xa6, 0xa1, 0x2b, 0x5a, 0x99, 0x26, 0x9b, 0x15, 0x23, 0x27, 0x00, 0x28, 0x2b, 0x3a,
+	0xb4, 0x03, 0x4b, 0x65, 0x4d, 0xa7, 0x54, 0x93, 0xd7, 0xf4, 0x7b, 0x42, 0x83, 0xe0, 0x19, 0x12,
+	0x2a, 0x8a, 0x1f, 0x29, 0x99, 0xea, 0xf8, 0xc8, 0xf9, 0x11, 0xf4, 0x94, 0x8b, 0x90, 0x6a, 0x59,
+	0xf4, 0x76, 0x5a, 0xa2, 0x81, 0xb3, 0xc6, 0x1c, 0xfe, 0x13, 0x37, 0x14, 0xc9, 0x43, 0x8c, 0xf8,
+	0xc1, 0x4b, 0xe8, 0x7c, 0x71, 0x01, 0xd0, 0xd1, 0xd6, 0x5f, 0x5a, 0x70, 0xf9, 0x40, 0xfc, 0x38,
+	0x72, 0x40, 0x92, 0x93, 0x60, 0x4c, 0xd0, 0x0e, 0x74, 0x9f, 0x10, 0xd9, 0x05, 0x99, 0x0b, 0x9b,
+	0x3d, 0xfa, 0x23, 0xc7, 0x50, 0xfb, 0xad, 0xc2, 0x59, 0xf9, 0xdd, 0x57, 0xff, 0xf8, 0x63, 0xa3,
+	0x87, 0xac, 0x4d, 0xfa, 0x5b, 0x0b, 0x23, 0x7c, 0x02, 0x5d, 0x16, 0x34, 0xcf, 0x62, 0x1f, 0xc9,
+	0x22, 0x55, 0xc6, 0xe7, 0x70, 0x76, 0xc2, 0x59, 0x65, 0x0c, 0x2e, 0xa3, 0x01, 0x65, 0xc0, 0x5f,
+	0x1a, 0x61, 0xec, 0xdf, 0x31, 0xee, 0x19, 0xe8, 0x09, 0xb4, 0x19, 0xa3, 0xb4, 0x56, 0x97, 0x39,
+	0x6e, 0x88, 0x71, 0xeb, 0x23, 0x28, 0xb8, 0xa5, 0xf7, 0x0c, 0xf4, 0x53, 0xe8, 0xec, 0xfd, 0x8a,
+	0x8c, 0xf3, 0x8c, 0x20, 0xd9, 0x44, 0x9b, 0x0b, 0xd8, 0x61, 0x8d, 0x0c, 0xe7, 0x1a, 0x63, 0xb9,
+	0xea, 0xf4, 0x18, 0x4b, 0xce, 0x66, 0x5b, 0x84, 0x2f, 0x72, 0xc1, 0x7a, 0x94, 0x67, 0x31, 0x2b,
+	0xb6, 0xd1, 0xaa, 0x1e, 0xaa, 0x8b, 0x18, 0xbf, 0xc3, 0x18, 0xdf, 0x1c, 0xae, 0x51, 0xc6, 0x2c,
+	0xfa, 0x36, 0xdd, 0x3c, 0x8b, 0x5f, 0x49, 0x19, 0x3c, 0xc8, 0xd1, 0x2b, 0xe8, 0x52, 0x11, 0x34,
+	0xcf, 0x5d, 0x54, 0xc2, 0x2d, 0x26, 0xe1, 0xc6, 0x70, 0x95, 0x6d, 0xce, 0x69, 0x34, 0xae, 0x14,
+	0x30, 0x06, 0xa0, 0x02, 0x78, 0x2d, 0x7c, 0x51, 0x11, 0xb7, 0x99, 0x88, 0x8d, 0xe1, 0x3a, 0x15,
+	0xc1, 0xcf, 0x45, 0xa5, 0x90, 0x67, 0xd0, 0xde, 0x77, 0x23, 0x2f, 0x24, 0x48, 0xbb, 0x58, 0x6a,
+	0xf9, 0x5e, 0x67, 0x7c, 0xd7, 0x9c, 0x95, 0x72, 0x23, 0x37, 0x3f, 0x63, 0x0c, 0xb6, 0x8d, 0xbb,
+	0x2f, 0xcd, 0xa3, 0x36, 0xc3, 0x3f, 0xf8, 0xef, 0x00, 0x9d, 0xba, 0x43, 0x98, 0xe3, 0x1b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
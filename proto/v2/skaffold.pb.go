@@ -41,6 +41,7 @@ const BuilderType_BUILDPACKS = BuilderType(enums.BuilderType_BUILDPACKS)
 const BuilderType_CUSTOM = BuilderType(enums.BuilderType_CUSTOM)
 const BuilderType_KANIKO = BuilderType(enums.BuilderType_KANIKO)
 const BuilderType_DOCKER = BuilderType(enums.BuilderType_DOCKER)
+const BuilderType_KO = BuilderType(enums.BuilderType_KO)
 
 // BuildType from public import enums/enums.proto
 type BuildType = enums.BuildType
@@ -140,6 +141,9 @@ const StatusCode_STATUSCHECK_CONTAINER_CREATING = StatusCode(enums.StatusCode_ST
 const StatusCode_STATUSCHECK_RUN_CONTAINER_ERR = StatusCode(enums.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR)
 const StatusCode_STATUSCHECK_CONTAINER_TERMINATED = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_TERMINATED)
 const StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING)
+const StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING)
+const StatusCode_STATUSCHECK_JOB_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_JOB_PENDING)
+const StatusCode_STATUSCHECK_JOB_FAILED = StatusCode(enums.StatusCode_STATUSCHECK_JOB_FAILED)
 const StatusCode_STATUSCHECK_CONTAINER_RESTARTING = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_RESTARTING)
 const StatusCode_STATUSCHECK_UNHEALTHY = StatusCode(enums.StatusCode_STATUSCHECK_UNHEALTHY)
 const StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE = StatusCode(enums.StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE)
@@ -212,6 +216,8 @@ const StatusCode_DEPLOY_MANIFEST_WRITE_ERR = StatusCode(enums.StatusCode_DEPLOY_
 const StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR = StatusCode(enums.StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR)
 const StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE = StatusCode(enums.StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE)
 const StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR = StatusCode(enums.StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR)
+const StatusCode_DEPLOY_SERVER_SIDE_APPLY_CONFLICT_ERR = StatusCode(enums.StatusCode_DEPLOY_SERVER_SIDE_APPLY_CONFLICT_ERR)
+const StatusCode_DEPLOY_DRIFT_DETECTED = StatusCode(enums.StatusCode_DEPLOY_DRIFT_DETECTED)
 const StatusCode_TEST_USER_CONFIG_ERR = StatusCode(enums.StatusCode_TEST_USER_CONFIG_ERR)
 const StatusCode_TEST_CST_USER_ERR = StatusCode(enums.StatusCode_TEST_CST_USER_ERR)
 const StatusCode_TEST_IMG_PULL_ERR = StatusCode(enums.StatusCode_TEST_IMG_PULL_ERR)
@@ -236,6 +242,10 @@ const StatusCode_CONFIG_APPLY_PROFILES_ERR = StatusCode(enums.StatusCode_CONFIG_
 const StatusCode_CONFIG_DEFAULT_VALUES_ERR = StatusCode(enums.StatusCode_CONFIG_DEFAULT_VALUES_ERR)
 const StatusCode_CONFIG_FILE_PATHS_SUBSTITUTION_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_PATHS_SUBSTITUTION_ERR)
 const StatusCode_CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR = StatusCode(enums.StatusCode_CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR)
+const StatusCode_HOOK_NON_ZERO_EXIT_ERR = StatusCode(enums.StatusCode_HOOK_NON_ZERO_EXIT_ERR)
+const StatusCode_HOOK_RUN_ERR = StatusCode(enums.StatusCode_HOOK_RUN_ERR)
+const StatusCode_HOOK_CONTAINER_NOT_FOUND_ERR = StatusCode(enums.StatusCode_HOOK_CONTAINER_NOT_FOUND_ERR)
+const StatusCode_HOOK_CANCELLED = StatusCode(enums.StatusCode_HOOK_CANCELLED)
 
 // SuggestionCode from public import enums/enums.proto
 type SuggestionCode = enums.SuggestionCode
@@ -267,6 +277,9 @@ const SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_ARTIFACT_OVERRIDES = SuggestionCod
 const SuggestionCode_UPGRADE_HELM32 = SuggestionCode(enums.SuggestionCode_UPGRADE_HELM32)
 const SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE = SuggestionCode(enums.SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE)
 const SuggestionCode_INSTALL_KUBECTL = SuggestionCode(enums.SuggestionCode_INSTALL_KUBECTL)
+const SuggestionCode_FIX_SKAFFOLD_CONFIG_KUBECTL_FORCE_CONFLICTS = SuggestionCode(enums.SuggestionCode_FIX_SKAFFOLD_CONFIG_KUBECTL_FORCE_CONFLICTS)
+const SuggestionCode_REMOVE_CONFLICTING_FIELDS = SuggestionCode(enums.SuggestionCode_REMOVE_CONFLICTING_FIELDS)
+const SuggestionCode_REAPPLY_MANIFESTS = SuggestionCode(enums.SuggestionCode_REAPPLY_MANIFESTS)
 const SuggestionCode_CHECK_CONTAINER_LOGS = SuggestionCode(enums.SuggestionCode_CHECK_CONTAINER_LOGS)
 const SuggestionCode_CHECK_READINESS_PROBE = SuggestionCode(enums.SuggestionCode_CHECK_READINESS_PROBE)
 const SuggestionCode_CHECK_CONTAINER_IMAGE = SuggestionCode(enums.SuggestionCode_CHECK_CONTAINER_IMAGE)
@@ -295,6 +308,8 @@ const SuggestionCode_FIX_CUSTOM_COMMAND_TIMEOUT = SuggestionCode(enums.Suggestio
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD = SuggestionCode(enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD)
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS = SuggestionCode(enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS)
 const SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME = SuggestionCode(enums.SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME)
+const SuggestionCode_CHECK_HOOK_COMMAND = SuggestionCode(enums.SuggestionCode_CHECK_HOOK_COMMAND)
+const SuggestionCode_CHECK_HOOK_CONTAINER = SuggestionCode(enums.SuggestionCode_CHECK_HOOK_CONTAINER)
 
 type StateResponse struct {
 	State                *State   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
@@ -1149,6 +1164,7 @@ func (m *FileSyncState) GetAutoTrigger() bool {
 // It is one of MetaEvent, BuildEvent, TestEvent, DeployEvent, PortEvent, StatusCheckEvent, ResourceStatusCheckEvent, FileSyncEvent, or DebuggingContainerEvent.
 type Event struct {
 	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Id        int64                `protobuf:"varint,16,opt,name=id,proto3" json:"id,omitempty"`
 	// Types that are valid to be assigned to EventType:
 	//	*Event_MetaEvent
 	//	*Event_SkaffoldLogEvent
@@ -1162,6 +1178,8 @@ type Event struct {
 	//	*Event_DebuggingContainerEvent
 	//	*Event_TerminationEvent
 	//	*Event_TestEvent
+	//	*Event_CacheMissEvent
+	//	*Event_DriftEvent
 	EventType            isEvent_EventType `protobuf_oneof:"event_type"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -1200,6 +1218,13 @@ func (m *Event) GetTimestamp() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Event) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 type isEvent_EventType interface {
 	isEvent_EventType()
 }
@@ -1252,6 +1277,14 @@ type Event_TestEvent struct {
 	TestEvent *TestSubtaskEvent `protobuf:"bytes,13,opt,name=testEvent,proto3,oneof"`
 }
 
+type Event_CacheMissEvent struct {
+	CacheMissEvent *CacheMissEvent `protobuf:"bytes,14,opt,name=cacheMissEvent,proto3,oneof"`
+}
+
+type Event_DriftEvent struct {
+	DriftEvent *DriftEvent `protobuf:"bytes,15,opt,name=driftEvent,proto3,oneof"`
+}
+
 func (*Event_MetaEvent) isEvent_EventType() {}
 
 func (*Event_SkaffoldLogEvent) isEvent_EventType() {}
@@ -1276,6 +1309,10 @@ func (*Event_TerminationEvent) isEvent_EventType() {}
 
 func (*Event_TestEvent) isEvent_EventType() {}
 
+func (*Event_CacheMissEvent) isEvent_EventType() {}
+
+func (*Event_DriftEvent) isEvent_EventType() {}
+
 func (m *Event) GetEventType() isEvent_EventType {
 	if m != nil {
 		return m.EventType
@@ -1367,6 +1404,20 @@ func (m *Event) GetTestEvent() *TestSubtaskEvent {
 	return nil
 }
 
+func (m *Event) GetCacheMissEvent() *CacheMissEvent {
+	if x, ok := m.GetEventType().(*Event_CacheMissEvent); ok {
+		return x.CacheMissEvent
+	}
+	return nil
+}
+
+func (m *Event) GetDriftEvent() *DriftEvent {
+	if x, ok := m.GetEventType().(*Event_DriftEvent); ok {
+		return x.DriftEvent
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1382,6 +1433,8 @@ func (*Event) XXX_OneofWrappers() []interface{} {
 		(*Event_DebuggingContainerEvent)(nil),
 		(*Event_TerminationEvent)(nil),
 		(*Event_TestEvent)(nil),
+		(*Event_CacheMissEvent)(nil),
+		(*Event_DriftEvent)(nil),
 	}
 }
 
@@ -2035,20 +2088,24 @@ func (m *StatusCheckSubtaskEvent) GetActionableErr() *ActionableErr {
 
 // PortForwardEvent Event describes each port forwarding event.
 type PortForwardEvent struct {
-	Id                   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TaskId               string       `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	LocalPort            int32        `protobuf:"varint,3,opt,name=localPort,proto3" json:"localPort,omitempty"`
-	PodName              string       `protobuf:"bytes,4,opt,name=podName,proto3" json:"podName,omitempty"`
-	ContainerName        string       `protobuf:"bytes,5,opt,name=containerName,proto3" json:"containerName,omitempty"`
-	Namespace            string       `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	PortName             string       `protobuf:"bytes,7,opt,name=portName,proto3" json:"portName,omitempty"`
-	ResourceType         string       `protobuf:"bytes,8,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
-	ResourceName         string       `protobuf:"bytes,9,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
-	Address              string       `protobuf:"bytes,10,opt,name=address,proto3" json:"address,omitempty"`
-	TargetPort           *IntOrString `protobuf:"bytes,11,opt,name=targetPort,proto3" json:"targetPort,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId               string         `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	LocalPort            int32          `protobuf:"varint,3,opt,name=localPort,proto3" json:"localPort,omitempty"`
+	PodName              string         `protobuf:"bytes,4,opt,name=podName,proto3" json:"podName,omitempty"`
+	ContainerName        string         `protobuf:"bytes,5,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	Namespace            string         `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PortName             string         `protobuf:"bytes,7,opt,name=portName,proto3" json:"portName,omitempty"`
+	ResourceType         string         `protobuf:"bytes,8,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
+	ResourceName         string         `protobuf:"bytes,9,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
+	Address              string         `protobuf:"bytes,10,opt,name=address,proto3" json:"address,omitempty"`
+	TargetPort           *IntOrString   `protobuf:"bytes,11,opt,name=targetPort,proto3" json:"targetPort,omitempty"`
+	Status               string         `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	BytesSent            int64          `protobuf:"varint,13,opt,name=bytesSent,proto3" json:"bytesSent,omitempty"`
+	BytesReceived        int64          `protobuf:"varint,14,opt,name=bytesReceived,proto3" json:"bytesReceived,omitempty"`
+	ActionableErr        *ActionableErr `protobuf:"bytes,15,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *PortForwardEvent) Reset()         { *m = PortForwardEvent{} }
@@ -2153,6 +2210,34 @@ func (m *PortForwardEvent) GetTargetPort() *IntOrString {
 	return nil
 }
 
+func (m *PortForwardEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *PortForwardEvent) GetBytesSent() int64 {
+	if m != nil {
+		return m.BytesSent
+	}
+	return 0
+}
+
+func (m *PortForwardEvent) GetBytesReceived() int64 {
+	if m != nil {
+		return m.BytesReceived
+	}
+	return 0
+}
+
+func (m *PortForwardEvent) GetActionableErr() *ActionableErr {
+	if m != nil {
+		return m.ActionableErr
+	}
+	return nil
+}
+
 // FileSyncEvent describes the sync status.
 type FileSyncEvent struct {
 	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2233,6 +2318,222 @@ func (m *FileSyncEvent) GetActionableErr() *ActionableErr {
 	return nil
 }
 
+// CacheMissEvent describes why an artifact wasn't found in the artifact cache and needs to be built.
+type CacheMissEvent struct {
+	TaskId               string            `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Artifact             string            `protobuf:"bytes,2,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Hash                 string            `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	PreviousHash         string            `protobuf:"bytes,4,opt,name=previousHash,proto3" json:"previousHash,omitempty"`
+	Reason               string            `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Diffs                []*CacheInputDiff `protobuf:"bytes,6,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CacheMissEvent) Reset()         { *m = CacheMissEvent{} }
+func (m *CacheMissEvent) String() string { return proto.CompactTextString(m) }
+func (*CacheMissEvent) ProtoMessage()    {}
+func (*CacheMissEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39088757fd9c8e40, []int{26}
+}
+
+func (m *CacheMissEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CacheMissEvent.Unmarshal(m, b)
+}
+func (m *CacheMissEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CacheMissEvent.Marshal(b, m, deterministic)
+}
+func (m *CacheMissEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheMissEvent.Merge(m, src)
+}
+func (m *CacheMissEvent) XXX_Size() int {
+	return xxx_messageInfo_CacheMissEvent.Size(m)
+}
+func (m *CacheMissEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheMissEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheMissEvent proto.InternalMessageInfo
+
+func (m *CacheMissEvent) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *CacheMissEvent) GetArtifact() string {
+	if m != nil {
+		return m.Artifact
+	}
+	return ""
+}
+
+func (m *CacheMissEvent) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *CacheMissEvent) GetPreviousHash() string {
+	if m != nil {
+		return m.PreviousHash
+	}
+	return ""
+}
+
+func (m *CacheMissEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CacheMissEvent) GetDiffs() []*CacheInputDiff {
+	if m != nil {
+		return m.Diffs
+	}
+	return nil
+}
+
+// CacheInputDiff describes an input of an artifact's hash that differs from a previous cache entry.
+type CacheInputDiff struct {
+	Kind                 string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Change               string   `protobuf:"bytes,3,opt,name=change,proto3" json:"change,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CacheInputDiff) Reset()         { *m = CacheInputDiff{} }
+func (m *CacheInputDiff) String() string { return proto.CompactTextString(m) }
+func (*CacheInputDiff) ProtoMessage()    {}
+func (*CacheInputDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39088757fd9c8e40, []int{27}
+}
+
+func (m *CacheInputDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CacheInputDiff.Unmarshal(m, b)
+}
+func (m *CacheInputDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CacheInputDiff.Marshal(b, m, deterministic)
+}
+func (m *CacheInputDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheInputDiff.Merge(m, src)
+}
+func (m *CacheInputDiff) XXX_Size() int {
+	return xxx_messageInfo_CacheInputDiff.Size(m)
+}
+func (m *CacheInputDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheInputDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheInputDiff proto.InternalMessageInfo
+
+func (m *CacheInputDiff) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *CacheInputDiff) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CacheInputDiff) GetChange() string {
+	if m != nil {
+		return m.Change
+	}
+	return ""
+}
+
+// DriftEvent is raised when a deployed resource no longer matches the manifests applied by Skaffold.
+type DriftEvent struct {
+	TaskId               string         `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Resource             string         `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	Namespace            string         `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Status               string         `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Fields               []string       `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	ActionableErr        *ActionableErr `protobuf:"bytes,6,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DriftEvent) Reset()         { *m = DriftEvent{} }
+func (m *DriftEvent) String() string { return proto.CompactTextString(m) }
+func (*DriftEvent) ProtoMessage()    {}
+func (*DriftEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39088757fd9c8e40, []int{28}
+}
+
+func (m *DriftEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DriftEvent.Unmarshal(m, b)
+}
+func (m *DriftEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DriftEvent.Marshal(b, m, deterministic)
+}
+func (m *DriftEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DriftEvent.Merge(m, src)
+}
+func (m *DriftEvent) XXX_Size() int {
+	return xxx_messageInfo_DriftEvent.Size(m)
+}
+func (m *DriftEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DriftEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DriftEvent proto.InternalMessageInfo
+
+func (m *DriftEvent) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *DriftEvent) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *DriftEvent) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DriftEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DriftEvent) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *DriftEvent) GetActionableErr() *ActionableErr {
+	if m != nil {
+		return m.ActionableErr
+	}
+	return nil
+}
+
 // DebuggingContainerEvent is raised when a debugging container is started or terminated
 type DebuggingContainerEvent struct {
 	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2254,7 +2555,7 @@ func (m *DebuggingContainerEvent) Reset()         { *m = DebuggingContainerEvent
 func (m *DebuggingContainerEvent) String() string { return proto.CompactTextString(m) }
 func (*DebuggingContainerEvent) ProtoMessage()    {}
 func (*DebuggingContainerEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{26}
+	return fileDescriptor_39088757fd9c8e40, []int{29}
 }
 
 func (m *DebuggingContainerEvent) XXX_Unmarshal(b []byte) error {
@@ -2356,7 +2657,7 @@ func (m *UserIntentRequest) Reset()         { *m = UserIntentRequest{} }
 func (m *UserIntentRequest) String() string { return proto.CompactTextString(m) }
 func (*UserIntentRequest) ProtoMessage()    {}
 func (*UserIntentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{27}
+	return fileDescriptor_39088757fd9c8e40, []int{30}
 }
 
 func (m *UserIntentRequest) XXX_Unmarshal(b []byte) error {
@@ -2395,7 +2696,7 @@ func (m *TriggerRequest) Reset()         { *m = TriggerRequest{} }
 func (m *TriggerRequest) String() string { return proto.CompactTextString(m) }
 func (*TriggerRequest) ProtoMessage()    {}
 func (*TriggerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{28}
+	return fileDescriptor_39088757fd9c8e40, []int{31}
 }
 
 func (m *TriggerRequest) XXX_Unmarshal(b []byte) error {
@@ -2437,7 +2738,7 @@ func (m *TriggerState) Reset()         { *m = TriggerState{} }
 func (m *TriggerState) String() string { return proto.CompactTextString(m) }
 func (*TriggerState) ProtoMessage()    {}
 func (*TriggerState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{29}
+	return fileDescriptor_39088757fd9c8e40, []int{32}
 }
 
 func (m *TriggerState) XXX_Unmarshal(b []byte) error {
@@ -2489,6 +2790,142 @@ func (*TriggerState) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// EventsRequest selects the events streamed by `Events`. Only the events matching all the criteria are sent.
+type EventsRequest struct {
+	FromId               int64          `protobuf:"varint,1,opt,name=fromId,proto3" json:"fromId,omitempty"`
+	Types                []string       `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	Artifacts            []string       `protobuf:"bytes,3,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	TaskIds              []string       `protobuf:"bytes,4,rep,name=taskIds,proto3" json:"taskIds,omitempty"`
+	MinSeverity          enums.LogLevel `protobuf:"varint,5,opt,name=minSeverity,proto3,enum=proto.enums.LogLevel" json:"minSeverity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *EventsRequest) Reset()         { *m = EventsRequest{} }
+func (m *EventsRequest) String() string { return proto.CompactTextString(m) }
+func (*EventsRequest) ProtoMessage()    {}
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39088757fd9c8e40, []int{33}
+}
+
+func (m *EventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventsRequest.Unmarshal(m, b)
+}
+func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventsRequest.Marshal(b, m, deterministic)
+}
+func (m *EventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsRequest.Merge(m, src)
+}
+func (m *EventsRequest) XXX_Size() int {
+	return xxx_messageInfo_EventsRequest.Size(m)
+}
+func (m *EventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsRequest proto.InternalMessageInfo
+
+func (m *EventsRequest) GetFromId() int64 {
+	if m != nil {
+		return m.FromId
+	}
+	return 0
+}
+
+func (m *EventsRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *EventsRequest) GetArtifacts() []string {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+func (m *EventsRequest) GetTaskIds() []string {
+	if m != nil {
+		return m.TaskIds
+	}
+	return nil
+}
+
+func (m *EventsRequest) GetMinSeverity() enums.LogLevel {
+	if m != nil {
+		return m.MinSeverity
+	}
+	return enums.LogLevel_DEBUG
+}
+
+// LogFilterRequest replaces the filters applied to the container logs.
+type LogFilterRequest struct {
+	MinLevel             string            `protobuf:"bytes,1,opt,name=minLevel,proto3" json:"minLevel,omitempty"`
+	Fields               map[string]string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Regex                string            `protobuf:"bytes,3,opt,name=regex,proto3" json:"regex,omitempty"`
+	Restore              bool              `protobuf:"varint,4,opt,name=restore,proto3" json:"restore,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LogFilterRequest) Reset()         { *m = LogFilterRequest{} }
+func (m *LogFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*LogFilterRequest) ProtoMessage()    {}
+func (*LogFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39088757fd9c8e40, []int{34}
+}
+
+func (m *LogFilterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogFilterRequest.Unmarshal(m, b)
+}
+func (m *LogFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogFilterRequest.Marshal(b, m, deterministic)
+}
+func (m *LogFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogFilterRequest.Merge(m, src)
+}
+func (m *LogFilterRequest) XXX_Size() int {
+	return xxx_messageInfo_LogFilterRequest.Size(m)
+}
+func (m *LogFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogFilterRequest proto.InternalMessageInfo
+
+func (m *LogFilterRequest) GetMinLevel() string {
+	if m != nil {
+		return m.MinLevel
+	}
+	return ""
+}
+
+func (m *LogFilterRequest) GetFields() map[string]string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *LogFilterRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *LogFilterRequest) GetRestore() bool {
+	if m != nil {
+		return m.Restore
+	}
+	return false
+}
+
 // Intent represents user intents for a given phase.
 type Intent struct {
 	Build                bool     `protobuf:"varint,1,opt,name=build,proto3" json:"build,omitempty"`
@@ -2503,7 +2940,7 @@ func (m *Intent) Reset()         { *m = Intent{} }
 func (m *Intent) String() string { return proto.CompactTextString(m) }
 func (*Intent) ProtoMessage()    {}
 func (*Intent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{30}
+	return fileDescriptor_39088757fd9c8e40, []int{35}
 }
 
 func (m *Intent) XXX_Unmarshal(b []byte) error {
@@ -2558,7 +2995,7 @@ func (m *Suggestion) Reset()         { *m = Suggestion{} }
 func (m *Suggestion) String() string { return proto.CompactTextString(m) }
 func (*Suggestion) ProtoMessage()    {}
 func (*Suggestion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{31}
+	return fileDescriptor_39088757fd9c8e40, []int{36}
 }
 
 func (m *Suggestion) XXX_Unmarshal(b []byte) error {
@@ -2607,7 +3044,7 @@ func (m *IntOrString) Reset()         { *m = IntOrString{} }
 func (m *IntOrString) String() string { return proto.CompactTextString(m) }
 func (*IntOrString) ProtoMessage()    {}
 func (*IntOrString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{32}
+	return fileDescriptor_39088757fd9c8e40, []int{37}
 }
 
 func (m *IntOrString) XXX_Unmarshal(b []byte) error {
@@ -2684,11 +3121,17 @@ func init() {
 	proto.RegisterType((*StatusCheckSubtaskEvent)(nil), "proto.v2.StatusCheckSubtaskEvent")
 	proto.RegisterType((*PortForwardEvent)(nil), "proto.v2.PortForwardEvent")
 	proto.RegisterType((*FileSyncEvent)(nil), "proto.v2.FileSyncEvent")
+	proto.RegisterType((*CacheMissEvent)(nil), "proto.v2.CacheMissEvent")
+	proto.RegisterType((*CacheInputDiff)(nil), "proto.v2.CacheInputDiff")
+	proto.RegisterType((*DriftEvent)(nil), "proto.v2.DriftEvent")
 	proto.RegisterType((*DebuggingContainerEvent)(nil), "proto.v2.DebuggingContainerEvent")
 	proto.RegisterMapType((map[string]uint32)(nil), "proto.v2.DebuggingContainerEvent.DebugPortsEntry")
 	proto.RegisterType((*UserIntentRequest)(nil), "proto.v2.UserIntentRequest")
 	proto.RegisterType((*TriggerRequest)(nil), "proto.v2.TriggerRequest")
 	proto.RegisterType((*TriggerState)(nil), "proto.v2.TriggerState")
+	proto.RegisterType((*EventsRequest)(nil), "proto.v2.EventsRequest")
+	proto.RegisterType((*LogFilterRequest)(nil), "proto.v2.LogFilterRequest")
+	proto.RegisterMapType((map[string]string)(nil), "proto.v2.LogFilterRequest.FieldsEntry")
 	proto.RegisterType((*Intent)(nil), "proto.v2.Intent")
 	proto.RegisterType((*Suggestion)(nil), "proto.v2.Suggestion")
 	proto.RegisterType((*IntOrString)(nil), "proto.v2.IntOrString")
@@ -2697,142 +3140,168 @@ func init() {
 func init() { proto.RegisterFile("v2/skaffold.proto", fileDescriptor_39088757fd9c8e40) }
 
 var fileDescriptor_39088757fd9c8e40 = []byte{
-	// 2158 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4f, 0x73, 0x1c, 0x47,
-	0x15, 0xd7, 0xee, 0x6a, 0xff, 0xcc, 0x5b, 0x49, 0x96, 0x5a, 0xb6, 0xb4, 0xac, 0xe5, 0xc4, 0x1e,
-	0x1c, 0x70, 0x12, 0x67, 0xd7, 0x92, 0x21, 0x4e, 0xb9, 0x10, 0x41, 0x52, 0x64, 0x49, 0xd8, 0xc4,
-	0x71, 0x4b, 0xc9, 0x01, 0x48, 0xb9, 0x46, 0x3b, 0xad, 0xf5, 0x94, 0x76, 0x67, 0x96, 0x99, 0x1e,
-	0x85, 0xbd, 0x51, 0x14, 0x55, 0x70, 0x86, 0x9c, 0x38, 0x71, 0xe0, 0xc2, 0x89, 0x0b, 0xdf, 0x80,
-	0x8f, 0x40, 0x15, 0x77, 0xaa, 0x38, 0x50, 0x14, 0x1f, 0x82, 0xea, 0xd7, 0xdd, 0x33, 0x3d, 0x33,
-	0xbb, 0x96, 0x64, 0x97, 0x2b, 0x17, 0x69, 0xba, 0xfb, 0xf7, 0x5e, 0xbf, 0xf7, 0xfa, 0xd7, 0xaf,
-	0x5f, 0xf7, 0xc2, 0xd2, 0xd9, 0x46, 0x37, 0x3a, 0x75, 0x4e, 0x4e, 0x82, 0x81, 0xdb, 0x19, 0x85,
-	0x01, 0x0f, 0x48, 0x03, 0xff, 0x75, 0xce, 0x36, 0xda, 0x6b, 0xfd, 0x20, 0xe8, 0x0f, 0x58, 0xd7,
-	0x19, 0x79, 0x5d, 0xc7, 0xf7, 0x03, 0xee, 0x70, 0x2f, 0xf0, 0x23, 0x89, 0x6b, 0xbf, 0xad, 0x46,
-	0xb1, 0x75, 0x1c, 0x9f, 0x74, 0xb9, 0x37, 0x64, 0x11, 0x77, 0x86, 0x23, 0x05, 0xb8, 0x9e, 0x07,
-	0xb0, 0xe1, 0x88, 0x8f, 0xd5, 0xe0, 0x12, 0xf3, 0xe3, 0x61, 0xd4, 0xc5, 0xbf, 0xb2, 0xcb, 0xfe,
-	0x10, 0xe6, 0x0f, 0xb9, 0xc3, 0x19, 0x65, 0xd1, 0x28, 0xf0, 0x23, 0x46, 0xde, 0x81, 0x6a, 0x24,
-	0x3a, 0x5a, 0xa5, 0x9b, 0xa5, 0x3b, 0xcd, 0x8d, 0x2b, 0x1d, 0x6d, 0x59, 0x47, 0xe2, 0xe4, 0xa8,
-	0xbd, 0x06, 0x8d, 0x44, 0x64, 0x11, 0x2a, 0xc3, 0xa8, 0x8f, 0x02, 0x16, 0x15, 0x9f, 0xf6, 0x0d,
-	0xa8, 0x53, 0xf6, 0x8b, 0x98, 0x45, 0x9c, 0x10, 0x98, 0xf5, 0x9d, 0x21, 0x53, 0xa3, 0xf8, 0x6d,
-	0xff, 0x6b, 0x16, 0xaa, 0xa8, 0x8d, 0x7c, 0x0f, 0xe0, 0x38, 0xf6, 0x06, 0xee, 0xa1, 0x31, 0xe5,
-	0xd5, 0x74, 0xca, 0xed, 0x64, 0x8c, 0x1a, 0x38, 0xf2, 0x00, 0x9a, 0x2e, 0x1b, 0x0d, 0x82, 0xb1,
-	0x14, 0x2b, 0xa3, 0xd8, 0xb5, 0x54, 0xec, 0x93, 0x74, 0x90, 0x9a, 0x48, 0xf2, 0x18, 0x16, 0x4e,
-	0x82, 0xf0, 0x2b, 0x27, 0x74, 0x99, 0xfb, 0x59, 0x10, 0xf2, 0xa8, 0x55, 0xb9, 0x59, 0xb9, 0xd3,
-	0xdc, 0xf8, 0x76, 0xce, 0xcb, 0xce, 0xa3, 0x0c, 0x6a, 0xd7, 0xe7, 0xe1, 0x98, 0xe6, 0x44, 0xc9,
-	0x23, 0x58, 0x14, 0xb1, 0x88, 0xa3, 0x9d, 0x17, 0xac, 0x77, 0x2a, 0x4d, 0x99, 0x45, 0x53, 0xda,
-	0x59, 0x75, 0x26, 0x82, 0x16, 0x64, 0xc8, 0x26, 0xcc, 0x9f, 0x78, 0x03, 0x76, 0x38, 0xf6, 0x7b,
-	0x52, 0x49, 0x15, 0x95, 0xac, 0xa6, 0x4a, 0x1e, 0x99, 0xc3, 0x34, 0x8b, 0x26, 0x87, 0xb0, 0xec,
-	0xb2, 0xe3, 0xb8, 0xdf, 0xf7, 0xfc, 0xfe, 0x4e, 0xe0, 0x73, 0xc7, 0xf3, 0x59, 0x18, 0xb5, 0x6a,
-	0xe8, 0xd8, 0x2d, 0x33, 0x28, 0x79, 0xd0, 0xee, 0x19, 0xf3, 0x39, 0x9d, 0x24, 0x4d, 0x3a, 0xd0,
-	0x18, 0x32, 0xee, 0xb8, 0x0e, 0x77, 0x5a, 0x75, 0x34, 0x87, 0xa4, 0x9a, 0x7e, 0xa2, 0x46, 0x68,
-	0x82, 0x21, 0xeb, 0x60, 0x71, 0x16, 0x71, 0x69, 0x7f, 0x03, 0x05, 0x96, 0x53, 0x81, 0x23, 0x3d,
-	0x44, 0x53, 0x54, 0xfb, 0x4b, 0x58, 0x9e, 0x10, 0x65, 0x41, 0xa6, 0x53, 0x36, 0x46, 0x2a, 0x54,
-	0xa9, 0xf8, 0x24, 0xf7, 0xa0, 0x7a, 0xe6, 0x0c, 0x62, 0xbd, 0xce, 0x46, 0x70, 0x85, 0x98, 0xd2,
-	0x21, 0x7d, 0x91, 0xc0, 0x87, 0xe5, 0x8f, 0x4a, 0xf6, 0x1f, 0xcb, 0xd0, 0xd0, 0x86, 0x92, 0x0f,
-	0xa0, 0x8a, 0xf4, 0x51, 0x0c, 0x5b, 0xcd, 0x31, 0x2c, 0x71, 0x48, 0xa2, 0xc8, 0x3d, 0xa8, 0x49,
-	0xd6, 0xa8, 0x29, 0x5b, 0x79, 0x6a, 0x25, 0x02, 0x0a, 0x47, 0xde, 0x83, 0x59, 0xe1, 0x59, 0xab,
-	0x82, 0xf8, 0x95, 0xac, 0xeb, 0x09, 0x1a, 0x31, 0x64, 0x1b, 0xc0, 0x71, 0x5d, 0x4f, 0x6c, 0x6b,
-	0x67, 0xd0, 0xea, 0xe1, 0x3a, 0xd9, 0xc5, 0xe8, 0x76, 0xb6, 0x12, 0x90, 0xe4, 0x9f, 0x21, 0xd5,
-	0xde, 0x84, 0x2b, 0xb9, 0x61, 0x33, 0x70, 0x96, 0x0c, 0xdc, 0x55, 0x33, 0x70, 0x96, 0x19, 0x9c,
-	0xdf, 0x56, 0x60, 0x3e, 0xe3, 0x39, 0xb9, 0x0b, 0x4b, 0x7e, 0x3c, 0x3c, 0x66, 0xe1, 0xd3, 0x93,
-	0xad, 0x90, 0x7b, 0x27, 0x4e, 0x8f, 0x47, 0x6a, 0x11, 0x8a, 0x03, 0xe4, 0x47, 0xd0, 0xc0, 0x48,
-	0x09, 0xa2, 0x95, 0xd1, 0x81, 0xdb, 0x53, 0x42, 0xda, 0x39, 0x18, 0x3a, 0x7d, 0xb6, 0x2d, 0xc1,
-	0x34, 0x91, 0xc2, 0x80, 0x8d, 0x47, 0x0c, 0x03, 0xb6, 0x90, 0x04, 0x4c, 0x66, 0x26, 0x44, 0x1f,
-	0x8d, 0x47, 0x8c, 0x22, 0x86, 0xec, 0x4d, 0x08, 0xd8, 0x77, 0xa7, 0xcd, 0xf7, 0xb2, 0xa8, 0x51,
-	0x98, 0x33, 0xcd, 0x21, 0x77, 0x95, 0x11, 0x25, 0x34, 0xa2, 0x55, 0x34, 0x82, 0x85, 0x86, 0x19,
-	0x57, 0xa1, 0xda, 0x0b, 0x62, 0x9f, 0x63, 0x38, 0xab, 0x54, 0x36, 0x5e, 0x77, 0x25, 0xbe, 0x2e,
-	0xc1, 0x9c, 0xc9, 0x11, 0xf2, 0x00, 0xea, 0xa2, 0x2d, 0x22, 0x5b, 0x42, 0x4f, 0x6f, 0x4c, 0x26,
-	0x53, 0x47, 0xa2, 0xa8, 0x46, 0xb7, 0x1f, 0x43, 0x4d, 0x7e, 0x92, 0xf7, 0x33, 0x6e, 0xad, 0x66,
-	0xdc, 0x92, 0x90, 0xf3, 0xbc, 0xb2, 0xff, 0x59, 0x82, 0x85, 0x2c, 0xd5, 0xc9, 0xc7, 0x60, 0x49,
-	0xb2, 0xa7, 0xa6, 0xdd, 0x9a, 0xb6, 0x2f, 0x54, 0x93, 0x85, 0x34, 0x95, 0x21, 0x1b, 0x50, 0xef,
-	0x0d, 0x62, 0x31, 0x3d, 0xce, 0x95, 0x0f, 0xf8, 0x8e, 0x1c, 0x43, 0xd3, 0x34, 0xb0, 0xfd, 0x14,
-	0x1a, 0x5a, 0x15, 0xf9, 0x20, 0xe3, 0xd6, 0xb7, 0x32, 0xc2, 0x1a, 0x74, 0xae, 0x63, 0xff, 0x29,
-	0x01, 0xa4, 0xa7, 0x0a, 0xd9, 0x02, 0xcb, 0x31, 0xe8, 0x9e, 0x3b, 0x0b, 0x52, 0x60, 0x27, 0xe1,
-	0xbe, 0x64, 0x55, 0x2a, 0x45, 0x6e, 0x42, 0xd3, 0x89, 0x79, 0x70, 0x14, 0x7a, 0xfd, 0xbe, 0x72,
-	0xad, 0x41, 0xcd, 0x2e, 0xf2, 0x00, 0x40, 0x25, 0xfd, 0xc0, 0xd5, 0x8c, 0xcf, 0xae, 0xca, 0x61,
-	0x32, 0x4c, 0x0d, 0x68, 0xfb, 0x07, 0xb0, 0x90, 0x9d, 0xf7, 0x52, 0xd4, 0xfa, 0x39, 0x58, 0x49,
-	0xe2, 0x25, 0x2b, 0x50, 0x93, 0x8a, 0x95, 0xac, 0x6a, 0xe5, 0x6c, 0x2b, 0x5f, 0xd8, 0x36, 0xfb,
-	0x57, 0x25, 0x68, 0x1a, 0xe7, 0xec, 0xd4, 0x09, 0xde, 0x5c, 0x78, 0xec, 0xff, 0x96, 0x60, 0x31,
-	0x7f, 0xbe, 0x4e, 0xb5, 0x63, 0x0f, 0xac, 0x90, 0x45, 0x41, 0x1c, 0xf6, 0x98, 0xce, 0x59, 0xef,
-	0x4e, 0x3f, 0xa6, 0x3b, 0x54, 0x63, 0xd5, 0x7a, 0x27, 0xb2, 0xaf, 0xb5, 0x9a, 0x59, 0xad, 0x97,
-	0x5a, 0xcd, 0x03, 0x98, 0xcf, 0x94, 0x01, 0xaf, 0x1e, 0x70, 0xfb, 0x6f, 0x75, 0xa8, 0xe2, 0x79,
-	0x49, 0x3e, 0x02, 0x2b, 0x29, 0x20, 0xd5, 0xd9, 0xd8, 0xee, 0xc8, 0x0a, 0xb2, 0xa3, 0x2b, 0xc8,
-	0xce, 0x91, 0x46, 0xd0, 0x14, 0x4c, 0xee, 0x83, 0x25, 0x0e, 0x7f, 0x54, 0xa3, 0x4e, 0xc9, 0xe5,
-	0xec, 0x19, 0x86, 0x43, 0xfb, 0x33, 0x34, 0xc5, 0x91, 0x7d, 0x58, 0xd4, 0x75, 0xef, 0x93, 0xa0,
-	0x2f, 0x65, 0x2b, 0x85, 0x8a, 0x29, 0x87, 0xd8, 0x9f, 0xa1, 0x05, 0x29, 0xf2, 0x0c, 0x96, 0x9d,
-	0xd1, 0x68, 0xe0, 0xf5, 0xb0, 0x3a, 0x4e, 0x94, 0xc9, 0xf2, 0xcb, 0xc8, 0x98, 0x5b, 0x45, 0xd0,
-	0xfe, 0x0c, 0x9d, 0x24, 0x2b, 0x3c, 0xe2, 0x4e, 0x74, 0x2a, 0x15, 0x55, 0x0b, 0x25, 0x8c, 0x1e,
-	0x12, 0x1e, 0x25, 0x38, 0xf2, 0x18, 0x96, 0x64, 0x5d, 0x1a, 0x1f, 0xa7, 0xc2, 0x35, 0x14, 0xbe,
-	0x9e, 0xcf, 0x23, 0x06, 0x64, 0x7f, 0x86, 0x16, 0xe5, 0xc8, 0xa7, 0x40, 0x54, 0xb1, 0x6a, 0x6a,
-	0x93, 0xe5, 0xd7, 0x5a, 0xa1, 0xba, 0xcd, 0xaa, 0x9b, 0x20, 0x49, 0x1e, 0x82, 0x35, 0x0a, 0x42,
-	0x2e, 0xd5, 0x34, 0xce, 0x2b, 0x9e, 0x84, 0x63, 0x09, 0x9c, 0x7c, 0x09, 0xab, 0x66, 0xa1, 0x6a,
-	0x1a, 0x64, 0xa1, 0xa6, 0x5b, 0x93, 0x37, 0x4f, 0xd6, 0xaa, 0x69, 0x3a, 0xc8, 0xc7, 0x69, 0xcd,
-	0x2b, 0x95, 0xc2, 0xb4, 0x9a, 0x57, 0xab, 0xca, 0xe2, 0x85, 0x7d, 0xee, 0xe4, 0x82, 0xb6, 0xd5,
-	0xcc, 0xdb, 0x37, 0xa5, 0xf2, 0x15, 0xf6, 0x4d, 0xd1, 0x21, 0x98, 0xca, 0x59, 0x38, 0xf4, 0x7c,
-	0xe4, 0x88, 0xd4, 0x3b, 0x97, 0x8f, 0xe0, 0x51, 0x0e, 0x21, 0x98, 0x9a, 0x97, 0x12, 0x8b, 0x20,
-	0xaa, 0x3e, 0xa9, 0x62, 0xbe, 0xa8, 0x22, 0xe2, 0xb9, 0x98, 0xa5, 0xf0, 0xed, 0x39, 0x00, 0x26,
-	0x3e, 0x9e, 0x8b, 0x03, 0xcd, 0xfe, 0x1c, 0x16, 0xf3, 0x33, 0x4e, 0x4d, 0x02, 0xef, 0x42, 0x85,
-	0x85, 0xa1, 0xda, 0x98, 0x46, 0x54, 0xb7, 0x7a, 0x58, 0xa8, 0x1c, 0x0f, 0xd8, 0x6e, 0x18, 0x52,
-	0x81, 0x11, 0x15, 0xc8, 0x7c, 0xa6, 0x9b, 0xac, 0x43, 0x9d, 0x85, 0x21, 0xa6, 0xb7, 0xd2, 0xcb,
-	0xd3, 0x9b, 0xc6, 0x91, 0x16, 0xd4, 0x87, 0x2c, 0x8a, 0x9c, 0xbe, 0xce, 0x5c, 0xba, 0x49, 0x3e,
-	0x84, 0x66, 0x14, 0xf7, 0xfb, 0x2c, 0xc2, 0x6b, 0xac, 0xba, 0x6f, 0x19, 0x57, 0xbc, 0xc3, 0x64,
-	0x90, 0x9a, 0x40, 0xfb, 0x19, 0x58, 0x49, 0x16, 0x11, 0x69, 0x91, 0x89, 0x8c, 0xa9, 0xbc, 0x94,
-	0x8d, 0xcc, 0x25, 0xa5, 0x7c, 0xfe, 0x25, 0xc5, 0xfe, 0x8b, 0x38, 0x2f, 0xf2, 0x99, 0x64, 0x15,
-	0xea, 0x22, 0xfa, 0xcf, 0x3d, 0x57, 0x87, 0x50, 0x34, 0x0f, 0x5c, 0x72, 0x03, 0x20, 0x92, 0x2b,
-	0x23, 0xc6, 0xa4, 0x57, 0x96, 0xea, 0x39, 0x70, 0x45, 0xe4, 0x83, 0xd0, 0xeb, 0x7b, 0x3e, 0x66,
-	0x30, 0x8b, 0xaa, 0x16, 0x79, 0x1f, 0xaa, 0x03, 0x76, 0xc6, 0x06, 0x98, 0x8b, 0x16, 0x92, 0x5b,
-	0xa9, 0x0c, 0xdd, 0x93, 0xa0, 0xff, 0x44, 0x0c, 0x52, 0x89, 0x31, 0xc3, 0x56, 0xcd, 0x84, 0xcd,
-	0x0e, 0x60, 0x79, 0x42, 0xee, 0x22, 0xb7, 0x61, 0xbe, 0xa7, 0x99, 0xfa, 0x69, 0x7a, 0xad, 0xce,
-	0x76, 0x0a, 0xb5, 0xa3, 0xc0, 0xc5, 0x71, 0xb5, 0x1a, 0xaa, 0x69, 0x4e, 0x58, 0xc9, 0x4e, 0xf8,
-	0xe7, 0x12, 0x58, 0x49, 0x92, 0x23, 0x0b, 0x50, 0x4e, 0x02, 0x52, 0xf6, 0x5c, 0x71, 0x8b, 0x17,
-	0x7e, 0x2b, 0x75, 0xf8, 0x4d, 0xd6, 0xc0, 0xf2, 0x38, 0x0b, 0xd1, 0x40, 0xd4, 0x56, 0xa5, 0x69,
-	0x87, 0xc1, 0xcc, 0xd9, 0x0c, 0x33, 0x37, 0x61, 0xde, 0x31, 0xd9, 0x56, 0xbc, 0xed, 0x66, 0x39,
-	0x9a, 0x45, 0xdb, 0x7f, 0x2d, 0xc1, 0x52, 0x21, 0x9d, 0x16, 0xcc, 0x35, 0x16, 0xb5, 0x9c, 0x59,
-	0xd4, 0x36, 0x34, 0x74, 0xe5, 0xa6, 0x02, 0x90, 0xb4, 0xdf, 0x94, 0xc5, 0xbf, 0x2f, 0x89, 0x7d,
-	0x9b, 0xdd, 0xe6, 0x17, 0x37, 0x38, 0x35, 0xaa, 0xf2, 0x72, 0xa3, 0x66, 0x2f, 0x65, 0xd4, 0xd7,
-	0x25, 0x20, 0xc5, 0x73, 0xe4, 0x1b, 0x37, 0xeb, 0x77, 0x65, 0x58, 0x9d, 0x72, 0x9a, 0x5c, 0x6a,
-	0x8d, 0x75, 0xb5, 0xa6, 0xd7, 0x58, 0xb7, 0xa7, 0xae, 0xf1, 0xd4, 0x8d, 0x98, 0x2b, 0xf7, 0x6a,
-	0x17, 0x2e, 0xf7, 0x8a, 0xa1, 0xa8, 0x5f, 0x2a, 0x14, 0xff, 0x2b, 0xc3, 0x62, 0xfe, 0x88, 0xbe,
-	0x78, 0x0c, 0xd6, 0xc0, 0x1a, 0x04, 0x3d, 0x67, 0x20, 0x34, 0xe8, 0xbd, 0x99, 0x74, 0x98, 0xf9,
-	0x61, 0x36, 0x9b, 0x1f, 0x0a, 0xf9, 0xa5, 0x3a, 0x29, 0xbf, 0xac, 0x81, 0xe5, 0x3b, 0x43, 0x16,
-	0x8d, 0x9c, 0x9e, 0x0c, 0x89, 0x45, 0xd3, 0x0e, 0x11, 0x7f, 0x51, 0x47, 0xa0, 0x78, 0x5d, 0xc6,
-	0x5f, 0xb7, 0x89, 0x0d, 0x73, 0x7a, 0x2d, 0xc4, 0x55, 0x0d, 0xab, 0x12, 0x8b, 0x66, 0xfa, 0x4c,
-	0x0c, 0xea, 0xb0, 0xb2, 0x18, 0x9d, 0xc7, 0x1c, 0xd7, 0x0d, 0x59, 0x14, 0x61, 0xe5, 0x60, 0x51,
-	0xdd, 0x24, 0xdf, 0x07, 0xe0, 0x4e, 0xd8, 0x67, 0x1c, 0x5d, 0x6f, 0xe6, 0x9f, 0x06, 0x0f, 0x7c,
-	0xfe, 0x34, 0x3c, 0xe4, 0xa1, 0xe7, 0xf7, 0xa9, 0x01, 0xb4, 0xff, 0x5e, 0x4a, 0xeb, 0xeb, 0xcb,
-	0xc7, 0x5a, 0xd4, 0x26, 0x3b, 0x78, 0xd9, 0x54, 0xb1, 0x4e, 0x3a, 0xc4, 0xd1, 0xe5, 0x0d, 0x05,
-	0xaf, 0x64, 0xa4, 0x65, 0xc3, 0xe0, 0x61, 0xf5, 0xe5, 0xfb, 0xa7, 0x76, 0x29, 0xd2, 0xfc, 0xa9,
-	0x02, 0xab, 0x53, 0xaa, 0x9d, 0xd7, 0xdf, 0xdb, 0x6f, 0x9c, 0x35, 0x49, 0x66, 0xae, 0xe7, 0x32,
-	0x73, 0x0b, 0xea, 0x61, 0xec, 0x8b, 0xcb, 0x87, 0x22, 0x8c, 0x6e, 0x92, 0xb7, 0x00, 0xbe, 0x0a,
-	0xc2, 0x53, 0xcf, 0xef, 0x7f, 0xe2, 0x85, 0x8a, 0x29, 0x46, 0x0f, 0x79, 0x06, 0x80, 0x25, 0x9e,
-	0x7c, 0xec, 0x05, 0x2c, 0x3e, 0xd6, 0xcf, 0xad, 0x0c, 0x65, 0xbf, 0xf1, 0xf4, 0x6b, 0x28, 0x69,
-	0x6f, 0xc2, 0x95, 0xdc, 0xf0, 0x79, 0xf7, 0xb8, 0x79, 0xf3, 0x1e, 0xb7, 0x09, 0x4b, 0x9f, 0x47,
-	0x2c, 0x3c, 0xf0, 0x39, 0xf3, 0xb9, 0x7e, 0x24, 0xbf, 0x03, 0x35, 0x0f, 0x3b, 0xd4, 0x25, 0x6c,
-	0x31, 0x43, 0x58, 0x01, 0x54, 0xe3, 0xf6, 0x0f, 0x61, 0x41, 0x5d, 0xe3, 0xb4, 0xec, 0xdd, 0xec,
-	0x83, 0xbd, 0xf9, 0xf6, 0x28, 0x81, 0x99, 0x77, 0xfb, 0x75, 0x98, 0x33, 0xbb, 0x49, 0x1b, 0xea,
-	0x0c, 0xe9, 0x23, 0xa9, 0xd1, 0xd8, 0x9f, 0xa1, 0xba, 0x63, 0xbb, 0x0a, 0x95, 0x33, 0x67, 0x60,
-	0xff, 0x18, 0x6a, 0xd2, 0x08, 0xe1, 0x55, 0xfa, 0x8c, 0xda, 0xd0, 0xaf, 0xa5, 0x04, 0x66, 0xa3,
-	0xb1, 0xdf, 0x53, 0x37, 0x4d, 0xfc, 0x16, 0x1c, 0x52, 0x2f, 0xa8, 0x15, 0xec, 0x55, 0x2d, 0xdb,
-	0x03, 0x48, 0x0b, 0x3e, 0xb2, 0x03, 0x0b, 0x69, 0xc9, 0x67, 0xd4, 0x9b, 0xd7, 0xb3, 0xf9, 0x35,
-	0x03, 0xa1, 0x39, 0x11, 0x31, 0x95, 0xdc, 0x04, 0x9a, 0xc6, 0xb2, 0x65, 0x3f, 0x83, 0xa6, 0xb1,
-	0xd9, 0xb1, 0x82, 0xd1, 0xaf, 0x47, 0x55, 0xf5, 0x44, 0xb4, 0x82, 0x61, 0xff, 0xc2, 0x19, 0xa8,
-	0x37, 0x22, 0xd5, 0x92, 0x3b, 0x20, 0x14, 0xfd, 0xc9, 0x0e, 0x10, 0xad, 0x8d, 0xdf, 0x54, 0x61,
-	0x49, 0x17, 0x90, 0x5f, 0x6c, 0x1c, 0xb2, 0xf0, 0xcc, 0xeb, 0x31, 0xf2, 0x08, 0x1a, 0x7b, 0x4c,
-	0x3f, 0xb3, 0x14, 0x6e, 0xcf, 0xbb, 0xc3, 0x11, 0x1f, 0xb7, 0xf3, 0x3f, 0xa3, 0xd8, 0x4b, 0xbf,
-	0xfe, 0xc7, 0xbf, 0xff, 0x50, 0x6e, 0x12, 0xab, 0x7b, 0xb6, 0xd1, 0xc5, 0xa5, 0x21, 0x7b, 0x50,
-	0x43, 0xf6, 0x45, 0x17, 0xd1, 0x82, 0x48, 0x9b, 0xa0, 0x96, 0x39, 0x02, 0x42, 0x0b, 0x5e, 0x15,
-	0xa2, 0x7b, 0x25, 0xf2, 0x33, 0xa8, 0xef, 0xfe, 0x92, 0xf5, 0x62, 0xce, 0x88, 0x71, 0x09, 0x2d,
-	0xb0, 0xae, 0x3d, 0x65, 0x1a, 0xfb, 0x3a, 0x6a, 0xbd, 0x66, 0x37, 0x51, 0xab, 0xd4, 0xf4, 0x50,
-	0x11, 0x90, 0xb8, 0x60, 0x6d, 0xc5, 0x3c, 0xc0, 0x1a, 0x8c, 0xb4, 0x0a, 0x64, 0x3b, 0x4f, 0xf7,
-	0x3b, 0xa8, 0xfb, 0xed, 0xf6, 0x8a, 0xd0, 0x8d, 0xfc, 0xe9, 0x3a, 0x31, 0x0f, 0x9e, 0xeb, 0x69,
-	0x24, 0x4d, 0xc9, 0x31, 0x34, 0xc4, 0x2c, 0x22, 0x1b, 0xbf, 0xc2, 0x24, 0xb7, 0x71, 0x92, 0xb7,
-	0xda, 0xd7, 0x30, 0xb8, 0x63, 0xbf, 0x37, 0x71, 0x8e, 0x13, 0x00, 0x31, 0x87, 0x2c, 0x83, 0x5e,
-	0x61, 0x96, 0xef, 0xe0, 0x2c, 0x37, 0xdb, 0xab, 0x62, 0x16, 0xc9, 0xef, 0x89, 0xf3, 0x3c, 0x85,
-	0xda, 0xbe, 0xe3, 0xbb, 0x03, 0x46, 0xf2, 0xeb, 0x37, 0x55, 0xf5, 0x1a, 0xaa, 0x5e, 0xb1, 0x97,
-	0xd2, 0x75, 0xed, 0xbe, 0x40, 0x1d, 0x0f, 0x4b, 0xef, 0x6d, 0xdf, 0xff, 0xe9, 0x7a, 0xdf, 0xe3,
-	0x2f, 0xe2, 0xe3, 0x4e, 0x2f, 0x18, 0x76, 0xf7, 0x50, 0x43, 0x92, 0xc0, 0x8e, 0x82, 0x60, 0x10,
-	0x25, 0x3f, 0x2f, 0xca, 0xdf, 0x01, 0xbb, 0x67, 0x1b, 0x9f, 0x55, 0x8e, 0x6b, 0xf8, 0x7d, 0xff,
-	0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x15, 0x91, 0xe8, 0xd4, 0x7f, 0x1c, 0x00, 0x00,
+	// 2566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0xe2, 0x8f, 0x7d, 0x94, 0x28, 0x69, 0x6c, 0x4b, 0xfc, 0xd2, 0x4a, 0xa2, 0xec,
+	0x37, 0x49, 0x1d, 0xc7, 0xa1, 0x6c, 0xb9, 0xb5, 0x53, 0xa3, 0x4e, 0x2a, 0xc9, 0x96, 0xa9, 0xda,
+	0x8e, 0xed, 0xa1, 0x92, 0x43, 0xdb, 0xd4, 0x58, 0x71, 0x87, 0xab, 0x85, 0x96, 0xbb, 0xec, 0xfe,
+	0x50, 0xc2, 0x5b, 0xd1, 0x4b, 0x7a, 0x6e, 0x73, 0xea, 0xa9, 0x87, 0x5e, 0x7a, 0x69, 0xff, 0x85,
+	0x02, 0x45, 0xaf, 0xbd, 0x14, 0x28, 0xd0, 0x63, 0x81, 0x16, 0x28, 0xfa, 0x57, 0x14, 0xf3, 0x6b,
+	0x77, 0x66, 0x49, 0x4a, 0x96, 0x0d, 0xa3, 0x17, 0x9b, 0x6f, 0xde, 0xe7, 0xbd, 0x79, 0xef, 0xcd,
+	0x9b, 0x37, 0x6f, 0x66, 0x05, 0x2b, 0x27, 0x5b, 0x9b, 0xf1, 0xb1, 0x3d, 0x18, 0x84, 0xbe, 0xd3,
+	0x19, 0x45, 0x61, 0x12, 0xa2, 0x3a, 0xfb, 0xaf, 0x73, 0xb2, 0xd5, 0x5e, 0x77, 0xc3, 0xd0, 0xf5,
+	0xc9, 0xa6, 0x3d, 0xf2, 0x36, 0xed, 0x20, 0x08, 0x13, 0x3b, 0xf1, 0xc2, 0x20, 0xe6, 0xb8, 0xf6,
+	0x5b, 0x82, 0xcb, 0xa8, 0xc3, 0x74, 0xb0, 0x99, 0x78, 0x43, 0x12, 0x27, 0xf6, 0x70, 0x24, 0x00,
+	0x97, 0x8b, 0x00, 0x32, 0x1c, 0x25, 0x63, 0xc1, 0x5c, 0x21, 0x41, 0x3a, 0x8c, 0x37, 0xd9, 0xbf,
+	0x7c, 0xc8, 0xba, 0x05, 0x8b, 0xbd, 0xc4, 0x4e, 0x08, 0x26, 0xf1, 0x28, 0x0c, 0x62, 0x82, 0xde,
+	0x85, 0x4a, 0x4c, 0x07, 0x5a, 0xc6, 0x86, 0x71, 0xa5, 0xb1, 0xb5, 0xd4, 0x91, 0x96, 0x75, 0x38,
+	0x8e, 0x73, 0xad, 0x75, 0xa8, 0x67, 0x22, 0xcb, 0x50, 0x1e, 0xc6, 0x2e, 0x13, 0x30, 0x31, 0xfd,
+	0x69, 0xbd, 0x01, 0x35, 0x4c, 0x7e, 0x9a, 0x92, 0x38, 0x41, 0x08, 0xe6, 0x03, 0x7b, 0x48, 0x04,
+	0x97, 0xfd, 0xb6, 0xfe, 0x31, 0x0f, 0x15, 0xa6, 0x0d, 0x7d, 0x1b, 0xe0, 0x30, 0xf5, 0x7c, 0xa7,
+	0xa7, 0x4c, 0x79, 0x31, 0x9f, 0x72, 0x27, 0xe3, 0x61, 0x05, 0x87, 0x6e, 0x43, 0xc3, 0x21, 0x23,
+	0x3f, 0x1c, 0x73, 0xb1, 0x12, 0x13, 0xbb, 0x94, 0x8b, 0xdd, 0xcb, 0x99, 0x58, 0x45, 0xa2, 0x87,
+	0xd0, 0x1c, 0x84, 0xd1, 0x97, 0x76, 0xe4, 0x10, 0xe7, 0x69, 0x18, 0x25, 0x71, 0xab, 0xbc, 0x51,
+	0xbe, 0xd2, 0xd8, 0xfa, 0xff, 0x82, 0x97, 0x9d, 0x3d, 0x0d, 0x75, 0x3f, 0x48, 0xa2, 0x31, 0x2e,
+	0x88, 0xa2, 0x3d, 0x58, 0xa6, 0xb1, 0x48, 0xe3, 0xdd, 0x23, 0xd2, 0x3f, 0xe6, 0xa6, 0xcc, 0x33,
+	0x53, 0xda, 0xba, 0x3a, 0x15, 0x81, 0x27, 0x64, 0xd0, 0x5d, 0x58, 0x1c, 0x78, 0x3e, 0xe9, 0x8d,
+	0x83, 0x3e, 0x57, 0x52, 0x61, 0x4a, 0xd6, 0x72, 0x25, 0x7b, 0x2a, 0x1b, 0xeb, 0x68, 0xd4, 0x83,
+	0x0b, 0x0e, 0x39, 0x4c, 0x5d, 0xd7, 0x0b, 0xdc, 0xdd, 0x30, 0x48, 0x6c, 0x2f, 0x20, 0x51, 0xdc,
+	0xaa, 0x32, 0xc7, 0xde, 0x56, 0x83, 0x52, 0x04, 0xdd, 0x3f, 0x21, 0x41, 0x82, 0xa7, 0x49, 0xa3,
+	0x0e, 0xd4, 0x87, 0x24, 0xb1, 0x1d, 0x3b, 0xb1, 0x5b, 0x35, 0x66, 0x0e, 0xca, 0x35, 0x3d, 0x16,
+	0x1c, 0x9c, 0x61, 0xd0, 0x0d, 0x30, 0x13, 0x12, 0x27, 0xdc, 0xfe, 0x3a, 0x13, 0xb8, 0x90, 0x0b,
+	0x1c, 0x48, 0x16, 0xce, 0x51, 0xed, 0x2f, 0xe0, 0xc2, 0x94, 0x28, 0xd3, 0x64, 0x3a, 0x26, 0x63,
+	0x96, 0x0a, 0x15, 0x4c, 0x7f, 0xa2, 0xeb, 0x50, 0x39, 0xb1, 0xfd, 0x54, 0xae, 0xb3, 0x12, 0x5c,
+	0x2a, 0x26, 0x74, 0x70, 0x5f, 0x38, 0xf0, 0x4e, 0xe9, 0x23, 0xc3, 0xfa, 0x75, 0x09, 0xea, 0xd2,
+	0x50, 0xf4, 0x21, 0x54, 0x58, 0xfa, 0xb4, 0x8c, 0x62, 0x68, 0x59, 0x86, 0x65, 0x0e, 0x71, 0x14,
+	0xba, 0x0e, 0x55, 0x9e, 0x35, 0x62, 0xca, 0x56, 0x31, 0xb5, 0x32, 0x01, 0x81, 0x43, 0x57, 0x61,
+	0x9e, 0x7a, 0xd6, 0x2a, 0x33, 0xfc, 0xaa, 0xee, 0x7a, 0x86, 0x66, 0x18, 0xb4, 0x03, 0x60, 0x3b,
+	0x8e, 0x47, 0xb7, 0xb5, 0xed, 0xb7, 0xfa, 0x6c, 0x9d, 0xac, 0xc9, 0xe8, 0x76, 0xb6, 0x33, 0x10,
+	0xcf, 0x3f, 0x45, 0xaa, 0x7d, 0x17, 0x96, 0x0a, 0x6c, 0x35, 0x70, 0x26, 0x0f, 0xdc, 0x45, 0x35,
+	0x70, 0xa6, 0x1a, 0x9c, 0xaf, 0xcb, 0xb0, 0xa8, 0x79, 0x8e, 0xae, 0xc1, 0x4a, 0x90, 0x0e, 0x0f,
+	0x49, 0xf4, 0x64, 0xb0, 0x1d, 0x25, 0xde, 0xc0, 0xee, 0x27, 0xb1, 0x58, 0x84, 0x49, 0x06, 0xfa,
+	0x3e, 0xd4, 0x59, 0xa4, 0x68, 0xa2, 0x95, 0x98, 0x03, 0xef, 0xcc, 0x08, 0x69, 0x67, 0x7f, 0x68,
+	0xbb, 0x64, 0x87, 0x83, 0x71, 0x26, 0xc5, 0x02, 0x36, 0x1e, 0x11, 0x16, 0xb0, 0x66, 0x16, 0x30,
+	0x5e, 0x99, 0x18, 0xfa, 0x60, 0x3c, 0x22, 0x98, 0x61, 0xd0, 0x83, 0x29, 0x01, 0xfb, 0xd6, 0xac,
+	0xf9, 0x4e, 0x8b, 0x1a, 0x86, 0x05, 0xd5, 0x1c, 0x74, 0x4d, 0x18, 0x61, 0x30, 0x23, 0x5a, 0x93,
+	0x46, 0x90, 0x48, 0x31, 0xe3, 0x22, 0x54, 0xfa, 0x61, 0x1a, 0x24, 0x2c, 0x9c, 0x15, 0xcc, 0x89,
+	0x57, 0x5d, 0x89, 0x6f, 0x0c, 0x58, 0x50, 0x73, 0x04, 0xdd, 0x86, 0x1a, 0xa5, 0x69, 0x64, 0x0d,
+	0xe6, 0xe9, 0x1b, 0xd3, 0x93, 0xa9, 0xc3, 0x51, 0x58, 0xa2, 0xdb, 0x0f, 0xa1, 0xca, 0x7f, 0xa2,
+	0x0f, 0x34, 0xb7, 0xd6, 0x34, 0xb7, 0x38, 0xe4, 0x2c, 0xaf, 0xac, 0xbf, 0x19, 0xd0, 0xd4, 0x53,
+	0x1d, 0x7d, 0x02, 0x26, 0x4f, 0xf6, 0xdc, 0xb4, 0xb7, 0x67, 0xed, 0x0b, 0x41, 0x92, 0x08, 0xe7,
+	0x32, 0x68, 0x0b, 0x6a, 0x7d, 0x3f, 0xa5, 0xd3, 0xb7, 0x4a, 0x53, 0x02, 0xbe, 0xeb, 0xa7, 0x99,
+	0x69, 0x12, 0xd8, 0x7e, 0x02, 0x75, 0xa9, 0x0a, 0x7d, 0xa8, 0xb9, 0xf5, 0x7f, 0x9a, 0xb0, 0x04,
+	0x9d, 0xe9, 0xd8, 0xbf, 0x0d, 0x80, 0xfc, 0x54, 0x41, 0xdb, 0x60, 0xda, 0x4a, 0xba, 0x17, 0xce,
+	0x82, 0x1c, 0xd8, 0xc9, 0x72, 0x9f, 0x67, 0x55, 0x2e, 0x85, 0x36, 0xa0, 0x61, 0xa7, 0x49, 0x78,
+	0x10, 0x79, 0xae, 0x2b, 0x5c, 0xab, 0x63, 0x75, 0x08, 0xdd, 0x06, 0x10, 0x45, 0x3f, 0x74, 0x64,
+	0xc6, 0xeb, 0xab, 0xd2, 0xcb, 0xd8, 0x58, 0x81, 0xb6, 0xbf, 0x07, 0x4d, 0x7d, 0xde, 0x73, 0xa5,
+	0xd6, 0x8f, 0xc1, 0xcc, 0x0a, 0x2f, 0x5a, 0x85, 0x2a, 0x57, 0x2c, 0x64, 0x05, 0x55, 0xb0, 0xad,
+	0xf4, 0xc2, 0xb6, 0x59, 0x3f, 0x33, 0xa0, 0xa1, 0x9c, 0xb3, 0x33, 0x27, 0x78, 0x7d, 0xe1, 0xb1,
+	0xfe, 0x63, 0xc0, 0x72, 0xf1, 0x7c, 0x9d, 0x69, 0xc7, 0x03, 0x30, 0x23, 0x12, 0x87, 0x69, 0xd4,
+	0x27, 0xb2, 0x66, 0xbd, 0x3f, 0xfb, 0x98, 0xee, 0x60, 0x89, 0x15, 0xeb, 0x9d, 0xc9, 0xbe, 0xd2,
+	0x6a, 0xea, 0x5a, 0xcf, 0xb5, 0x9a, 0xfb, 0xb0, 0xa8, 0xb5, 0x01, 0x2f, 0x1f, 0x70, 0xeb, 0x8f,
+	0x75, 0xa8, 0xb0, 0xf3, 0x12, 0x7d, 0x04, 0x66, 0xd6, 0x40, 0x8a, 0xb3, 0xb1, 0xdd, 0xe1, 0x1d,
+	0x64, 0x47, 0x76, 0x90, 0x9d, 0x03, 0x89, 0xc0, 0x39, 0x18, 0x35, 0xa1, 0xe4, 0x39, 0xad, 0xe5,
+	0x0d, 0xe3, 0x4a, 0x19, 0x97, 0x3c, 0x07, 0xdd, 0x04, 0x93, 0x36, 0x03, 0x4c, 0x6d, 0xab, 0x54,
+	0x6c, 0x00, 0x1e, 0x4b, 0x56, 0x77, 0x0e, 0xe7, 0x38, 0xd4, 0x85, 0x65, 0xd9, 0x07, 0x3f, 0x0a,
+	0x5d, 0x2e, 0x5b, 0x9e, 0xe8, 0xa0, 0x0a, 0x88, 0xee, 0x1c, 0x9e, 0x90, 0x42, 0xcf, 0xe0, 0x82,
+	0x3d, 0x1a, 0xf9, 0x5e, 0x9f, 0x75, 0xcb, 0x99, 0x32, 0xde, 0x8e, 0x29, 0x15, 0x74, 0x7b, 0x12,
+	0xd4, 0x9d, 0xc3, 0xd3, 0x64, 0xa9, 0x47, 0x89, 0x1d, 0x1f, 0x73, 0x45, 0x95, 0x89, 0x96, 0x46,
+	0xb2, 0xa8, 0x47, 0x19, 0x0e, 0x3d, 0x84, 0x15, 0xde, 0xa7, 0xa6, 0x87, 0xb9, 0x70, 0x95, 0x09,
+	0x5f, 0x2e, 0xd6, 0x15, 0x05, 0xd2, 0x9d, 0xc3, 0x93, 0x72, 0xe8, 0x53, 0x40, 0xa2, 0x79, 0x55,
+	0xb5, 0xf1, 0x76, 0x6c, 0x7d, 0xa2, 0xdb, 0xd5, 0xd5, 0x4d, 0x91, 0x44, 0x77, 0xc0, 0x1c, 0x85,
+	0x51, 0xc2, 0xd5, 0xd4, 0xcf, 0x6a, 0xa6, 0xa8, 0x63, 0x19, 0x1c, 0x7d, 0x01, 0x6b, 0x6a, 0xe3,
+	0xaa, 0x1a, 0x64, 0x6e, 0x18, 0xfa, 0x59, 0xd0, 0x9b, 0x0e, 0xec, 0xce, 0xe1, 0x59, 0x3a, 0xd0,
+	0x27, 0x79, 0x0f, 0xcc, 0x95, 0xc2, 0xac, 0x1e, 0x58, 0xaa, 0xd2, 0xf1, 0xd4, 0x3e, 0x67, 0x7a,
+	0x83, 0xdb, 0x6a, 0x6c, 0x18, 0x2f, 0xd4, 0x09, 0x53, 0xfb, 0x66, 0xe8, 0xa0, 0x99, 0x9a, 0x90,
+	0x68, 0xe8, 0x05, 0x2c, 0x47, 0xb8, 0xde, 0x85, 0x62, 0x04, 0x0f, 0x0a, 0x08, 0x9a, 0xa9, 0x45,
+	0x29, 0xba, 0x08, 0x09, 0x89, 0xc5, 0x22, 0x2c, 0x4e, 0xaa, 0x88, 0x93, 0x42, 0xcc, 0x72, 0x38,
+	0xda, 0x81, 0x66, 0xdf, 0xee, 0x1f, 0x91, 0xc7, 0x5e, 0x1c, 0x73, 0x05, 0xcd, 0x62, 0x7f, 0xba,
+	0xab, 0xf1, 0xbb, 0x73, 0xb8, 0x20, 0x81, 0x6e, 0x01, 0x38, 0x91, 0x37, 0x10, 0x06, 0x2c, 0x15,
+	0x6f, 0x5c, 0xf7, 0x32, 0x5e, 0x77, 0x0e, 0x2b, 0xc8, 0x9d, 0x05, 0x00, 0x42, 0x7f, 0x3c, 0xa7,
+	0x87, 0xab, 0xf5, 0x19, 0x2c, 0x17, 0xbd, 0x9d, 0x59, 0x90, 0xde, 0x87, 0x32, 0x89, 0xa2, 0x56,
+	0xa9, 0xb8, 0xa2, 0xdb, 0x7d, 0x2a, 0x6b, 0x1f, 0xfa, 0xe4, 0x7e, 0x14, 0x61, 0x8a, 0xa1, 0xdd,
+	0xd0, 0xa2, 0x36, 0x8c, 0x6e, 0x40, 0x8d, 0x44, 0x11, 0x2b, 0xb5, 0xc6, 0xe9, 0xa5, 0x56, 0xe2,
+	0x50, 0x0b, 0x6a, 0x43, 0x12, 0xc7, 0xb6, 0x2b, 0xab, 0xa8, 0x24, 0xd1, 0x2d, 0x68, 0xc4, 0xa9,
+	0xeb, 0x92, 0x98, 0xce, 0x20, 0xef, 0x7e, 0x8a, 0xf3, 0xbd, 0x8c, 0x89, 0x55, 0xa0, 0xf5, 0x0c,
+	0xcc, 0xac, 0x82, 0xd1, 0x12, 0x4d, 0x68, 0xf5, 0x16, 0x5e, 0x72, 0x42, 0xbb, 0x30, 0x95, 0xce,
+	0xbe, 0x30, 0x59, 0xbf, 0xa3, 0x67, 0x57, 0xb1, 0x8a, 0xad, 0x41, 0x8d, 0xae, 0xfc, 0x73, 0xcf,
+	0x91, 0x21, 0xa4, 0xe4, 0xbe, 0x83, 0xde, 0x00, 0x88, 0xd3, 0x43, 0xc9, 0xe3, 0x5e, 0x99, 0x62,
+	0x64, 0xdf, 0xa1, 0x91, 0x0f, 0x23, 0xcf, 0xf5, 0x02, 0x56, 0x3d, 0x4d, 0x2c, 0x28, 0xf4, 0x01,
+	0x54, 0x7c, 0x72, 0x42, 0x7c, 0x56, 0x07, 0x9b, 0x5b, 0x97, 0xb4, 0xd0, 0x3d, 0x0a, 0xdd, 0x47,
+	0x94, 0x89, 0x39, 0x46, 0x0d, 0x5b, 0x45, 0x0b, 0x9b, 0x15, 0xc2, 0x85, 0x29, 0x75, 0x13, 0xbd,
+	0x03, 0x8b, 0x7d, 0xb9, 0x4b, 0x3e, 0xcd, 0xaf, 0xf8, 0xfa, 0x20, 0x55, 0x3b, 0x0a, 0x1d, 0xc6,
+	0x17, 0xab, 0x21, 0x48, 0x75, 0xc2, 0xb2, 0x3e, 0xe1, 0x6f, 0x0d, 0x30, 0xb3, 0x02, 0x2b, 0x8e,
+	0x1a, 0xae, 0x9c, 0x1e, 0x35, 0x08, 0xe6, 0xa9, 0xdf, 0x42, 0x1d, 0xfb, 0x8d, 0xd6, 0xc1, 0xf4,
+	0x12, 0x12, 0x31, 0x03, 0x99, 0xb6, 0x0a, 0xce, 0x07, 0x94, 0xcc, 0x9c, 0xd7, 0x32, 0xf3, 0x2e,
+	0x2c, 0xda, 0x6a, 0xb6, 0x4d, 0xde, 0xbc, 0xf5, 0x1c, 0xd5, 0xd1, 0xd6, 0x1f, 0x0c, 0x58, 0x99,
+	0x28, 0xe5, 0x13, 0xe6, 0x2a, 0x8b, 0x5a, 0xd2, 0x16, 0xb5, 0x0d, 0x75, 0xd9, 0x45, 0x8a, 0x00,
+	0x64, 0xf4, 0xeb, 0xb2, 0xf8, 0x97, 0x06, 0x2c, 0x17, 0x4b, 0xcc, 0x8b, 0x1b, 0x9c, 0x1b, 0x55,
+	0x3e, 0xdd, 0xa8, 0xf9, 0x73, 0x19, 0xf5, 0x8d, 0x01, 0x68, 0xf2, 0x0c, 0xfb, 0x9f, 0x9b, 0xf5,
+	0x8b, 0x12, 0xac, 0xcd, 0x38, 0xc9, 0xce, 0xb5, 0xc6, 0xb2, 0x73, 0x94, 0x6b, 0x2c, 0xe9, 0x99,
+	0x6b, 0x3c, 0x73, 0x23, 0x16, 0x5a, 0xcf, 0xea, 0x0b, 0xb7, 0x9e, 0x93, 0xa1, 0xa8, 0x9d, 0x2b,
+	0x14, 0x5f, 0xcf, 0xc3, 0x72, 0xb1, 0x3d, 0x78, 0xf1, 0x18, 0xac, 0x83, 0xe9, 0x87, 0x7d, 0xdb,
+	0xa7, 0x1a, 0xe4, 0xde, 0xcc, 0x06, 0xd4, 0xfa, 0x30, 0xaf, 0xd7, 0x87, 0x89, 0xfa, 0x52, 0x99,
+	0x56, 0x5f, 0xd6, 0xc1, 0xa4, 0x6f, 0x8a, 0xf1, 0xc8, 0xee, 0xf3, 0x90, 0x98, 0x38, 0x1f, 0xa0,
+	0xf1, 0xa7, 0x3d, 0x0c, 0x13, 0xaf, 0xf1, 0xf8, 0x4b, 0x1a, 0x59, 0xb0, 0x20, 0xd7, 0x82, 0x5e,
+	0x1b, 0x59, 0x47, 0x64, 0x62, 0x6d, 0x4c, 0xc5, 0x30, 0x1d, 0xa6, 0x8e, 0x91, 0x75, 0xcc, 0x76,
+	0x9c, 0x88, 0xc4, 0x31, 0xeb, 0x5a, 0x4c, 0x2c, 0x49, 0xf4, 0x1d, 0x80, 0xc4, 0x8e, 0x5c, 0x92,
+	0x30, 0xd7, 0x1b, 0xc5, 0x67, 0xca, 0xfd, 0x20, 0x79, 0x12, 0xf5, 0x92, 0xc8, 0x0b, 0x5c, 0xac,
+	0x00, 0x95, 0xc4, 0x58, 0xd0, 0x12, 0x63, 0x1d, 0xcc, 0xc3, 0x71, 0x42, 0xe2, 0x9e, 0x6c, 0x1d,
+	0xca, 0x38, 0x1f, 0xa0, 0xe1, 0x62, 0x04, 0x26, 0x7d, 0xe2, 0x9d, 0x10, 0x87, 0xf5, 0x06, 0x65,
+	0xac, 0x0f, 0x4e, 0x66, 0xc2, 0xd2, 0xb9, 0x32, 0xe1, 0x4f, 0x46, 0x7e, 0x0d, 0x39, 0x7f, 0x1a,
+	0xd0, 0x96, 0x6d, 0x97, 0xdd, 0xc9, 0x45, 0x1a, 0x64, 0x03, 0xf4, 0x54, 0xf5, 0xe8, 0xd3, 0x8c,
+	0x48, 0x02, 0x4e, 0x28, 0x91, 0xa8, 0x9c, 0xbe, 0xb5, 0xab, 0xe7, 0xf2, 0xe2, 0xcf, 0x06, 0x34,
+	0xf5, 0x46, 0x69, 0xf6, 0xd1, 0xab, 0x56, 0xe9, 0x52, 0xa1, 0x4a, 0x23, 0x98, 0x3f, 0xb2, 0xe3,
+	0x23, 0xb1, 0xb3, 0xd9, 0x6f, 0x9a, 0x31, 0xa3, 0x88, 0x9c, 0x78, 0x61, 0x1a, 0x77, 0x29, 0x8f,
+	0xfb, 0xa3, 0x8d, 0x51, 0xb7, 0x22, 0x62, 0xc7, 0x61, 0x20, 0xdd, 0xe2, 0x14, 0xea, 0x40, 0xc5,
+	0xf1, 0x06, 0x03, 0xf9, 0x78, 0x5b, 0x6c, 0xeb, 0xf6, 0x83, 0x51, 0x9a, 0xdc, 0xf3, 0x06, 0x03,
+	0xcc, 0x61, 0xd6, 0x53, 0x68, 0xea, 0x0c, 0x6a, 0xd1, 0xb1, 0x17, 0x48, 0x1f, 0xd8, 0xef, 0xec,
+	0x05, 0xbe, 0x94, 0xbf, 0xc0, 0x53, 0x0b, 0xfa, 0x47, 0x76, 0x90, 0x1d, 0xbd, 0x82, 0xb2, 0xfe,
+	0x62, 0x00, 0xe4, 0x2d, 0xe0, 0xa9, 0x51, 0xc9, 0xea, 0x5a, 0xa9, 0x50, 0xd7, 0xb4, 0x1d, 0x59,
+	0x2e, 0xee, 0xc8, 0x59, 0x55, 0x6f, 0x15, 0xaa, 0x03, 0x8f, 0xf8, 0x0e, 0x5d, 0xea, 0x32, 0x1d,
+	0xe7, 0xd4, 0xab, 0x2e, 0xf5, 0x6f, 0xca, 0xb0, 0x36, 0xa3, 0xdf, 0x7f, 0xf5, 0x13, 0xe6, 0xb5,
+	0xd7, 0xae, 0x2c, 0xf3, 0x6a, 0x85, 0xcc, 0x6b, 0x41, 0x2d, 0x4a, 0x03, 0x7a, 0x1d, 0x17, 0x65,
+	0x4b, 0x92, 0xe8, 0x4d, 0x80, 0x2f, 0xc3, 0xe8, 0xd8, 0x0b, 0xdc, 0x7b, 0x5e, 0x24, 0xea, 0x95,
+	0x32, 0x82, 0x9e, 0x01, 0xb0, 0x4b, 0x0e, 0xff, 0xfc, 0x01, 0x2c, 0xd1, 0x6e, 0x9c, 0x79, 0x37,
+	0xea, 0xdc, 0xcb, 0x64, 0xc4, 0xb3, 0x6a, 0xae, 0x84, 0x3e, 0x81, 0x16, 0xd8, 0x67, 0xbd, 0x6c,
+	0x2c, 0xaa, 0x2f, 0x1b, 0x77, 0x61, 0xe5, 0xb3, 0x98, 0x44, 0xfb, 0x41, 0x42, 0x82, 0x44, 0x7e,
+	0x36, 0xba, 0x02, 0x55, 0x8f, 0x0d, 0x88, 0x67, 0x89, 0x65, 0xad, 0x6c, 0x52, 0xa0, 0xe0, 0x5b,
+	0x1f, 0x43, 0x53, 0x3c, 0x6c, 0x48, 0xd9, 0x6b, 0xfa, 0x27, 0x2c, 0xf5, 0x35, 0x9e, 0x03, 0xb5,
+	0x2f, 0x59, 0x37, 0x60, 0x41, 0x1d, 0x46, 0x6d, 0xa8, 0x11, 0x96, 0x3e, 0x3c, 0x35, 0xea, 0xdd,
+	0x39, 0x2c, 0x07, 0x76, 0x2a, 0x50, 0x3e, 0xb1, 0x7d, 0xeb, 0xf7, 0x06, 0x2c, 0xb2, 0xb0, 0xc4,
+	0x72, 0x4a, 0x9a, 0xbd, 0x51, 0x38, 0xdc, 0xe7, 0x32, 0x65, 0x2c, 0x28, 0xea, 0x35, 0xbd, 0x2f,
+	0xf1, 0x17, 0x27, 0x13, 0x73, 0x82, 0xae, 0x7b, 0xfe, 0xea, 0x58, 0x66, 0x9c, 0x7c, 0x80, 0xae,
+	0x2d, 0xcf, 0x3b, 0xba, 0x45, 0x28, 0x4f, 0x92, 0xf4, 0xbb, 0xd7, 0xd0, 0x0b, 0x7a, 0xe4, 0x84,
+	0x44, 0x5e, 0x32, 0x6e, 0x55, 0x4e, 0xeb, 0xea, 0x55, 0xa4, 0xf5, 0x77, 0x03, 0x96, 0x1f, 0x85,
+	0xee, 0x9e, 0xe7, 0x27, 0x79, 0x98, 0xda, 0x50, 0x1f, 0x7a, 0x01, 0x43, 0x8b, 0x85, 0xca, 0x68,
+	0xf4, 0x71, 0xb6, 0x1b, 0xf9, 0x53, 0xd9, 0x7b, 0x79, 0x0c, 0x8b, 0x7a, 0x3a, 0x7b, 0x0c, 0xc8,
+	0xd3, 0x42, 0xee, 0xda, 0x8b, 0x50, 0x89, 0x88, 0x4b, 0xbe, 0x12, 0x1b, 0x86, 0x13, 0x2c, 0x6b,
+	0x49, 0x9c, 0x84, 0x11, 0xdf, 0x2f, 0x75, 0x2c, 0xc9, 0xf6, 0x77, 0xa1, 0xa1, 0xa8, 0x39, 0xd7,
+	0xc3, 0xd8, 0x0f, 0xa0, 0xca, 0x33, 0x82, 0x62, 0xf2, 0xaf, 0x3c, 0x75, 0xf9, 0x31, 0x07, 0xc1,
+	0x7c, 0x3c, 0x0e, 0xfa, 0xe2, 0x21, 0x8c, 0xfd, 0xa6, 0xcb, 0x25, 0x3e, 0xf0, 0x94, 0xd9, 0xa8,
+	0xa0, 0x2c, 0x0f, 0x20, 0xbf, 0x03, 0xa2, 0x5d, 0x68, 0xe6, 0xb7, 0x40, 0xe5, 0x0a, 0x7a, 0x59,
+	0x6f, 0xb9, 0x34, 0x08, 0x2e, 0x88, 0xd0, 0xa9, 0x78, 0x45, 0x92, 0x35, 0x85, 0x53, 0xd6, 0x33,
+	0x68, 0x28, 0xe7, 0x3f, 0xb5, 0x32, 0x7b, 0xdc, 0xae, 0x88, 0x17, 0xec, 0x55, 0xb6, 0x07, 0x3e,
+	0xb7, 0x7d, 0xf1, 0x84, 0x2d, 0x28, 0x5e, 0x8e, 0x22, 0x3a, 0x9e, 0x95, 0x23, 0x4a, 0x6d, 0xfd,
+	0xab, 0x02, 0x2b, 0xf2, 0x4e, 0xf9, 0xf9, 0x56, 0x8f, 0x44, 0x27, 0x5e, 0x9f, 0xa0, 0x3d, 0xa8,
+	0x3f, 0x20, 0xf2, 0x15, 0x78, 0xe2, 0x71, 0xef, 0x3e, 0xfd, 0x3c, 0xdc, 0x2e, 0x7e, 0xe5, 0xb5,
+	0x56, 0x7e, 0xfe, 0xd7, 0x7f, 0xfe, 0xaa, 0xd4, 0x40, 0xe6, 0x26, 0xfd, 0x56, 0xcd, 0x64, 0xbb,
+	0x50, 0xe5, 0x39, 0x8f, 0x94, 0xda, 0xab, 0xed, 0x82, 0xf6, 0x52, 0x81, 0x61, 0x21, 0xa6, 0x66,
+	0x01, 0x01, 0x55, 0xc3, 0x9e, 0x0f, 0xe2, 0xeb, 0x06, 0xfa, 0x11, 0xd4, 0xee, 0x7f, 0x45, 0xfa,
+	0x69, 0x42, 0x90, 0xf2, 0x28, 0x36, 0x51, 0x03, 0xda, 0x33, 0xac, 0xb5, 0x2e, 0x33, 0xad, 0x97,
+	0xac, 0x06, 0xd3, 0xca, 0x35, 0xdd, 0x11, 0xe5, 0x00, 0x39, 0x60, 0x6e, 0xa7, 0x49, 0xc8, 0xee,
+	0x65, 0xa8, 0x35, 0xb1, 0xf5, 0xcf, 0xd2, 0xfd, 0x2e, 0xd3, 0xfd, 0x56, 0x7b, 0x95, 0xea, 0x66,
+	0x09, 0xb4, 0x69, 0xa7, 0x49, 0xf8, 0x5c, 0x4e, 0xc3, 0x8b, 0x06, 0x3a, 0x84, 0x3a, 0x9d, 0x85,
+	0xb6, 0x41, 0x2f, 0x31, 0xc9, 0x3b, 0x6c, 0x92, 0x37, 0x85, 0xb2, 0xf6, 0x25, 0x16, 0xe4, 0x71,
+	0xd0, 0xd7, 0xa6, 0x42, 0x03, 0x00, 0x3a, 0x07, 0xbf, 0x1a, 0xbd, 0xc4, 0x2c, 0xef, 0xb1, 0x59,
+	0x36, 0xda, 0x6b, 0x54, 0x3d, 0x4f, 0xf0, 0xa9, 0xbe, 0xfc, 0x04, 0x16, 0x7a, 0x24, 0xc9, 0xb6,
+	0x35, 0x6a, 0xcf, 0xde, 0xeb, 0x33, 0xe7, 0x6a, 0xb3, 0xb9, 0x2e, 0xb6, 0x97, 0xe8, 0x5c, 0x7e,
+	0xe8, 0xc6, 0x9b, 0x03, 0x26, 0x77, 0xc7, 0xb8, 0x8a, 0x9e, 0x40, 0xb5, 0x6b, 0x07, 0x8e, 0x4f,
+	0x50, 0x31, 0x3f, 0x66, 0xaa, 0x5b, 0x67, 0xea, 0x56, 0xef, 0x18, 0x57, 0xad, 0x95, 0x3c, 0x75,
+	0x36, 0x8f, 0x98, 0x9a, 0x9d, 0x9b, 0x3f, 0xbc, 0xe1, 0x7a, 0xc9, 0x51, 0x7a, 0xd8, 0xe9, 0x87,
+	0xc3, 0xcd, 0x07, 0x4c, 0x43, 0x76, 0x5c, 0x1d, 0x84, 0xa1, 0x1f, 0x67, 0x7f, 0x5e, 0xc1, 0xff,
+	0x0e, 0x62, 0xf3, 0x64, 0xeb, 0x69, 0xf9, 0xb0, 0xca, 0x7e, 0xdf, 0xfc, 0xef, 0x00, 0x56, 0x84,
+	0x6a, 0x34, 0x7f, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2849,8 +3318,8 @@ const _ = grpc.SupportPackageIsVersion6
 type SkaffoldV2ServiceClient interface {
 	// Returns the state of the current Skaffold execution
 	GetState(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*State, error)
-	// Returns all the events of the current Skaffold execution from the start
-	Events(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (SkaffoldV2Service_EventsClient, error)
+	// Returns the events of the current Skaffold execution, optionally filtered and starting from a given event id
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (SkaffoldV2Service_EventsClient, error)
 	// Allows for a single execution of some or all of the phases (build, sync, deploy) in case autoBuild, autoDeploy or autoSync are disabled.
 	Execute(ctx context.Context, in *UserIntentRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Allows for enabling or disabling automatic build trigger
@@ -2859,6 +3328,8 @@ type SkaffoldV2ServiceClient interface {
 	AutoSync(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Allows for enabling or disabling automatic deploy trigger
 	AutoDeploy(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Replaces the filters applied to the container logs, until they are restored
+	SetLogFilter(ctx context.Context, in *LogFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// EXPERIMENTAL. It allows for custom events to be implemented in custom builders for example.
 	Handle(ctx context.Context, in *Event, opts ...grpc.CallOption) (*empty.Empty, error)
 }
@@ -2880,7 +3351,7 @@ func (c *skaffoldV2ServiceClient) GetState(ctx context.Context, in *empty.Empty,
 	return out, nil
 }
 
-func (c *skaffoldV2ServiceClient) Events(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (SkaffoldV2Service_EventsClient, error) {
+func (c *skaffoldV2ServiceClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (SkaffoldV2Service_EventsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_SkaffoldV2Service_serviceDesc.Streams[0], "/proto.v2.SkaffoldV2Service/Events", opts...)
 	if err != nil {
 		return nil, err
@@ -2948,6 +3419,15 @@ func (c *skaffoldV2ServiceClient) AutoDeploy(ctx context.Context, in *TriggerReq
 	return out, nil
 }
 
+func (c *skaffoldV2ServiceClient) SetLogFilter(ctx context.Context, in *LogFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/proto.v2.SkaffoldV2Service/SetLogFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *skaffoldV2ServiceClient) Handle(ctx context.Context, in *Event, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/proto.v2.SkaffoldV2Service/Handle", in, out, opts...)
@@ -2961,8 +3441,8 @@ func (c *skaffoldV2ServiceClient) Handle(ctx context.Context, in *Event, opts ..
 type SkaffoldV2ServiceServer interface {
 	// Returns the state of the current Skaffold execution
 	GetState(context.Context, *empty.Empty) (*State, error)
-	// Returns all the events of the current Skaffold execution from the start
-	Events(*empty.Empty, SkaffoldV2Service_EventsServer) error
+	// Returns the events of the current Skaffold execution, optionally filtered and starting from a given event id
+	Events(*EventsRequest, SkaffoldV2Service_EventsServer) error
 	// Allows for a single execution of some or all of the phases (build, sync, deploy) in case autoBuild, autoDeploy or autoSync are disabled.
 	Execute(context.Context, *UserIntentRequest) (*empty.Empty, error)
 	// Allows for enabling or disabling automatic build trigger
@@ -2971,6 +3451,8 @@ type SkaffoldV2ServiceServer interface {
 	AutoSync(context.Context, *TriggerRequest) (*empty.Empty, error)
 	// Allows for enabling or disabling automatic deploy trigger
 	AutoDeploy(context.Context, *TriggerRequest) (*empty.Empty, error)
+	// Replaces the filters applied to the container logs, until they are restored
+	SetLogFilter(context.Context, *LogFilterRequest) (*empty.Empty, error)
 	// EXPERIMENTAL. It allows for custom events to be implemented in custom builders for example.
 	Handle(context.Context, *Event) (*empty.Empty, error)
 }
@@ -2982,7 +3464,7 @@ type UnimplementedSkaffoldV2ServiceServer struct {
 func (*UnimplementedSkaffoldV2ServiceServer) GetState(ctx context.Context, req *empty.Empty) (*State, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetState not implemented")
 }
-func (*UnimplementedSkaffoldV2ServiceServer) Events(req *empty.Empty, srv SkaffoldV2Service_EventsServer) error {
+func (*UnimplementedSkaffoldV2ServiceServer) Events(req *EventsRequest, srv SkaffoldV2Service_EventsServer) error {
 	return status.Errorf(codes.Unimplemented, "method Events not implemented")
 }
 func (*UnimplementedSkaffoldV2ServiceServer) Execute(ctx context.Context, req *UserIntentRequest) (*empty.Empty, error) {
@@ -2997,6 +3479,9 @@ func (*UnimplementedSkaffoldV2ServiceServer) AutoSync(ctx context.Context, req *
 func (*UnimplementedSkaffoldV2ServiceServer) AutoDeploy(ctx context.Context, req *TriggerRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AutoDeploy not implemented")
 }
+func (*UnimplementedSkaffoldV2ServiceServer) SetLogFilter(ctx context.Context, req *LogFilterRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogFilter not implemented")
+}
 func (*UnimplementedSkaffoldV2ServiceServer) Handle(ctx context.Context, req *Event) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Handle not implemented")
 }
@@ -3024,7 +3509,7 @@ func _SkaffoldV2Service_GetState_Handler(srv interface{}, ctx context.Context, d
 }
 
 func _SkaffoldV2Service_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(empty.Empty)
+	m := new(EventsRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -3116,6 +3601,24 @@ func _SkaffoldV2Service_AutoDeploy_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SkaffoldV2Service_SetLogFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkaffoldV2ServiceServer).SetLogFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v2.SkaffoldV2Service/SetLogFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkaffoldV2ServiceServer).SetLogFilter(ctx, req.(*LogFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SkaffoldV2Service_Handle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Event)
 	if err := dec(in); err != nil {
@@ -3158,6 +3661,10 @@ var _SkaffoldV2Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AutoDeploy",
 			Handler:    _SkaffoldV2Service_AutoDeploy_Handler,
 		},
+		{
+			MethodName: "SetLogFilter",
+			Handler:    _SkaffoldV2Service_SetLogFilter_Handler,
+		},
 		{
 			MethodName: "Handle",
 			Handler:    _SkaffoldV2Service_Handle_Handler,
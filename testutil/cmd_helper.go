@@ -88,6 +88,10 @@ func CmdRunEnv(command string, env []string) *FakeCmd {
 	return newFakeCmd().AndRunEnv(command, env)
 }
 
+func CmdRunOutEnv(command string, output string, env []string) *FakeCmd {
+	return newFakeCmd().AndRunOutEnv(command, output, env)
+}
+
 // CmdRunWithOutput programs the fake runner with a command and expected output
 func CmdRunWithOutput(command, output string) *FakeCmd {
 	return newFakeCmd().AndRunWithOutput(command, output)
@@ -154,6 +158,14 @@ func (c *FakeCmd) AndRunEnv(command string, env []string) *FakeCmd {
 	})
 }
 
+func (c *FakeCmd) AndRunOutEnv(command string, output string, env []string) *FakeCmd {
+	return c.addRun(run{
+		command: command,
+		output:  []byte(output),
+		env:     env,
+	})
+}
+
 func (c *FakeCmd) RunCmdOut(cmd *exec.Cmd) ([]byte, error) {
 	c.timesCalled++
 	command := strings.Join(cmd.Args, " ")